@@ -15,4 +15,7 @@ func main() {
 			return nsxt.Provider()
 		},
 	})
+
+	// Serve returns once terraform shuts the plugin down
+	nsxt.CloseAPITracers()
 }
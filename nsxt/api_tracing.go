@@ -0,0 +1,451 @@
+/* Copyright © 2023 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/core"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/data"
+)
+
+const apiTraceServiceName = "terraform-provider-nsxt"
+
+// OTLP span kinds and status codes
+const (
+	otlpSpanKindInternal = 1
+	otlpSpanKindClient   = 3
+	otlpStatusOk         = 1
+	otlpStatusError      = 2
+)
+
+type apiTraceContextKey string
+
+const (
+	apiTraceOperationKey apiTraceContextKey = "operation"
+	apiTraceAttemptKey   apiTraceContextKey = "attempt"
+)
+
+// Single API call, written as JSON line to trace file
+type apiTraceRecord struct {
+	Time      time.Time `json:"time"`
+	Resource  string    `json:"resource,omitempty"`
+	Operation string    `json:"operation,omitempty"`
+	Method    string    `json:"method"`
+	Path      string    `json:"path"`
+	Status    int       `json:"status"`
+	LatencyMs float64   `json:"latency_ms"`
+	Retry     int       `json:"retry"`
+	RequestID string    `json:"request_id,omitempty"`
+	Error     string    `json:"error,omitempty"`
+}
+
+// Provider operation on a resource or data source, all API calls within the
+// operation share single trace
+type apiTraceOperation struct {
+	resource  string
+	operation string
+	traceID   string
+	spanID    string
+	start     time.Time
+}
+
+type otlpKeyValue struct {
+	Key   string                 `json:"key"`
+	Value map[string]interface{} `json:"value"`
+}
+
+type otlpStatus struct {
+	Code    int    `json:"code"`
+	Message string `json:"message,omitempty"`
+}
+
+type otlpSpan struct {
+	TraceID           string         `json:"traceId"`
+	SpanID            string         `json:"spanId"`
+	ParentSpanID      string         `json:"parentSpanId,omitempty"`
+	Name              string         `json:"name"`
+	Kind              int            `json:"kind"`
+	StartTimeUnixNano string         `json:"startTimeUnixNano"`
+	EndTimeUnixNano   string         `json:"endTimeUnixNano"`
+	Attributes        []otlpKeyValue `json:"attributes"`
+	Status            otlpStatus     `json:"status"`
+}
+
+// Spans are exported in batches by background exporter, with limits similar to
+// OpenTelemetry batch span processor defaults. Spans that do not fit the queue
+// are dropped rather than holding API calls.
+const (
+	apiTraceQueueSize      = 2048
+	apiTraceMaxBatchSize   = 512
+	apiTraceExportInterval = 5 * time.Second
+)
+
+type apiTracer struct {
+	file         *os.File
+	otlpEndpoint string
+	otlpClient   *http.Client
+	spans        chan otlpSpan
+	exportDone   chan struct{}
+	closed       bool
+	lock         sync.Mutex
+}
+
+// Tracers of configured provider instances, to be closed on plugin shutdown
+var openAPITracers struct {
+	tracers []*apiTracer
+	lock    sync.Mutex
+}
+
+func newAPITracer(traceFile string, otlpEndpoint string) (*apiTracer, error) {
+	if traceFile == "" && otlpEndpoint == "" {
+		return nil, nil
+	}
+
+	tracer := &apiTracer{}
+	if traceFile != "" {
+		file, err := os.OpenFile(traceFile, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+		if err != nil {
+			return nil, fmt.Errorf("Failed to open API trace file %s: %v", traceFile, err)
+		}
+		tracer.file = file
+	}
+
+	if otlpEndpoint != "" {
+		tracer.otlpEndpoint = strings.TrimSuffix(otlpEndpoint, "/") + "/v1/traces"
+		tracer.otlpClient = &http.Client{Timeout: 10 * time.Second}
+		tracer.spans = make(chan otlpSpan, apiTraceQueueSize)
+		tracer.exportDone = make(chan struct{})
+		go tracer.export()
+	}
+
+	openAPITracers.lock.Lock()
+	openAPITracers.tracers = append(openAPITracers.tracers, tracer)
+	openAPITracers.lock.Unlock()
+
+	return tracer, nil
+}
+
+// CloseAPITracers exports pending API trace spans and closes API trace files
+// of all configured providers. It is called when the plugin is shut down.
+func CloseAPITracers() {
+	openAPITracers.lock.Lock()
+	tracers := openAPITracers.tracers
+	openAPITracers.tracers = nil
+	openAPITracers.lock.Unlock()
+
+	for _, tracer := range tracers {
+		tracer.close()
+	}
+}
+
+func (t *apiTracer) close() {
+	t.lock.Lock()
+	if t.closed {
+		t.lock.Unlock()
+		return
+	}
+	t.closed = true
+	if t.file != nil {
+		if err := t.file.Close(); err != nil {
+			log.Printf("[WARNING]: Failed to close API trace file: %v", err)
+		}
+		t.file = nil
+	}
+	if t.spans != nil {
+		close(t.spans)
+	}
+	t.lock.Unlock()
+
+	if t.exportDone != nil {
+		// Wait for pending spans to be exported
+		<-t.exportDone
+	}
+}
+
+func newTraceID(length int) string {
+	id := make([]byte, length)
+	_, err := rand.Read(id)
+	if err != nil {
+		log.Printf("[WARNING]: Failed to generate trace ID: %v", err)
+	}
+	return hex.EncodeToString(id)
+}
+
+func otlpStringAttribute(key string, value string) otlpKeyValue {
+	return otlpKeyValue{Key: key, Value: map[string]interface{}{"stringValue": value}}
+}
+
+func otlpIntAttribute(key string, value int) otlpKeyValue {
+	// 64 bit integers are encoded as strings in OTLP JSON
+	return otlpKeyValue{Key: key, Value: map[string]interface{}{"intValue": fmt.Sprintf("%d", value)}}
+}
+
+func otlpTime(t time.Time) string {
+	return fmt.Sprintf("%d", t.UnixNano())
+}
+
+// Queues span for export, without waiting for the collector
+func (t *apiTracer) enqueue(span otlpSpan) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	if t.closed || t.spans == nil {
+		return
+	}
+	select {
+	case t.spans <- span:
+	default:
+		log.Printf("[WARNING]: API trace export queue is full, dropping span %s", span.Name)
+	}
+}
+
+func (t *apiTracer) record(ctx context.Context, record apiTraceRecord, start time.Time) {
+	operation, _ := ctx.Value(apiTraceOperationKey).(*apiTraceOperation)
+	if operation != nil {
+		record.Resource = operation.resource
+		record.Operation = operation.operation
+	}
+
+	t.lock.Lock()
+	if t.file != nil {
+		line, err := json.Marshal(record)
+		if err == nil {
+			_, err = t.file.Write(append(line, '\n'))
+		}
+		if err != nil {
+			log.Printf("[WARNING]: Failed to write API trace: %v", err)
+		}
+	}
+	t.lock.Unlock()
+
+	if t.otlpEndpoint == "" {
+		return
+	}
+
+	span := otlpSpan{
+		SpanID:            newTraceID(8),
+		Name:              fmt.Sprintf("%s %s", record.Method, record.Path),
+		Kind:              otlpSpanKindClient,
+		StartTimeUnixNano: otlpTime(start),
+		EndTimeUnixNano:   otlpTime(record.Time),
+		Attributes: []otlpKeyValue{
+			otlpStringAttribute("http.method", record.Method),
+			otlpStringAttribute("http.target", record.Path),
+			otlpIntAttribute("http.status_code", record.Status),
+			otlpIntAttribute("nsx.retry", record.Retry),
+			otlpStringAttribute("nsx.request_id", record.RequestID),
+		},
+		Status: otlpStatus{Code: otlpStatusOk},
+	}
+	if record.Error != "" || record.Status >= 400 {
+		span.Status = otlpStatus{Code: otlpStatusError, Message: record.Error}
+	}
+	if operation != nil {
+		span.TraceID = operation.traceID
+		span.ParentSpanID = operation.spanID
+	} else {
+		span.TraceID = newTraceID(16)
+	}
+	t.enqueue(span)
+}
+
+// Background exporter, sends queued spans in batches until the tracer is closed
+func (t *apiTracer) export() {
+	defer close(t.exportDone)
+
+	ticker := time.NewTicker(apiTraceExportInterval)
+	defer ticker.Stop()
+
+	var batch []otlpSpan
+	for {
+		select {
+		case span, ok := <-t.spans:
+			if !ok {
+				t.send(batch)
+				return
+			}
+			batch = append(batch, span)
+			if len(batch) >= apiTraceMaxBatchSize {
+				t.send(batch)
+				batch = nil
+			}
+		case <-ticker.C:
+			t.send(batch)
+			batch = nil
+		}
+	}
+}
+
+// Encodes spans as OTLP JSON export request
+func encodeOTLPSpans(spans []otlpSpan) ([]byte, error) {
+	payload := map[string]interface{}{
+		"resourceSpans": []interface{}{
+			map[string]interface{}{
+				"resource": map[string]interface{}{
+					"attributes": []otlpKeyValue{otlpStringAttribute("service.name", apiTraceServiceName)},
+				},
+				"scopeSpans": []interface{}{
+					map[string]interface{}{
+						"scope": map[string]interface{}{"name": apiTraceServiceName},
+						"spans": spans,
+					},
+				},
+			},
+		},
+	}
+
+	return json.Marshal(payload)
+}
+
+// Exports batch of spans to OTLP collector
+func (t *apiTracer) send(spans []otlpSpan) {
+	if len(spans) == 0 {
+		return
+	}
+
+	body, err := encodeOTLPSpans(spans)
+	if err != nil {
+		log.Printf("[WARNING]: Failed to encode API trace spans: %v", err)
+		return
+	}
+
+	response, err := t.otlpClient.Post(t.otlpEndpoint, "application/json", bytes.NewReader(body))
+	if err != nil {
+		log.Printf("[WARNING]: Failed to export API trace spans to %s: %v", t.otlpEndpoint, err)
+		return
+	}
+	response.Body.Close()
+	if response.StatusCode >= 300 {
+		log.Printf("[WARNING]: Failed to export API trace spans to %s: status %d", t.otlpEndpoint, response.StatusCode)
+	}
+}
+
+func startAPITraceOperation(ctx context.Context, m interface{}, resource string, operation string) context.Context {
+	clients, ok := m.(nsxtClients)
+	if !ok || clients.CommonConfig.APITracer == nil {
+		return ctx
+	}
+
+	return context.WithValue(ctx, apiTraceOperationKey, &apiTraceOperation{
+		resource:  resource,
+		operation: operation,
+		traceID:   newTraceID(16),
+		spanID:    newTraceID(8),
+		start:     time.Now(),
+	})
+}
+
+func finishAPITraceOperation(ctx context.Context, m interface{}, failed bool) {
+	clients, ok := m.(nsxtClients)
+	if !ok || clients.CommonConfig.APITracer == nil {
+		return
+	}
+
+	tracer := clients.CommonConfig.APITracer
+	operation, _ := ctx.Value(apiTraceOperationKey).(*apiTraceOperation)
+	if operation == nil || tracer.otlpEndpoint == "" {
+		return
+	}
+
+	span := otlpSpan{
+		TraceID:           operation.traceID,
+		SpanID:            operation.spanID,
+		Name:              fmt.Sprintf("%s %s", operation.resource, operation.operation),
+		Kind:              otlpSpanKindInternal,
+		StartTimeUnixNano: otlpTime(operation.start),
+		EndTimeUnixNano:   otlpTime(time.Now()),
+		Attributes: []otlpKeyValue{
+			otlpStringAttribute("terraform.resource", operation.resource),
+			otlpStringAttribute("terraform.operation", operation.operation),
+		},
+		Status: otlpStatus{Code: otlpStatusOk},
+	}
+	if failed {
+		span.Status.Code = otlpStatusError
+	}
+
+	tracer.enqueue(span)
+}
+
+type tracingTransport struct {
+	tracer *apiTracer
+	next   http.RoundTripper
+}
+
+func newTracingTransport(tracer *apiTracer, next http.RoundTripper) http.RoundTripper {
+	if tracer == nil {
+		return next
+	}
+	if next == nil {
+		next = http.DefaultTransport
+	}
+
+	return &tracingTransport{
+		tracer: tracer,
+		next:   next,
+	}
+}
+
+func (t *tracingTransport) RoundTrip(request *http.Request) (*http.Response, error) {
+	start := time.Now()
+	response, err := t.next.RoundTrip(request)
+	end := time.Now()
+
+	record := apiTraceRecord{
+		Time:      end,
+		Method:    request.Method,
+		Path:      request.URL.Path,
+		LatencyMs: float64(end.Sub(start).Microseconds()) / 1000,
+	}
+	// Attempt count is available for policy API calls only
+	if attempt, ok := request.Context().Value(apiTraceAttemptKey).(*int); ok {
+		record.Retry = *attempt
+	}
+	if response != nil {
+		record.Status = response.StatusCode
+		record.RequestID = response.Header.Get("X-Nsx-Requestid")
+	}
+	if err != nil {
+		record.Error = err.Error()
+	}
+
+	t.tracer.record(request.Context(), record, start)
+	return response, err
+}
+
+// Connector decorator that counts attempts of policy API calls. It is placed
+// under retry decorator, and hence is invoked for every attempt with the same
+// execution context.
+type traceAttemptDecorator struct {
+	next core.APIProvider
+}
+
+func newTraceAttemptDecorator() core.APIProviderDecorator {
+	return func(next core.APIProvider) core.APIProvider {
+		return traceAttemptDecorator{next: next}
+	}
+}
+
+func (d traceAttemptDecorator) Invoke(serviceID string, operationID string, input data.DataValue, ctx *core.ExecutionContext) core.MethodResult {
+	if attempt, ok := ctx.Context().Value(apiTraceAttemptKey).(*int); ok {
+		*attempt++
+	} else {
+		attempt := 0
+		ctx.WithContext(context.WithValue(ctx.Context(), apiTraceAttemptKey, &attempt))
+	}
+
+	return d.next.Invoke(serviceID, operationID, input, ctx)
+}
@@ -0,0 +1,205 @@
+/* Copyright © 2023 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+type testOTLPExportRequest struct {
+	ResourceSpans []struct {
+		Resource struct {
+			Attributes []otlpKeyValue `json:"attributes"`
+		} `json:"resource"`
+		ScopeSpans []struct {
+			Spans []otlpSpan `json:"spans"`
+		} `json:"scopeSpans"`
+	} `json:"resourceSpans"`
+}
+
+// Fake OTLP collector that records received spans
+type testOTLPCollector struct {
+	server   *httptest.Server
+	requests int
+	spans    []otlpSpan
+	lock     sync.Mutex
+}
+
+func newTestOTLPCollector(t *testing.T) *testOTLPCollector {
+	collector := &testOTLPCollector{}
+	collector.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/traces" {
+			t.Errorf("Unexpected OTLP path %s", r.URL.Path)
+		}
+		var request testOTLPExportRequest
+		if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+			t.Errorf("Failed to decode OTLP request: %v", err)
+		}
+
+		collector.lock.Lock()
+		defer collector.lock.Unlock()
+		collector.requests++
+		for _, resourceSpans := range request.ResourceSpans {
+			for _, scopeSpans := range resourceSpans.ScopeSpans {
+				collector.spans = append(collector.spans, scopeSpans.Spans...)
+			}
+		}
+	}))
+	t.Cleanup(collector.server.Close)
+	return collector
+}
+
+func findTestOTLPAttribute(span otlpSpan, key string) interface{} {
+	for _, attribute := range span.Attributes {
+		if attribute.Key == key {
+			for _, value := range attribute.Value {
+				return value
+			}
+		}
+	}
+	return nil
+}
+
+func TestUnitAPITracerSpans(t *testing.T) {
+	collector := newTestOTLPCollector(t)
+	nsx := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Nsx-Requestid", "request1")
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer nsx.Close()
+
+	traceFile := filepath.Join(t.TempDir(), "trace.json")
+	tracer, err := newAPITracer(traceFile, collector.server.URL+"/")
+	if err != nil {
+		t.Fatalf("Failed to create tracer: %v", err)
+	}
+	m := nsxtClients{CommonConfig: commonProviderConfig{APITracer: tracer}}
+
+	ctx := startAPITraceOperation(context.Background(), m, "nsxt_policy_group", "read")
+	client := http.Client{Transport: newTracingTransport(tracer, nil)}
+	request, _ := http.NewRequestWithContext(ctx, http.MethodGet, nsx.URL+"/policy/api/v1/infra", nil)
+	response, err := client.Do(request)
+	if err != nil {
+		t.Fatalf("Request failed: %v", err)
+	}
+	response.Body.Close()
+	finishAPITraceOperation(ctx, m, true)
+
+	// Spans are not exported per operation
+	collector.lock.Lock()
+	requests := collector.requests
+	collector.lock.Unlock()
+	if requests != 0 {
+		t.Errorf("Expected spans to be exported in background batch, got %d requests", requests)
+	}
+
+	CloseAPITracers()
+
+	if collector.requests != 1 || len(collector.spans) != 2 {
+		t.Fatalf("Expected 2 spans in single request on close, got %d spans in %d requests", len(collector.spans), collector.requests)
+	}
+	callSpan := collector.spans[0]
+	operationSpan := collector.spans[1]
+	if callSpan.TraceID != operationSpan.TraceID || callSpan.ParentSpanID != operationSpan.SpanID {
+		t.Errorf("Expected API call span to be child of operation span")
+	}
+	if callSpan.Name != "GET /policy/api/v1/infra" || callSpan.Kind != otlpSpanKindClient || callSpan.Status.Code != otlpStatusError {
+		t.Errorf("Unexpected API call span %v", callSpan)
+	}
+	if value := findTestOTLPAttribute(callSpan, "http.status_code"); value != "404" {
+		t.Errorf("Expected status code attribute 404, got %v", value)
+	}
+	if value := findTestOTLPAttribute(callSpan, "nsx.request_id"); value != "request1" {
+		t.Errorf("Expected request ID attribute, got %v", value)
+	}
+	if operationSpan.Name != "nsxt_policy_group read" || operationSpan.Kind != otlpSpanKindInternal || operationSpan.Status.Code != otlpStatusError {
+		t.Errorf("Unexpected operation span %v", operationSpan)
+	}
+
+	content, err := os.ReadFile(traceFile)
+	if err != nil {
+		t.Fatalf("Failed to read trace file: %v", err)
+	}
+	var record apiTraceRecord
+	if err := json.Unmarshal([]byte(strings.TrimSpace(string(content))), &record); err != nil {
+		t.Fatalf("Failed to decode trace record: %v", err)
+	}
+	if record.Resource != "nsxt_policy_group" || record.Operation != "read" || record.Status != http.StatusNotFound {
+		t.Errorf("Unexpected trace record %v", record)
+	}
+
+	// Trace file is closed, and closed tracer drops further records
+	if tracer.file != nil {
+		t.Errorf("Expected trace file to be closed")
+	}
+	tracer.record(ctx, apiTraceRecord{Method: http.MethodGet}, time.Now())
+	tracer.close()
+	if collector.requests != 1 {
+		t.Errorf("Expected no export after close, got %d requests", collector.requests)
+	}
+}
+
+func TestUnitEncodeOTLPSpans(t *testing.T) {
+	span := otlpSpan{
+		TraceID:           "0102030405060708090a0b0c0d0e0f10",
+		SpanID:            "0102030405060708",
+		Name:              "GET /api/v1/node",
+		Kind:              otlpSpanKindClient,
+		StartTimeUnixNano: "1000",
+		EndTimeUnixNano:   "2000",
+		Attributes:        []otlpKeyValue{otlpIntAttribute("http.status_code", 200)},
+		Status:            otlpStatus{Code: otlpStatusOk},
+	}
+	body, err := encodeOTLPSpans([]otlpSpan{span})
+	if err != nil {
+		t.Fatalf("Failed to encode spans: %v", err)
+	}
+
+	var request testOTLPExportRequest
+	if err := json.Unmarshal(body, &request); err != nil {
+		t.Fatalf("Failed to decode spans: %v", err)
+	}
+	if len(request.ResourceSpans) != 1 || len(request.ResourceSpans[0].ScopeSpans) != 1 {
+		t.Fatalf("Unexpected OTLP structure %s", body)
+	}
+	resourceAttributes := request.ResourceSpans[0].Resource.Attributes
+	if len(resourceAttributes) != 1 || resourceAttributes[0].Value["stringValue"] != apiTraceServiceName {
+		t.Errorf("Expected service name resource attribute, got %v", resourceAttributes)
+	}
+	spans := request.ResourceSpans[0].ScopeSpans[0].Spans
+	if len(spans) != 1 || spans[0].TraceID != span.TraceID || spans[0].EndTimeUnixNano != "2000" {
+		t.Errorf("Unexpected spans %v", spans)
+	}
+
+	// 64 bit integers are encoded as strings, as required by OTLP JSON
+	if !strings.Contains(string(body), `"intValue":"200"`) {
+		t.Errorf("Expected integer attribute to be encoded as string, got %s", body)
+	}
+}
+
+func TestUnitAPITracerBatch(t *testing.T) {
+	collector := newTestOTLPCollector(t)
+	tracer, err := newAPITracer("", collector.server.URL)
+	if err != nil {
+		t.Fatalf("Failed to create tracer: %v", err)
+	}
+
+	for i := 0; i < apiTraceMaxBatchSize+1; i++ {
+		tracer.record(context.Background(), apiTraceRecord{Method: http.MethodGet, Path: "/api/v1/node"}, time.Now())
+	}
+	tracer.close()
+
+	if collector.requests != 2 || len(collector.spans) != apiTraceMaxBatchSize+1 {
+		t.Errorf("Expected %d spans in 2 batches, got %d spans in %d requests", apiTraceMaxBatchSize+1, len(collector.spans), collector.requests)
+	}
+}
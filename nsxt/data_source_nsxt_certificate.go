@@ -4,17 +4,18 @@
 package nsxt
 
 import (
-	"fmt"
+	"context"
 
 	"net/http"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/vmware/go-vmware-nsxt/trust"
 )
 
 func dataSourceNsxtCertificate() *schema.Resource {
 	return &schema.Resource{
-		Read:               dataSourceNsxtCertificateRead,
+		ReadContext:        dataSourceNsxtCertificateRead,
 		DeprecationMessage: mpObjectDataSourceDeprecationMessage,
 		Schema: map[string]*schema.Schema{
 			"id": {
@@ -39,11 +40,11 @@ func dataSourceNsxtCertificate() *schema.Resource {
 	}
 }
 
-func dataSourceNsxtCertificateRead(d *schema.ResourceData, m interface{}) error {
+func dataSourceNsxtCertificateRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	// Read cerificate by name or id
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return dataSourceNotSupportedError()
+		return getErrorDiagnostics(dataSourceNotSupportedError())
 	}
 
 	objID := d.Get("id").(string)
@@ -54,10 +55,10 @@ func dataSourceNsxtCertificateRead(d *schema.ResourceData, m interface{}) error
 		objGet, resp, err := nsxClient.NsxComponentAdministrationApi.GetCertificate(nsxClient.Context, objID, nil)
 
 		if resp != nil && resp.StatusCode == http.StatusNotFound {
-			return fmt.Errorf("certificate %s was not found", objID)
+			return diag.Errorf("certificate %s was not found", objID)
 		}
 		if err != nil {
-			return fmt.Errorf("Error while reading certificate %s: %v", objID, err)
+			return diag.Errorf("Error while reading certificate %s: %v", objID, err)
 		}
 		obj = objGet
 
@@ -66,24 +67,24 @@ func dataSourceNsxtCertificateRead(d *schema.ResourceData, m interface{}) error
 		// TODO use 2nd parameter localVarOptionals for paging
 		objList, _, err := nsxClient.NsxComponentAdministrationApi.GetCertificates(nsxClient.Context, nil)
 		if err != nil {
-			return fmt.Errorf("Error while reading certificates: %v", err)
+			return diag.Errorf("Error while reading certificates: %v", err)
 		}
 		// go over the list to find the correct one
 		found := false
 		for _, objInList := range objList.Results {
 			if objInList.DisplayName == objName {
 				if found {
-					return fmt.Errorf("Found multiple certificates with name '%s'", objName)
+					return diag.Errorf("Found multiple certificates with name '%s'", objName)
 				}
 				obj = objInList
 				found = true
 			}
 		}
 		if !found {
-			return fmt.Errorf("Certificate with name '%s' was not found", objName)
+			return diag.Errorf("Certificate with name '%s' was not found", objName)
 		}
 	} else {
-		return fmt.Errorf("Error obtaining certificate ID or name during read")
+		return diag.Errorf("Error obtaining certificate ID or name during read")
 	}
 
 	d.SetId(obj.Id)
@@ -4,17 +4,19 @@
 package nsxt
 
 import (
+	"context"
 	"fmt"
 
 	"net/http"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/vmware/go-vmware-nsxt/manager"
 )
 
 func dataSourceNsxtIPPool() *schema.Resource {
 	return &schema.Resource{
-		Read:               dataSourceNsxtIPPoolRead,
+		ReadContext:        dataSourceNsxtIPPoolRead,
 		DeprecationMessage: mpObjectDataSourceDeprecationMessage,
 		Schema: map[string]*schema.Schema{
 			"id": {
@@ -39,11 +41,11 @@ func dataSourceNsxtIPPool() *schema.Resource {
 	}
 }
 
-func dataSourceNsxtIPPoolRead(d *schema.ResourceData, m interface{}) error {
+func dataSourceNsxtIPPoolRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	// Read IP Pool by name or id
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return dataSourceNotSupportedError()
+		return getErrorDiagnostics(dataSourceNotSupportedError())
 	}
 	objID := d.Get("id").(string)
 	objName := d.Get("display_name").(string)
@@ -53,10 +55,10 @@ func dataSourceNsxtIPPoolRead(d *schema.ResourceData, m interface{}) error {
 		objGet, resp, err := nsxClient.PoolManagementApi.ReadIpPool(nsxClient.Context, objID)
 
 		if resp != nil && resp.StatusCode == http.StatusNotFound {
-			return fmt.Errorf("IP pool %s was not found", objID)
+			return diag.Errorf("IP pool %s was not found", objID)
 		}
 		if err != nil {
-			return fmt.Errorf("Error while reading ns service %s: %v", objID, err)
+			return diag.Errorf("Error while reading ns service %s: %v", objID, err)
 		}
 		obj = objGet
 	} else if objName != "" {
@@ -87,13 +89,13 @@ func dataSourceNsxtIPPoolRead(d *schema.ResourceData, m interface{}) error {
 
 		total, err := handlePagination(lister)
 		if err != nil {
-			return err
+			return getOperationDiagnostics(m, err)
 		}
 		if !found {
-			return fmt.Errorf("IP pool '%s' was not found out of %d objects", objName, total)
+			return diag.Errorf("IP pool '%s' was not found out of %d objects", objName, total)
 		}
 	} else {
-		return fmt.Errorf("Error obtaining IP pool ID or name during read")
+		return diag.Errorf("Error obtaining IP pool ID or name during read")
 	}
 
 	d.SetId(obj.Id)
@@ -4,18 +4,20 @@
 package nsxt
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
 	"net/http"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/vmware/go-vmware-nsxt/manager"
 )
 
 func dataSourceNsxtLogicalTier1Router() *schema.Resource {
 	return &schema.Resource{
-		Read:               dataSourceNsxtLogicalTier1RouterRead,
+		ReadContext:        dataSourceNsxtLogicalTier1RouterRead,
 		DeprecationMessage: mpObjectDataSourceDeprecationMessage,
 		Schema: map[string]*schema.Schema{
 			"id": {
@@ -46,11 +48,11 @@ func dataSourceNsxtLogicalTier1Router() *schema.Resource {
 	}
 }
 
-func dataSourceNsxtLogicalTier1RouterRead(d *schema.ResourceData, m interface{}) error {
+func dataSourceNsxtLogicalTier1RouterRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	// Read a logical tier1 router by name or id
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return dataSourceNotSupportedError()
+		return getErrorDiagnostics(dataSourceNotSupportedError())
 	}
 
 	objID := d.Get("id").(string)
@@ -61,17 +63,17 @@ func dataSourceNsxtLogicalTier1RouterRead(d *schema.ResourceData, m interface{})
 		objGet, resp, err := nsxClient.LogicalRoutingAndServicesApi.ReadLogicalRouter(nsxClient.Context, objID)
 
 		if resp != nil && resp.StatusCode == http.StatusNotFound {
-			return fmt.Errorf("Logical tier1 router %s was not found", objID)
+			return diag.Errorf("Logical tier1 router %s was not found", objID)
 		}
 		if err != nil {
-			return fmt.Errorf("Error while reading logical tier1 router %s: %v", objID, err)
+			return diag.Errorf("Error while reading logical tier1 router %s: %v", objID, err)
 		}
 		if objGet.RouterType != "TIER1" {
-			return fmt.Errorf("Logical router %s is not a tier1 router", objID)
+			return diag.Errorf("Logical router %s is not a tier1 router", objID)
 		}
 		obj = objGet
 	} else if objName == "" {
-		return fmt.Errorf("Error obtaining logical tier1 router ID or name during read")
+		return diag.Errorf("Error obtaining logical tier1 router ID or name during read")
 	} else {
 		// Get by full name/prefix
 		var perfectMatch []manager.LogicalRouter
@@ -102,21 +104,21 @@ func dataSourceNsxtLogicalTier1RouterRead(d *schema.ResourceData, m interface{})
 
 		total, err := handlePagination(lister)
 		if err != nil {
-			return err
+			return getOperationDiagnostics(m, err)
 		}
 
 		if len(perfectMatch) > 0 {
 			if len(perfectMatch) > 1 {
-				return fmt.Errorf("Found multiple logical tier1 routers with name '%s'", objName)
+				return diag.Errorf("Found multiple logical tier1 routers with name '%s'", objName)
 			}
 			obj = perfectMatch[0]
 		} else if len(prefixMatch) > 0 {
 			if len(prefixMatch) > 1 {
-				return fmt.Errorf("Found multiple logical tier1 routers with name starting with '%s'", objName)
+				return diag.Errorf("Found multiple logical tier1 routers with name starting with '%s'", objName)
 			}
 			obj = prefixMatch[0]
 		} else {
-			return fmt.Errorf("Logical tier1 router with name '%s' was not found among %d objects", objName, total)
+			return diag.Errorf("Logical tier1 router with name '%s' was not found among %d objects", objName, total)
 		}
 	}
 
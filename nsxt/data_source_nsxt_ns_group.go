@@ -4,16 +4,18 @@
 package nsxt
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/vmware/go-vmware-nsxt/manager"
 )
 
 func dataSourceNsxtNsGroup() *schema.Resource {
 	return &schema.Resource{
-		Read:               dataSourceNsxtNsGroupRead,
+		ReadContext:        dataSourceNsxtNsGroupRead,
 		DeprecationMessage: mpObjectDataSourceDeprecationMessage,
 		Schema: map[string]*schema.Schema{
 			"id": {
@@ -38,11 +40,11 @@ func dataSourceNsxtNsGroup() *schema.Resource {
 	}
 }
 
-func dataSourceNsxtNsGroupRead(d *schema.ResourceData, m interface{}) error {
+func dataSourceNsxtNsGroupRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	// Read NS Group by name or id
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return dataSourceNotSupportedError()
+		return getErrorDiagnostics(dataSourceNotSupportedError())
 	}
 
 	objID := d.Get("id").(string)
@@ -55,10 +57,10 @@ func dataSourceNsxtNsGroupRead(d *schema.ResourceData, m interface{}) error {
 		objGet, resp, err := nsxClient.GroupingObjectsApi.ReadNSGroup(nsxClient.Context, objID, localVarOptionals)
 
 		if resp != nil && resp.StatusCode == http.StatusNotFound {
-			return fmt.Errorf("NS group %s was not found", objID)
+			return diag.Errorf("NS group %s was not found", objID)
 		}
 		if err != nil {
-			return fmt.Errorf("Error while reading NS group %s: %v", objID, err)
+			return diag.Errorf("Error while reading NS group %s: %v", objID, err)
 		}
 		obj = objGet
 	} else if objName != "" {
@@ -88,13 +90,13 @@ func dataSourceNsxtNsGroupRead(d *schema.ResourceData, m interface{}) error {
 
 		total, err := handlePagination(lister)
 		if err != nil {
-			return err
+			return getOperationDiagnostics(m, err)
 		}
 		if !found {
-			return fmt.Errorf("NS group with name '%s' was not found among %d groups", objName, total)
+			return diag.Errorf("NS group with name '%s' was not found among %d groups", objName, total)
 		}
 	} else {
-		return fmt.Errorf("Error obtaining NS group ID or name during read")
+		return diag.Errorf("Error obtaining NS group ID or name during read")
 	}
 
 	d.SetId(obj.Id)
@@ -4,13 +4,15 @@
 package nsxt
 
 import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceNsxtPolicyBfdProfile() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceNsxtPolicyBfdProfileRead,
-
+		ReadContext: dataSourceNsxtPolicyBfdProfileRead,
 		Schema: map[string]*schema.Schema{
 			"id":           getDataSourceIDSchema(),
 			"display_name": getDataSourceExtendedDisplayNameSchema(),
@@ -20,12 +22,12 @@ func dataSourceNsxtPolicyBfdProfile() *schema.Resource {
 	}
 }
 
-func dataSourceNsxtPolicyBfdProfileRead(d *schema.ResourceData, m interface{}) error {
+func dataSourceNsxtPolicyBfdProfileRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 
 	_, err := policyDataSourceResourceRead(d, connector, isPolicyGlobalManager(m), "BfdProfile", nil)
 	if err != nil {
-		return err
+		return getOperationDiagnostics(m, err)
 	}
 
 	return nil
@@ -4,13 +4,15 @@
 package nsxt
 
 import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceNsxtPolicyCertificate() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceNsxtPolicyCertificateRead,
-
+		ReadContext: dataSourceNsxtPolicyCertificateRead,
 		Schema: map[string]*schema.Schema{
 			"id":           getDataSourceIDSchema(),
 			"display_name": getDataSourceDisplayNameSchema(),
@@ -20,12 +22,12 @@ func dataSourceNsxtPolicyCertificate() *schema.Resource {
 	}
 }
 
-func dataSourceNsxtPolicyCertificateRead(d *schema.ResourceData, m interface{}) error {
+func dataSourceNsxtPolicyCertificateRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 
 	_, err := policyDataSourceResourceRead(d, connector, isPolicyGlobalManager(m), "TlsCertificate", nil)
 	if err != nil {
-		return err
+		return getOperationDiagnostics(m, err)
 	}
 
 	return nil
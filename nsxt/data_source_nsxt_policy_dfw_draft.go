@@ -0,0 +1,133 @@
+/* Copyright © 2023 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+func dataSourceNsxtPolicyDfwDraft() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceNsxtPolicyDfwDraftRead,
+
+		Schema: map[string]*schema.Schema{
+			"id":           getDataSourceIDSchema(),
+			"display_name": getDataSourceDisplayNameSchema(),
+			"description":  getDataSourceDescriptionSchema(),
+			"path":         getPathSchema(),
+			"is_auto_draft": {
+				Type:        schema.TypeBool,
+				Description: "Whether the draft was created automatically by NSX upon publish of DFW configuration",
+				Optional:    true,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Returns most recently modified draft in the list
+func getLatestPolicyDfwDraft(drafts []model.PolicyDraft) model.PolicyDraft {
+	latest := drafts[0]
+	for _, draft := range drafts[1:] {
+		if draft.LastModifiedTime != nil && (latest.LastModifiedTime == nil || *draft.LastModifiedTime > *latest.LastModifiedTime) {
+			latest = draft
+		}
+	}
+	return latest
+}
+
+func dataSourceNsxtPolicyDfwDraftRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// Read a draft by name or id, or latest auto draft
+	if isPolicyGlobalManager(m) {
+		return getErrorDiagnostics(dataSourceNotSupportedError())
+	}
+
+	connector := getPolicyConnector(m)
+	client := infra.NewDraftsClient(connector)
+
+	objID := d.Get("id").(string)
+	objName := d.Get("display_name").(string)
+	isAutoDraft, autoDraftSet := d.GetOkExists("is_auto_draft")
+	var obj model.PolicyDraft
+	if objID != "" {
+		// Get by id
+		objGet, err := client.Get(objID)
+		if err != nil {
+			return getOperationDiagnostics(m, handleDataSourceReadError(d, "DFW Draft", objID, err))
+		}
+		obj = objGet
+	} else {
+		var autoDrafts *bool
+		if autoDraftSet {
+			value := isAutoDraft.(bool)
+			autoDrafts = &value
+		}
+		includeMarkForDeleteObjectsParam := false
+		var drafts []model.PolicyDraft
+		var cursor *string
+		for {
+			objList, err := client.List(autoDrafts, cursor, &includeMarkForDeleteObjectsParam, nil, nil, nil, nil)
+			if err != nil {
+				return getOperationDiagnostics(m, handleListError("DFW Draft", err))
+			}
+			drafts = append(drafts, objList.Results...)
+			cursor = objList.Cursor
+			if cursor == nil || *cursor == "" || len(objList.Results) == 0 {
+				break
+			}
+		}
+
+		if objName == "" {
+			if !autoDraftSet || !isAutoDraft.(bool) {
+				return diag.Errorf("Error obtaining DFW Draft ID or name during read")
+			}
+			// Latest auto draft
+			if len(drafts) == 0 {
+				return diag.Errorf("No auto DFW Draft was found")
+			}
+			obj = getLatestPolicyDfwDraft(drafts)
+		} else {
+			// Get by full name/prefix
+			var perfectMatch []model.PolicyDraft
+			var prefixMatch []model.PolicyDraft
+			for _, objInList := range drafts {
+				if objInList.DisplayName == nil {
+					continue
+				}
+				if strings.HasPrefix(*objInList.DisplayName, objName) {
+					prefixMatch = append(prefixMatch, objInList)
+				}
+				if *objInList.DisplayName == objName {
+					perfectMatch = append(perfectMatch, objInList)
+				}
+			}
+			if len(perfectMatch) > 0 {
+				if len(perfectMatch) > 1 {
+					return diag.Errorf("Found multiple DFW Drafts with name '%s'", objName)
+				}
+				obj = perfectMatch[0]
+			} else if len(prefixMatch) > 0 {
+				if len(prefixMatch) > 1 {
+					return diag.Errorf("Found multiple DFW Drafts with name starting with '%s'", objName)
+				}
+				obj = prefixMatch[0]
+			} else {
+				return diag.Errorf("DFW Draft '%s' was not found", objName)
+			}
+		}
+	}
+
+	d.SetId(*obj.Id)
+	d.Set("display_name", obj.DisplayName)
+	d.Set("description", obj.Description)
+	d.Set("path", obj.Path)
+	d.Set("is_auto_draft", obj.IsAutoDraft)
+	return nil
+}
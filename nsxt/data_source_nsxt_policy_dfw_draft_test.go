@@ -0,0 +1,50 @@
+/* Copyright © 2023 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccDataSourceNsxtPolicyDfwDraft_basic(t *testing.T) {
+	name := getAccTestDataSourceName()
+	testResourceName := "data.nsxt_policy_dfw_draft.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccOnlyLocalManager(t); testAccPreCheck(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyResourceCheckDestroy(state, name, "nsxt_policy_dfw_draft", resourceNsxtPolicyDfwDraftExists)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyDfwDraftReadTemplate(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(testResourceName, "display_name", name),
+					resource.TestCheckResourceAttr(testResourceName, "description", name),
+					resource.TestCheckResourceAttr(testResourceName, "is_auto_draft", "false"),
+					resource.TestCheckResourceAttrPair(testResourceName, "path", "nsxt_policy_dfw_draft.test", "path"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicyDfwDraftReadTemplate(name string) string {
+	return fmt.Sprintf(`
+resource "nsxt_policy_dfw_draft" "test" {
+  display_name = "%s"
+  description  = "%s"
+}
+
+data "nsxt_policy_dfw_draft" "test" {
+  display_name = "%s"
+
+  depends_on = [nsxt_policy_dfw_draft.test]
+}`, name, name, name)
+}
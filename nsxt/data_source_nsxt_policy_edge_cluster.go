@@ -4,9 +4,10 @@
 package nsxt
 
 import (
-	"fmt"
+	"context"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/sites/enforcement_points"
 	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
@@ -14,8 +15,7 @@ import (
 
 func dataSourceNsxtPolicyEdgeCluster() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceNsxtPolicyEdgeClusterRead,
-
+		ReadContext: dataSourceNsxtPolicyEdgeClusterRead,
 		Schema: map[string]*schema.Schema{
 			"id":           getDataSourceIDSchema(),
 			"display_name": getDataSourceDisplayNameSchema(),
@@ -31,7 +31,7 @@ func dataSourceNsxtPolicyEdgeCluster() *schema.Resource {
 	}
 }
 
-func dataSourceNsxtPolicyEdgeClusterRead(d *schema.ResourceData, m interface{}) error {
+func dataSourceNsxtPolicyEdgeClusterRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	// Read an edge cluster by name or id
 	objSitePath := d.Get("site_path").(string)
 
@@ -39,11 +39,11 @@ func dataSourceNsxtPolicyEdgeClusterRead(d *schema.ResourceData, m interface{})
 	objName := d.Get("display_name").(string)
 
 	if !isPolicyGlobalManager(m) && objSitePath != "" {
-		return globalManagerOnlyError()
+		return getErrorDiagnostics(globalManagerOnlyError())
 	}
 	if isPolicyGlobalManager(m) {
 		if objSitePath == "" {
-			return attributeRequiredGlobalManagerError("site_path", "nsxt_policy_edge_cluster")
+			return getErrorDiagnostics(attributeRequiredGlobalManagerError("site_path", "nsxt_policy_edge_cluster"))
 		}
 
 		query := make(map[string]string)
@@ -51,7 +51,7 @@ func dataSourceNsxtPolicyEdgeClusterRead(d *schema.ResourceData, m interface{})
 		query["parent_path"] = globalPolicyEnforcementPointPath
 		_, err := policyDataSourceResourceReadWithValidation(d, getPolicyConnector(m), true, "PolicyEdgeCluster", query, false)
 		if err != nil {
-			return err
+			return getOperationDiagnostics(m, err)
 		}
 		return nil
 	}
@@ -65,17 +65,17 @@ func dataSourceNsxtPolicyEdgeClusterRead(d *schema.ResourceData, m interface{})
 		objGet, err := client.Get(defaultSite, getPolicyEnforcementPoint(m), objID)
 
 		if err != nil {
-			return handleDataSourceReadError(d, "Edge Cluster", objID, err)
+			return getOperationDiagnostics(m, handleDataSourceReadError(d, "Edge Cluster", objID, err))
 		}
 		obj = objGet
 	} else if objName == "" {
-		return fmt.Errorf("Error obtaining edge cluster ID or name during read")
+		return diag.Errorf("Error obtaining edge cluster ID or name during read")
 	} else {
 		// Get by full name/prefix
 		includeMarkForDeleteObjectsParam := false
 		objList, err := client.List(defaultSite, getPolicyEnforcementPoint(m), nil, &includeMarkForDeleteObjectsParam, nil, nil, nil, nil)
 		if err != nil {
-			return handleListError("Edge Cluster", err)
+			return getOperationDiagnostics(m, handleListError("Edge Cluster", err))
 		}
 		// go over the list to find the correct one (prefer a perfect match. If not - prefix match)
 		var perfectMatch []model.PolicyEdgeCluster
@@ -90,16 +90,16 @@ func dataSourceNsxtPolicyEdgeClusterRead(d *schema.ResourceData, m interface{})
 		}
 		if len(perfectMatch) > 0 {
 			if len(perfectMatch) > 1 {
-				return fmt.Errorf("Found multiple edge clusters with name '%s'", objName)
+				return diag.Errorf("Found multiple edge clusters with name '%s'", objName)
 			}
 			obj = perfectMatch[0]
 		} else if len(prefixMatch) > 0 {
 			if len(prefixMatch) > 1 {
-				return fmt.Errorf("Found multiple edge clusters with name starting with '%s'", objName)
+				return diag.Errorf("Found multiple edge clusters with name starting with '%s'", objName)
 			}
 			obj = prefixMatch[0]
 		} else {
-			return fmt.Errorf("edge cluster '%s' was not found", objName)
+			return diag.Errorf("edge cluster '%s' was not found", objName)
 		}
 	}
 	d.SetId(*obj.Id)
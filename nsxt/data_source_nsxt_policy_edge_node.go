@@ -4,10 +4,11 @@
 package nsxt
 
 import (
-	"fmt"
+	"context"
 	"strconv"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/sites/enforcement_points/edge_clusters"
@@ -16,8 +17,7 @@ import (
 
 func dataSourceNsxtPolicyEdgeNode() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceNsxtPolicyEdgeNodeRead,
-
+		ReadContext: dataSourceNsxtPolicyEdgeNodeRead,
 		Schema: map[string]*schema.Schema{
 			"edge_cluster_path": getPolicyPathSchema(true, false, "Edge cluster Path"),
 			"member_index": {
@@ -34,7 +34,7 @@ func dataSourceNsxtPolicyEdgeNode() *schema.Resource {
 	}
 }
 
-func dataSourceNsxtPolicyEdgeNodeRead(d *schema.ResourceData, m interface{}) error {
+func dataSourceNsxtPolicyEdgeNodeRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	// Read an edge node by name or id
 	edgeClusterPath := d.Get("edge_cluster_path").(string)
 	// Note - according to the documentation GetOkExists should be used
@@ -49,7 +49,7 @@ func dataSourceNsxtPolicyEdgeNodeRead(d *schema.ResourceData, m interface{}) err
 		}
 		_, err := policyDataSourceResourceReadWithValidation(d, getPolicyConnector(m), isPolicyGlobalManager(m), "PolicyEdgeNode", query, false)
 		if err != nil {
-			return err
+			return getOperationDiagnostics(m, err)
 		}
 		return nil
 	}
@@ -66,7 +66,7 @@ func dataSourceNsxtPolicyEdgeNodeRead(d *schema.ResourceData, m interface{}) err
 		objGet, err := client.Get(defaultSite, getPolicyEnforcementPoint(m), edgeClusterID, objID)
 
 		if err != nil {
-			return handleDataSourceReadError(d, "Edge Node", objID, err)
+			return getOperationDiagnostics(m, handleDataSourceReadError(d, "Edge Node", objID, err))
 		}
 		obj = objGet
 	} else {
@@ -77,7 +77,7 @@ func dataSourceNsxtPolicyEdgeNodeRead(d *schema.ResourceData, m interface{}) err
 		includeMarkForDeleteObjectsParam := false
 		objList, err := client.List(defaultSite, getPolicyEnforcementPoint(m), edgeClusterID, nil, &includeMarkForDeleteObjectsParam, nil, nil, nil, nil)
 		if err != nil {
-			return handleListError("Edge Node", err)
+			return getOperationDiagnostics(m, handleListError("Edge Node", err))
 		}
 		// go over the list to find the correct one (prefer a perfect match. If not - prefix match)
 		var perfectMatch []model.PolicyEdgeNode
@@ -100,16 +100,16 @@ func dataSourceNsxtPolicyEdgeNodeRead(d *schema.ResourceData, m interface{}) err
 
 		if len(perfectMatch) > 0 {
 			if len(perfectMatch) > 1 {
-				return fmt.Errorf("Found multiple edge nodes with name '%s' and index %d", objName, memberIndex)
+				return diag.Errorf("Found multiple edge nodes with name '%s' and index %d", objName, memberIndex)
 			}
 			obj = perfectMatch[0]
 		} else if len(prefixMatch) > 0 {
 			if len(prefixMatch) > 1 {
-				return fmt.Errorf("Found multiple edge nodes with name starting with '%s' and index %d", objName, memberIndex)
+				return diag.Errorf("Found multiple edge nodes with name starting with '%s' and index %d", objName, memberIndex)
 			}
 			obj = prefixMatch[0]
 		} else {
-			return fmt.Errorf("edge node '%s' was not found and %d", objName, memberIndex)
+			return diag.Errorf("edge node '%s' was not found and %d", objName, memberIndex)
 		}
 	}
 
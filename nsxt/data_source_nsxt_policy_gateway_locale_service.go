@@ -4,8 +4,10 @@
 package nsxt
 
 import (
+	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/vmware/vsphere-automation-sdk-go/runtime/bindings"
 	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
@@ -13,8 +15,7 @@ import (
 
 func dataSourceNsxtPolicyGatewayLocaleService() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceNsxtPolicyGatewayLocaleServiceRead,
-
+		ReadContext: dataSourceNsxtPolicyGatewayLocaleServiceRead,
 		Schema: map[string]*schema.Schema{
 			"gateway_path": getPolicyPathSchema(true, true, "Gateway path"),
 			"id":           getDataSourceIDSchema(),
@@ -32,7 +33,7 @@ func dataSourceNsxtPolicyGatewayLocaleService() *schema.Resource {
 	}
 }
 
-func dataSourceNsxtPolicyGatewayLocaleServiceRead(d *schema.ResourceData, m interface{}) error {
+func dataSourceNsxtPolicyGatewayLocaleServiceRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 
 	gwPath := d.Get("gateway_path").(string)
@@ -41,13 +42,13 @@ func dataSourceNsxtPolicyGatewayLocaleServiceRead(d *schema.ResourceData, m inte
 	obj, err := policyDataSourceResourceReadWithValidation(d, connector, isPolicyGlobalManager(m), "LocaleServices", query, false)
 
 	if err != nil {
-		return err
+		return getOperationDiagnostics(m, err)
 	}
 
 	converter := bindings.NewTypeConverter()
 	dataValue, errors := converter.ConvertToGolang(obj, model.LocaleServicesBindingType())
 	if len(errors) > 0 {
-		return errors[0]
+		return getOperationDiagnostics(m, errors[0])
 	}
 	localeService := dataValue.(model.LocaleServices)
 
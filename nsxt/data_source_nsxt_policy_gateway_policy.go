@@ -4,9 +4,10 @@
 package nsxt
 
 import (
-	"fmt"
+	"context"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/vmware/vsphere-automation-sdk-go/runtime/bindings"
@@ -20,8 +21,7 @@ var gatewayPolicyCategoryValues = []string{"Emergency", "SystemRules", "SharedPr
 
 func dataSourceNsxtPolicyGatewayPolicy() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceNsxtPolicyGatewayPolicyRead,
-
+		ReadContext: dataSourceNsxtPolicyGatewayPolicyRead,
 		Schema: map[string]*schema.Schema{
 			"id":           getDataSourceIDSchema(),
 			"display_name": getDataSourceDisplayNameSchema(),
@@ -66,7 +66,7 @@ func listGatewayPolicies(domain string, connector client.Connector) ([]model.Gat
 	}
 }
 
-func dataSourceNsxtPolicyGatewayPolicyRead(d *schema.ResourceData, m interface{}) error {
+func dataSourceNsxtPolicyGatewayPolicyRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 
 	category := d.Get("category").(string)
@@ -79,13 +79,13 @@ func dataSourceNsxtPolicyGatewayPolicyRead(d *schema.ResourceData, m interface{}
 		}
 		obj, err := policyDataSourceResourceReadWithValidation(d, connector, true, "GatewayPolicy", query, false)
 		if err != nil {
-			return err
+			return getOperationDiagnostics(m, err)
 		}
 
 		converter := bindings.NewTypeConverter()
 		dataValue, errors := converter.ConvertToGolang(obj, gm_model.GatewayPolicyBindingType())
 		if len(errors) > 0 {
-			return errors[0]
+			return getOperationDiagnostics(m, errors[0])
 		}
 
 		policy := dataValue.(gm_model.GatewayPolicy)
@@ -102,19 +102,19 @@ func dataSourceNsxtPolicyGatewayPolicyRead(d *schema.ResourceData, m interface{}
 		client := domains.NewGatewayPoliciesClient(connector)
 		objGet, err := client.Get(domain, objID)
 		if isNotFoundError(err) {
-			return fmt.Errorf("Gateway Policy with ID %s was not found", objID)
+			return diag.Errorf("Gateway Policy with ID %s was not found", objID)
 		}
 
 		if err != nil {
-			return fmt.Errorf("Error while reading Gateway Policy %s: %v", objID, err)
+			return diag.Errorf("Error while reading Gateway Policy %s: %v", objID, err)
 		}
 		obj = objGet
 	} else if objName == "" && category == "" {
-		return fmt.Errorf("Gateway Policy id, display name or category must be specified")
+		return diag.Errorf("Gateway Policy id, display name or category must be specified")
 	} else {
 		objList, err := listGatewayPolicies(domain, connector)
 		if err != nil {
-			return fmt.Errorf("Error while reading Gateway Policies: %v", err)
+			return diag.Errorf("Error while reading Gateway Policies: %v", err)
 		}
 		// go over the list to find the correct one (prefer a perfect match. If not - prefix match)
 		var perfectMatch []model.GatewayPolicy
@@ -136,16 +136,16 @@ func dataSourceNsxtPolicyGatewayPolicyRead(d *schema.ResourceData, m interface{}
 		}
 		if len(perfectMatch) > 0 {
 			if len(perfectMatch) > 1 {
-				return fmt.Errorf("Found multiple Gateway Policies with name '%s'", objName)
+				return diag.Errorf("Found multiple Gateway Policies with name '%s'", objName)
 			}
 			obj = perfectMatch[0]
 		} else if len(prefixMatch) > 0 {
 			if len(prefixMatch) > 1 {
-				return fmt.Errorf("Found multiple Gateway Policies with name starting with '%s' and category '%s'", objName, category)
+				return diag.Errorf("Found multiple Gateway Policies with name starting with '%s' and category '%s'", objName, category)
 			}
 			obj = prefixMatch[0]
 		} else {
-			return fmt.Errorf("Gateway Policy with name '%s' and category '%s' was not found", objName, category)
+			return diag.Errorf("Gateway Policy with name '%s' and category '%s' was not found", objName, category)
 		}
 	}
 
@@ -0,0 +1,214 @@
+/* Copyright © 2023 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	gm_infra "github.com/vmware/vsphere-automation-sdk-go/services/nsxt-gm/global_infra"
+	gm_model "github.com/vmware/vsphere-automation-sdk-go/services/nsxt-gm/model"
+)
+
+var policyFullSyncFinalStages = []string{
+	gm_model.FullSyncState_LAST_COMPLETED_STAGE_COMPLETED,
+	gm_model.FullSyncState_LAST_COMPLETED_STAGE_ERROR,
+	gm_model.FullSyncState_LAST_COMPLETED_STAGE_ABORTED,
+}
+
+func getPolicyGmFullSyncStateSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Description: "State of full sync",
+		Computed:    true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"id": {
+					Type:        schema.TypeString,
+					Description: "Full sync ID",
+					Computed:    true,
+				},
+				"last_completed_stage": {
+					Type:        schema.TypeString,
+					Description: "Last completed stage of full sync",
+					Computed:    true,
+				},
+				"errors": {
+					Type:        schema.TypeList,
+					Description: "Errors occurred during full sync",
+					Computed:    true,
+					Elem: &schema.Schema{
+						Type: schema.TypeString,
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceNsxtPolicyGmFullSync() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceNsxtPolicyGmFullSyncRead,
+
+		Schema: map[string]*schema.Schema{
+			"id": getDataSourceIDSchema(),
+			"timeout": {
+				Type:         schema.TypeInt,
+				Description:  "Timeout in seconds to wait for full sync to complete",
+				Optional:     true,
+				Default:      1200,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"delay": {
+				Type:         schema.TypeInt,
+				Description:  "Initial delay to start full sync checks in seconds",
+				Optional:     true,
+				Default:      1,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"state": getPolicyGmFullSyncStateSchema(),
+		},
+	}
+}
+
+func listPolicyGmFullSyncStates(connector client.Connector) ([]gm_model.FullSyncState, error) {
+	client := gm_infra.NewFullSyncStatesClient(connector)
+	var results []gm_model.FullSyncState
+	var cursor *string
+
+	for {
+		listResponse, err := client.List(cursor, nil, nil, nil, nil, nil)
+		if err != nil {
+			return results, err
+		}
+		results = append(results, listResponse.Results...)
+		cursor = listResponse.Cursor
+		if cursor == nil || len(listResponse.Results) == 0 {
+			return results, nil
+		}
+	}
+}
+
+func getPolicyGmFullSyncStateKey(state gm_model.FullSyncState) string {
+	key := ""
+	if state.Id != nil {
+		key = *state.Id
+	}
+	if state.StartTime != nil {
+		key = fmt.Sprintf("%s/%d", key, *state.StartTime)
+	}
+	return key
+}
+
+func listPolicyGmSitePaths(connector client.Connector) ([]string, error) {
+	client := gm_infra.NewSitesClient(connector)
+	sites, err := client.List(nil, nil, nil, nil, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var sitePaths []string
+	for _, site := range sites.Results {
+		if site.Path != nil {
+			sitePaths = append(sitePaths, *site.Path)
+		}
+	}
+
+	return sitePaths, nil
+}
+
+// Waits until full syncs that are not in previousStates reach final stage. If full sync
+// was triggered, at least one new full sync is expected to appear.
+func policyGmFullSyncWait(ctx context.Context, connector client.Connector, previousStates map[string]bool, triggered bool, delay int, timeout int) ([]gm_model.FullSyncState, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"UNKNOWN", "IN_PROGRESS"},
+		Target:  []string{"DONE"},
+		Refresh: func() (interface{}, string, error) {
+			states, err := listPolicyGmFullSyncStates(connector)
+			if err != nil {
+				return nil, "", logAPIError("Error listing full sync states", err)
+			}
+
+			var currentStates []gm_model.FullSyncState
+			for _, state := range states {
+				if !previousStates[getPolicyGmFullSyncStateKey(state)] {
+					currentStates = append(currentStates, state)
+				}
+			}
+
+			if triggered && len(currentStates) == 0 {
+				// Triggered full sync did not start yet
+				return currentStates, "UNKNOWN", nil
+			}
+
+			for _, state := range currentStates {
+				if state.LastCompletedStage == nil || !stringInList(*state.LastCompletedStage, policyFullSyncFinalStages) {
+					return currentStates, "IN_PROGRESS", nil
+				}
+			}
+
+			return currentStates, "DONE", nil
+		},
+		Timeout:    time.Duration(timeout) * time.Second,
+		MinTimeout: 1 * time.Second,
+		Delay:      time.Duration(delay) * time.Second,
+	}
+	result, err := stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to wait for full sync: %v", err)
+	}
+
+	return result.([]gm_model.FullSyncState), nil
+}
+
+// Sets full sync states in schema, and returns error if any of them failed
+func setPolicyGmFullSyncStateInSchema(d *schema.ResourceData, states []gm_model.FullSyncState) error {
+	var stateList []map[string]interface{}
+	var failures []string
+	for _, state := range states {
+		elem := make(map[string]interface{})
+		elem["id"] = state.Id
+		elem["last_completed_stage"] = state.LastCompletedStage
+		elem["errors"] = state.Errors
+		stateList = append(stateList, elem)
+
+		if state.LastCompletedStage != nil && *state.LastCompletedStage != gm_model.FullSyncState_LAST_COMPLETED_STAGE_COMPLETED {
+			failures = append(failures, fmt.Sprintf("%s (%s): %s", getPolicyGmFullSyncStateKey(state), *state.LastCompletedStage, strings.Join(state.Errors, "; ")))
+		}
+	}
+	d.Set("state", stateList)
+
+	if len(failures) > 0 {
+		return fmt.Errorf("Full sync failed:\n%s", strings.Join(failures, "\n"))
+	}
+
+	return nil
+}
+
+func dataSourceNsxtPolicyGmFullSyncRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if !isPolicyGlobalManager(m) {
+		return getErrorDiagnostics(globalManagerOnlyError())
+	}
+
+	// Dummy id, just because each data source needs one
+	id := d.Get("id").(string)
+	if id == "" {
+		d.SetId(newUUID())
+	}
+
+	// Full sync is only monitored here, it is triggered with nsxt_policy_gm_full_sync resource
+	states, err := policyGmFullSyncWait(ctx, getPolicyConnector(m), nil, false, d.Get("delay").(int), d.Get("timeout").(int))
+	if err != nil {
+		return getErrorDiagnostics(err)
+	}
+
+	return getErrorDiagnostics(setPolicyGmFullSyncStateInSchema(d, states))
+}
@@ -0,0 +1,70 @@
+/* Copyright © 2023 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	gm_model "github.com/vmware/vsphere-automation-sdk-go/services/nsxt-gm/model"
+)
+
+func TestAccDataSourceNsxtPolicyGmFullSync_basic(t *testing.T) {
+	testResourceName := "data.nsxt_policy_gm_full_sync.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccOnlyGlobalManager(t)
+			testAccEnvDefined(t, "NSXT_TEST_SITE_NAME")
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyGmFullSyncReadTemplate(getTestSiteName()),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(testResourceName, "state.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicyGmFullSyncReadTemplate(siteName string) string {
+	return fmt.Sprintf(`
+data "nsxt_policy_site" "test" {
+  display_name = "%s"
+}
+
+resource "nsxt_policy_gm_full_sync" "test" {
+  site_paths = [data.nsxt_policy_site.test.path]
+}
+
+data "nsxt_policy_gm_full_sync" "test" {
+  depends_on = [nsxt_policy_gm_full_sync.test]
+}`, siteName)
+}
+
+func TestUnitListPolicyGmFullSyncStates(t *testing.T) {
+	fake := newFakeNsxServer(t)
+	meta := testUnitConfigureProvider(t, fake)
+	for _, id := range []string{"sync1", "sync2", "sync3"} {
+		fake.fullSyncStates = append(fake.fullSyncStates, encodeFakeNsxObject(id, gm_model.FullSyncState{Id: &id}, gm_model.FullSyncStateBindingType()))
+	}
+	fake.fullSyncPageSize = 2
+
+	// Listing follows the cursor regardless of reported result count
+	for _, resultCount := range []int{0, 2, 5} {
+		fake.fullSyncResultCount = resultCount
+		fake.fullSyncStateListRequests = 0
+		states, err := listPolicyGmFullSyncStates(getPolicyConnector(meta))
+		if err != nil {
+			t.Fatalf("Failed to list full sync states: %v", err)
+		}
+		if len(states) != 3 || *states[2].Id != "sync3" || fake.fullSyncStateListRequests != 2 {
+			t.Errorf("Expected 3 states in 2 requests with result count %d, got %d states in %d requests", resultCount, len(states), fake.fullSyncStateListRequests)
+		}
+	}
+}
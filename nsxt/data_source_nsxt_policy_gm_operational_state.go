@@ -0,0 +1,118 @@
+/* Copyright © 2023 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	gm "github.com/vmware/vsphere-automation-sdk-go/services/nsxt-gm"
+	gm_model "github.com/vmware/vsphere-automation-sdk-go/services/nsxt-gm/model"
+)
+
+func dataSourceNsxtPolicyGmOperationalState() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceNsxtPolicyGmOperationalStateRead,
+
+		Schema: map[string]*schema.Schema{
+			"id": getDataSourceIDSchema(),
+			"status": {
+				Type:        schema.TypeString,
+				Description: "Operational status of the Global Manager",
+				Computed:    true,
+			},
+			"consolidated_progress": {
+				Type:        schema.TypeString,
+				Description: "Consolidated progress of the current operation",
+				Computed:    true,
+			},
+			"errors": {
+				Type:        schema.TypeList,
+				Description: "Errors reported for the current operation",
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"warnings": {
+				Type:        schema.TypeList,
+				Description: "Warnings reported for the current operation",
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"timeout": {
+				Type:         schema.TypeInt,
+				Description:  "Timeout in seconds to wait for the current operation to complete",
+				Optional:     true,
+				Default:      1200,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"delay": {
+				Type:         schema.TypeInt,
+				Description:  "Initial delay to start operational state checks in seconds",
+				Optional:     true,
+				Default:      1,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+		},
+	}
+}
+
+func dataSourceNsxtPolicyGmOperationalStateRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if !isPolicyGlobalManager(m) {
+		return getErrorDiagnostics(globalManagerOnlyError())
+	}
+
+	connector := getPolicyConnector(m)
+	client := gm.NewGmOperationalStateClient(connector)
+	delay := d.Get("delay").(int)
+	timeout := d.Get("timeout").(int)
+
+	// Dummy id, just because each data source needs one
+	id := d.Get("id").(string)
+	if id == "" {
+		d.SetId(newUUID())
+	}
+
+	// Wait till the current operation, such as site onboarding or full sync, is no longer in progress
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{gm_model.GmOperationalState_CONSOLIDATED_PROGRESS_IN_PROGRESS},
+		Target: []string{
+			gm_model.GmOperationalState_CONSOLIDATED_PROGRESS_COMPLETED,
+			gm_model.GmOperationalState_CONSOLIDATED_PROGRESS_FAILED,
+			gm_model.GmOperationalState_STATUS_NONE,
+		},
+		Refresh: func() (interface{}, string, error) {
+			state, err := client.Get()
+			if err != nil {
+				return state, "", logAPIError("Error retrieving Global Manager operational state", err)
+			}
+
+			d.Set("status", state.Status)
+			d.Set("consolidated_progress", state.ConsolidatedProgress)
+			d.Set("errors", state.Errors)
+			d.Set("warnings", state.Warnings)
+
+			if state.ConsolidatedProgress == nil {
+				return state, gm_model.GmOperationalState_STATUS_NONE, nil
+			}
+			return state, *state.ConsolidatedProgress, nil
+		},
+		Timeout:    time.Duration(timeout) * time.Second,
+		MinTimeout: 1 * time.Second,
+		Delay:      time.Duration(delay) * time.Second,
+	}
+	_, err := stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		return diag.Errorf("Failed to get Global Manager operational state: %v", err)
+	}
+
+	return nil
+}
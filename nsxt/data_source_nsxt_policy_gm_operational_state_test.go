@@ -0,0 +1,37 @@
+/* Copyright © 2023 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceNsxtPolicyGmOperationalState_basic(t *testing.T) {
+	testResourceName := "data.nsxt_policy_gm_operational_state.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccOnlyGlobalManager(t)
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyGmOperationalStateReadTemplate(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(testResourceName, "status", "ACTIVE"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicyGmOperationalStateReadTemplate() string {
+	return `
+data "nsxt_policy_gm_operational_state" "test" {
+  timeout = 600
+}`
+}
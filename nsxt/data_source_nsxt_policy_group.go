@@ -4,9 +4,10 @@
 package nsxt
 
 import (
-	"fmt"
+	"context"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
 	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/domains"
@@ -15,8 +16,7 @@ import (
 
 func dataSourceNsxtPolicyGroup() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceNsxtPolicyGroupRead,
-
+		ReadContext: dataSourceNsxtPolicyGroupRead,
 		Schema: map[string]*schema.Schema{
 			"id":           getDataSourceIDSchema(),
 			"display_name": getDataSourceDisplayNameSchema(),
@@ -53,14 +53,14 @@ func listPolicyGroups(domain string, connector client.Connector) ([]model.Group,
 	}
 }
 
-func dataSourceNsxtPolicyGroupRead(d *schema.ResourceData, m interface{}) error {
+func dataSourceNsxtPolicyGroupRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	if isPolicyGlobalManager(m) {
 		domain := d.Get("domain").(string)
 		query := make(map[string]string)
 		query["parent_path"] = "*/" + domain
 		_, err := policyDataSourceResourceRead(d, getPolicyConnector(m), true, "Group", query)
 		if err != nil {
-			return err
+			return getOperationDiagnostics(m, err)
 		}
 		return nil
 	}
@@ -76,16 +76,16 @@ func dataSourceNsxtPolicyGroupRead(d *schema.ResourceData, m interface{}) error
 		objGet, err := client.Get(domain, objID)
 
 		if err != nil {
-			return handleDataSourceReadError(d, "Group", objID, err)
+			return getOperationDiagnostics(m, handleDataSourceReadError(d, "Group", objID, err))
 		}
 		obj = objGet
 	} else if objName == "" {
-		return fmt.Errorf("Error obtaining Group ID or name during read")
+		return diag.Errorf("Error obtaining Group ID or name during read")
 	} else {
 		// Get by full name/prefix
 		objList, err := listPolicyGroups(domain, connector)
 		if err != nil {
-			return handleListError("Group", err)
+			return getOperationDiagnostics(m, handleListError("Group", err))
 		}
 
 		// go over the list to find the correct one (prefer a perfect match. If not - prefix match)
@@ -101,16 +101,16 @@ func dataSourceNsxtPolicyGroupRead(d *schema.ResourceData, m interface{}) error
 		}
 		if len(perfectMatch) > 0 {
 			if len(perfectMatch) > 1 {
-				return fmt.Errorf("Found multiple Groups with name '%s'", objName)
+				return diag.Errorf("Found multiple Groups with name '%s'", objName)
 			}
 			obj = perfectMatch[0]
 		} else if len(prefixMatch) > 0 {
 			if len(prefixMatch) > 1 {
-				return fmt.Errorf("Found multiple Groups with name starting with '%s'", objName)
+				return diag.Errorf("Found multiple Groups with name starting with '%s'", objName)
 			}
 			obj = prefixMatch[0]
 		} else {
-			return fmt.Errorf("Group with name '%s' was not found", objName)
+			return diag.Errorf("Group with name '%s' was not found", objName)
 		}
 	}
 
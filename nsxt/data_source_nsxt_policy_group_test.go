@@ -157,3 +157,22 @@ data "nsxt_policy_group" "test" {
   domain       = "%s"
 }`, name, domain)
 }
+
+func TestUnitDataSourceNsxtPolicyGroup_basic(t *testing.T) {
+	fake := newFakeNsxServer(t)
+	meta := testUnitConfigureProvider(t, fake)
+
+	displayName := "group1"
+	fake.putPolicyObject("/infra/domains/default/groups/group1", model.Group{DisplayName: &displayName})
+
+	state := testUnitReadDataSource(t, meta, "nsxt_policy_group", map[string]interface{}{
+		"display_name": displayName,
+	})
+	testUnitCheckAttr(t, state, "id", "group1")
+	testUnitCheckAttr(t, state, "path", "/infra/domains/default/groups/group1")
+
+	state = testUnitReadDataSource(t, meta, "nsxt_policy_group", map[string]interface{}{
+		"id": "group1",
+	})
+	testUnitCheckAttr(t, state, "display_name", displayName)
+}
@@ -0,0 +1,176 @@
+/* Copyright © 2023 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/bindings"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/firewall_identity_stores"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+func dataSourceNsxtPolicyIdentityStoreDirectory() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceNsxtPolicyIdentityStoreDirectoryRead,
+
+		Schema: map[string]*schema.Schema{
+			"id": getDataSourceIDSchema(),
+			"identity_store_id": {
+				Type:        schema.TypeString,
+				Description: "ID of the Firewall Identity Store",
+				Required:    true,
+			},
+			"base_distinguished_name": {
+				Type:        schema.TypeString,
+				Description: "Only list entries under this distinguished name",
+				Optional:    true,
+			},
+			"group_filter": {
+				Type:        schema.TypeString,
+				Description: "Search keyword for directory groups. Groups are only listed when this is specified",
+				Optional:    true,
+			},
+			"org_unit": {
+				Type:        schema.TypeList,
+				Description: "Organization units found in the directory",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Description: "Organization unit name",
+							Computed:    true,
+						},
+						"distinguished_name": {
+							Type:        schema.TypeString,
+							Description: "Distinguished name of the organization unit",
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"group": {
+				Type:        schema.TypeList,
+				Description: "Groups found in the directory",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Description: "Group name",
+							Computed:    true,
+						},
+						"distinguished_name": {
+							Type:        schema.TypeString,
+							Description: "Distinguished name of the group",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Whether DN equals base DN or is below it. Match is on whole RDN components,
+// so that OU=b,DC=example,DC=com is not considered to be under DC=ample,DC=com
+func isDistinguishedNameUnder(dn string, baseDN string) bool {
+	if baseDN == "" {
+		return true
+	}
+
+	dn = strings.ToLower(dn)
+	baseDN = strings.ToLower(baseDN)
+	return dn == baseDN || strings.HasSuffix(dn, ","+baseDN)
+}
+
+func flattenPolicyDirectoryOrgUnits(orgUnits []model.DirectoryOrgUnit, baseDN string) []map[string]interface{} {
+	var result []map[string]interface{}
+	for _, orgUnit := range orgUnits {
+		if orgUnit.DistinguishedName != nil && isDistinguishedNameUnder(*orgUnit.DistinguishedName, baseDN) {
+			elem := make(map[string]interface{})
+			elem["name"] = orgUnit.Name
+			elem["distinguished_name"] = orgUnit.DistinguishedName
+			result = append(result, elem)
+		}
+		result = append(result, flattenPolicyDirectoryOrgUnits(orgUnit.Children, baseDN)...)
+	}
+
+	return result
+}
+
+func listPolicyDirectoryGroups(connector client.Connector, storeID string, filter string, enforcementPointPath string) ([]model.DirectoryGroup, error) {
+	client := firewall_identity_stores.NewGroupsClient(connector)
+	converter := bindings.NewTypeConverter()
+	var results []model.DirectoryGroup
+	var cursor *string
+
+	for {
+		listResult, err := client.List(storeID, filter, cursor, &enforcementPointPath, nil, nil, nil, nil)
+		if err != nil {
+			return results, err
+		}
+		for _, obj := range listResult.Results {
+			group, errs := converter.ConvertToGolang(obj, model.DirectoryGroupBindingType())
+			if len(errs) > 0 {
+				return results, fmt.Errorf("Error converting Directory Group: %v", errs[0])
+			}
+			results = append(results, group.(model.DirectoryGroup))
+		}
+		cursor = listResult.Cursor
+		if cursor == nil || len(*cursor) == 0 {
+			return results, nil
+		}
+	}
+}
+
+func dataSourceNsxtPolicyIdentityStoreDirectoryRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if isPolicyGlobalManager(m) {
+		return getErrorDiagnostics(localManagerOnlyError())
+	}
+
+	connector := getPolicyConnector(m)
+	storeID := d.Get("identity_store_id").(string)
+	baseDN := d.Get("base_distinguished_name").(string)
+	groupFilter := d.Get("group_filter").(string)
+	enforcementPointPath := getPolicyEnforcementPointPath(m)
+
+	orgUnitsClient := firewall_identity_stores.NewOrgUnitsClient(connector)
+	orgUnits, err := orgUnitsClient.List(storeID, &enforcementPointPath)
+	if err != nil {
+		return getOperationDiagnostics(m, handleDataSourceReadError(d, "Firewall Identity Store Org Units", storeID, err))
+	}
+
+	err = d.Set("org_unit", flattenPolicyDirectoryOrgUnits(orgUnits.Results, baseDN))
+	if err != nil {
+		return getErrorDiagnostics(err)
+	}
+
+	var groupList []map[string]interface{}
+	if groupFilter != "" {
+		groups, err := listPolicyDirectoryGroups(connector, storeID, groupFilter, enforcementPointPath)
+		if err != nil {
+			return getOperationDiagnostics(m, handleDataSourceReadError(d, "Firewall Identity Store Groups", storeID, err))
+		}
+
+		for _, group := range groups {
+			if group.DistinguishedName == nil || !isDistinguishedNameUnder(*group.DistinguishedName, baseDN) {
+				continue
+			}
+			elem := make(map[string]interface{})
+			elem["name"] = group.DisplayName
+			elem["distinguished_name"] = group.DistinguishedName
+			groupList = append(groupList, elem)
+		}
+	}
+
+	d.SetId(storeID)
+	return getErrorDiagnostics(d.Set("group", groupList))
+}
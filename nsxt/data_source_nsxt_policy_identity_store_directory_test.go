@@ -0,0 +1,60 @@
+/* Copyright © 2023 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceNsxtPolicyIdentityStoreDirectory_basic(t *testing.T) {
+	testResourceName := "data.nsxt_policy_identity_store_directory.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t); testAccOnlyLocalManager(t); testAccNSXLdapPrecheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyIdentityStoreDirectoryTemplate(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(testResourceName, "id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "org_unit.#"),
+					resource.TestCheckResourceAttrSet(testResourceName, "group.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicyIdentityStoreDirectoryTemplate() string {
+	return testAccNsxtPolicyIdentityStoreLdapServerTemplate("tf-ldap-server") + fmt.Sprintf(`
+data "nsxt_policy_identity_store_directory" "test" {
+  identity_store_id       = nsxt_policy_identity_store_ldap_server.test.identity_store_id
+  base_distinguished_name = "%s"
+  group_filter            = "Domain"
+}`, getTestLdapBaseDN())
+}
+
+func TestUnitIsDistinguishedNameUnder(t *testing.T) {
+	baseDN := "DC=example,DC=com"
+	for dn, expected := range map[string]bool{
+		"DC=example,DC=com":               true,
+		"dc=Example,dc=COM":               true,
+		"OU=users,DC=example,DC=com":      true,
+		"CN=a,OU=users,dc=example,dc=com": true,
+		"DC=badexample,DC=com":            false,
+		"OU=users,DC=myexample,DC=com":    false,
+		"DC=com":                          false,
+	} {
+		if actual := isDistinguishedNameUnder(dn, baseDN); actual != expected {
+			t.Errorf("Expected %s under %s to be %v", dn, baseDN, expected)
+		}
+	}
+
+	if !isDistinguishedNameUnder("DC=example,DC=com", "") {
+		t.Errorf("Expected any DN to match empty base DN")
+	}
+}
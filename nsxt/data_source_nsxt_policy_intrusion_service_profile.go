@@ -4,13 +4,15 @@
 package nsxt
 
 import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceNsxtPolicyIntrusionServiceProfile() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceNsxtPolicyIntrusionServiceProfileRead,
-
+		ReadContext: dataSourceNsxtPolicyIntrusionServiceProfileRead,
 		Schema: map[string]*schema.Schema{
 			"id":           getDataSourceIDSchema(),
 			"display_name": getDataSourceExtendedDisplayNameSchema(),
@@ -20,16 +22,16 @@ func dataSourceNsxtPolicyIntrusionServiceProfile() *schema.Resource {
 	}
 }
 
-func dataSourceNsxtPolicyIntrusionServiceProfileRead(d *schema.ResourceData, m interface{}) error {
+func dataSourceNsxtPolicyIntrusionServiceProfileRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 
 	if isPolicyGlobalManager(m) {
-		return localManagerOnlyError()
+		return getErrorDiagnostics(localManagerOnlyError())
 	}
 
 	_, err := policyDataSourceResourceRead(d, connector, isPolicyGlobalManager(m), "IdsProfile", nil)
 	if err != nil {
-		return err
+		return getOperationDiagnostics(m, err)
 	}
 
 	return nil
@@ -4,9 +4,10 @@
 package nsxt
 
 import (
-	"fmt"
+	"context"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra"
 	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
@@ -14,8 +15,7 @@ import (
 
 func dataSourceNsxtPolicyIPBlock() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceNsxtPolicyIPBlockRead,
-
+		ReadContext: dataSourceNsxtPolicyIPBlockRead,
 		Schema: map[string]*schema.Schema{
 			"id":           getDataSourceIDSchema(),
 			"display_name": getDataSourceDisplayNameSchema(),
@@ -25,7 +25,7 @@ func dataSourceNsxtPolicyIPBlock() *schema.Resource {
 	}
 }
 
-func dataSourceNsxtPolicyIPBlockRead(d *schema.ResourceData, m interface{}) error {
+func dataSourceNsxtPolicyIPBlockRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 	client := infra.NewIpBlocksClient(connector)
 
@@ -36,16 +36,16 @@ func dataSourceNsxtPolicyIPBlockRead(d *schema.ResourceData, m interface{}) erro
 		// Get by id
 		objGet, err := client.Get(objID)
 		if err != nil {
-			return handleDataSourceReadError(d, "IpAddressBlock", objID, err)
+			return getOperationDiagnostics(m, handleDataSourceReadError(d, "IpAddressBlock", objID, err))
 		}
 		obj = objGet
 	} else if objName == "" {
-		return fmt.Errorf("Error obtaining IpAddressBlock ID or name during read")
+		return diag.Errorf("Error obtaining IpAddressBlock ID or name during read")
 	} else {
 		// Get by full name/prefix
 		objList, err := client.List(nil, nil, nil, nil, nil, nil)
 		if err != nil {
-			return handleListError("IpAddressBlock", err)
+			return getOperationDiagnostics(m, handleListError("IpAddressBlock", err))
 		}
 		// go over the list to find the correct one (prefer a perfect match. If not - prefix match)
 		var perfectMatch []model.IpAddressBlock
@@ -60,16 +60,16 @@ func dataSourceNsxtPolicyIPBlockRead(d *schema.ResourceData, m interface{}) erro
 		}
 		if len(perfectMatch) > 0 {
 			if len(perfectMatch) > 1 {
-				return fmt.Errorf("Found multiple IpAddressBlocks with name '%s'", objName)
+				return diag.Errorf("Found multiple IpAddressBlocks with name '%s'", objName)
 			}
 			obj = perfectMatch[0]
 		} else if len(prefixMatch) > 0 {
 			if len(prefixMatch) > 1 {
-				return fmt.Errorf("Found multiple IpAddressBlocks with name starting with '%s'", objName)
+				return diag.Errorf("Found multiple IpAddressBlocks with name starting with '%s'", objName)
 			}
 			obj = prefixMatch[0]
 		} else {
-			return fmt.Errorf("IpAddressBlock with name '%s' was not found", objName)
+			return diag.Errorf("IpAddressBlock with name '%s' was not found", objName)
 		}
 	}
 
@@ -4,9 +4,10 @@
 package nsxt
 
 import (
-	"fmt"
+	"context"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra"
 	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
@@ -14,8 +15,7 @@ import (
 
 func dataSourceNsxtPolicyIPPool() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceNsxtPolicyIPPoolRead,
-
+		ReadContext: dataSourceNsxtPolicyIPPoolRead,
 		Schema: map[string]*schema.Schema{
 			"id":           getDataSourceIDSchema(),
 			"display_name": getDataSourceDisplayNameSchema(),
@@ -25,7 +25,7 @@ func dataSourceNsxtPolicyIPPool() *schema.Resource {
 	}
 }
 
-func dataSourceNsxtPolicyIPPoolRead(d *schema.ResourceData, m interface{}) error {
+func dataSourceNsxtPolicyIPPoolRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 	client := infra.NewIpPoolsClient(connector)
 
@@ -36,16 +36,16 @@ func dataSourceNsxtPolicyIPPoolRead(d *schema.ResourceData, m interface{}) error
 		// Get by id
 		objGet, err := client.Get(objID)
 		if err != nil {
-			return handleDataSourceReadError(d, "IpAddressPool", objID, err)
+			return getOperationDiagnostics(m, handleDataSourceReadError(d, "IpAddressPool", objID, err))
 		}
 		obj = objGet
 	} else if objName == "" {
-		return fmt.Errorf("Error obtaining IpAddressPool ID or name during read")
+		return diag.Errorf("Error obtaining IpAddressPool ID or name during read")
 	} else {
 		// Get by full name/prefix
 		objList, err := client.List(nil, nil, nil, nil, nil, nil)
 		if err != nil {
-			return handleListError("IpAddressPool", err)
+			return getOperationDiagnostics(m, handleListError("IpAddressPool", err))
 		}
 		// go over the list to find the correct one (prefer a perfect match. If not - prefix match)
 		var perfectMatch []model.IpAddressPool
@@ -60,16 +60,16 @@ func dataSourceNsxtPolicyIPPoolRead(d *schema.ResourceData, m interface{}) error
 		}
 		if len(perfectMatch) > 0 {
 			if len(perfectMatch) > 1 {
-				return fmt.Errorf("Found multiple IpAddressPools with name '%s'", objName)
+				return diag.Errorf("Found multiple IpAddressPools with name '%s'", objName)
 			}
 			obj = perfectMatch[0]
 		} else if len(prefixMatch) > 0 {
 			if len(prefixMatch) > 1 {
-				return fmt.Errorf("Found multiple IpAddressPools with name starting with '%s'", objName)
+				return diag.Errorf("Found multiple IpAddressPools with name starting with '%s'", objName)
 			}
 			obj = prefixMatch[0]
 		} else {
-			return fmt.Errorf("IpAddressPool with name '%s' was not found", objName)
+			return diag.Errorf("IpAddressPool with name '%s' was not found", objName)
 		}
 	}
 
@@ -4,9 +4,11 @@
 package nsxt
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/vmware/vsphere-automation-sdk-go/runtime/bindings"
 	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
@@ -14,8 +16,7 @@ import (
 
 func dataSourceNsxtPolicyIPSecVpnLocalEndpoint() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceNsxtPolicyIPSecVpnLocalEndpointRead,
-
+		ReadContext: dataSourceNsxtPolicyIPSecVpnLocalEndpointRead,
 		Schema: map[string]*schema.Schema{
 			"id":           getDataSourceIDSchema(),
 			"service_path": getPolicyPathSchema(false, false, "Policy path for IPSec VPN service"),
@@ -31,7 +32,7 @@ func dataSourceNsxtPolicyIPSecVpnLocalEndpoint() *schema.Resource {
 	}
 }
 
-func dataSourceNsxtPolicyIPSecVpnLocalEndpointRead(d *schema.ResourceData, m interface{}) error {
+func dataSourceNsxtPolicyIPSecVpnLocalEndpointRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 
 	servicePath := d.Get("service_path").(string)
@@ -41,7 +42,7 @@ func dataSourceNsxtPolicyIPSecVpnLocalEndpointRead(d *schema.ResourceData, m int
 		if len(s) != 8 && len(s) != 6 {
 			// The policy path of IPSec VPN Service should be like /infra/tier-0s/aaa/locale-services/bbb/ipsec-vpn-services/ccc
 			// or /infra/tier-0s/aaa/ipsec-vpn-services/bbb
-			return fmt.Errorf("Invalid IPSec Vpn Service path: %s", servicePath)
+			return diag.Errorf("Invalid IPSec Vpn Service path: %s", servicePath)
 		}
 		if len(s) == 8 {
 			// search API does not recognized the locale-services part in the VPN service path
@@ -51,13 +52,13 @@ func dataSourceNsxtPolicyIPSecVpnLocalEndpointRead(d *schema.ResourceData, m int
 	}
 	objInt, err := policyDataSourceResourceReadWithValidation(d, connector, isPolicyGlobalManager(m), "IPSecVpnLocalEndpoint", query, false)
 	if err != nil {
-		return err
+		return getOperationDiagnostics(m, err)
 	}
 
 	converter := bindings.NewTypeConverter()
 	dataValue, errors := converter.ConvertToGolang(objInt, model.IPSecVpnLocalEndpointBindingType())
 	if len(errors) > 0 {
-		return fmt.Errorf("Failed to convert type for Local Endpoint: %v", errors[0])
+		return diag.Errorf("Failed to convert type for Local Endpoint: %v", errors[0])
 	}
 	obj := dataValue.(model.IPSecVpnLocalEndpoint)
 	d.Set("local_address", obj.LocalAddress)
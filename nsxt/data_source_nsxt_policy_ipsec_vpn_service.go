@@ -4,15 +4,16 @@
 package nsxt
 
 import (
+	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceNsxtPolicyIPSecVpnService() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceNsxtPolicyIPSecVpnServiceRead,
-
+		ReadContext: dataSourceNsxtPolicyIPSecVpnServiceRead,
 		Schema: map[string]*schema.Schema{
 			"id":           getDataSourceIDSchema(),
 			"gateway_path": getPolicyPathSchema(false, false, "Gateway path"),
@@ -23,7 +24,7 @@ func dataSourceNsxtPolicyIPSecVpnService() *schema.Resource {
 	}
 }
 
-func dataSourceNsxtPolicyIPSecVpnServiceRead(d *schema.ResourceData, m interface{}) error {
+func dataSourceNsxtPolicyIPSecVpnServiceRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 
 	gwPath := d.Get("gateway_path").(string)
@@ -33,7 +34,7 @@ func dataSourceNsxtPolicyIPSecVpnServiceRead(d *schema.ResourceData, m interface
 	}
 	_, err := policyDataSourceResourceReadWithValidation(d, connector, isPolicyGlobalManager(m), "IPSecVpnService", query, false)
 	if err != nil {
-		return err
+		return getOperationDiagnostics(m, err)
 	}
 
 	return nil
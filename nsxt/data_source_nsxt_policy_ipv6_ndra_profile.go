@@ -4,9 +4,10 @@
 package nsxt
 
 import (
-	"fmt"
+	"context"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra"
 	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
@@ -14,8 +15,7 @@ import (
 
 func dataSourceNsxtPolicyIpv6NdraProfile() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceNsxtPolicyIpv6NdraProfileRead,
-
+		ReadContext: dataSourceNsxtPolicyIpv6NdraProfileRead,
 		Schema: map[string]*schema.Schema{
 			"id":           getDataSourceIDSchema(),
 			"display_name": getDataSourceDisplayNameSchema(),
@@ -25,11 +25,11 @@ func dataSourceNsxtPolicyIpv6NdraProfile() *schema.Resource {
 	}
 }
 
-func dataSourceNsxtPolicyIpv6NdraProfileRead(d *schema.ResourceData, m interface{}) error {
+func dataSourceNsxtPolicyIpv6NdraProfileRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	if isPolicyGlobalManager(m) {
 		_, err := policyDataSourceResourceRead(d, getPolicyConnector(m), true, "Ipv6NdraProfile", nil)
 		if err != nil {
-			return err
+			return getOperationDiagnostics(m, err)
 		}
 		return nil
 	}
@@ -44,17 +44,17 @@ func dataSourceNsxtPolicyIpv6NdraProfileRead(d *schema.ResourceData, m interface
 		// Get by id
 		objGet, err := client.Get(objID)
 		if err != nil {
-			return handleDataSourceReadError(d, "IPv6NdraProfile", objID, err)
+			return getOperationDiagnostics(m, handleDataSourceReadError(d, "IPv6NdraProfile", objID, err))
 		}
 		obj = objGet
 	} else if objName == "" {
-		return fmt.Errorf("Error obtaining Ipv6NdraProfile ID or name during read")
+		return diag.Errorf("Error obtaining Ipv6NdraProfile ID or name during read")
 	} else {
 		// Get by full name/prefix
 		includeMarkForDeleteObjectsParam := false
 		objList, err := client.List(nil, &includeMarkForDeleteObjectsParam, nil, nil, nil, nil)
 		if err != nil {
-			return handleListError("IPv6NdraProfile", err)
+			return getOperationDiagnostics(m, handleListError("IPv6NdraProfile", err))
 		}
 		// go over the list to find the correct one (prefer a perfect match. If not - prefix match)
 		var perfectMatch []model.Ipv6NdraProfile
@@ -69,16 +69,16 @@ func dataSourceNsxtPolicyIpv6NdraProfileRead(d *schema.ResourceData, m interface
 		}
 		if len(perfectMatch) > 0 {
 			if len(perfectMatch) > 1 {
-				return fmt.Errorf("Found multiple Ipv6NdraProfiles with name '%s'", objName)
+				return diag.Errorf("Found multiple Ipv6NdraProfiles with name '%s'", objName)
 			}
 			obj = perfectMatch[0]
 		} else if len(prefixMatch) > 0 {
 			if len(prefixMatch) > 1 {
-				return fmt.Errorf("Found multiple Ipv6NdraProfiles with name starting with '%s'", objName)
+				return diag.Errorf("Found multiple Ipv6NdraProfiles with name starting with '%s'", objName)
 			}
 			obj = prefixMatch[0]
 		} else {
-			return fmt.Errorf("Ipv6NdraProfile with name '%s' was not found", objName)
+			return diag.Errorf("Ipv6NdraProfile with name '%s' was not found", objName)
 		}
 	}
 
@@ -4,9 +4,11 @@
 package nsxt
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/vmware/vsphere-automation-sdk-go/runtime/bindings"
@@ -24,8 +26,7 @@ var lbAppProfileTypeMap = map[string]string{
 
 func dataSourceNsxtPolicyLBAppProfile() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceNsxtPolicyLBAppProfileRead,
-
+		ReadContext: dataSourceNsxtPolicyLBAppProfileRead,
 		Schema: map[string]*schema.Schema{
 			"id": getDataSourceIDSchema(),
 			"type": {
@@ -61,7 +62,7 @@ func policyLbAppProfileConvert(obj *data.StructValue, requestedType string) (*mo
 	return &profile, nil
 }
 
-func dataSourceNsxtPolicyLBAppProfileRead(d *schema.ResourceData, m interface{}) error {
+func dataSourceNsxtPolicyLBAppProfileRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 	client := infra.NewLbAppProfilesClient(connector)
 
@@ -75,23 +76,23 @@ func dataSourceNsxtPolicyLBAppProfileRead(d *schema.ResourceData, m interface{})
 		objGet, err := client.Get(objID)
 
 		if err != nil {
-			return handleDataSourceReadError(d, "LBAppProfile", objID, err)
+			return getOperationDiagnostics(m, handleDataSourceReadError(d, "LBAppProfile", objID, err))
 		}
 		result, err = policyLbAppProfileConvert(objGet, objType)
 		if err != nil {
-			return fmt.Errorf("Error while converting LBAppProfile %s: %v", objID, err)
+			return diag.Errorf("Error while converting LBAppProfile %s: %v", objID, err)
 		}
 		if result == nil {
-			return fmt.Errorf("LBAppProfile with ID '%s' and type %s was not found", objID, objType)
+			return diag.Errorf("LBAppProfile with ID '%s' and type %s was not found", objID, objType)
 		}
 	} else if objName == "" && !typeSet {
-		return fmt.Errorf("Error obtaining LBAppProfile ID or name or type during read")
+		return diag.Errorf("Error obtaining LBAppProfile ID or name or type during read")
 	} else {
 		// Get by full name/prefix
 		includeMarkForDeleteObjectsParam := false
 		objList, err := client.List(nil, &includeMarkForDeleteObjectsParam, nil, nil, nil, nil)
 		if err != nil {
-			return handleListError("LBAppProfile", err)
+			return getOperationDiagnostics(m, handleListError("LBAppProfile", err))
 		}
 		// go over the list to find the correct one (prefer a perfect match. If not - prefix match)
 		var perfectMatch []model.LBAppProfile
@@ -99,7 +100,7 @@ func dataSourceNsxtPolicyLBAppProfileRead(d *schema.ResourceData, m interface{})
 		for _, objInList := range objList.Results {
 			obj, err := policyLbAppProfileConvert(objInList, objType)
 			if err != nil {
-				return fmt.Errorf("Error while converting LBAppProfile %s: %v", objID, err)
+				return diag.Errorf("Error while converting LBAppProfile %s: %v", objID, err)
 			}
 			if obj == nil {
 				continue
@@ -117,16 +118,16 @@ func dataSourceNsxtPolicyLBAppProfileRead(d *schema.ResourceData, m interface{})
 		}
 		if len(perfectMatch) > 0 {
 			if len(perfectMatch) > 1 {
-				return fmt.Errorf("Found multiple LBAppProfiles with name '%s'", objName)
+				return diag.Errorf("Found multiple LBAppProfiles with name '%s'", objName)
 			}
 			result = &perfectMatch[0]
 		} else if len(prefixMatch) > 0 {
 			if len(prefixMatch) > 1 {
-				return fmt.Errorf("Found multiple LBAppProfiles with name starting with '%s'", objName)
+				return diag.Errorf("Found multiple LBAppProfiles with name starting with '%s'", objName)
 			}
 			result = &prefixMatch[0]
 		} else {
-			return fmt.Errorf("LBAppProfile with name '%s' and type %s was not found", objName, objType)
+			return diag.Errorf("LBAppProfile with name '%s' and type %s was not found", objName, objType)
 		}
 	}
 
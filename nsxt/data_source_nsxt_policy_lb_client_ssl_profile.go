@@ -4,9 +4,10 @@
 package nsxt
 
 import (
-	"fmt"
+	"context"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra"
 	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
@@ -14,8 +15,7 @@ import (
 
 func dataSourceNsxtPolicyLBClientSslProfile() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceNsxtPolicyLBClientSslProfileRead,
-
+		ReadContext: dataSourceNsxtPolicyLBClientSslProfileRead,
 		Schema: map[string]*schema.Schema{
 			"id":           getDataSourceIDSchema(),
 			"display_name": getDataSourceDisplayNameSchema(),
@@ -25,7 +25,7 @@ func dataSourceNsxtPolicyLBClientSslProfile() *schema.Resource {
 	}
 }
 
-func dataSourceNsxtPolicyLBClientSslProfileRead(d *schema.ResourceData, m interface{}) error {
+func dataSourceNsxtPolicyLBClientSslProfileRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 	client := infra.NewLbClientSslProfilesClient(connector)
 
@@ -37,17 +37,17 @@ func dataSourceNsxtPolicyLBClientSslProfileRead(d *schema.ResourceData, m interf
 		objGet, err := client.Get(objID)
 
 		if err != nil {
-			return handleDataSourceReadError(d, "LBClientSslProfile", objID, err)
+			return getOperationDiagnostics(m, handleDataSourceReadError(d, "LBClientSslProfile", objID, err))
 		}
 		obj = objGet
 	} else if objName == "" {
-		return fmt.Errorf("Error obtaining LBClientSslProfile ID or name during read")
+		return diag.Errorf("Error obtaining LBClientSslProfile ID or name during read")
 	} else {
 		// Get by full name/prefix
 		includeMarkForDeleteObjectsParam := false
 		objList, err := client.List(nil, &includeMarkForDeleteObjectsParam, nil, nil, nil, nil)
 		if err != nil {
-			return handleListError("LBClientSslProfile", err)
+			return getOperationDiagnostics(m, handleListError("LBClientSslProfile", err))
 		}
 		// go over the list to find the correct one (prefer a perfect match. If not - prefix match)
 		var perfectMatch []model.LBClientSslProfile
@@ -62,16 +62,16 @@ func dataSourceNsxtPolicyLBClientSslProfileRead(d *schema.ResourceData, m interf
 		}
 		if len(perfectMatch) > 0 {
 			if len(perfectMatch) > 1 {
-				return fmt.Errorf("Found multiple LBClientSslProfiles with name '%s'", objName)
+				return diag.Errorf("Found multiple LBClientSslProfiles with name '%s'", objName)
 			}
 			obj = perfectMatch[0]
 		} else if len(prefixMatch) > 0 {
 			if len(prefixMatch) > 1 {
-				return fmt.Errorf("Found multiple LBClientSslProfiles with name starting with '%s'", objName)
+				return diag.Errorf("Found multiple LBClientSslProfiles with name starting with '%s'", objName)
 			}
 			obj = prefixMatch[0]
 		} else {
-			return fmt.Errorf("LBClientSslProfile with name '%s' was not found", objName)
+			return diag.Errorf("LBClientSslProfile with name '%s' was not found", objName)
 		}
 	}
 
@@ -4,9 +4,11 @@
 package nsxt
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/vmware/vsphere-automation-sdk-go/runtime/bindings"
@@ -27,8 +29,7 @@ var lbMonitorTypeMap = map[string]string{
 
 func dataSourceNsxtPolicyLBMonitor() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceNsxtPolicyLBMonitorRead,
-
+		ReadContext: dataSourceNsxtPolicyLBMonitorRead,
 		Schema: map[string]*schema.Schema{
 			"id": getDataSourceIDSchema(),
 			"type": {
@@ -64,7 +65,7 @@ func policyLbMonitorConvert(obj *data.StructValue, requestedType string) (*model
 	return &profile, nil
 }
 
-func dataSourceNsxtPolicyLBMonitorRead(d *schema.ResourceData, m interface{}) error {
+func dataSourceNsxtPolicyLBMonitorRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 	client := infra.NewLbMonitorProfilesClient(connector)
 
@@ -78,23 +79,23 @@ func dataSourceNsxtPolicyLBMonitorRead(d *schema.ResourceData, m interface{}) er
 		objGet, err := client.Get(objID)
 
 		if err != nil {
-			return handleDataSourceReadError(d, "LBMonitor", objID, err)
+			return getOperationDiagnostics(m, handleDataSourceReadError(d, "LBMonitor", objID, err))
 		}
 		result, err = policyLbMonitorConvert(objGet, objType)
 		if err != nil {
-			return fmt.Errorf("Error while converting LBMonitor %s: %v", objID, err)
+			return diag.Errorf("Error while converting LBMonitor %s: %v", objID, err)
 		}
 		if result == nil {
-			return fmt.Errorf("LBMonitor with ID '%s' and type %s was not found", objID, objType)
+			return diag.Errorf("LBMonitor with ID '%s' and type %s was not found", objID, objType)
 		}
 	} else if objName == "" && !typeSet {
-		return fmt.Errorf("Error obtaining LBMonitor ID or name or type during read")
+		return diag.Errorf("Error obtaining LBMonitor ID or name or type during read")
 	} else {
 		// Get by full name/prefix
 		includeMarkForDeleteObjectsParam := false
 		objList, err := client.List(nil, &includeMarkForDeleteObjectsParam, nil, nil, nil, nil)
 		if err != nil {
-			return handleListError("LBMonitor", err)
+			return getOperationDiagnostics(m, handleListError("LBMonitor", err))
 		}
 		// go over the list to find the correct one (prefer a perfect match. If not - prefix match)
 		var perfectMatch []model.LBMonitorProfile
@@ -102,7 +103,7 @@ func dataSourceNsxtPolicyLBMonitorRead(d *schema.ResourceData, m interface{}) er
 		for _, objInList := range objList.Results {
 			obj, err := policyLbMonitorConvert(objInList, objType)
 			if err != nil {
-				return fmt.Errorf("Error while converting LBMonitor %s: %v", objID, err)
+				return diag.Errorf("Error while converting LBMonitor %s: %v", objID, err)
 			}
 			if obj == nil {
 				continue
@@ -120,16 +121,16 @@ func dataSourceNsxtPolicyLBMonitorRead(d *schema.ResourceData, m interface{}) er
 		}
 		if len(perfectMatch) > 0 {
 			if len(perfectMatch) > 1 {
-				return fmt.Errorf("Found multiple LBMonitors with name '%s'", objName)
+				return diag.Errorf("Found multiple LBMonitors with name '%s'", objName)
 			}
 			result = &perfectMatch[0]
 		} else if len(prefixMatch) > 0 {
 			if len(prefixMatch) > 1 {
-				return fmt.Errorf("Found multiple LBMonitors with name starting with '%s'", objName)
+				return diag.Errorf("Found multiple LBMonitors with name starting with '%s'", objName)
 			}
 			result = &prefixMatch[0]
 		} else {
-			return fmt.Errorf("LBMonitor with name '%s' and type %s was not found", objName, objType)
+			return diag.Errorf("LBMonitor with name '%s' and type %s was not found", objName, objType)
 		}
 	}
 
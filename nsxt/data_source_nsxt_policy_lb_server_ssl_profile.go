@@ -4,9 +4,10 @@
 package nsxt
 
 import (
-	"fmt"
+	"context"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra"
 	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
@@ -14,8 +15,7 @@ import (
 
 func dataSourceNsxtPolicyLBServerSslProfile() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceNsxtPolicyLBServerSslProfileRead,
-
+		ReadContext: dataSourceNsxtPolicyLBServerSslProfileRead,
 		Schema: map[string]*schema.Schema{
 			"id":           getDataSourceIDSchema(),
 			"display_name": getDataSourceDisplayNameSchema(),
@@ -25,7 +25,7 @@ func dataSourceNsxtPolicyLBServerSslProfile() *schema.Resource {
 	}
 }
 
-func dataSourceNsxtPolicyLBServerSslProfileRead(d *schema.ResourceData, m interface{}) error {
+func dataSourceNsxtPolicyLBServerSslProfileRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 	client := infra.NewLbServerSslProfilesClient(connector)
 
@@ -37,17 +37,17 @@ func dataSourceNsxtPolicyLBServerSslProfileRead(d *schema.ResourceData, m interf
 		objGet, err := client.Get(objID)
 
 		if err != nil {
-			return handleDataSourceReadError(d, "LBServerSslProfile", objID, err)
+			return getOperationDiagnostics(m, handleDataSourceReadError(d, "LBServerSslProfile", objID, err))
 		}
 		obj = objGet
 	} else if objName == "" {
-		return fmt.Errorf("Error obtaining LBServerSslProfile ID or name during read")
+		return diag.Errorf("Error obtaining LBServerSslProfile ID or name during read")
 	} else {
 		// Get by full name/prefix
 		includeMarkForDeleteObjectsParam := false
 		objList, err := client.List(nil, &includeMarkForDeleteObjectsParam, nil, nil, nil, nil)
 		if err != nil {
-			return handleListError("LBServerSslProfile", err)
+			return getOperationDiagnostics(m, handleListError("LBServerSslProfile", err))
 		}
 		// go over the list to find the correct one (prefer a perfect match. If not - prefix match)
 		var perfectMatch []model.LBServerSslProfile
@@ -62,16 +62,16 @@ func dataSourceNsxtPolicyLBServerSslProfileRead(d *schema.ResourceData, m interf
 		}
 		if len(perfectMatch) > 0 {
 			if len(perfectMatch) > 1 {
-				return fmt.Errorf("Found multiple LBServerSslProfiles with name '%s'", objName)
+				return diag.Errorf("Found multiple LBServerSslProfiles with name '%s'", objName)
 			}
 			obj = perfectMatch[0]
 		} else if len(prefixMatch) > 0 {
 			if len(prefixMatch) > 1 {
-				return fmt.Errorf("Found multiple LBServerSslProfiles with name starting with '%s'", objName)
+				return diag.Errorf("Found multiple LBServerSslProfiles with name starting with '%s'", objName)
 			}
 			obj = prefixMatch[0]
 		} else {
-			return fmt.Errorf("LBServerSslProfile with name '%s' was not found", objName)
+			return diag.Errorf("LBServerSslProfile with name '%s' was not found", objName)
 		}
 	}
 
@@ -4,13 +4,15 @@
 package nsxt
 
 import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceNsxtPolicyLbService() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceNsxtPolicyLbServiceRead,
-
+		ReadContext: dataSourceNsxtPolicyLbServiceRead,
 		Schema: map[string]*schema.Schema{
 			"id":           getDataSourceIDSchema(),
 			"display_name": getDataSourceExtendedDisplayNameSchema(),
@@ -20,12 +22,12 @@ func dataSourceNsxtPolicyLbService() *schema.Resource {
 	}
 }
 
-func dataSourceNsxtPolicyLbServiceRead(d *schema.ResourceData, m interface{}) error {
+func dataSourceNsxtPolicyLbServiceRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 
 	_, err := policyDataSourceResourceRead(d, connector, isPolicyGlobalManager(m), "LBService", nil)
 	if err != nil {
-		return err
+		return getOperationDiagnostics(m, err)
 	}
 
 	return nil
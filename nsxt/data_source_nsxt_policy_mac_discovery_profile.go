@@ -4,9 +4,10 @@
 package nsxt
 
 import (
-	"fmt"
+	"context"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra"
 	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
@@ -14,8 +15,7 @@ import (
 
 func dataSourceNsxtPolicyMacDiscoveryProfile() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceNsxtPolicyMacDiscoveryProfileRead,
-
+		ReadContext: dataSourceNsxtPolicyMacDiscoveryProfileRead,
 		Schema: map[string]*schema.Schema{
 			"id":           getDataSourceIDSchema(),
 			"display_name": getDataSourceDisplayNameSchema(),
@@ -25,11 +25,11 @@ func dataSourceNsxtPolicyMacDiscoveryProfile() *schema.Resource {
 	}
 }
 
-func dataSourceNsxtPolicyMacDiscoveryProfileRead(d *schema.ResourceData, m interface{}) error {
+func dataSourceNsxtPolicyMacDiscoveryProfileRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	if isPolicyGlobalManager(m) {
 		_, err := policyDataSourceResourceRead(d, getPolicyConnector(m), true, "MacDiscoveryProfile", nil)
 		if err != nil {
-			return err
+			return getOperationDiagnostics(m, err)
 		}
 		return nil
 	}
@@ -46,17 +46,17 @@ func dataSourceNsxtPolicyMacDiscoveryProfileRead(d *schema.ResourceData, m inter
 		objGet, err := client.Get(objID)
 
 		if err != nil {
-			return handleDataSourceReadError(d, "MacDiscoveryProfile", objID, err)
+			return getOperationDiagnostics(m, handleDataSourceReadError(d, "MacDiscoveryProfile", objID, err))
 		}
 		obj = objGet
 	} else if objName == "" {
-		return fmt.Errorf("Error obtaining MacDiscoveryProfile ID or name during read")
+		return diag.Errorf("Error obtaining MacDiscoveryProfile ID or name during read")
 	} else {
 		// Get by full name/prefix
 		includeMarkForDeleteObjectsParam := false
 		objList, err := client.List(nil, &includeMarkForDeleteObjectsParam, nil, nil, nil, nil)
 		if err != nil {
-			return handleListError("MacDiscoveryProfile", err)
+			return getOperationDiagnostics(m, handleListError("MacDiscoveryProfile", err))
 		}
 		// go over the list to find the correct one (prefer a perfect match. If not - prefix match)
 		var perfectMatch []model.MacDiscoveryProfile
@@ -71,16 +71,16 @@ func dataSourceNsxtPolicyMacDiscoveryProfileRead(d *schema.ResourceData, m inter
 		}
 		if len(perfectMatch) > 0 {
 			if len(perfectMatch) > 1 {
-				return fmt.Errorf("Found multiple MacDiscoveryProfiles with name '%s'", objName)
+				return diag.Errorf("Found multiple MacDiscoveryProfiles with name '%s'", objName)
 			}
 			obj = perfectMatch[0]
 		} else if len(prefixMatch) > 0 {
 			if len(prefixMatch) > 1 {
-				return fmt.Errorf("Found multiple MacDiscoveryProfiles with name starting with '%s'", objName)
+				return diag.Errorf("Found multiple MacDiscoveryProfiles with name starting with '%s'", objName)
 			}
 			obj = prefixMatch[0]
 		} else {
-			return fmt.Errorf("MacDiscoveryProfile with name '%s' was not found", objName)
+			return diag.Errorf("MacDiscoveryProfile with name '%s' was not found", objName)
 		}
 	}
 
@@ -4,9 +4,10 @@
 package nsxt
 
 import (
-	"fmt"
+	"context"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra"
 	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
@@ -14,8 +15,7 @@ import (
 
 func dataSourceNsxtPolicyQosProfile() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceNsxtPolicyQosProfileRead,
-
+		ReadContext: dataSourceNsxtPolicyQosProfileRead,
 		Schema: map[string]*schema.Schema{
 			"id":           getDataSourceIDSchema(),
 			"display_name": getDataSourceDisplayNameSchema(),
@@ -25,11 +25,11 @@ func dataSourceNsxtPolicyQosProfile() *schema.Resource {
 	}
 }
 
-func dataSourceNsxtPolicyQosProfileRead(d *schema.ResourceData, m interface{}) error {
+func dataSourceNsxtPolicyQosProfileRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	if isPolicyGlobalManager(m) {
 		_, err := policyDataSourceResourceRead(d, getPolicyConnector(m), true, "QoSProfile", nil)
 		if err != nil {
-			return err
+			return getOperationDiagnostics(m, err)
 		}
 		return nil
 	}
@@ -45,16 +45,16 @@ func dataSourceNsxtPolicyQosProfileRead(d *schema.ResourceData, m interface{}) e
 		objGet, err := client.Get(objID)
 
 		if err != nil {
-			return handleDataSourceReadError(d, "QosProfile", objID, err)
+			return getOperationDiagnostics(m, handleDataSourceReadError(d, "QosProfile", objID, err))
 		}
 		obj = objGet
 	} else if objName == "" {
-		return fmt.Errorf("Error obtaining QosProfile ID or name during read")
+		return diag.Errorf("Error obtaining QosProfile ID or name during read")
 	} else {
 		// Get by full name/prefix
 		objList, err := client.List(nil, nil, nil, nil, nil)
 		if err != nil {
-			return handleListError("QosProfile", err)
+			return getOperationDiagnostics(m, handleListError("QosProfile", err))
 		}
 		// go over the list to find the correct one (prefer a perfect match. If not - prefix match)
 		var perfectMatch []model.QosProfile
@@ -69,16 +69,16 @@ func dataSourceNsxtPolicyQosProfileRead(d *schema.ResourceData, m interface{}) e
 		}
 		if len(perfectMatch) > 0 {
 			if len(perfectMatch) > 1 {
-				return fmt.Errorf("Found multiple QosProfiles with name '%s'", objName)
+				return diag.Errorf("Found multiple QosProfiles with name '%s'", objName)
 			}
 			obj = perfectMatch[0]
 		} else if len(prefixMatch) > 0 {
 			if len(prefixMatch) > 1 {
-				return fmt.Errorf("Found multiple QosProfiles with name starting with '%s'", objName)
+				return diag.Errorf("Found multiple QosProfiles with name starting with '%s'", objName)
 			}
 			obj = prefixMatch[0]
 		} else {
-			return fmt.Errorf("QosProfile with name '%s' was not found", objName)
+			return diag.Errorf("QosProfile with name '%s' was not found", objName)
 		}
 	}
 
@@ -0,0 +1,110 @@
+/* Copyright © 2023 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceNsxtPolicyRealizationAlarms() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceNsxtPolicyRealizationAlarmsRead,
+
+		Schema: map[string]*schema.Schema{
+			"id": getDataSourceIDSchema(),
+			"path_prefix": {
+				Type:        schema.TypeString,
+				Description: "Only alarms on objects with this policy path or under it will be retrieved",
+				Optional:    true,
+			},
+			"source_type": {
+				Type:        schema.TypeString,
+				Description: "Only alarms on objects of this type, as it appears in policy path, will be retrieved",
+				Optional:    true,
+			},
+			"alarm": {
+				Type:        schema.TypeList,
+				Description: "List of realization alarms",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Description: "ID of the alarm",
+							Computed:    true,
+						},
+						"path": {
+							Type:        schema.TypeString,
+							Description: "Policy path of the alarm",
+							Computed:    true,
+						},
+						"source_path": {
+							Type:        schema.TypeString,
+							Description: "Policy path of the object the alarm is raised on",
+							Computed:    true,
+						},
+						"source_type": {
+							Type:        schema.TypeString,
+							Description: "Type of the object the alarm is raised on",
+							Computed:    true,
+						},
+						"source_site_id": {
+							Type:        schema.TypeString,
+							Description: "Site the alarm is raised on, relevant for global manager only",
+							Computed:    true,
+						},
+						"message": {
+							Type:        schema.TypeString,
+							Description: "Alarm message",
+							Computed:    true,
+						},
+						"details": {
+							Type:        schema.TypeString,
+							Description: "Alarm message along with error details",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceNsxtPolicyRealizationAlarmsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	connector := getPolicyConnector(m)
+	pathPrefix := d.Get("path_prefix").(string)
+	sourceType := d.Get("source_type").(string)
+
+	alarms, err := listPolicyRealizationAlarms(connector, isPolicyGlobalManager(m))
+	if err != nil {
+		return getOperationDiagnostics(m, handleListError("Realization Alarm", err))
+	}
+
+	var alarmList []map[string]interface{}
+	for _, alarm := range filterPolicyRealizationAlarms(alarms, pathPrefix, sourceType) {
+		elem := make(map[string]interface{})
+		elem["id"] = alarm.Id
+		elem["path"] = alarm.Path
+		elem["source_path"] = alarm.SourceReference
+		if alarm.SourceReference != nil {
+			elem["source_type"] = getPolicyObjectTypeFromPath(*alarm.SourceReference)
+		}
+		elem["source_site_id"] = alarm.SourceSiteId
+		elem["message"] = alarm.Message
+		elem["details"] = printPolicyAlarm(alarm)
+		alarmList = append(alarmList, elem)
+	}
+
+	// Dummy id, just because each data source needs one
+	id := d.Get("id").(string)
+	if id == "" {
+		d.SetId(newUUID())
+	}
+	d.Set("alarm", alarmList)
+
+	return nil
+}
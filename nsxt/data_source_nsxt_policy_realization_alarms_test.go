@@ -0,0 +1,45 @@
+/* Copyright © 2023 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceNsxtPolicyRealizationAlarms_basic(t *testing.T) {
+	name := getAccTestResourceName()
+	testResourceName := "data.nsxt_policy_realization_alarms.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccOnlyLocalManager(t)
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyRealizationAlarmsReadTemplate(name),
+				Check: resource.ComposeTestCheckFunc(
+					// Healthy gateway is not expected to have alarms
+					resource.TestCheckResourceAttr(testResourceName, "alarm.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicyRealizationAlarmsReadTemplate(name string) string {
+	return fmt.Sprintf(`
+resource "nsxt_policy_tier1_gateway" "test" {
+  display_name = "%s"
+}
+
+data "nsxt_policy_realization_alarms" "test" {
+  path_prefix = nsxt_policy_tier1_gateway.test.path
+  source_type = "tier-1s"
+}`, name)
+}
@@ -0,0 +1,68 @@
+/* Copyright © 2023 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceNsxtPolicyResource() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceNsxtPolicyResourceRead,
+
+		Schema: map[string]*schema.Schema{
+			"id": getDataSourceIDSchema(),
+			"path": {
+				Type:         schema.TypeString,
+				Description:  "Policy path of the object",
+				Required:     true,
+				ValidateFunc: validatePolicyGenericPath(),
+			},
+			"resource_type": {
+				Type:        schema.TypeString,
+				Description: "NSX resource type of the object",
+				Computed:    true,
+			},
+			"display_name": {
+				Type:        schema.TypeString,
+				Description: "Display name of the object",
+				Computed:    true,
+			},
+			"body": {
+				Type:        schema.TypeString,
+				Description: "JSON of the object as returned by NSX",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func dataSourceNsxtPolicyResourceRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	connector := getPolicyConnector(m)
+	path := d.Get("path").(string)
+
+	obj, err := policyGenericGet(connector, isPolicyGlobalManager(m), path)
+	if err != nil {
+		if isNotFoundError(err) {
+			return diag.Errorf("Policy object %s was not found", path)
+		}
+		return getOperationDiagnostics(m, handleDataSourceReadError(d, "Policy Resource", path, err))
+	}
+
+	body, err := json.Marshal(obj)
+	if err != nil {
+		return getErrorDiagnostics(err)
+	}
+
+	d.SetId(path)
+	d.Set("resource_type", obj["resource_type"])
+	d.Set("display_name", obj["display_name"])
+	d.Set("body", string(body))
+
+	return nil
+}
@@ -4,9 +4,11 @@
 package nsxt
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/vmware/vsphere-automation-sdk-go/runtime/bindings"
@@ -18,8 +20,7 @@ import (
 
 func dataSourceNsxtPolicySecurityPolicy() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceNsxtPolicySecurityPolicyRead,
-
+		ReadContext: dataSourceNsxtPolicySecurityPolicyRead,
 		Schema: map[string]*schema.Schema{
 			"id":           getDataSourceIDSchema(),
 			"display_name": getDataSourceDisplayNameSchema(),
@@ -70,7 +71,7 @@ func listSecurityPolicies(domain string, connector client.Connector) ([]model.Se
 	}
 }
 
-func dataSourceNsxtPolicySecurityPolicyRead(d *schema.ResourceData, m interface{}) error {
+func dataSourceNsxtPolicySecurityPolicyRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 
 	category := d.Get("category").(string)
@@ -85,13 +86,13 @@ func dataSourceNsxtPolicySecurityPolicyRead(d *schema.ResourceData, m interface{
 		query["is_default"] = fmt.Sprintf("%v", isDefault)
 		obj, err := policyDataSourceResourceReadWithValidation(d, connector, true, "SecurityPolicy", query, false)
 		if err != nil {
-			return err
+			return getOperationDiagnostics(m, err)
 		}
 
 		converter := bindings.NewTypeConverter()
 		dataValue, errors := converter.ConvertToGolang(obj, gm_model.SecurityPolicyBindingType())
 		if len(errors) > 0 {
-			return errors[0]
+			return getOperationDiagnostics(m, errors[0])
 		}
 
 		policy := dataValue.(gm_model.SecurityPolicy)
@@ -109,19 +110,19 @@ func dataSourceNsxtPolicySecurityPolicyRead(d *schema.ResourceData, m interface{
 		client := domains.NewSecurityPoliciesClient(connector)
 		objGet, err := client.Get(domain, objID)
 		if isNotFoundError(err) {
-			return fmt.Errorf("Security Policy with ID %s was not found", objID)
+			return diag.Errorf("Security Policy with ID %s was not found", objID)
 		}
 
 		if err != nil {
-			return fmt.Errorf("Error while reading Security Policy %s: %v", objID, err)
+			return diag.Errorf("Error while reading Security Policy %s: %v", objID, err)
 		}
 		obj = objGet
 	} else if objName == "" && category == "" {
-		return fmt.Errorf("Security Policy id, display name or category must be specified")
+		return diag.Errorf("Security Policy id, display name or category must be specified")
 	} else {
 		objList, err := listSecurityPolicies(domain, connector)
 		if err != nil {
-			return fmt.Errorf("Error while reading Security Policies: %v", err)
+			return diag.Errorf("Error while reading Security Policies: %v", err)
 		}
 		// go over the list to find the correct one (prefer a perfect match. If not - prefix match)
 		var perfectMatch []model.SecurityPolicy
@@ -146,16 +147,16 @@ func dataSourceNsxtPolicySecurityPolicyRead(d *schema.ResourceData, m interface{
 		}
 		if len(perfectMatch) > 0 {
 			if len(perfectMatch) > 1 {
-				return fmt.Errorf("Found multiple Security Policies with name '%s'", objName)
+				return diag.Errorf("Found multiple Security Policies with name '%s'", objName)
 			}
 			obj = perfectMatch[0]
 		} else if len(prefixMatch) > 0 {
 			if len(prefixMatch) > 1 {
-				return fmt.Errorf("Found multiple Security Policies with name starting with '%s' and category '%s'", objName, category)
+				return diag.Errorf("Found multiple Security Policies with name starting with '%s' and category '%s'", objName, category)
 			}
 			obj = prefixMatch[0]
 		} else {
-			return fmt.Errorf("Security Policy with name '%s' and category '%s' was not found", objName, category)
+			return diag.Errorf("Security Policy with name '%s' and category '%s' was not found", objName, category)
 		}
 	}
 
@@ -4,10 +4,11 @@
 package nsxt
 
 import (
-	"fmt"
+	"context"
 	"log"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra"
@@ -17,8 +18,7 @@ import (
 
 func dataSourceNsxtPolicySegmentRealization() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceNsxtPolicySegmentRealizationRead,
-
+		ReadContext: dataSourceNsxtPolicySegmentRealizationRead,
 		Schema: map[string]*schema.Schema{
 			"id": getDataSourceIDSchema(),
 			"path": {
@@ -41,7 +41,7 @@ func dataSourceNsxtPolicySegmentRealization() *schema.Resource {
 	}
 }
 
-func dataSourceNsxtPolicySegmentRealizationRead(d *schema.ResourceData, m interface{}) error {
+func dataSourceNsxtPolicySegmentRealizationRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	// Read the realization info by the path, and wait till it is valid
 	connector := getPolicyConnector(m)
 	commonProviderConfig := getCommonProviderConfig(m)
@@ -88,14 +88,18 @@ func dataSourceNsxtPolicySegmentRealizationRead(d *schema.ResourceData, m interf
 		MinTimeout: 1 * time.Second,
 		Delay:      1 * time.Second,
 	}
-	_, err := stateConf.WaitForState()
+	_, err := stateConf.WaitForStateContext(ctx)
 	if err != nil {
-		return fmt.Errorf("Failed to get realization information for %s: %v", path, err)
+		return diag.Errorf("Failed to get realization information for %s: %v", path, err)
 	}
 
 	// In some cases success state is returned a moment before VC actually sees the network
 	// Adding a short sleep here prevents vsphere provider from erroring out
-	time.Sleep(1 * time.Second)
+	select {
+	case <-ctx.Done():
+		return diag.FromErr(ctx.Err())
+	case <-time.After(1 * time.Second):
+	}
 
 	// We need to fetch network name to use in vpshere provider. However, state API does not
 	// return it in details yet. For now, we'll use segment display name, since its always
@@ -103,7 +107,7 @@ func dataSourceNsxtPolicySegmentRealizationRead(d *schema.ResourceData, m interf
 	segClient := infra.NewSegmentsClient(connector)
 	obj, err := segClient.Get(segmentID)
 	if err != nil {
-		return handleReadError(d, "Segment", segmentID, err)
+		return getOperationDiagnostics(m, handleReadError(d, "Segment", segmentID, err))
 	}
 
 	d.Set("network_name", obj.DisplayName)
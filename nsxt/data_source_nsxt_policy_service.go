@@ -4,9 +4,10 @@
 package nsxt
 
 import (
-	"fmt"
+	"context"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
 	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra"
@@ -15,8 +16,7 @@ import (
 
 func dataSourceNsxtPolicyService() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceNsxtPolicyServiceRead,
-
+		ReadContext: dataSourceNsxtPolicyServiceRead,
 		Schema: map[string]*schema.Schema{
 			"id":           getDataSourceIDSchema(),
 			"display_name": getDataSourceDisplayNameSchema(),
@@ -50,11 +50,11 @@ func dataSourceNsxtPolicyServiceReadAllServices(connector client.Connector) ([]m
 	}
 }
 
-func dataSourceNsxtPolicyServiceRead(d *schema.ResourceData, m interface{}) error {
+func dataSourceNsxtPolicyServiceRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	if isPolicyGlobalManager(m) {
 		_, err := policyDataSourceResourceRead(d, getPolicyConnector(m), true, "Service", nil)
 		if err != nil {
-			return err
+			return getOperationDiagnostics(m, err)
 		}
 		return nil
 	}
@@ -70,16 +70,16 @@ func dataSourceNsxtPolicyServiceRead(d *schema.ResourceData, m interface{}) erro
 		objGet, err := client.Get(objID)
 
 		if err != nil {
-			return handleDataSourceReadError(d, "Service", objID, err)
+			return getOperationDiagnostics(m, handleDataSourceReadError(d, "Service", objID, err))
 		}
 		obj = objGet
 	} else if objName == "" {
-		return fmt.Errorf("Error obtaining service ID or name during read")
+		return diag.Errorf("Error obtaining service ID or name during read")
 	} else {
 		// Get by full name/prefix
 		objList, err := dataSourceNsxtPolicyServiceReadAllServices(connector)
 		if err != nil {
-			return handleListError("Service", err)
+			return getOperationDiagnostics(m, handleListError("Service", err))
 		}
 		// go over the list to find the correct one (prefer a perfect match. If not - prefix match)
 		var perfectMatch []model.Service
@@ -94,16 +94,16 @@ func dataSourceNsxtPolicyServiceRead(d *schema.ResourceData, m interface{}) erro
 		}
 		if len(perfectMatch) > 0 {
 			if len(perfectMatch) > 1 {
-				return fmt.Errorf("Found multiple services with name '%s'", objName)
+				return diag.Errorf("Found multiple services with name '%s'", objName)
 			}
 			obj = perfectMatch[0]
 		} else if len(prefixMatch) > 0 {
 			if len(prefixMatch) > 1 {
-				return fmt.Errorf("Found multiple services with name starting with '%s'", objName)
+				return diag.Errorf("Found multiple services with name starting with '%s'", objName)
 			}
 			obj = prefixMatch[0]
 		} else {
-			return fmt.Errorf("Service '%s' was not found", objName)
+			return diag.Errorf("Service '%s' was not found", objName)
 		}
 	}
 
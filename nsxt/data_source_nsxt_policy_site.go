@@ -4,13 +4,15 @@
 package nsxt
 
 import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceNsxtPolicySite() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceNsxtPolicySiteRead,
-
+		ReadContext: dataSourceNsxtPolicySiteRead,
 		Schema: map[string]*schema.Schema{
 			"id":           getDataSourceIDSchema(),
 			"display_name": getDataSourceDisplayNameSchema(),
@@ -20,14 +22,14 @@ func dataSourceNsxtPolicySite() *schema.Resource {
 	}
 }
 
-func dataSourceNsxtPolicySiteRead(d *schema.ResourceData, m interface{}) error {
+func dataSourceNsxtPolicySiteRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	if !isPolicyGlobalManager(m) {
-		return globalManagerOnlyError()
+		return getErrorDiagnostics(globalManagerOnlyError())
 	}
 
 	_, err := policyDataSourceResourceRead(d, getPolicyConnector(m), true, "Site", nil)
 	if err != nil {
-		return err
+		return getOperationDiagnostics(m, err)
 	}
 
 	return nil
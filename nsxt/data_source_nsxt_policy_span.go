@@ -0,0 +1,80 @@
+/* Copyright © 2023 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	gm_infra "github.com/vmware/vsphere-automation-sdk-go/services/nsxt-gm/global_infra"
+)
+
+func dataSourceNsxtPolicySpan() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceNsxtPolicySpanRead,
+
+		Schema: map[string]*schema.Schema{
+			"id": getDataSourceIDSchema(),
+			"path": {
+				Type:         schema.TypeString,
+				Description:  "Policy path of the object to retrieve span for",
+				Required:     true,
+				ValidateFunc: validatePolicyPath(),
+			},
+			"site_path": {
+				Type:         schema.TypeString,
+				Description:  "Policy path of the site to retrieve span from",
+				Optional:     true,
+				ValidateFunc: validatePolicyPath(),
+			},
+			"site_paths": {
+				Type:        schema.TypeList,
+				Description: "Policy paths of the sites this object spans",
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"span_leader": {
+				Type:        schema.TypeString,
+				Description: "Policy path of the span leader object",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func dataSourceNsxtPolicySpanRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if !isPolicyGlobalManager(m) {
+		return getErrorDiagnostics(globalManagerOnlyError())
+	}
+
+	connector := getPolicyConnector(m)
+	client := gm_infra.NewSpanClient(connector)
+	path := d.Get("path").(string)
+	var sitePath *string
+	if d.Get("site_path").(string) != "" {
+		site := d.Get("site_path").(string)
+		sitePath = &site
+	}
+
+	obj, err := client.Get(path, sitePath)
+	if err != nil {
+		return getOperationDiagnostics(m, handleDataSourceReadError(d, "Span", path, err))
+	}
+
+	var sitePaths []string
+	for _, site := range obj.Sites {
+		if site.SitePath != nil {
+			sitePaths = append(sitePaths, *site.SitePath)
+		}
+	}
+
+	d.SetId(path)
+	d.Set("site_paths", sitePaths)
+	d.Set("span_leader", obj.SpanLeader)
+
+	return nil
+}
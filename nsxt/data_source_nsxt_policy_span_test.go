@@ -0,0 +1,37 @@
+/* Copyright © 2023 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceNsxtPolicySpan_basic(t *testing.T) {
+	testResourceName := "data.nsxt_policy_span.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccOnlyGlobalManager(t)
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicySpanReadTemplate(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(testResourceName, "site_paths.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicySpanReadTemplate() string {
+	return testAccNsxtPolicyTier0GatewayReadTemplate(getTier0RouterName()) + `
+data "nsxt_policy_span" "test" {
+  path = data.nsxt_policy_tier0_gateway.test.path
+}`
+}
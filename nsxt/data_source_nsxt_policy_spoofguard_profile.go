@@ -4,9 +4,10 @@
 package nsxt
 
 import (
-	"fmt"
+	"context"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra"
 	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
@@ -14,8 +15,7 @@ import (
 
 func dataSourceNsxtPolicySpoofGuardProfile() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceNsxtPolicySpoofGuardProfileRead,
-
+		ReadContext: dataSourceNsxtPolicySpoofGuardProfileRead,
 		Schema: map[string]*schema.Schema{
 			"id":           getDataSourceIDSchema(),
 			"display_name": getDataSourceDisplayNameSchema(),
@@ -25,11 +25,11 @@ func dataSourceNsxtPolicySpoofGuardProfile() *schema.Resource {
 	}
 }
 
-func dataSourceNsxtPolicySpoofGuardProfileRead(d *schema.ResourceData, m interface{}) error {
+func dataSourceNsxtPolicySpoofGuardProfileRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	if isPolicyGlobalManager(m) {
 		_, err := policyDataSourceResourceRead(d, getPolicyConnector(m), true, "SpoofGuardProfile", nil)
 		if err != nil {
-			return err
+			return getOperationDiagnostics(m, err)
 		}
 		return nil
 	}
@@ -45,17 +45,17 @@ func dataSourceNsxtPolicySpoofGuardProfileRead(d *schema.ResourceData, m interfa
 		objGet, err := client.Get(objID)
 
 		if err != nil {
-			return handleDataSourceReadError(d, "SpoofguardProfile", objID, err)
+			return getOperationDiagnostics(m, handleDataSourceReadError(d, "SpoofguardProfile", objID, err))
 		}
 		obj = objGet
 	} else if objName == "" {
-		return fmt.Errorf("Error obtaining SpoofGuardProfile ID or name during read")
+		return diag.Errorf("Error obtaining SpoofGuardProfile ID or name during read")
 	} else {
 		// Get by full name/prefix
 		includeMarkForDeleteObjectsParam := false
 		objList, err := client.List(nil, &includeMarkForDeleteObjectsParam, nil, nil, nil, nil)
 		if err != nil {
-			return handleListError("SpoofGuardProfiles", err)
+			return getOperationDiagnostics(m, handleListError("SpoofGuardProfiles", err))
 		}
 		// go over the list to find the correct one (prefer a perfect match. If not - prefix match)
 		var perfectMatch []model.SpoofGuardProfile
@@ -70,16 +70,16 @@ func dataSourceNsxtPolicySpoofGuardProfileRead(d *schema.ResourceData, m interfa
 		}
 		if len(perfectMatch) > 0 {
 			if len(perfectMatch) > 1 {
-				return fmt.Errorf("Found multiple SpoofGuardProfiles with name '%s'", objName)
+				return diag.Errorf("Found multiple SpoofGuardProfiles with name '%s'", objName)
 			}
 			obj = perfectMatch[0]
 		} else if len(prefixMatch) > 0 {
 			if len(prefixMatch) > 1 {
-				return fmt.Errorf("Found multiple SpoofGuardProfiles with name starting with '%s'", objName)
+				return diag.Errorf("Found multiple SpoofGuardProfiles with name starting with '%s'", objName)
 			}
 			obj = prefixMatch[0]
 		} else {
-			return fmt.Errorf("SpoofGuardProfile with name '%s' was not found", objName)
+			return diag.Errorf("SpoofGuardProfile with name '%s' was not found", objName)
 		}
 	}
 
@@ -4,9 +4,10 @@
 package nsxt
 
 import (
-	"fmt"
+	"context"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra"
 	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
@@ -14,8 +15,7 @@ import (
 
 func dataSourceNsxtPolicyTier0Gateway() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceNsxtPolicyTier0GatewayRead,
-
+		ReadContext: dataSourceNsxtPolicyTier0GatewayRead,
 		Schema: map[string]*schema.Schema{
 			"id":           getDataSourceIDSchema(),
 			"display_name": getDataSourceDisplayNameSchema(),
@@ -31,13 +31,13 @@ func dataSourceNsxtPolicyTier0Gateway() *schema.Resource {
 	}
 }
 
-func dataSourceNsxtPolicyTier0GatewayRead(d *schema.ResourceData, m interface{}) error {
+func dataSourceNsxtPolicyTier0GatewayRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 
 	if isPolicyGlobalManager(m) {
 		_, err := policyDataSourceResourceRead(d, connector, true, "Tier0", nil)
 		if err != nil {
-			return err
+			return getOperationDiagnostics(m, err)
 		}
 
 		// Single edge cluster is not informative for global manager
@@ -53,21 +53,21 @@ func dataSourceNsxtPolicyTier0GatewayRead(d *schema.ResourceData, m interface{})
 		// Get by id
 		objGet, err := client.Get(objID)
 		if isNotFoundError(err) {
-			return fmt.Errorf("Tier0 with ID %s was not found", objID)
+			return diag.Errorf("Tier0 with ID %s was not found", objID)
 		}
 
 		if err != nil {
-			return fmt.Errorf("Error while reading Tier0 %s: %v", objID, err)
+			return diag.Errorf("Error while reading Tier0 %s: %v", objID, err)
 		}
 		obj = objGet
 	} else if objName == "" {
-		return fmt.Errorf("Error obtaining Tier0 ID or name during read")
+		return diag.Errorf("Error obtaining Tier0 ID or name during read")
 	} else {
 		// Get by full name/prefix
 		includeMarkForDeleteObjectsParam := false
 		objList, err := client.List(nil, &includeMarkForDeleteObjectsParam, nil, nil, nil, nil)
 		if err != nil {
-			return fmt.Errorf("Error while reading Tier0s: %v", err)
+			return diag.Errorf("Error while reading Tier0s: %v", err)
 		}
 		// go over the list to find the correct one (prefer a perfect match. If not - prefix match)
 		var perfectMatch []model.Tier0
@@ -82,16 +82,16 @@ func dataSourceNsxtPolicyTier0GatewayRead(d *schema.ResourceData, m interface{})
 		}
 		if len(perfectMatch) > 0 {
 			if len(perfectMatch) > 1 {
-				return fmt.Errorf("Found multiple Tier0s with name '%s'", objName)
+				return diag.Errorf("Found multiple Tier0s with name '%s'", objName)
 			}
 			obj = perfectMatch[0]
 		} else if len(prefixMatch) > 0 {
 			if len(prefixMatch) > 1 {
-				return fmt.Errorf("Found multiple Tier0s with name starting with '%s'", objName)
+				return diag.Errorf("Found multiple Tier0s with name starting with '%s'", objName)
 			}
 			obj = prefixMatch[0]
 		} else {
-			return fmt.Errorf("Tier0 with name '%s' was not found", objName)
+			return diag.Errorf("Tier0 with name '%s' was not found", objName)
 		}
 	}
 
@@ -102,7 +102,7 @@ func dataSourceNsxtPolicyTier0GatewayRead(d *schema.ResourceData, m interface{})
 
 	localeServices, err := listPolicyTier0GatewayLocaleServices(connector, *obj.Id, false)
 	if err != nil {
-		return fmt.Errorf("Failed to read locale services for '%s'", objName)
+		return diag.Errorf("Failed to read locale services for '%s'", objName)
 	}
 	for _, service := range localeServices {
 		if service.EdgeClusterPath != nil {
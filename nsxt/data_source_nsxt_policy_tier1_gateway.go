@@ -4,9 +4,10 @@
 package nsxt
 
 import (
-	"fmt"
+	"context"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
 	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra"
@@ -15,8 +16,7 @@ import (
 
 func dataSourceNsxtPolicyTier1Gateway() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceNsxtPolicyTier1GatewayRead,
-
+		ReadContext: dataSourceNsxtPolicyTier1GatewayRead,
 		Schema: map[string]*schema.Schema{
 			"id":           getDataSourceIDSchema(),
 			"display_name": getDataSourceDisplayNameSchema(),
@@ -56,12 +56,12 @@ func dataSourceNsxtPolicyTier1GatewayReadAllTier1(connector client.Connector) ([
 	}
 }
 
-func dataSourceNsxtPolicyTier1GatewayRead(d *schema.ResourceData, m interface{}) error {
+func dataSourceNsxtPolicyTier1GatewayRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	if isPolicyGlobalManager(m) {
 		connector := getPolicyConnector(m)
 		_, err := policyDataSourceResourceRead(d, connector, true, "Tier1", nil)
 		if err != nil {
-			return err
+			return getOperationDiagnostics(m, err)
 		}
 
 		// Single edge cluster is not informative for global manager
@@ -81,16 +81,16 @@ func dataSourceNsxtPolicyTier1GatewayRead(d *schema.ResourceData, m interface{})
 		objGet, err := client.Get(objID)
 
 		if err != nil {
-			return handleDataSourceReadError(d, "Tier1", objID, err)
+			return getOperationDiagnostics(m, handleDataSourceReadError(d, "Tier1", objID, err))
 		}
 		obj = objGet
 	} else if objName == "" {
-		return fmt.Errorf("Error obtaining Tier1 ID or name during read")
+		return diag.Errorf("Error obtaining Tier1 ID or name during read")
 	} else {
 		// Get by full name/prefix
 		objList, err := dataSourceNsxtPolicyTier1GatewayReadAllTier1(connector)
 		if err != nil {
-			return handleListError("Tier1", err)
+			return getOperationDiagnostics(m, handleListError("Tier1", err))
 		}
 		// go over the list to find the correct one (prefer a perfect match. If not - prefix match)
 		var perfectMatch []model.Tier1
@@ -105,16 +105,16 @@ func dataSourceNsxtPolicyTier1GatewayRead(d *schema.ResourceData, m interface{})
 		}
 		if len(perfectMatch) > 0 {
 			if len(perfectMatch) > 1 {
-				return fmt.Errorf("Found multiple Tier1s with name '%s'", objName)
+				return diag.Errorf("Found multiple Tier1s with name '%s'", objName)
 			}
 			obj = perfectMatch[0]
 		} else if len(prefixMatch) > 0 {
 			if len(prefixMatch) > 1 {
-				return fmt.Errorf("Found multiple Tier1s with name starting with '%s'", objName)
+				return diag.Errorf("Found multiple Tier1s with name starting with '%s'", objName)
 			}
 			obj = prefixMatch[0]
 		} else {
-			return fmt.Errorf("Tier1 router '%s' was not found", objName)
+			return diag.Errorf("Tier1 router '%s' was not found", objName)
 		}
 	}
 
@@ -122,9 +122,9 @@ func dataSourceNsxtPolicyTier1GatewayRead(d *schema.ResourceData, m interface{})
 	d.Set("display_name", obj.DisplayName)
 	d.Set("description", obj.Description)
 	d.Set("path", obj.Path)
-	err := resourceNsxtPolicyTier1GatewayReadEdgeCluster(d, connector)
+	err := resourceNsxtPolicyTier1GatewayReadEdgeCluster(d, getPolicyProjectIDFromPath(*obj.Path), connector)
 	if err != nil {
-		return fmt.Errorf("Failed to get Tier1 %s locale-services: %v", *obj.Id, err)
+		return diag.Errorf("Failed to get Tier1 %s locale-services: %v", *obj.Id, err)
 	}
 	return nil
 }
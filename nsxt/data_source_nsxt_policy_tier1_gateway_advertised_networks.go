@@ -0,0 +1,189 @@
+/* Copyright © 2023 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"context"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/tier_1s"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+// Status reported by NSX for network successfully plumbed on the connected gateway
+var advertisedNetworkStatusSuccess = "SUCCESS"
+
+func dataSourceNsxtPolicyTier1GatewayAdvertisedNetworks() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceNsxtPolicyTier1GatewayAdvertisedNetworksRead,
+
+		Schema: map[string]*schema.Schema{
+			"id": getDataSourceIDSchema(),
+			"gateway_path": {
+				Type:         schema.TypeString,
+				Description:  "Policy path of Tier1 gateway",
+				Required:     true,
+				ValidateFunc: validatePolicyPath(),
+			},
+			"expected_networks": {
+				Type:        schema.TypeSet,
+				Description: "Networks that are expected to be successfully advertised to connected gateway",
+				Optional:    true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validateCidr(),
+				},
+			},
+			"timeout": {
+				Type:         schema.TypeInt,
+				Description:  "Timeout in seconds to wait for expected networks to be advertised",
+				Optional:     true,
+				Default:      300,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"delay": {
+				Type:         schema.TypeInt,
+				Description:  "Initial delay to start advertisement checks in seconds",
+				Optional:     true,
+				Default:      1,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"advertised_network": {
+				Type:        schema.TypeList,
+				Description: "Networks advertised to connected gateway",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"network": {
+							Type:        schema.TypeString,
+							Description: "Advertised network address",
+							Computed:    true,
+						},
+						"rule_filter_type": {
+							Type:        schema.TypeString,
+							Description: "Advertised rule filter type",
+							Computed:    true,
+						},
+						"status": {
+							Type:        schema.TypeString,
+							Description: "Advertisement status of network to connected gateway",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func listPolicyTier1GatewayAdvertisedNetworks(connector client.Connector, gwID string, enforcementPointPath string) ([]model.PolicyAdvertisedNetwork, error) {
+	client := tier_1s.NewAdvertisedNetworksClient(connector)
+	var results []model.PolicyAdvertisedNetwork
+	var cursor *string
+
+	for {
+		listResult, err := client.List(gwID, cursor, &enforcementPointPath, nil, nil, nil, nil)
+		if err != nil {
+			return results, err
+		}
+		results = append(results, listResult.Results...)
+		cursor = listResult.Cursor
+		if cursor == nil || len(*cursor) == 0 {
+			return results, nil
+		}
+	}
+}
+
+func getMissingPolicyAdvertisedNetworks(networks []model.PolicyAdvertisedNetwork, expected []string) []string {
+	advertised := make(map[string]bool)
+	for _, network := range networks {
+		if network.Network != nil && network.Status != nil && *network.Status == advertisedNetworkStatusSuccess {
+			advertised[*network.Network] = true
+		}
+	}
+
+	var missing []string
+	for _, network := range expected {
+		if !advertised[network] {
+			missing = append(missing, network)
+		}
+	}
+
+	return missing
+}
+
+func dataSourceNsxtPolicyTier1GatewayAdvertisedNetworksRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if isPolicyGlobalManager(m) {
+		return getErrorDiagnostics(localManagerOnlyError())
+	}
+
+	connector := getPolicyConnector(m)
+	gwPath := d.Get("gateway_path").(string)
+	isT0, gwID := parseGatewayPolicyPath(gwPath)
+	if isT0 || gwID == "" {
+		return diag.Errorf("Tier1 Gateway path expected, got %s", gwPath)
+	}
+	expected := getStringListFromSchemaSet(d, "expected_networks")
+	delay := d.Get("delay").(int)
+	timeout := d.Get("timeout").(int)
+	enforcementPointPath := getPolicyEnforcementPointPath(m)
+
+	var networks []model.PolicyAdvertisedNetwork
+	var missing []string
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"PENDING"},
+		Target:  []string{"ADVERTISED"},
+		Refresh: func() (interface{}, string, error) {
+			var err error
+			networks, err = listPolicyTier1GatewayAdvertisedNetworks(connector, gwID, enforcementPointPath)
+			if err != nil {
+				return networks, "", handleDataSourceReadError(d, "Tier1 Advertised Networks", gwID, err)
+			}
+
+			missing = getMissingPolicyAdvertisedNetworks(networks, expected)
+			if len(missing) > 0 {
+				log.Printf("[DEBUG] Networks %v are not yet advertised by Tier1 Gateway %s", missing, gwID)
+				return networks, "PENDING", nil
+			}
+
+			return networks, "ADVERTISED", nil
+		},
+		Timeout:    time.Duration(timeout) * time.Second,
+		MinTimeout: 1 * time.Second,
+		Delay:      time.Duration(delay) * time.Second,
+	}
+
+	if len(expected) == 0 {
+		// Nothing to wait for
+		stateConf.Delay = 0
+	}
+
+	_, err := stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		if len(missing) > 0 {
+			return diag.Errorf("Networks %s are not advertised by Tier1 Gateway %s: %v", strings.Join(missing, ", "), gwID, err)
+		}
+		return getErrorDiagnostics(err)
+	}
+
+	var networkList []map[string]interface{}
+	for _, network := range networks {
+		elem := make(map[string]interface{})
+		elem["network"] = network.Network
+		elem["rule_filter_type"] = network.RuleFilterType
+		elem["status"] = network.Status
+
+		networkList = append(networkList, elem)
+	}
+
+	d.SetId(gwID)
+	return getErrorDiagnostics(d.Set("advertised_network", networkList))
+}
@@ -0,0 +1,68 @@
+/* Copyright © 2023 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceNsxtPolicyTier1GatewayAdvertisedNetworks_basic(t *testing.T) {
+	name := getAccTestDataSourceName()
+	testResourceName := "data.nsxt_policy_tier1_gateway_advertised_networks.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t); testAccOnlyLocalManager(t); testAccNSXVersion(t, "3.2.0") },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyTier1GatewayAdvertisedNetworksTemplate(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(testResourceName, "id"),
+					resource.TestCheckResourceAttr(testResourceName, "expected_networks.#", "1"),
+					resource.TestCheckResourceAttrSet(testResourceName, "advertised_network.#"),
+					resource.TestCheckResourceAttrSet(testResourceName, "advertised_network.0.network"),
+					resource.TestCheckResourceAttr(testResourceName, "advertised_network.0.status", "SUCCESS"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicyTier1GatewayAdvertisedNetworksDeps(name string) string {
+	return testAccNsxtPolicyGatewayFabricDeps(false) + fmt.Sprintf(`
+resource "nsxt_policy_tier0_gateway" "test" {
+  display_name      = "%s"
+  edge_cluster_path = data.nsxt_policy_edge_cluster.EC.path
+}
+
+resource "nsxt_policy_tier1_gateway" "test" {
+  display_name              = "%s"
+  edge_cluster_path         = data.nsxt_policy_edge_cluster.EC.path
+  tier0_path                = nsxt_policy_tier0_gateway.test.path
+  route_advertisement_types = ["TIER1_CONNECTED"]
+}
+
+resource "nsxt_policy_segment" "test" {
+  display_name        = "%s"
+  transport_zone_path = data.nsxt_policy_transport_zone.test.path
+  connectivity_path   = nsxt_policy_tier1_gateway.test.path
+
+  subnet {
+    cidr = "12.12.2.1/24"
+  }
+}`, name, name, name)
+}
+
+func testAccNsxtPolicyTier1GatewayAdvertisedNetworksTemplate(name string) string {
+	return testAccNsxtPolicyTier1GatewayAdvertisedNetworksDeps(name) + `
+data "nsxt_policy_tier1_gateway_advertised_networks" "test" {
+  gateway_path      = nsxt_policy_tier1_gateway.test.path
+  expected_networks = ["12.12.2.0/24"]
+
+  depends_on = [nsxt_policy_segment.test]
+}`
+}
@@ -0,0 +1,127 @@
+/* Copyright © 2023 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/tier_1s"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+func dataSourceNsxtPolicyTier1GatewayState() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceNsxtPolicyTier1GatewayStateRead,
+
+		Schema: map[string]*schema.Schema{
+			"id": getDataSourceIDSchema(),
+			"gateway_path": {
+				Type:         schema.TypeString,
+				Description:  "Policy path of Tier1 gateway",
+				Required:     true,
+				ValidateFunc: validatePolicyPath(),
+			},
+			"state": {
+				Type:        schema.TypeString,
+				Description: "Realization state of the gateway on edge nodes",
+				Computed:    true,
+			},
+			"failure_message": {
+				Type:        schema.TypeString,
+				Description: "Error message in case of failure",
+				Computed:    true,
+			},
+			"locale_operation_mode": {
+				Type:        schema.TypeString,
+				Description: "Egress mode for the gateway",
+				Computed:    true,
+			},
+			"active_edge_path": {
+				Type:        schema.TypeString,
+				Description: "Policy path of the edge node where the gateway is active",
+				Computed:    true,
+			},
+			"edge_status": {
+				Type:        schema.TypeList,
+				Description: "High availability status per edge node",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"edge_path": {
+							Type:        schema.TypeString,
+							Description: "Policy path of the edge node",
+							Computed:    true,
+						},
+						"transport_node_id": {
+							Type:        schema.TypeString,
+							Description: "ID of the edge transport node",
+							Computed:    true,
+						},
+						"service_router_id": {
+							Type:        schema.TypeString,
+							Description: "ID of the service router on this edge node",
+							Computed:    true,
+						},
+						"high_availability_status": {
+							Type:        schema.TypeString,
+							Description: "High availability status of the service router on this edge node",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceNsxtPolicyTier1GatewayStateRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if isPolicyGlobalManager(m) {
+		return getErrorDiagnostics(localManagerOnlyError())
+	}
+
+	connector := getPolicyConnector(m)
+	gwPath := d.Get("gateway_path").(string)
+	isT0, gwID := parseGatewayPolicyPath(gwPath)
+	if isT0 || gwID == "" {
+		return diag.Errorf("Tier1 Gateway path expected, got %s", gwPath)
+	}
+
+	enforcementPointPath := getPolicyEnforcementPointPath(m)
+	client := tier_1s.NewStateClient(connector)
+	obj, err := client.Get(gwID, nil, &enforcementPointPath, nil, nil, nil, nil, nil, nil)
+	if err != nil {
+		return getOperationDiagnostics(m, handleDataSourceReadError(d, "Tier1 Gateway State", gwID, err))
+	}
+
+	if obj.Tier1State != nil {
+		d.Set("state", obj.Tier1State.State)
+		d.Set("failure_message", obj.Tier1State.FailureMessage)
+	}
+
+	var statusList []map[string]interface{}
+	activeEdgePath := ""
+	if obj.Tier1Status != nil {
+		d.Set("locale_operation_mode", obj.Tier1Status.LocaleOperationMode)
+		for _, nodeStatus := range obj.Tier1Status.PerNodeStatus {
+			elem := make(map[string]interface{})
+			elem["edge_path"] = nodeStatus.EdgePath
+			elem["transport_node_id"] = nodeStatus.TransportNodeId
+			elem["service_router_id"] = nodeStatus.ServiceRouterId
+			elem["high_availability_status"] = nodeStatus.HighAvailabilityStatus
+
+			if activeEdgePath == "" && nodeStatus.EdgePath != nil && nodeStatus.HighAvailabilityStatus != nil &&
+				*nodeStatus.HighAvailabilityStatus == model.LogicalRouterStatusPerNode_HIGH_AVAILABILITY_STATUS_ACTIVE {
+				activeEdgePath = *nodeStatus.EdgePath
+			}
+
+			statusList = append(statusList, elem)
+		}
+	}
+
+	d.Set("active_edge_path", activeEdgePath)
+	d.SetId(gwID)
+	return getErrorDiagnostics(d.Set("edge_status", statusList))
+}
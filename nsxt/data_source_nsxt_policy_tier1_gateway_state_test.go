@@ -0,0 +1,42 @@
+/* Copyright © 2023 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceNsxtPolicyTier1GatewayState_basic(t *testing.T) {
+	name := getAccTestDataSourceName()
+	testResourceName := "data.nsxt_policy_tier1_gateway_state.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t); testAccOnlyLocalManager(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyTier1GatewayStateTemplate(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(testResourceName, "id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "state"),
+					resource.TestCheckResourceAttrSet(testResourceName, "edge_status.#"),
+					resource.TestCheckResourceAttrSet(testResourceName, "active_edge_path"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicyTier1GatewayStateTemplate(name string) string {
+	return testAccNsxtPolicyTier1GatewayAdvertisedNetworksDeps(name) + `
+data "nsxt_policy_realization_info" "test" {
+  path = nsxt_policy_tier1_gateway.test.path
+}
+
+data "nsxt_policy_tier1_gateway_state" "test" {
+  gateway_path = data.nsxt_policy_realization_info.test.path
+}`
+}
@@ -4,9 +4,10 @@
 package nsxt
 
 import (
-	"fmt"
+	"context"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/vmware/vsphere-automation-sdk-go/runtime/bindings"
@@ -24,8 +25,7 @@ var policyTransportZoneTransportTypes = [](string){
 
 func dataSourceNsxtPolicyTransportZone() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceNsxtPolicyTransportZoneRead,
-
+		ReadContext: dataSourceNsxtPolicyTransportZoneRead,
 		Schema: map[string]*schema.Schema{
 			"id":           getDataSourceIDSchema(),
 			"display_name": getDataSourceDisplayNameSchema(),
@@ -54,17 +54,17 @@ func dataSourceNsxtPolicyTransportZone() *schema.Resource {
 	}
 }
 
-func dataSourceNsxtPolicyTransportZoneRead(d *schema.ResourceData, m interface{}) error {
+func dataSourceNsxtPolicyTransportZoneRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	objSitePath := d.Get("site_path").(string)
 	transportType := d.Get("transport_type").(string)
 	defaultVal, isDefaultSet := d.GetOkExists("is_default")
 	isDefault := isDefaultSet && defaultVal.(bool)
 	if !isPolicyGlobalManager(m) && objSitePath != "" {
-		return globalManagerOnlyError()
+		return getErrorDiagnostics(globalManagerOnlyError())
 	}
 	if isPolicyGlobalManager(m) {
 		if objSitePath == "" {
-			return attributeRequiredGlobalManagerError("site_path", "nsxt_policy_transport_zone")
+			return getErrorDiagnostics(attributeRequiredGlobalManagerError("site_path", "nsxt_policy_transport_zone"))
 		}
 		query := make(map[string]string)
 		globalPolicyEnforcementPointPath := getGlobalPolicyEnforcementPointPath(m, &objSitePath)
@@ -77,12 +77,12 @@ func dataSourceNsxtPolicyTransportZoneRead(d *schema.ResourceData, m interface{}
 		}
 		obj, err := policyDataSourceResourceReadWithValidation(d, getPolicyConnector(m), true, "PolicyTransportZone", query, false)
 		if err != nil {
-			return err
+			return getOperationDiagnostics(m, err)
 		}
 		converter := bindings.NewTypeConverter()
 		dataValue, errors := converter.ConvertToGolang(obj, gm_model.PolicyTransportZoneBindingType())
 		if len(errors) > 0 {
-			return errors[0]
+			return getOperationDiagnostics(m, errors[0])
 		}
 		transportZoneResource := dataValue.(gm_model.PolicyTransportZone)
 
@@ -103,17 +103,17 @@ func dataSourceNsxtPolicyTransportZoneRead(d *schema.ResourceData, m interface{}
 		objGet, err := client.Get(defaultSite, getPolicyEnforcementPoint(m), objID)
 
 		if err != nil {
-			return handleDataSourceReadError(d, "TransportZone", objID, err)
+			return getOperationDiagnostics(m, handleDataSourceReadError(d, "TransportZone", objID, err))
 		}
 		obj = objGet
 	} else if objName == "" && !(isDefault && transportType != "") {
-		return fmt.Errorf("Please specify id, display_name or is_default and transport_type in order to identify Transport Zone")
+		return diag.Errorf("Please specify id, display_name or is_default and transport_type in order to identify Transport Zone")
 	} else {
 		// Get by full name/prefix
 		includeMarkForDeleteObjectsParam := false
 		objList, err := client.List(defaultSite, getPolicyEnforcementPoint(m), nil, &includeMarkForDeleteObjectsParam, nil, nil, &includeMarkForDeleteObjectsParam, nil)
 		if err != nil {
-			return handleListError("TransportZone", err)
+			return getOperationDiagnostics(m, handleListError("TransportZone", err))
 		}
 		// go over the list to find the correct one (prefer a perfect match. If not - prefix match)
 		var perfectMatch []lm_model.PolicyTransportZone
@@ -139,16 +139,16 @@ func dataSourceNsxtPolicyTransportZoneRead(d *schema.ResourceData, m interface{}
 		}
 		if len(perfectMatch) > 0 {
 			if len(perfectMatch) > 1 {
-				return fmt.Errorf("Found multiple TransportZones with name '%s'", objName)
+				return diag.Errorf("Found multiple TransportZones with name '%s'", objName)
 			}
 			obj = perfectMatch[0]
 		} else if len(prefixMatch) > 0 {
 			if len(prefixMatch) > 1 {
-				return fmt.Errorf("Found multiple TransportZones with name starting with '%s'", objName)
+				return diag.Errorf("Found multiple TransportZones with name starting with '%s'", objName)
 			}
 			obj = prefixMatch[0]
 		} else {
-			return fmt.Errorf("TransportZone '%s' was not found", objName)
+			return diag.Errorf("TransportZone '%s' was not found", objName)
 		}
 	}
 
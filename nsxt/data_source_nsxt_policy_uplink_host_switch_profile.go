@@ -0,0 +1,23 @@
+/* Copyright © 2023 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+func dataSourceNsxtPolicyUplinkHostSwitchProfile() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceNsxtPolicyUplinkHostSwitchProfileRead,
+		Schema:      getPolicyHostSwitchProfileDataSourceSchema(),
+	}
+}
+
+func dataSourceNsxtPolicyUplinkHostSwitchProfileRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	return getErrorDiagnostics(dataSourceNsxtPolicyHostSwitchProfileRead(d, m, model.PolicyBaseHostSwitchProfile_RESOURCE_TYPE_POLICYUPLINKHOSTSWITCHPROFILE, "Uplink Host Switch Profile"))
+}
@@ -0,0 +1,43 @@
+/* Copyright © 2023 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceNsxtPolicyUplinkHostSwitchProfile_basic(t *testing.T) {
+	// Use system default profile
+	name := "nsx-default-uplink-hostswitch-profile"
+	testResourceName := "data.nsxt_policy_uplink_host_switch_profile.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccOnlyLocalManager(t)
+			testAccNSXVersion(t, "4.0.0")
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyUplinkHostSwitchProfileReadTemplate(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(testResourceName, "display_name", name),
+					resource.TestCheckResourceAttrSet(testResourceName, "id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicyUplinkHostSwitchProfileReadTemplate(name string) string {
+	return fmt.Sprintf(`
+data "nsxt_policy_uplink_host_switch_profile" "test" {
+  display_name = "%s"
+}`, name)
+}
@@ -0,0 +1,130 @@
+/* Copyright © 2023 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+func dataSourceNsxtPolicyURLCategory() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceNsxtPolicyURLCategoryRead,
+
+		Schema: map[string]*schema.Schema{
+			"id":           getDataSourceIDSchema(),
+			"display_name": getDataSourceDisplayNameSchema(),
+			"description":  getDataSourceDescriptionSchema(),
+			"path":         getPathSchema(),
+			"category_id": {
+				Type:        schema.TypeInt,
+				Description: "Numeric ID of the URL category",
+				Computed:    true,
+			},
+			"category_name": {
+				Type:        schema.TypeString,
+				Description: "Name of the URL category, to be used as url_category attribute value",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func listPolicyURLCategories(m interface{}) ([]model.PolicyUrlCategory, error) {
+	connector := getPolicyConnector(m)
+	client := infra.NewUrlCategoriesClient(connector)
+
+	var results []model.PolicyUrlCategory
+	var cursor *string
+	total := 0
+	for {
+		includeMarkForDeleteObjectsParam := false
+		objList, err := client.List(cursor, &includeMarkForDeleteObjectsParam, nil, nil, nil, nil)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, objList.Results...)
+		if total == 0 && objList.ResultCount != nil {
+			// first response
+			total = int(*objList.ResultCount)
+		}
+		cursor = objList.Cursor
+		if len(results) >= total || cursor == nil || len(objList.Results) == 0 {
+			return results, nil
+		}
+	}
+}
+
+func dataSourceNsxtPolicyURLCategoryRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if isPolicyGlobalManager(m) {
+		return getErrorDiagnostics(localManagerOnlyError())
+	}
+
+	objID := d.Get("id").(string)
+	objName := d.Get("display_name").(string)
+	if objID == "" && objName == "" {
+		return diag.Errorf("Error obtaining URL Category ID or name during read")
+	}
+
+	objList, err := listPolicyURLCategories(m)
+	if err != nil {
+		return getOperationDiagnostics(m, handleListError("URL Category", err))
+	}
+
+	var obj model.PolicyUrlCategory
+	if objID != "" {
+		found := false
+		for _, objInList := range objList {
+			if objInList.Id != nil && *objInList.Id == objID {
+				obj = objInList
+				found = true
+				break
+			}
+		}
+		if !found {
+			return diag.Errorf("URL Category with ID '%s' was not found", objID)
+		}
+	} else {
+		// go over the list to find the correct one (prefer a perfect match. If not - prefix match)
+		var perfectMatch []model.PolicyUrlCategory
+		var prefixMatch []model.PolicyUrlCategory
+		for _, objInList := range objList {
+			if objInList.DisplayName == nil {
+				continue
+			}
+			if strings.HasPrefix(*objInList.DisplayName, objName) {
+				prefixMatch = append(prefixMatch, objInList)
+			}
+			if *objInList.DisplayName == objName {
+				perfectMatch = append(perfectMatch, objInList)
+			}
+		}
+		if len(perfectMatch) > 0 {
+			if len(perfectMatch) > 1 {
+				return diag.Errorf("Found multiple URL Categories with name '%s'", objName)
+			}
+			obj = perfectMatch[0]
+		} else if len(prefixMatch) > 0 {
+			if len(prefixMatch) > 1 {
+				return diag.Errorf("Found multiple URL Categories with name starting with '%s'", objName)
+			}
+			obj = prefixMatch[0]
+		} else {
+			return diag.Errorf("URL Category with name '%s' was not found", objName)
+		}
+	}
+
+	d.SetId(*obj.Id)
+	d.Set("display_name", obj.DisplayName)
+	d.Set("description", obj.Description)
+	d.Set("path", obj.Path)
+	d.Set("category_id", obj.CategoryId)
+	d.Set("category_name", obj.CategoryName)
+	return nil
+}
@@ -0,0 +1,44 @@
+/* Copyright © 2023 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceNsxtPolicyURLCategory_basic(t *testing.T) {
+	// Use existing system defined category
+	name := "Gambling"
+	testResourceName := "data.nsxt_policy_url_category.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccOnlyLocalManager(t)
+			testAccNSXVersion(t, "4.0.0")
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyURLCategoryReadTemplate(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(testResourceName, "display_name", name),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "category_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "category_name"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicyURLCategoryReadTemplate(name string) string {
+	return fmt.Sprintf(`
+data "nsxt_policy_url_category" "test" {
+  display_name = "%s"
+}`, name)
+}
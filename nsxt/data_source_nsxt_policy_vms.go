@@ -4,9 +4,10 @@
 package nsxt
 
 import (
-	"fmt"
+	"context"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
@@ -28,8 +29,7 @@ func dataSourceNsxtPolicyVMs() *schema.Resource {
 	}
 
 	return &schema.Resource{
-		Read: dataSourceNsxtPolicyVMsRead,
-
+		ReadContext: dataSourceNsxtPolicyVMsRead,
 		Schema: map[string]*schema.Schema{
 			// TODO: add option to filter by display name regex
 			"value_type": {
@@ -62,7 +62,7 @@ func dataSourceNsxtPolicyVMs() *schema.Resource {
 	}
 }
 
-func dataSourceNsxtPolicyVMsRead(d *schema.ResourceData, m interface{}) error {
+func dataSourceNsxtPolicyVMsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 
 	valueType := d.Get("value_type").(string)
@@ -72,7 +72,7 @@ func dataSourceNsxtPolicyVMsRead(d *schema.ResourceData, m interface{}) error {
 
 	allVMs, err := listAllPolicyVirtualMachines(connector, m)
 	if err != nil {
-		return fmt.Errorf("Error reading Virtual Machines: %v", err)
+		return diag.Errorf("Error reading Virtual Machines: %v", err)
 	}
 
 	for _, vm := range allVMs {
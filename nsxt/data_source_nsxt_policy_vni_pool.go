@@ -4,9 +4,10 @@
 package nsxt
 
 import (
-	"fmt"
+	"context"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra"
 	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
@@ -14,8 +15,7 @@ import (
 
 func dataSourceNsxtPolicyVniPool() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceNsxtPolicyVniPoolRead,
-
+		ReadContext: dataSourceNsxtPolicyVniPoolRead,
 		Schema: map[string]*schema.Schema{
 			"id":           getDataSourceIDSchema(),
 			"display_name": getDataSourceDisplayNameSchema(),
@@ -35,7 +35,7 @@ func dataSourceNsxtPolicyVniPool() *schema.Resource {
 	}
 }
 
-func dataSourceNsxtPolicyVniPoolRead(d *schema.ResourceData, m interface{}) error {
+func dataSourceNsxtPolicyVniPoolRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 	client := infra.NewVniPoolsClient(connector)
 
@@ -46,21 +46,21 @@ func dataSourceNsxtPolicyVniPoolRead(d *schema.ResourceData, m interface{}) erro
 		// Get by id
 		objGet, err := client.Get(objID)
 		if isNotFoundError(err) {
-			return fmt.Errorf("VniPoolConfig with ID %s was not found", objID)
+			return diag.Errorf("VniPoolConfig with ID %s was not found", objID)
 		}
 
 		if err != nil {
-			return fmt.Errorf("Error while reading VniPoolConfig %s: %v", objID, err)
+			return diag.Errorf("Error while reading VniPoolConfig %s: %v", objID, err)
 		}
 		obj = objGet
 	} else if objName == "" {
-		return fmt.Errorf("Error obtaining VniPoolConfig ID or name during read")
+		return diag.Errorf("Error obtaining VniPoolConfig ID or name during read")
 	} else {
 		// Get by full name/prefix
 		includeMarkForDeleteObjectsParam := false
 		objList, err := client.List(nil, &includeMarkForDeleteObjectsParam, nil, nil, nil, nil)
 		if err != nil {
-			return fmt.Errorf("Error while reading VniPoolConfigs: %v", err)
+			return diag.Errorf("Error while reading VniPoolConfigs: %v", err)
 		}
 		// go over the list to find the correct one (prefer a perfect match. If not - prefix match)
 		var perfectMatch []model.VniPoolConfig
@@ -75,16 +75,16 @@ func dataSourceNsxtPolicyVniPoolRead(d *schema.ResourceData, m interface{}) erro
 		}
 		if len(perfectMatch) > 0 {
 			if len(perfectMatch) > 1 {
-				return fmt.Errorf("Found multiple VniPoolConfigs with name '%s'", objName)
+				return diag.Errorf("Found multiple VniPoolConfigs with name '%s'", objName)
 			}
 			obj = perfectMatch[0]
 		} else if len(prefixMatch) > 0 {
 			if len(prefixMatch) > 1 {
-				return fmt.Errorf("Found multiple VniPoolConfigs with name starting with '%s'", objName)
+				return diag.Errorf("Found multiple VniPoolConfigs with name starting with '%s'", objName)
 			}
 			obj = prefixMatch[0]
 		} else {
-			return fmt.Errorf("VniPoolConfig with name '%s' was not found", objName)
+			return diag.Errorf("VniPoolConfig with name '%s' was not found", objName)
 		}
 	}
 
@@ -4,16 +4,18 @@
 package nsxt
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/vmware/go-vmware-nsxt/manager"
 )
 
 func dataSourceNsxtSwitchingProfile() *schema.Resource {
 	return &schema.Resource{
-		Read:               dataSourceNsxtSwitchingProfileRead,
+		ReadContext:        dataSourceNsxtSwitchingProfileRead,
 		DeprecationMessage: mpObjectDataSourceDeprecationMessage,
 		Schema: map[string]*schema.Schema{
 			"id": {
@@ -43,11 +45,11 @@ func dataSourceNsxtSwitchingProfile() *schema.Resource {
 	}
 }
 
-func dataSourceNsxtSwitchingProfileRead(d *schema.ResourceData, m interface{}) error {
+func dataSourceNsxtSwitchingProfileRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	// Read a switching profile by name or id
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return dataSourceNotSupportedError()
+		return getErrorDiagnostics(dataSourceNotSupportedError())
 	}
 
 	objID := d.Get("id").(string)
@@ -58,10 +60,10 @@ func dataSourceNsxtSwitchingProfileRead(d *schema.ResourceData, m interface{}) e
 		objGet, resp, err := nsxClient.LogicalSwitchingApi.GetSwitchingProfile(nsxClient.Context, objID)
 
 		if resp != nil && resp.StatusCode == http.StatusNotFound {
-			return fmt.Errorf("switching profile %s was not found", objID)
+			return diag.Errorf("switching profile %s was not found", objID)
 		}
 		if err != nil {
-			return fmt.Errorf("Error while reading switching profile %s: %v", objID, err)
+			return diag.Errorf("Error while reading switching profile %s: %v", objID, err)
 		}
 		obj = objGet
 	} else if objName != "" {
@@ -93,14 +95,14 @@ func dataSourceNsxtSwitchingProfileRead(d *schema.ResourceData, m interface{}) e
 
 		total, err := handlePagination(lister)
 		if err != nil {
-			return err
+			return getOperationDiagnostics(m, err)
 		}
 
 		if !found {
-			return fmt.Errorf("Switching profile with name '%s' was not found among %d objects", objName, total)
+			return diag.Errorf("Switching profile with name '%s' was not found among %d objects", objName, total)
 		}
 	} else {
-		return fmt.Errorf("Error obtaining switching profile ID or name during read")
+		return diag.Errorf("Error obtaining switching profile ID or name during read")
 	}
 
 	d.SetId(obj.Id)
@@ -4,18 +4,19 @@
 package nsxt
 
 import (
-	"fmt"
+	"context"
 	"strings"
 
 	"net/http"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/vmware/go-vmware-nsxt/manager"
 )
 
 func dataSourceNsxtTransportZone() *schema.Resource {
 	return &schema.Resource{
-		Read:               dataSourceNsxtTransportZoneRead,
+		ReadContext:        dataSourceNsxtTransportZoneRead,
 		DeprecationMessage: mpObjectDataSourceDeprecationMessage,
 		Schema: map[string]*schema.Schema{
 			"id": {
@@ -52,11 +53,11 @@ func dataSourceNsxtTransportZone() *schema.Resource {
 	}
 }
 
-func dataSourceNsxtTransportZoneRead(d *schema.ResourceData, m interface{}) error {
+func dataSourceNsxtTransportZoneRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	// Read a transport zone by name or id
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return dataSourceNotSupportedError()
+		return getErrorDiagnostics(dataSourceNotSupportedError())
 	}
 
 	objID := d.Get("id").(string)
@@ -67,20 +68,20 @@ func dataSourceNsxtTransportZoneRead(d *schema.ResourceData, m interface{}) erro
 		objGet, resp, err := nsxClient.NetworkTransportApi.GetTransportZone(nsxClient.Context, objID)
 
 		if resp != nil && resp.StatusCode == http.StatusNotFound {
-			return fmt.Errorf("Transport zone %s was not found", objID)
+			return diag.Errorf("Transport zone %s was not found", objID)
 		}
 		if err != nil {
-			return fmt.Errorf("Error while reading transport zone %s: %v", objID, err)
+			return diag.Errorf("Error while reading transport zone %s: %v", objID, err)
 		}
 		obj = objGet
 	} else if objName == "" {
-		return fmt.Errorf("Error obtaining transport zone ID or name during read")
+		return diag.Errorf("Error obtaining transport zone ID or name during read")
 	} else {
 		// Get by full name/prefix
 		// TODO use 2nd parameter localVarOptionals for paging
 		objList, _, err := nsxClient.NetworkTransportApi.ListTransportZones(nsxClient.Context, nil)
 		if err != nil {
-			return fmt.Errorf("Error while reading transport zones: %v", err)
+			return diag.Errorf("Error while reading transport zones: %v", err)
 		}
 		// go over the list to find the correct one (prefer a perfect match. If not - prefix match)
 		var perfectMatch []manager.TransportZone
@@ -95,16 +96,16 @@ func dataSourceNsxtTransportZoneRead(d *schema.ResourceData, m interface{}) erro
 		}
 		if len(perfectMatch) > 0 {
 			if len(perfectMatch) > 1 {
-				return fmt.Errorf("Found multiple transport zones with name '%s'", objName)
+				return diag.Errorf("Found multiple transport zones with name '%s'", objName)
 			}
 			obj = perfectMatch[0]
 		} else if len(prefixMatch) > 0 {
 			if len(prefixMatch) > 1 {
-				return fmt.Errorf("Found multiple transport zones with name starting with '%s'", objName)
+				return diag.Errorf("Found multiple transport zones with name starting with '%s'", objName)
 			}
 			obj = prefixMatch[0]
 		} else {
-			return fmt.Errorf("Transport zone with name '%s' was not found", objName)
+			return diag.Errorf("Transport zone with name '%s' was not found", objName)
 		}
 	}
 
@@ -0,0 +1,949 @@
+/* Copyright © 2023 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"os/exec"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/bindings"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/data/serializers/cleanjson"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+// In-process fake of NSX policy and MP API, used for unit tests that do not
+// require NSX manager. Objects are stored by path, and validated against SDK
+// model binding types where the object type is known.
+
+const fakeNsxVersion = "4.1.0"
+const fakeNsxPolicyPrefix = "/policy/api/v1"
+const fakeNsxManagerPrefix = "/api/v1"
+const fakeNsxGlobalPolicyPrefix = "/global-manager/api/v1"
+
+// User for changes done directly on NSX, rather than via provider
+const fakeNsxOperatorUser = "operator"
+
+type fakeNsxPolicyType struct {
+	resourceType string
+	bindingType  func() bindings.BindingType
+}
+
+// Policy object types by collection name in the path
+var fakeNsxPolicyTypes = map[string]fakeNsxPolicyType{
+	"domains":                    {"Domain", model.DomainBindingType},
+	"domain-deployment-maps":     {"DomainDeploymentMap", model.DomainDeploymentMapBindingType},
+	"drafts":                     {"PolicyDraft", model.PolicyDraftBindingType},
+	"firewall-schedulers":        {"PolicyFirewallScheduler", model.PolicyFirewallSchedulerBindingType},
+	"gateway-policies":           {"GatewayPolicy", model.GatewayPolicyBindingType},
+	"groups":                     {"Group", model.GroupBindingType},
+	"host-transport-nodes":       {"HostTransportNode", model.HostTransportNodeBindingType},
+	"ip-pools":                   {"IpAddressPool", model.IpAddressPoolBindingType},
+	"rules":                      {"Rule", model.RuleBindingType},
+	"security-policies":          {"SecurityPolicy", model.SecurityPolicyBindingType},
+	"services":                   {"Service", model.ServiceBindingType},
+	"shares":                     {"Share", model.ShareBindingType},
+	"resources":                  {"SharedResource", model.SharedResourceBindingType},
+	"tier-1s":                    {"Tier1", model.Tier1BindingType},
+	"transport-node-collections": {"HostTransportNodeCollection", model.HostTransportNodeCollectionBindingType},
+}
+
+// Child collections that NSX returns embedded in parent object, by parent collection
+var fakeNsxPolicyEmbeddedCollections = map[string]string{
+	"gateway-policies":  "rules",
+	"security-policies": "rules",
+}
+
+// Collection name for policy object type, as used in hierarchical API
+func getFakeNsxPolicyCollectionForType(resourceType string) string {
+	for collection, objType := range fakeNsxPolicyTypes {
+		if objType.resourceType == resourceType {
+			return collection
+		}
+	}
+	return ""
+}
+
+// MP object types by collection name in the path
+var fakeNsxManagerTypes = map[string]string{
+	"ns-groups": "NSGroup",
+	"ip-sets":   "IPSet",
+}
+
+type fakeNsxObject map[string]interface{}
+
+type fakeNsxServer struct {
+	server  *httptest.Server
+	objects map[string]fakeNsxObject
+	// User and query parameters of request being handled
+	user  string
+	query url.Values
+	// Paths of published drafts, in order of publish
+	published []string
+	// Lookups of error resolver info, and invoked resolvers as code:entity
+	errorResolverLookups int
+	resolvedErrors       []string
+	// Realized entities by intent path, and number of their lookups
+	realizedEntities      map[string][]fakeNsxObject
+	realizedEntityLookups int
+	alarmListRequests     int
+	// Alarm count reported by alarm list, if differs from number of alarms, or
+	// negative to omit it. Alarms are listed in pages of given size.
+	alarmListResultCount int
+	alarmListPageSize    int
+	// Site paths of global objects by intent path, and status of failed span lookup
+	globalSpans      map[string][]string
+	globalSpanStatus int
+	// Full sync states of global manager, listed in pages of given size. Result
+	// count is not reported, unless set.
+	fullSyncStates            []fakeNsxObject
+	fullSyncPageSize          int
+	fullSyncResultCount       int
+	fullSyncStateListRequests int
+	lock                      sync.Mutex
+}
+
+func newFakeNsxServer(t *testing.T) *fakeNsxServer {
+	fake := &fakeNsxServer{
+		objects:          make(map[string]fakeNsxObject),
+		realizedEntities: make(map[string][]fakeNsxObject),
+		globalSpans:      make(map[string][]string),
+	}
+	fake.server = httptest.NewTLSServer(http.HandlerFunc(fake.handle))
+	t.Cleanup(fake.server.Close)
+
+	// Objects that always exist on NSX
+	fake.putPolicyObject("/infra/domains/default", model.Domain{})
+	return fake
+}
+
+// Host in format expected by provider configuration
+func (f *fakeNsxServer) host() string {
+	return strings.TrimPrefix(f.server.URL, "https://")
+}
+
+func (f *fakeNsxServer) getObject(path string) fakeNsxObject {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	return f.objects[path]
+}
+
+// Stores policy object, converted from SDK model, as if it was created or
+// patched by NSX operator
+func (f *fakeNsxServer) putPolicyObject(path string, obj interface{}) {
+	objType := fakeNsxPolicyTypes[getFakeNsxCollection(path)]
+	body := encodeFakeNsxObject(path, obj, objType.bindingType())
+
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	f.user = fakeNsxOperatorUser
+	f.storeObject(path, body, f.objects[path])
+}
+
+// Registers error resolver for NSX error code
+func (f *fakeNsxServer) putErrorResolver(code int64, info model.ErrorResolverInfo) {
+	path := fmt.Sprintf("/error-resolver/%d", code)
+	body := encodeFakeNsxObject(path, info, model.ErrorResolverInfoBindingType())
+
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	f.objects[path] = body
+}
+
+// Stores state of policy object, such as transport node, as reported by NSX
+func (f *fakeNsxServer) putState(path string, state interface{}, bindingType bindings.BindingType) {
+	body := encodeFakeNsxObject(path, state, bindingType)
+
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	f.objects[path+"/state"] = body
+}
+
+// Stores realized entity of policy object, as realized by NSX
+func (f *fakeNsxServer) putRealizedEntity(intentPath string, entity model.GenericPolicyRealizedResource) {
+	body := encodeFakeNsxObject(intentPath, entity, model.GenericPolicyRealizedResourceBindingType())
+
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	f.realizedEntities[intentPath] = append(f.realizedEntities[intentPath], body)
+}
+
+func encodeFakeNsxObject(path string, obj interface{}, bindingType bindings.BindingType) fakeNsxObject {
+	dataValue, errs := bindings.NewTypeConverter().ConvertToVapi(obj, bindingType)
+	if errs != nil {
+		panic(fmt.Sprintf("Failed to convert %s: %v", path, errs[0]))
+	}
+	encoded, err := cleanjson.NewDataValueToJsonEncoder().Encode(dataValue)
+	if err != nil {
+		panic(fmt.Sprintf("Failed to encode %s: %v", path, err))
+	}
+	body, err := decodeFakeNsxObject([]byte(encoded))
+	if err != nil {
+		panic(fmt.Sprintf("Failed to decode %s: %v", path, err))
+	}
+	return body
+}
+
+func decodeFakeNsxObject(body []byte) (fakeNsxObject, error) {
+	obj := make(fakeNsxObject)
+	if len(bytes.TrimSpace(body)) == 0 {
+		return obj, nil
+	}
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+	err := decoder.Decode(&obj)
+	return obj, err
+}
+
+// Collection is the path segment preceding object ID
+func getFakeNsxCollection(path string) string {
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	if len(segments) < 2 {
+		return ""
+	}
+	return segments[len(segments)-2]
+}
+
+// Policy paths alternate collections and object IDs below infra root
+func isFakeNsxPolicyCollection(path string) bool {
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	root := 0
+	for i, segment := range segments {
+		if segment == "infra" || segment == "global-infra" {
+			root = i
+		}
+	}
+	return (len(segments)-root)%2 == 0
+}
+
+// MP paths alternate collections and object IDs below API root
+func isFakeNsxManagerCollection(path string) bool {
+	return len(strings.Split(strings.Trim(path, "/"), "/"))%2 == 1
+}
+
+func (f *fakeNsxServer) storeObject(path string, body fakeNsxObject, existing fakeNsxObject) fakeNsxObject {
+	now := json.Number(fmt.Sprintf("%d", time.Now().UnixNano()/int64(time.Millisecond)))
+	obj := make(fakeNsxObject)
+	revision := int64(0)
+	if existing != nil {
+		for key, value := range existing {
+			obj[key] = value
+		}
+		current, _ := existing["_revision"].(json.Number).Int64()
+		revision = current + 1
+	} else {
+		obj["_create_time"] = now
+	}
+	for key, value := range body {
+		obj[key] = value
+	}
+
+	id := path[strings.LastIndex(path, "/")+1:]
+	obj["id"] = id
+	obj["_revision"] = json.Number(fmt.Sprintf("%d", revision))
+	obj["_last_modified_time"] = now
+	obj["_last_modified_user"] = f.user
+	if _, ok := obj["display_name"]; !ok {
+		obj["display_name"] = id
+	}
+
+	if strings.HasPrefix(path, fakeNsxManagerPrefix) {
+		if resourceType, ok := fakeNsxManagerTypes[getFakeNsxCollection(path)]; ok {
+			obj["resource_type"] = resourceType
+		}
+	} else {
+		parentPath := path[:strings.LastIndex(path, "/")]
+		parentPath = parentPath[:strings.LastIndex(parentPath, "/")]
+		obj["path"] = path
+		obj["relative_path"] = id
+		obj["parent_path"] = parentPath
+		obj["marked_for_delete"] = false
+		if objType, ok := fakeNsxPolicyTypes[getFakeNsxCollection(path)]; ok {
+			obj["resource_type"] = objType.resourceType
+		}
+	}
+
+	f.objects[path] = obj
+	return obj
+}
+
+func (f *fakeNsxServer) listChildren(path string) []fakeNsxObject {
+	var paths []string
+	for objPath := range f.objects {
+		if strings.HasPrefix(objPath, path+"/") && !strings.Contains(objPath[len(path)+1:], "/") {
+			paths = append(paths, objPath)
+		}
+	}
+	sort.Strings(paths)
+
+	results := []fakeNsxObject{}
+	for _, objPath := range paths {
+		results = append(results, f.objects[objPath])
+	}
+	return results
+}
+
+// Returns objects below given path in hierarchical API format, where each object
+// is wrapped in Child<Type> object. Objects of types not in filter are skipped
+// along with their subtree.
+func (f *fakeNsxServer) getHierarchy(parentPath string, types []string) []interface{} {
+	var paths []string
+	for path, obj := range f.objects {
+		if obj["parent_path"] == parentPath {
+			paths = append(paths, path)
+		}
+	}
+	sort.Strings(paths)
+
+	children := []interface{}{}
+	for _, path := range paths {
+		resourceType, _ := f.objects[path]["resource_type"].(string)
+		if len(types) > 0 && !stringInList(resourceType, types) {
+			continue
+		}
+		obj := make(fakeNsxObject)
+		for key, value := range f.objects[path] {
+			obj[key] = value
+		}
+		obj["children"] = f.getHierarchy(path, types)
+		children = append(children, map[string]interface{}{
+			"resource_type": "Child" + resourceType,
+			resourceType:    obj,
+		})
+	}
+	return children
+}
+
+func (f *fakeNsxServer) handleInfra(w http.ResponseWriter, typeFilter string) {
+	var types []string
+	if typeFilter != "" {
+		types = strings.Split(typeFilter, ",")
+	}
+	writeFakeNsxResponse(w, http.StatusOK, map[string]interface{}{
+		"resource_type": "Infra",
+		"id":            "infra",
+		"path":          "/infra",
+		"children":      f.getHierarchy("/infra", types),
+	})
+}
+
+func (f *fakeNsxServer) deleteObject(path string) bool {
+	if _, ok := f.objects[path]; !ok {
+		return false
+	}
+	for objPath := range f.objects {
+		if objPath == path || strings.HasPrefix(objPath, path+"/") {
+			delete(f.objects, objPath)
+		}
+	}
+	return true
+}
+
+func writeFakeNsxResponse(w http.ResponseWriter, status int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("X-Nsx-Requestid", uuid.New().String())
+	w.WriteHeader(status)
+	if body != nil {
+		json.NewEncoder(w).Encode(body)
+	}
+}
+
+func writeFakeNsxError(w http.ResponseWriter, status int, code int, format string, a ...interface{}) {
+	writeFakeNsxResponse(w, status, map[string]interface{}{
+		"httpStatus":    http.StatusText(status),
+		"error_code":    code,
+		"error_message": fmt.Sprintf(format, a...),
+		"module_name":   "fake",
+	})
+}
+
+func writeFakeNsxList(w http.ResponseWriter, results []fakeNsxObject) {
+	writeFakeNsxResponse(w, http.StatusOK, map[string]interface{}{
+		"results":      results,
+		"result_count": len(results),
+	})
+}
+
+func (f *fakeNsxServer) handle(w http.ResponseWriter, r *http.Request) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	body := new(bytes.Buffer)
+	body.ReadFrom(r.Body)
+	f.user, _, _ = r.BasicAuth()
+	f.query = r.URL.Query()
+	obj, err := decodeFakeNsxObject(body.Bytes())
+	if err != nil {
+		writeFakeNsxError(w, http.StatusBadRequest, 255, "Malformed request body: %v", err)
+		return
+	}
+
+	switch {
+	case r.URL.Path == "/api/session/create":
+		writeFakeNsxResponse(w, http.StatusOK, nil)
+	case r.URL.Path == fakeNsxManagerPrefix+"/node":
+		writeFakeNsxResponse(w, http.StatusOK, map[string]interface{}{"node_version": fakeNsxVersion})
+	case r.URL.Path == fakeNsxPolicyPrefix+"/search/query":
+		f.handleSearch(w, r.URL.Query().Get("query"))
+	case r.URL.Path == fakeNsxPolicyPrefix+"/infra" && r.Method == http.MethodGet:
+		f.handleInfra(w, r.URL.Query().Get("type_filter"))
+	case strings.HasSuffix(r.URL.Path, "/infra") && r.Method == http.MethodPatch:
+		f.handleInfraPatch(w, strings.TrimPrefix(r.URL.Path, fakeNsxPolicyPrefix), obj, r.URL.Query().Get("enforce_revision_check") == "true")
+	case r.URL.Path == fakeNsxPolicyPrefix+"/infra/realized-state/realized-entities":
+		f.realizedEntityLookups++
+		results := f.realizedEntities[r.URL.Query().Get("intent_path")]
+		if results == nil {
+			results = []fakeNsxObject{}
+		}
+		writeFakeNsxList(w, results)
+	case r.URL.Path == fakeNsxPolicyPrefix+"/infra/realized-state/alarms":
+		f.handleAlarms(w, r.URL.Query().Get("cursor"))
+	case r.URL.Path == fakeNsxGlobalPolicyPrefix+"/global-infra/full-sync-states":
+		f.handleFullSyncStates(w, r.URL.Query().Get("cursor"))
+	case r.URL.Path == fakeNsxGlobalPolicyPrefix+"/global-infra/span":
+		f.handleGlobalSpan(w, r.URL.Query().Get("intent_path"))
+	case strings.HasPrefix(r.URL.Path, fakeNsxPolicyPrefix+"/infra/") && strings.HasSuffix(r.URL.Path, "/state") && r.Method == http.MethodGet:
+		f.handleState(w, strings.TrimPrefix(r.URL.Path, fakeNsxPolicyPrefix))
+	case strings.HasPrefix(r.URL.Path, fakeNsxPolicyPrefix+"/error-resolver"):
+		f.handleErrorResolver(w, r.Method, strings.TrimPrefix(r.URL.Path, fakeNsxPolicyPrefix), obj)
+	case r.Method == http.MethodPost && r.URL.Query().Get("action") == "publish":
+		f.handlePublish(w, strings.TrimPrefix(r.URL.Path, fakeNsxPolicyPrefix))
+	case strings.HasPrefix(r.URL.Path, fakeNsxGlobalPolicyPrefix+"/global-infra/"):
+		f.handlePolicy(w, r.Method, strings.TrimPrefix(r.URL.Path, fakeNsxGlobalPolicyPrefix), obj)
+	case strings.HasPrefix(r.URL.Path, fakeNsxPolicyPrefix+"/"):
+		f.handlePolicy(w, r.Method, strings.TrimPrefix(r.URL.Path, fakeNsxPolicyPrefix), obj)
+	case strings.HasPrefix(r.URL.Path, fakeNsxManagerPrefix+"/"):
+		f.handleManager(w, r.Method, r.URL.Path, obj)
+	default:
+		writeFakeNsxError(w, http.StatusNotFound, 404, "Unsupported path %s", r.URL.Path)
+	}
+}
+
+// Verifies request body against SDK model of the object type
+func validateFakeNsxPolicyObject(path string, obj fakeNsxObject) error {
+	objType, ok := fakeNsxPolicyTypes[getFakeNsxCollection(path)]
+	if !ok {
+		return nil
+	}
+	dataValue, err := cleanjson.NewJsonToDataValueDecoder().Decode(map[string]interface{}(obj))
+	if err != nil {
+		return err
+	}
+	_, errs := bindings.NewTypeConverter().ConvertToGolang(dataValue, objType.bindingType())
+	if errs != nil {
+		return errs[0]
+	}
+	return nil
+}
+
+func isFakeNsxRevisionMismatch(obj fakeNsxObject, existing fakeNsxObject) bool {
+	revision, ok := obj["_revision"]
+	return ok && existing != nil && revision.(json.Number).String() != existing["_revision"].(json.Number).String()
+}
+
+func (f *fakeNsxServer) handlePolicy(w http.ResponseWriter, method string, path string, obj fakeNsxObject) {
+	existing := f.objects[path]
+	switch method {
+	case http.MethodGet:
+		if isFakeNsxPolicyCollection(path) {
+			writeFakeNsxList(w, f.listChildren(path))
+			return
+		}
+		if existing == nil {
+			writeFakeNsxError(w, http.StatusNotFound, 500090, "The path=[%s] is invalid", path)
+			return
+		}
+		writeFakeNsxResponse(w, http.StatusOK, f.getEmbeddedChildren(path, existing))
+	case http.MethodPatch, http.MethodPut:
+		if err := validateFakeNsxPolicyObject(path, obj); err != nil {
+			writeFakeNsxError(w, http.StatusBadRequest, 255, "Invalid object: %v", err)
+			return
+		}
+		if method == http.MethodPut {
+			if isFakeNsxRevisionMismatch(obj, existing) {
+				writeFakeNsxError(w, http.StatusPreconditionFailed, 604, "The object was modified by somebody else")
+				return
+			}
+			// Replace semantics
+			existingRevision := existing
+			existing = nil
+			if existingRevision != nil {
+				existing = fakeNsxObject{"_revision": existingRevision["_revision"], "_create_time": existingRevision["_create_time"]}
+			}
+		}
+		writeFakeNsxResponse(w, http.StatusOK, f.storeObject(path, obj, existing))
+	case http.MethodDelete:
+		// Delete of non-existing policy object succeeds
+		f.deleteObject(path)
+		writeFakeNsxResponse(w, http.StatusOK, nil)
+	default:
+		writeFakeNsxError(w, http.StatusMethodNotAllowed, 405, "Method %s is not supported", method)
+	}
+}
+
+// Returns copy of object with its embedded child collection, such as rules of
+// security policy, ordered by sequence number
+func (f *fakeNsxServer) getEmbeddedChildren(path string, obj fakeNsxObject) fakeNsxObject {
+	collection, ok := fakeNsxPolicyEmbeddedCollections[getFakeNsxCollection(path)]
+	if !ok {
+		return obj
+	}
+
+	children := f.listChildren(path + "/" + collection)
+	sort.SliceStable(children, func(i, j int) bool {
+		first, _ := strconv.Atoi(getFakeNsxObjectField(children[i], "sequence_number"))
+		second, _ := strconv.Atoi(getFakeNsxObjectField(children[j], "sequence_number"))
+		return first < second
+	})
+	result := make(fakeNsxObject)
+	for key, value := range obj {
+		result[key] = value
+	}
+	result[collection] = children
+	return result
+}
+
+func (f *fakeNsxServer) getNextSequenceNumber(collectionPath string) int {
+	next := 1
+	for _, child := range f.listChildren(collectionPath) {
+		sequenceNumber, _ := strconv.Atoi(getFakeNsxObjectField(child, "sequence_number"))
+		if sequenceNumber >= next {
+			next = sequenceNumber + 1
+		}
+	}
+	return next
+}
+
+// Applies hierarchical API request on objects below infra root. Child<Type>
+// wraps object to be patched or deleted, and ChildResourceReference refers to
+// existing object, such as domain, by its ID. The request is applied as whole,
+// or not at all.
+func (f *fakeNsxServer) handleInfraPatch(w http.ResponseWriter, path string, obj fakeNsxObject, enforceRevision bool) {
+	snapshot := make(map[string]fakeNsxObject)
+	for objPath, existing := range f.objects {
+		snapshot[objPath] = existing
+	}
+
+	children, _ := obj["children"].([]interface{})
+	if status, err := f.patchChildren(path, children, enforceRevision); err != nil {
+		f.objects = snapshot
+		code := 255
+		if status == http.StatusPreconditionFailed {
+			code = 604
+		}
+		writeFakeNsxError(w, status, code, "%v", err)
+		return
+	}
+	writeFakeNsxResponse(w, http.StatusOK, nil)
+}
+
+func (f *fakeNsxServer) patchChildren(parentPath string, children []interface{}, enforceRevision bool) (int, error) {
+	for _, rawChild := range children {
+		child, ok := rawChild.(map[string]interface{})
+		if !ok {
+			return http.StatusBadRequest, fmt.Errorf("Malformed child of %s", parentPath)
+		}
+		childType, _ := child["resource_type"].(string)
+
+		if childType == "ChildResourceReference" {
+			targetType, _ := child["target_type"].(string)
+			path := fmt.Sprintf("%s/%s/%v", parentPath, getFakeNsxPolicyCollectionForType(targetType), child["id"])
+			if f.objects[path] == nil {
+				return http.StatusNotFound, fmt.Errorf("The path=[%s] is invalid", path)
+			}
+			nested, _ := child["children"].([]interface{})
+			if status, err := f.patchChildren(path, nested, enforceRevision); err != nil {
+				return status, err
+			}
+			continue
+		}
+
+		objType := strings.TrimPrefix(childType, "Child")
+		collection := getFakeNsxPolicyCollectionForType(objType)
+		body, ok := child[objType].(map[string]interface{})
+		if !strings.HasPrefix(childType, "Child") || collection == "" || !ok {
+			return http.StatusBadRequest, fmt.Errorf("Unsupported child type %s", childType)
+		}
+		obj := fakeNsxObject(body)
+		id := obj["id"]
+		if id == nil {
+			id = child["id"]
+		}
+		path := fmt.Sprintf("%s/%s/%v", parentPath, collection, id)
+
+		if markedForDelete, _ := child["marked_for_delete"].(bool); markedForDelete {
+			f.deleteObject(path)
+			continue
+		}
+
+		nested, _ := obj["children"].([]interface{})
+		delete(obj, "children")
+		if err := validateFakeNsxPolicyObject(path, obj); err != nil {
+			return http.StatusBadRequest, fmt.Errorf("Invalid object %s: %v", path, err)
+		}
+		existing := f.objects[path]
+		if enforceRevision && isFakeNsxRevisionMismatch(obj, existing) {
+			return http.StatusPreconditionFailed, fmt.Errorf("The object %s was modified by somebody else", path)
+		}
+		if _, ok := obj["sequence_number"]; !ok && existing == nil && fakeNsxPolicyEmbeddedCollections[getFakeNsxCollection(parentPath)] == collection {
+			// NSX appends embedded objects, such as rules, in order of the request
+			obj["sequence_number"] = json.Number(strconv.Itoa(f.getNextSequenceNumber(parentPath + "/" + collection)))
+		}
+		f.storeObject(path, obj, existing)
+		if status, err := f.patchChildren(path, nested, enforceRevision); err != nil {
+			return status, err
+		}
+	}
+	return http.StatusOK, nil
+}
+
+func (f *fakeNsxServer) handlePublish(w http.ResponseWriter, path string) {
+	if f.objects[path] == nil {
+		writeFakeNsxError(w, http.StatusNotFound, 500090, "The path=[%s] is invalid", path)
+		return
+	}
+	f.published = append(f.published, path)
+	writeFakeNsxResponse(w, http.StatusOK, nil)
+}
+
+func (f *fakeNsxServer) handleErrorResolver(w http.ResponseWriter, method string, path string, obj fakeNsxObject) {
+	if method == http.MethodGet {
+		f.errorResolverLookups++
+		info := f.objects[path]
+		if info == nil {
+			writeFakeNsxError(w, http.StatusNotFound, 500090, "The path=[%s] is invalid", path)
+			return
+		}
+		writeFakeNsxResponse(w, http.StatusOK, info)
+		return
+	}
+
+	errorList, _ := obj["errors"].([]interface{})
+	for _, rawError := range errorList {
+		metadata := rawError.(map[string]interface{})
+		f.resolvedErrors = append(f.resolvedErrors, fmt.Sprintf("%v:%v", metadata["error_id"], metadata["entity_id"]))
+	}
+	writeFakeNsxResponse(w, http.StatusOK, nil)
+}
+
+func (f *fakeNsxServer) handleState(w http.ResponseWriter, path string) {
+	state := f.objects[path]
+	if state == nil {
+		writeFakeNsxError(w, http.StatusNotFound, 500090, "The path=[%s] is invalid", path)
+		return
+	}
+	writeFakeNsxResponse(w, http.StatusOK, state)
+}
+
+func (f *fakeNsxServer) handleGlobalSpan(w http.ResponseWriter, intentPath string) {
+	if f.globalSpanStatus != 0 {
+		writeFakeNsxError(w, f.globalSpanStatus, f.globalSpanStatus, "Span of %s is not available", intentPath)
+		return
+	}
+	sitePaths, ok := f.globalSpans[intentPath]
+	if !ok {
+		writeFakeNsxError(w, http.StatusNotFound, 500090, "The path=[%s] is invalid", intentPath)
+		return
+	}
+
+	sites := []interface{}{}
+	for _, sitePath := range sitePaths {
+		sites = append(sites, map[string]interface{}{"site_path": sitePath})
+	}
+	writeFakeNsxResponse(w, http.StatusOK, map[string]interface{}{
+		"resource_type": "Span",
+		"span_resource": intentPath,
+		"sites":         sites,
+	})
+}
+
+func (f *fakeNsxServer) handleFullSyncStates(w http.ResponseWriter, cursor string) {
+	f.fullSyncStateListRequests++
+	start, _ := strconv.Atoi(cursor)
+	end := len(f.fullSyncStates)
+	if f.fullSyncPageSize > 0 && start+f.fullSyncPageSize < end {
+		end = start + f.fullSyncPageSize
+	}
+
+	response := map[string]interface{}{
+		"results": f.fullSyncStates[start:end],
+	}
+	if end < len(f.fullSyncStates) {
+		response["cursor"] = strconv.Itoa(end)
+	}
+	if f.fullSyncResultCount > 0 {
+		response["result_count"] = f.fullSyncResultCount
+	}
+	writeFakeNsxResponse(w, http.StatusOK, response)
+}
+
+// Lists alarms of all realized entities
+func (f *fakeNsxServer) handleAlarms(w http.ResponseWriter, cursor string) {
+	f.alarmListRequests++
+	var paths []string
+	for path := range f.realizedEntities {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+
+	results := []interface{}{}
+	for _, path := range paths {
+		for _, entity := range f.realizedEntities[path] {
+			alarms, _ := entity["alarms"].([]interface{})
+			results = append(results, alarms...)
+		}
+	}
+
+	response := map[string]interface{}{
+		"result_count": len(results),
+	}
+	if f.alarmListResultCount > 0 {
+		response["result_count"] = f.alarmListResultCount
+	} else if f.alarmListResultCount < 0 {
+		delete(response, "result_count")
+	}
+	start, _ := strconv.Atoi(cursor)
+	end := len(results)
+	if f.alarmListPageSize > 0 && start+f.alarmListPageSize < end {
+		end = start + f.alarmListPageSize
+		response["cursor"] = strconv.Itoa(end)
+	}
+	response["results"] = results[start:end]
+	writeFakeNsxResponse(w, http.StatusOK, response)
+}
+
+func (f *fakeNsxServer) handleManager(w http.ResponseWriter, method string, path string, obj fakeNsxObject) {
+	existing := f.objects[path]
+	isCollection := isFakeNsxManagerCollection(path)
+	switch {
+	case method == http.MethodGet && isCollection:
+		writeFakeNsxList(w, f.listChildren(path))
+	case method == http.MethodPost && isCollection:
+		writeFakeNsxResponse(w, http.StatusCreated, f.storeObject(path+"/"+uuid.New().String(), obj, nil))
+	case existing == nil:
+		writeFakeNsxError(w, http.StatusNotFound, 600, "The requested object : %s could not be found", path)
+	case method == http.MethodGet:
+		writeFakeNsxResponse(w, http.StatusOK, existing)
+	case method == http.MethodPut:
+		if _, ok := obj["_revision"]; !ok || isFakeNsxRevisionMismatch(obj, existing) {
+			writeFakeNsxError(w, http.StatusPreconditionFailed, 604, "The object was modified by somebody else")
+			return
+		}
+		writeFakeNsxResponse(w, http.StatusOK, f.storeObject(path, obj, existing))
+	case method == http.MethodDelete:
+		f.deleteObject(path)
+		writeFakeNsxResponse(w, http.StatusOK, nil)
+	default:
+		writeFakeNsxError(w, http.StatusMethodNotAllowed, 405, "Method %s is not supported", method)
+	}
+}
+
+func getFakeNsxObjectField(obj fakeNsxObject, key string) string {
+	value, ok := obj[key]
+	if !ok || value == nil {
+		return ""
+	}
+	return fmt.Sprintf("%v", value)
+}
+
+// Supports queries in form of key:value terms joined with AND, with optional
+// trailing wildcard in value
+func matchFakeNsxQuery(obj fakeNsxObject, query string) bool {
+	for _, term := range strings.Split(query, " AND ") {
+		separator := strings.Index(term, ":")
+		if separator < 0 {
+			return false
+		}
+		key := strings.TrimSpace(term[:separator])
+		value := strings.Trim(strings.TrimSpace(term[separator+1:]), "\"")
+		value = strings.ReplaceAll(value, "\\", "")
+		if key == "nsx_id" {
+			key = "id"
+		}
+
+		actual := getFakeNsxObjectField(obj, key)
+		if key == "marked_for_delete" && actual == "" {
+			actual = "false"
+		}
+		if strings.HasSuffix(value, "*") {
+			if !strings.HasPrefix(actual, strings.TrimSuffix(value, "*")) {
+				return false
+			}
+		} else if actual != value {
+			return false
+		}
+	}
+	return true
+}
+
+func (f *fakeNsxServer) handleSearch(w http.ResponseWriter, query string) {
+	var paths []string
+	for path, obj := range f.objects {
+		if _, isPolicy := obj["path"]; isPolicy && matchFakeNsxQuery(obj, query) {
+			paths = append(paths, path)
+		}
+	}
+	sort.Strings(paths)
+
+	results := []fakeNsxObject{}
+	for _, path := range paths {
+		results = append(results, f.objects[path])
+	}
+	writeFakeNsxList(w, results)
+}
+
+// Provider configuration for unit tests with fake NSX, to be used with resource.UnitTest
+func testUnitProviderConfig(fake *fakeNsxServer) string {
+	return fmt.Sprintf(`
+provider "nsxt" {
+  host                 = "%s"
+  username             = "admin"
+  password             = "fake"
+  allow_unverified_ssl = true
+  session_auth         = false
+  global_manager       = false
+}
+`, fake.host())
+}
+
+// resource.UnitTest requires terraform binary, which is not downloaded for unit tests
+func testUnitPreCheckTerraform(t *testing.T) {
+	if os.Getenv("TF_ACC_TERRAFORM_PATH") != "" {
+		return
+	}
+	if _, err := exec.LookPath("terraform"); err != nil {
+		t.Skipf("Terraform binary is required for this test")
+	}
+}
+
+// Configures provider against fake NSX, and returns provider meta for in-process
+// resource operations
+func testUnitConfigureProvider(t *testing.T, fake *fakeNsxServer) interface{} {
+	return testUnitConfigureProviderWithOptions(t, fake, nil)
+}
+
+// Configures provider against fake NSX, with additional provider options
+func testUnitConfigureProviderWithOptions(t *testing.T, fake *fakeNsxServer, options map[string]interface{}) interface{} {
+	provider := Provider()
+	raw := map[string]interface{}{
+		"host":                 fake.host(),
+		"username":             "admin",
+		"password":             "fake",
+		"allow_unverified_ssl": true,
+		"session_auth":         false,
+		"global_manager":       false,
+		"max_retries":          0,
+	}
+	for key, value := range options {
+		raw[key] = value
+	}
+	config := terraform.NewResourceConfigRaw(raw)
+	diags := provider.Configure(context.Background(), config)
+	if diags.HasError() {
+		t.Fatalf("Failed to configure provider: %v", diags)
+	}
+	return provider.Meta()
+}
+
+func testUnitGetResource(t *testing.T, name string) *schema.Resource {
+	r, ok := Provider().ResourcesMap[name]
+	if !ok {
+		t.Fatalf("Resource %s is not registered", name)
+	}
+	return r
+}
+
+// Plans and applies configuration for resource, as terraform would, and returns
+// new state
+func testUnitApplyResource(t *testing.T, meta interface{}, name string, state *terraform.InstanceState, raw map[string]interface{}) *terraform.InstanceState {
+	r := testUnitGetResource(t, name)
+	ctx := context.Background()
+	diff, err := r.Diff(ctx, state, terraform.NewResourceConfigRaw(raw), meta)
+	if err != nil {
+		t.Fatalf("Failed to plan %s: %v", name, err)
+	}
+	if diff == nil {
+		return state
+	}
+	newState, diags := r.Apply(ctx, state, diff, meta)
+	if diags.HasError() {
+		t.Fatalf("Failed to apply %s: %v", name, diags)
+	}
+	return newState
+}
+
+func testUnitRefreshResource(t *testing.T, meta interface{}, name string, state *terraform.InstanceState) *terraform.InstanceState {
+	r := testUnitGetResource(t, name)
+	newState, diags := r.RefreshWithoutUpgrade(context.Background(), state, meta)
+	if diags.HasError() {
+		t.Fatalf("Failed to refresh %s: %v", name, diags)
+	}
+	return newState
+}
+
+func testUnitDestroyResource(t *testing.T, meta interface{}, name string, state *terraform.InstanceState) {
+	r := testUnitGetResource(t, name)
+	_, diags := r.Apply(context.Background(), state, &terraform.InstanceDiff{Destroy: true}, meta)
+	if diags.HasError() {
+		t.Fatalf("Failed to destroy %s: %v", name, diags)
+	}
+}
+
+func testUnitReadDataSource(t *testing.T, meta interface{}, name string, raw map[string]interface{}) *terraform.InstanceState {
+	r, ok := Provider().DataSourcesMap[name]
+	if !ok {
+		t.Fatalf("Data source %s is not registered", name)
+	}
+	ctx := context.Background()
+	diff, err := r.Diff(ctx, nil, terraform.NewResourceConfigRaw(raw), meta)
+	if err != nil {
+		t.Fatalf("Failed to plan %s: %v", name, err)
+	}
+	state, diags := r.ReadDataApply(ctx, diff, meta)
+	if diags.HasError() {
+		t.Fatalf("Failed to read %s: %v", name, diags)
+	}
+	return state
+}
+
+// Applies new resource, and verifies that it fails with error on given attribute
+func testUnitCheckApplyAttributeError(t *testing.T, meta interface{}, name string, raw map[string]interface{}, attribute string) {
+	r := testUnitGetResource(t, name)
+	ctx := context.Background()
+	diff, err := r.Diff(ctx, nil, terraform.NewResourceConfigRaw(raw), meta)
+	if err != nil {
+		t.Fatalf("Failed to plan %s: %v", name, err)
+	}
+
+	_, diags := r.Apply(ctx, nil, diff, meta)
+	if !diags.HasError() || !diags[0].AttributePath.Equals(cty.GetAttrPath(attribute)) {
+		t.Errorf("Expected %s to fail with error on %s, got %v", name, attribute, diags)
+	}
+}
+
+func testUnitCheckAttr(t *testing.T, state *terraform.InstanceState, key string, expected string) {
+	if state == nil {
+		t.Fatalf("Expected attribute %s to be %s, but state is empty", key, expected)
+	}
+	if actual := state.Attributes[key]; actual != expected {
+		t.Errorf("Expected attribute %s to be %s, got %s", key, expected, actual)
+	}
+}
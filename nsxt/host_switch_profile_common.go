@@ -0,0 +1,184 @@
+/* Copyright © 2023 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/bindings"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/data"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+func getPolicyHostSwitchProfileDataSourceSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"id":           getDataSourceIDSchema(),
+		"display_name": getDataSourceDisplayNameSchema(),
+		"description":  getDataSourceDescriptionSchema(),
+		"path":         getPathSchema(),
+	}
+}
+
+func resourceNsxtPolicyHostSwitchProfileExists(id string, connector client.Connector, isGlobalManager bool) (bool, error) {
+	client := infra.NewHostSwitchProfilesClient(connector)
+	_, err := client.Get(id)
+	if err == nil {
+		return true, nil
+	}
+
+	if isNotFoundError(err) {
+		return false, nil
+	}
+
+	return false, logAPIError("Error retrieving Host Switch Profile", err)
+}
+
+func policyHostSwitchProfilePatch(connector client.Connector, id string, obj interface{}, bindingType bindings.BindingType) error {
+	converter := bindings.NewTypeConverter()
+	dataValue, errs := converter.ConvertToVapi(obj, bindingType)
+	if errs != nil {
+		return errs[0]
+	}
+
+	client := infra.NewHostSwitchProfilesClient(connector)
+	_, err := client.Patch(id, dataValue.(*data.StructValue))
+	return err
+}
+
+func policyHostSwitchProfileGet(connector client.Connector, id string, bindingType bindings.BindingType) (interface{}, error) {
+	client := infra.NewHostSwitchProfilesClient(connector)
+	obj, err := client.Get(id)
+	if err != nil {
+		return nil, err
+	}
+
+	converter := bindings.NewTypeConverter()
+	profile, errs := converter.ConvertToGolang(obj, bindingType)
+	if errs != nil {
+		return nil, errs[0]
+	}
+
+	return profile, nil
+}
+
+func resourceNsxtPolicyHostSwitchProfileDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	id := d.Id()
+	if id == "" {
+		return diag.Errorf("Error obtaining Host Switch Profile ID")
+	}
+
+	connector := getPolicyConnector(m)
+	client := infra.NewHostSwitchProfilesClient(connector)
+	err := client.Delete(id)
+	if err != nil {
+		return getOperationDiagnostics(m, handleDeleteError("Host Switch Profile", id, err))
+	}
+
+	return nil
+}
+
+func listPolicyHostSwitchProfiles(connector client.Connector, resourceType string) ([]model.PolicyBaseHostSwitchProfile, error) {
+	client := infra.NewHostSwitchProfilesClient(connector)
+	converter := bindings.NewTypeConverter()
+
+	var results []model.PolicyBaseHostSwitchProfile
+	var cursor *string
+	total := 0
+	includeMarkForDeleteObjectsParam := false
+	// System owned profiles are needed in order to resolve NSX defaults
+	includeSystemOwnedParam := true
+	for {
+		objList, err := client.List(cursor, nil, &resourceType, &includeMarkForDeleteObjectsParam, &includeSystemOwnedParam, nil, nil, nil, nil, nil, nil, nil)
+		if err != nil {
+			return nil, err
+		}
+		for _, obj := range objList.Results {
+			baseObj, errs := converter.ConvertToGolang(obj, model.PolicyBaseHostSwitchProfileBindingType())
+			if errs != nil {
+				return nil, errs[0]
+			}
+			results = append(results, baseObj.(model.PolicyBaseHostSwitchProfile))
+		}
+		if total == 0 && objList.ResultCount != nil {
+			// first response
+			total = int(*objList.ResultCount)
+		}
+		cursor = objList.Cursor
+		if len(results) >= total || cursor == nil || len(objList.Results) == 0 {
+			return results, nil
+		}
+	}
+}
+
+func dataSourceNsxtPolicyHostSwitchProfileRead(d *schema.ResourceData, m interface{}, resourceType string, resourceName string) error {
+	if isPolicyGlobalManager(m) {
+		return localManagerOnlyError()
+	}
+
+	objID := d.Get("id").(string)
+	objName := d.Get("display_name").(string)
+	if objID == "" && objName == "" {
+		return fmt.Errorf("Error obtaining %s ID or name during read", resourceName)
+	}
+
+	objList, err := listPolicyHostSwitchProfiles(getPolicyConnector(m), resourceType)
+	if err != nil {
+		return handleListError(resourceName, err)
+	}
+
+	var obj model.PolicyBaseHostSwitchProfile
+	if objID != "" {
+		found := false
+		for _, objInList := range objList {
+			if objInList.Id != nil && *objInList.Id == objID {
+				obj = objInList
+				found = true
+				break
+			}
+		}
+		if !found {
+			return fmt.Errorf("%s with ID '%s' was not found", resourceName, objID)
+		}
+	} else {
+		// go over the list to find the correct one (prefer a perfect match. If not - prefix match)
+		var perfectMatch []model.PolicyBaseHostSwitchProfile
+		var prefixMatch []model.PolicyBaseHostSwitchProfile
+		for _, objInList := range objList {
+			if objInList.DisplayName == nil {
+				continue
+			}
+			if strings.HasPrefix(*objInList.DisplayName, objName) {
+				prefixMatch = append(prefixMatch, objInList)
+			}
+			if *objInList.DisplayName == objName {
+				perfectMatch = append(perfectMatch, objInList)
+			}
+		}
+		if len(perfectMatch) > 0 {
+			if len(perfectMatch) > 1 {
+				return fmt.Errorf("Found multiple %ss with name '%s'", resourceName, objName)
+			}
+			obj = perfectMatch[0]
+		} else if len(prefixMatch) > 0 {
+			if len(prefixMatch) > 1 {
+				return fmt.Errorf("Found multiple %ss with name starting with '%s'", resourceName, objName)
+			}
+			obj = prefixMatch[0]
+		} else {
+			return fmt.Errorf("%s with name '%s' was not found", resourceName, objName)
+		}
+	}
+
+	d.SetId(*obj.Id)
+	d.Set("display_name", obj.DisplayName)
+	d.Set("description", obj.Description)
+	d.Set("path", obj.Path)
+	return nil
+}
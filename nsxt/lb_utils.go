@@ -4,10 +4,11 @@
 package nsxt
 
 import (
-	"fmt"
+	"context"
 	"log"
 	"net/http"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/vmware/go-vmware-nsxt/loadbalancer"
@@ -215,20 +216,20 @@ func setLbHTTPHeaderInSchema(d *schema.ResourceData, attrName string, headers []
 	d.Set(attrName, headerList)
 }
 
-func resourceNsxtLbMonitorDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtLbMonitorDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return getErrorDiagnostics(resourceNotSupportedError())
 	}
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining logical object id")
+		return diag.Errorf("Error obtaining logical object id")
 	}
 
 	resp, err := nsxClient.ServicesApi.DeleteLoadBalancerMonitor(nsxClient.Context, id)
 	if err != nil {
-		return fmt.Errorf("Error during LbMonitor delete: %v", err)
+		return diag.Errorf("Error during LbMonitor delete: %v", err)
 	}
 
 	if resp.StatusCode == http.StatusNotFound {
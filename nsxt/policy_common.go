@@ -290,10 +290,23 @@ func getPolicyGatewayPolicySchema() map[string]*schema.Schema {
 	delete(secPolicy, "scope")
 	secPolicy["category"].ValidateFunc = validation.StringInSlice(gatewayPolicyCategoryWritableValues, false)
 	// GW Policy rules require scope to be set
-	secPolicy["rule"] = getSecurityPolicyAndGatewayRulesSchema(true, false, true)
+	secPolicy["rule"] = getPolicyGatewayRulesSchema(true)
 	return secPolicy
 }
 
+func getPolicyGatewayRulesSchema(nsxIDReadOnly bool) *schema.Schema {
+	ruleSchema := getSecurityPolicyAndGatewayRulesSchema(true, false, nsxIDReadOnly)
+	ruleElemSchema := ruleSchema.Elem.(*schema.Resource).Schema
+	// L7 Access Profile is stored in rule profiles on NSX side, but is exposed
+	// separately since it can not be combined with context profiles
+	ruleElemSchema["l7_access_profile"] = getPolicyPathSchema(false, false, "Policy path of L7 Access Profile")
+	return ruleSchema
+}
+
+func isPolicyL7AccessProfilePath(path string) bool {
+	return strings.Contains(path, "/l7-access-profiles/")
+}
+
 func getPolicySecurityPolicySchema(isIds bool) map[string]*schema.Schema {
 	result := map[string]*schema.Schema{
 		"nsx_id":       getNsxIDSchema(),
@@ -349,11 +362,13 @@ func getPolicySecurityPolicySchema(isIds bool) map[string]*schema.Schema {
 			Optional:    true,
 			Computed:    true,
 		},
-		"rule": getSecurityPolicyAndGatewayRulesSchema(false, isIds, true),
+		"scheduler_path": getPolicyPathSchema(false, false, "Path of firewall scheduler that determines when rules in this policy are enforced"),
+		"rule":           getSecurityPolicyAndGatewayRulesSchema(false, isIds, true),
 	}
 
 	if isIds {
 		delete(result, "category")
+		delete(result, "scheduler_path")
 		delete(result, "scope")
 		delete(result, "tcp_strict")
 	}
@@ -361,7 +376,7 @@ func getPolicySecurityPolicySchema(isIds bool) map[string]*schema.Schema {
 	return result
 }
 
-func setPolicyRulesInSchema(d *schema.ResourceData, rules []model.Rule) error {
+func setPolicyRulesInSchema(d *schema.ResourceData, rules []model.Rule, withL7AccessProfile bool) error {
 	var rulesList []map[string]interface{}
 	for _, rule := range rules {
 		elem := make(map[string]interface{})
@@ -383,7 +398,20 @@ func setPolicyRulesInSchema(d *schema.ResourceData, rules []model.Rule) error {
 		elem["revision"] = rule.Revision
 		setPathListInMap(elem, "source_groups", rule.SourceGroups)
 		setPathListInMap(elem, "destination_groups", rule.DestinationGroups)
-		setPathListInMap(elem, "profiles", rule.Profiles)
+		if withL7AccessProfile {
+			var profiles []string
+			elem["l7_access_profile"] = ""
+			for _, profile := range rule.Profiles {
+				if isPolicyL7AccessProfilePath(profile) {
+					elem["l7_access_profile"] = profile
+				} else {
+					profiles = append(profiles, profile)
+				}
+			}
+			setPathListInMap(elem, "profiles", profiles)
+		} else {
+			setPathListInMap(elem, "profiles", rule.Profiles)
+		}
 		setPathListInMap(elem, "services", rule.Services)
 		setPathListInMap(elem, "scope", rule.Scope)
 		elem["sequence_number"] = rule.SequenceNumber
@@ -459,6 +487,14 @@ func getPolicyRulesFromSchema(d *schema.ResourceData) []model.Rule {
 			Profiles:             getPathListFromMap(data, "profiles"),
 		}
 
+		if l7AccessProfile, ok := data["l7_access_profile"]; ok && l7AccessProfile.(string) != "" {
+			if len(elem.Profiles) == 1 && elem.Profiles[0] == "ANY" {
+				elem.Profiles = []string{l7AccessProfile.(string)}
+			} else {
+				elem.Profiles = append(elem.Profiles, l7AccessProfile.(string))
+			}
+		}
+
 		if sequenceNumber > 0 {
 			elem.SequenceNumber = &sequenceNumber
 		}
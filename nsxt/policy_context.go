@@ -0,0 +1,194 @@
+/* Copyright © 2023 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/bindings"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/data"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	nsx_policy "github.com/vmware/vsphere-automation-sdk-go/services/nsxt"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+const defaultOrgID = "default"
+
+// Multi-tenancy (projects) is supported starting NSX 4.1.0
+const policyMultitenancyMinVersion = "4.1.0"
+
+func getPolicyContextSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Description: "Resource context",
+		Optional:    true,
+		MaxItems:    1,
+		ForceNew:    true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"project_id": {
+					Type:        schema.TypeString,
+					Description: "Id of the project which the resource belongs to",
+					Required:    true,
+					ForceNew:    true,
+				},
+			},
+		},
+	}
+}
+
+// Returns project ID from context block, or empty string for default (/infra) context
+func getPolicyProjectID(d *schema.ResourceData) string {
+	contexts := d.Get("context").([]interface{})
+	if len(contexts) == 0 || contexts[0] == nil {
+		return ""
+	}
+
+	context := contexts[0].(map[string]interface{})
+	return context["project_id"].(string)
+}
+
+func setPolicyProjectIDInSchema(d *schema.ResourceData, projectID string) {
+	if projectID == "" {
+		d.Set("context", nil)
+		return
+	}
+
+	context := make(map[string]interface{})
+	context["project_id"] = projectID
+	d.Set("context", []interface{}{context})
+}
+
+func validatePolicyProjectContext(projectID string, m interface{}) error {
+	if projectID == "" {
+		return nil
+	}
+
+	if isPolicyGlobalManager(m) {
+		return fmt.Errorf("Project context is not supported with NSX Global Manager")
+	}
+
+	if !nsxVersionHigherOrEqual(policyMultitenancyMinVersion) {
+		return fmt.Errorf("Project context requires NSX version %s or higher", policyMultitenancyMinVersion)
+	}
+
+	return nil
+}
+
+// Verifies that object referred by policy path belongs to the same project as the
+// resource, since project API can not refer to objects outside of the project
+func validatePolicyPathInContext(attribute string, path string, projectID string) error {
+	pathProjectID := getPolicyProjectIDFromPath(path)
+	if path == "" || pathProjectID == projectID {
+		return nil
+	}
+
+	if projectID == "" {
+		return newAttributeError(attribute, fmt.Errorf("%s %s belongs to project %s, while resource has no project context", attribute, path, pathProjectID))
+	}
+	return newAttributeError(attribute, fmt.Errorf("%s %s does not belong to project %s, expected path starting with %s/", attribute, path, projectID, strings.TrimSuffix(getPolicyInfraPathPrefix(projectID), "/infra")))
+}
+
+func getPolicyInfraPathPrefix(projectID string) string {
+	if projectID == "" {
+		return "/infra"
+	}
+
+	return fmt.Sprintf("/orgs/%s/projects/%s/infra", defaultOrgID, projectID)
+}
+
+// Returns project ID if policy path belongs to a project, otherwise empty string
+func getPolicyProjectIDFromPath(path string) string {
+	if !strings.HasPrefix(path, "/orgs/") {
+		return ""
+	}
+
+	return getResourceIDFromResourcePath(path, "projects")
+}
+
+func policyWrapInfraChild(id string, targetType string, children []*data.StructValue) (*data.StructValue, error) {
+	childType := "ChildResourceReference"
+	child := model.ChildResourceReference{
+		Id:           &id,
+		ResourceType: childType,
+		TargetType:   &targetType,
+		Children:     children,
+	}
+
+	converter := bindings.NewTypeConverter()
+	dataValue, errs := converter.ConvertToVapi(child, model.ChildResourceReferenceBindingType())
+	if errs != nil {
+		return nil, errs[0]
+	}
+
+	return dataValue.(*data.StructValue), nil
+}
+
+func policyProjectInfraPatch(projectID string, obj model.Infra, connector client.Connector, enforceRevision bool) error {
+	// Infra, Project and Org are wrapped as references, since
+	// this call is not meant to modify any of them
+	infraChild, err := policyWrapInfraChild("default", "Infra", obj.Children)
+	if err != nil {
+		return err
+	}
+
+	projectChild, err := policyWrapInfraChild(projectID, "Project", []*data.StructValue{infraChild})
+	if err != nil {
+		return err
+	}
+
+	orgChild, err := policyWrapInfraChild(defaultOrgID, "Org", []*data.StructValue{projectChild})
+	if err != nil {
+		return err
+	}
+
+	orgRootType := "OrgRoot"
+	orgRoot := model.OrgRoot{
+		ResourceType: &orgRootType,
+		Children:     []*data.StructValue{orgChild},
+	}
+
+	client := nsx_policy.NewOrgRootClient(connector)
+	return client.Patch(orgRoot, &enforceRevision)
+}
+
+func policyInfraPatchWithContext(projectID string, obj model.Infra, isGlobalManager bool, connector client.Connector, enforceRevision bool) error {
+	if projectID == "" {
+		return policyInfraPatch(obj, isGlobalManager, connector, enforceRevision)
+	}
+
+	return policyProjectInfraPatch(projectID, obj, connector, enforceRevision)
+}
+
+// Supports import by ID (default context) or by full policy path of project-scoped object
+func nsxtPolicyContextResourceImporter(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	importID := d.Id()
+	projectID := getPolicyProjectIDFromPath(importID)
+	if projectID == "" {
+		return []*schema.ResourceData{d}, nil
+	}
+
+	setPolicyProjectIDInSchema(d, projectID)
+	d.SetId(getPolicyIDFromPath(importID))
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// Same as nsxtDomainResourceImporter, with additional support for project-scoped policy paths
+func nsxtDomainResourceImporterWithContext(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	importID := d.Id()
+	projectID := getPolicyProjectIDFromPath(importID)
+	if projectID == "" {
+		return nsxtDomainResourceImporter(ctx, d, m)
+	}
+
+	setPolicyProjectIDInSchema(d, projectID)
+	d.Set("domain", getDomainFromResourcePath(importID))
+	d.SetId(getPolicyIDFromPath(importID))
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,142 @@
+/* Copyright © 2023 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Modifications by these users are done by NSX itself, and are not considered
+// out of band changes
+var policySystemUsers = []string{"system"}
+
+// Returns user that last modified the object on NSX, or empty string if unknown
+func getPolicyLastModifiedUser(m interface{}, path string) string {
+	obj, err := policyGenericGet(getPolicyConnector(m), isPolicyGlobalManager(m), path)
+	if err != nil {
+		log.Printf("[WARNING]: Failed to retrieve last modified user of %s: %v", path, err)
+		return ""
+	}
+
+	user, _ := obj["_last_modified_user"].(string)
+	return user
+}
+
+// Change of object revision is considered out of band if it was not done by
+// the provider user or by NSX. For authentication methods without username,
+// such as token or certificate, and when modifying user is not known, changes
+// can not be attributed and are not considered out of band.
+func isPolicyOutOfBandModification(m interface{}, user string) bool {
+	if user == "" || stringInList(user, policySystemUsers) {
+		return false
+	}
+
+	providerUser := m.(nsxtClients).CommonConfig.Username
+	return providerUser != "" && user != providerUser
+}
+
+// Records last modifying user after the object is applied by the provider
+func setPolicyAppliedModificationInSchema(d *schema.ResourceData, m interface{}) {
+	if d.Id() == "" {
+		return
+	}
+
+	d.Set("last_modified_by", getPolicyLastModifiedUser(m, d.Get("path").(string)))
+	d.Set("modified_out_of_band", false)
+}
+
+func getPolicyDriftOperation(operation func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+	return func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		diags := operation(ctx, d, m)
+		if diags.HasError() || !getPolicyTrackOutOfBandChanges(m) {
+			return diags
+		}
+
+		setPolicyAppliedModificationInSchema(d, withProviderContext(m, ctx))
+		return diags
+	}
+}
+
+func wrapPolicyResourceDrift(r *schema.Resource) {
+	r.Schema["last_modified_by"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Description: "User that last modified the object on NSX",
+		Computed:    true,
+	}
+	r.Schema["modified_out_of_band"] = &schema.Schema{
+		Type:        schema.TypeBool,
+		Description: "Indicates that the object was modified on NSX outside of terraform since it was last applied",
+		Computed:    true,
+	}
+
+	if r.CreateContext != nil {
+		r.CreateContext = getPolicyDriftOperation(r.CreateContext)
+	}
+
+	if r.UpdateContext != nil {
+		r.UpdateContext = getPolicyDriftOperation(r.UpdateContext)
+	}
+
+	read := r.ReadContext
+	r.ReadContext = func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		if !getPolicyTrackOutOfBandChanges(m) {
+			return read(ctx, d, m)
+		}
+
+		oldRevision := d.Get("revision").(int)
+		// Modification is not known for objects that were just imported
+		recorded := d.Get("last_modified_by").(string) != ""
+
+		diags := read(ctx, d, m)
+		if diags.HasError() || d.Id() == "" {
+			return diags
+		}
+
+		revision := d.Get("revision").(int)
+		if recorded && revision == oldRevision {
+			// Server side defaults and reordering do not change revision
+			return diags
+		}
+
+		m = withProviderContext(m, ctx)
+		path := d.Get("path").(string)
+		user := getPolicyLastModifiedUser(m, path)
+		d.Set("last_modified_by", user)
+		if !recorded || !isPolicyOutOfBandModification(m, user) {
+			return diags
+		}
+
+		d.Set("modified_out_of_band", true)
+		return append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("Object %s was modified outside of terraform", path),
+			Detail:   fmt.Sprintf("Revision changed from %d to %d, last modified by %s", oldRevision, revision, user),
+		})
+	}
+}
+
+// Only resources that represent single policy object with revision are tracked
+func isPolicyRevisionTracked(r *schema.Resource) bool {
+	for _, attr := range []string{"path", "revision"} {
+		s, ok := r.Schema[attr]
+		if !ok || !s.Computed || s.Type == schema.TypeList {
+			return false
+		}
+	}
+	return r.ReadContext != nil
+}
+
+func wrapPolicyResourcesDrift(resources map[string]*schema.Resource) {
+	for name, r := range resources {
+		if strings.HasPrefix(name, "nsxt_policy_") && isPolicyRevisionTracked(r) {
+			wrapPolicyResourceDrift(r)
+		}
+	}
+}
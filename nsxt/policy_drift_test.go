@@ -0,0 +1,94 @@
+/* Copyright © 2023 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+func TestUnitPolicyResourceDrift(t *testing.T) {
+	fake := newFakeNsxServer(t)
+	meta := testUnitConfigureProviderWithOptions(t, fake, map[string]interface{}{
+		"track_out_of_band_changes": true,
+	})
+	resourceName := "nsxt_policy_group"
+	config := map[string]interface{}{
+		"display_name": "group1",
+		"description":  "created",
+	}
+
+	state := testUnitApplyResource(t, meta, resourceName, nil, config)
+	testUnitCheckAttr(t, state, "last_modified_by", "admin")
+	testUnitCheckAttr(t, state, "modified_out_of_band", "false")
+
+	r := testUnitGetResource(t, resourceName)
+	state, diags := r.RefreshWithoutUpgrade(context.Background(), state, meta)
+	if len(diags) > 0 {
+		t.Fatalf("Expected no diagnostics on refresh, got %v", diags)
+	}
+	testUnitCheckAttr(t, state, "modified_out_of_band", "false")
+
+	description := "changed in UI"
+	fake.putPolicyObject(state.Attributes["path"], model.Group{Description: &description})
+	state, diags = r.RefreshWithoutUpgrade(context.Background(), state, meta)
+	if len(diags) != 1 || diags[0].Severity != diag.Warning {
+		t.Fatalf("Expected out of band modification warning, got %v", diags)
+	}
+	testUnitCheckAttr(t, state, "last_modified_by", fakeNsxOperatorUser)
+	testUnitCheckAttr(t, state, "modified_out_of_band", "true")
+	testUnitCheckAttr(t, state, "description", description)
+
+	// Flag is reset once terraform applies configuration again
+	state = testUnitApplyResource(t, meta, resourceName, state, config)
+	testUnitCheckAttr(t, state, "last_modified_by", "admin")
+	testUnitCheckAttr(t, state, "modified_out_of_band", "false")
+	testUnitCheckAttr(t, state, "description", "created")
+}
+
+func TestUnitPolicyResourceDriftDisabled(t *testing.T) {
+	fake := newFakeNsxServer(t)
+	meta := testUnitConfigureProvider(t, fake)
+	resourceName := "nsxt_policy_group"
+
+	state := testUnitApplyResource(t, meta, resourceName, nil, map[string]interface{}{
+		"display_name": "group1",
+	})
+	testUnitCheckAttr(t, state, "last_modified_by", "")
+
+	description := "changed in UI"
+	fake.putPolicyObject(state.Attributes["path"], model.Group{Description: &description})
+	state, diags := testUnitGetResource(t, resourceName).RefreshWithoutUpgrade(context.Background(), state, meta)
+	if len(diags) > 0 {
+		t.Fatalf("Expected no diagnostics when tracking is disabled, got %v", diags)
+	}
+	testUnitCheckAttr(t, state, "last_modified_by", "")
+	testUnitCheckAttr(t, state, "description", description)
+}
+
+func TestUnitIsPolicyOutOfBandModification(t *testing.T) {
+	withUser := nsxtClients{CommonConfig: commonProviderConfig{Username: "admin"}}
+	withoutUser := nsxtClients{}
+
+	for _, tc := range []struct {
+		m        nsxtClients
+		user     string
+		expected bool
+	}{
+		{withUser, "admin", false},
+		{withUser, "operator", true},
+		{withUser, "system", false},
+		// Modifying user is not known
+		{withUser, "", false},
+		// Token or certificate authentication
+		{withoutUser, "operator", false},
+	} {
+		if actual := isPolicyOutOfBandModification(tc.m, tc.user); actual != tc.expected {
+			t.Errorf("Expected modification by %q with provider user %q to be out of band: %v", tc.user, tc.m.CommonConfig.Username, tc.expected)
+		}
+	}
+}
@@ -0,0 +1,227 @@
+/* Copyright © 2023 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	nsx_policy "github.com/vmware/vsphere-automation-sdk-go/services/nsxt"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+const policyErrorResolverAPIPath = "/policy/api/v1/error-resolver"
+
+// Error resolver settings and metadata cache of single provider instance
+type policyErrorResolver struct {
+	hints            bool
+	autoResolveCodes []int64
+	infoCache        map[int64]*model.ErrorResolverInfo
+	lock             sync.Mutex
+}
+
+func configurePolicyErrorResolver(d *schema.ResourceData, clients *nsxtClients) {
+	resolver := &policyErrorResolver{
+		hints:     d.Get("error_resolver_hints").(bool),
+		infoCache: make(map[int64]*model.ErrorResolverInfo),
+	}
+	for _, code := range d.Get("error_resolver_auto_resolve_codes").([]interface{}) {
+		resolver.autoResolveCodes = append(resolver.autoResolveCodes, int64(code.(int)))
+	}
+
+	clients.ErrorResolver = nil
+	if (resolver.hints || len(resolver.autoResolveCodes) > 0) && !clients.PolicyGlobalManager {
+		// Error resolver is not available on global manager
+		clients.ErrorResolver = resolver
+	}
+}
+
+func getPolicyErrorResolver(m interface{}) *policyErrorResolver {
+	clients, ok := m.(nsxtClients)
+	if !ok {
+		return nil
+	}
+
+	return clients.ErrorResolver
+}
+
+func (r *policyErrorResolver) isAutoResolvable(code int64) bool {
+	for _, autoCode := range r.autoResolveCodes {
+		if autoCode == code {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (r *policyErrorResolver) getCachedInfo(code int64) (*model.ErrorResolverInfo, bool) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	info, ok := r.infoCache[code]
+	return info, ok
+}
+
+func (r *policyErrorResolver) getInfo(m interface{}, code int64) *model.ErrorResolverInfo {
+	if info, ok := r.getCachedInfo(code); ok {
+		return info
+	}
+
+	// Concurrent lookups of same code are harmless, hence API call is not
+	// done under the lock
+	client := nsx_policy.NewErrorResolverClient(getPolicyConnector(m))
+	info, err := client.Get(fmt.Sprintf("%d", code))
+	if err != nil {
+		if !isNotFoundError(err) {
+			log.Printf("[WARNING]: Failed to retrieve error resolver info for error code %d: %v", code, err)
+			return nil
+		}
+	}
+
+	var result *model.ErrorResolverInfo
+	if err == nil {
+		result = &info
+	}
+	// Nil is cached as well, since no resolver is registered for this error code
+	r.lock.Lock()
+	r.infoCache[code] = result
+	r.lock.Unlock()
+
+	return result
+}
+
+func getPolicyErrorResolverUserInputs(info *model.ErrorResolverInfo) []string {
+	var userInputs []string
+	if info.UserMetadata != nil {
+		for _, input := range info.UserMetadata.UserInputList {
+			if input.PropertyName != nil {
+				userInputs = append(userInputs, *input.PropertyName)
+			}
+		}
+	}
+
+	return userInputs
+}
+
+// Builds resolution from resolver metadata, in form of error resolver request for
+// the failing entity. User inputs the resolver expects are listed with their data
+// type, to be filled in by the user.
+func getPolicyErrorResolutionHint(code int64, info *model.ErrorResolverInfo, entityID string) string {
+	errorMetadata := map[string]interface{}{
+		"error_id": code,
+	}
+	if entityID != "" {
+		errorMetadata["entity_id"] = entityID
+	}
+	var userInputs []map[string]string
+	if info.UserMetadata != nil {
+		for _, input := range info.UserMetadata.UserInputList {
+			if input.PropertyName == nil {
+				continue
+			}
+			userInput := map[string]string{"property_name": *input.PropertyName}
+			if input.DataType != nil {
+				userInput["data_type"] = *input.DataType
+			}
+			userInputs = append(userInputs, userInput)
+		}
+	}
+	if len(userInputs) > 0 {
+		errorMetadata["user_metadata"] = map[string]interface{}{"user_input_list": userInputs}
+	}
+	body, _ := json.Marshal(map[string]interface{}{"errors": []interface{}{errorMetadata}})
+
+	hint := fmt.Sprintf("invoke NSX error resolver with POST %s?action=resolve_error and body %s", policyErrorResolverAPIPath, body)
+	if len(userInputs) > 0 {
+		return hint + ", setting property_value of each user input"
+	}
+	return hint + ". No user input is required"
+}
+
+// Returns resolution hint for NSX error code reported on entity, or empty string if
+// hints are disabled or no resolver is registered for the code
+func getPolicyErrorResolution(m interface{}, code int64, entityID string) string {
+	resolver := getPolicyErrorResolver(m)
+	if resolver == nil || !resolver.hints {
+		return ""
+	}
+
+	info := resolver.getInfo(m, code)
+	if info == nil || info.ResolverPresent == nil || !*info.ResolverPresent {
+		return ""
+	}
+
+	return getPolicyErrorResolutionHint(code, info, entityID)
+}
+
+// Invokes error resolver for NSX entity the error was reported on, such as transport
+// node, if the error code is configured to be auto resolvable and its resolver
+// requires no user input. Returns whether the resolver was invoked.
+func resolvePolicyError(m interface{}, code int64, entityID string) bool {
+	resolver := getPolicyErrorResolver(m)
+	if resolver == nil || entityID == "" || !resolver.isAutoResolvable(code) {
+		return false
+	}
+
+	info := resolver.getInfo(m, code)
+	if info == nil || info.ResolverPresent == nil || !*info.ResolverPresent {
+		return false
+	}
+	if len(getPolicyErrorResolverUserInputs(info)) > 0 {
+		log.Printf("[DEBUG]: Error resolver for error code %d requires user input, not invoking it", code)
+		return false
+	}
+
+	log.Printf("[INFO]: Invoking error resolver for error code %d on %s", code, entityID)
+	client := nsx_policy.NewErrorResolverClient(getPolicyConnector(m))
+	errorList := model.ErrorResolverMetadataList{
+		Errors: []model.ErrorResolverMetadata{
+			{
+				ErrorId:  &code,
+				EntityId: &entityID,
+			},
+		},
+	}
+	err := client.Resolveerror(errorList)
+	if err != nil {
+		log.Printf("[WARNING]: Failed to invoke error resolver for error code %d on %s: %v", code, entityID, err)
+		return false
+	}
+
+	return true
+}
+
+// Adds resolution hint to error of failed operation, if the error was reported
+// by NSX with error code
+func resolvePolicyOperationError(m interface{}, err error) error {
+	var apiErr *policyAPIError
+	if err == nil || !errors.As(err, &apiErr) {
+		return err
+	}
+
+	resolution := getPolicyErrorResolution(m, apiErr.code, "")
+	if resolution == "" {
+		return err
+	}
+
+	return &resolvedError{err: err, resolution: resolution}
+}
+
+type resolvedError struct {
+	err        error
+	resolution string
+}
+
+func (e *resolvedError) Error() string {
+	return fmt.Sprintf("%s\nResolution: %s", e.err.Error(), e.resolution)
+}
+
+func (e *resolvedError) Unwrap() error {
+	return e.err
+}
@@ -0,0 +1,133 @@
+/* Copyright © 2023 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+const testErrorResolverCode = 8800
+const testErrorResolverInputCode = 8801
+
+func testUnitPutErrorResolvers(fake *fakeNsxServer) {
+	present := true
+	fake.putErrorResolver(testErrorResolverCode, model.ErrorResolverInfo{
+		ResolverPresent: &present,
+	})
+
+	propertyName := "password"
+	dataType := model.ErrorResolverUserInputData_DATA_TYPE_PASSWORD
+	fake.putErrorResolver(testErrorResolverInputCode, model.ErrorResolverInfo{
+		ResolverPresent: &present,
+		UserMetadata: &model.ErrorResolverUserMetadata{
+			UserInputList: []model.ErrorResolverUserInputData{
+				{
+					PropertyName: &propertyName,
+					DataType:     &dataType,
+				},
+			},
+		},
+	})
+}
+
+func TestUnitPolicyErrorResolverHints(t *testing.T) {
+	fake := newFakeNsxServer(t)
+	testUnitPutErrorResolvers(fake)
+	meta := testUnitConfigureProviderWithOptions(t, fake, map[string]interface{}{
+		"error_resolver_hints": true,
+	})
+
+	// Error code is kept when API error is formatted, and hint is added as a
+	// separate step
+	client := infra.NewFirewallSchedulersClient(getPolicyConnector(meta))
+	_, err := client.Get("missing")
+	err = logAPIError("Failed to read scheduler", err)
+	if resolved := resolvePolicyOperationError(meta, err); resolved.Error() != err.Error() {
+		t.Errorf("Expected no hint for error without resolver, got %s", resolved)
+	}
+
+	err = &policyAPIError{message: "Failed to prepare host", code: testErrorResolverCode}
+	resolved := resolvePolicyOperationError(meta, err)
+	if !strings.Contains(resolved.Error(), `Resolution: invoke NSX error resolver with POST /policy/api/v1/error-resolver?action=resolve_error and body {"errors":[{"error_id":8800}]}. No user input is required`) {
+		t.Errorf("Expected resolution hint, got %s", resolved)
+	}
+
+	err = &policyAPIError{message: "Failed to prepare host", code: testErrorResolverInputCode}
+	resolved = resolvePolicyOperationError(meta, err)
+	if !strings.Contains(resolved.Error(), `{"errors":[{"error_id":8801,"user_metadata":{"user_input_list":[{"data_type":"PASSWORD","property_name":"password"}]}}]}, setting property_value of each user input`) {
+		t.Errorf("Expected resolution hint with user input, got %s", resolved)
+	}
+
+	// Resolution refers to entity the error was reported on, if known
+	if resolution := getPolicyErrorResolution(meta, testErrorResolverCode, "node1"); !strings.Contains(resolution, `{"errors":[{"entity_id":"node1","error_id":8800}]}`) {
+		t.Errorf("Expected resolution for entity node1, got %s", resolution)
+	}
+
+	// Resolver info is looked up once per error code
+	lookups := fake.errorResolverLookups
+	resolvePolicyOperationError(meta, &policyAPIError{message: "Failed again", code: testErrorResolverCode})
+	if fake.errorResolverLookups != lookups {
+		t.Errorf("Expected resolver info to be cached")
+	}
+
+	// Hints never invoke the resolver
+	if len(fake.resolvedErrors) > 0 {
+		t.Errorf("Expected no resolver to be invoked, got %v", fake.resolvedErrors)
+	}
+}
+
+func TestUnitPolicyErrorResolverAutoResolve(t *testing.T) {
+	fake := newFakeNsxServer(t)
+	testUnitPutErrorResolvers(fake)
+	meta := testUnitConfigureProviderWithOptions(t, fake, map[string]interface{}{
+		"error_resolver_auto_resolve_codes": []interface{}{testErrorResolverCode, testErrorResolverInputCode},
+	})
+
+	if !resolvePolicyError(meta, testErrorResolverCode, "node1") {
+		t.Errorf("Expected resolver to be invoked")
+	}
+	// Resolver that requires user input is not invoked
+	if resolvePolicyError(meta, testErrorResolverInputCode, "node1") {
+		t.Errorf("Expected resolver that requires user input not to be invoked")
+	}
+	// Code that is not configured as auto resolvable
+	if resolvePolicyError(meta, 8802, "node1") {
+		t.Errorf("Expected resolver not to be invoked for code that is not auto resolvable")
+	}
+	// Entity is required
+	if resolvePolicyError(meta, testErrorResolverCode, "") {
+		t.Errorf("Expected resolver not to be invoked without entity")
+	}
+
+	if len(fake.resolvedErrors) != 1 || fake.resolvedErrors[0] != "8800:node1" {
+		t.Errorf("Expected resolver to be invoked for node1 only, got %v", fake.resolvedErrors)
+	}
+
+	// Hints are disabled
+	err := &policyAPIError{message: "Failed to prepare host", code: testErrorResolverCode}
+	if resolved := resolvePolicyOperationError(meta, err); resolved != err {
+		t.Errorf("Expected no hint when hints are disabled, got %s", resolved)
+	}
+}
+
+func TestUnitPolicyErrorResolverPerProvider(t *testing.T) {
+	fake := newFakeNsxServer(t)
+	testUnitPutErrorResolvers(fake)
+	withHints := testUnitConfigureProviderWithOptions(t, fake, map[string]interface{}{
+		"error_resolver_hints": true,
+	})
+	withoutHints := testUnitConfigureProvider(t, fake)
+
+	err := &policyAPIError{message: "Failed to prepare host", code: testErrorResolverCode}
+	if resolved := resolvePolicyOperationError(withHints, err); resolved == err {
+		t.Errorf("Expected hint from provider with hints enabled")
+	}
+	if resolved := resolvePolicyOperationError(withoutHints, err); resolved != err {
+		t.Errorf("Expected no hint from provider configured later without hints, got %s", resolved)
+	}
+}
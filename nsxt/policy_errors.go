@@ -16,6 +16,16 @@ import (
 	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
 )
 
+// Error reported by NSX API, with NSX error code
+type policyAPIError struct {
+	message string
+	code    int64
+}
+
+func (e *policyAPIError) Error() string {
+	return e.message
+}
+
 func isEmptyAPIError(apiError model.ApiError) bool {
 	return (apiError.ErrorCode == nil && apiError.ErrorMessage == nil)
 }
@@ -53,6 +63,40 @@ func printRelatedAPIError(apiError model.RelatedApiError) string {
 	return ""
 }
 
+func printPolicyAlarm(alarm model.PolicyAlarmResource) string {
+	details := ""
+	if alarm.Message != nil {
+		details = *alarm.Message
+	}
+
+	if alarm.ErrorDetails == nil {
+		return details
+	}
+
+	apiError := model.ApiError{
+		ErrorCode:    alarm.ErrorDetails.ErrorCode,
+		ErrorMessage: alarm.ErrorDetails.ErrorMessage,
+	}
+	if !isEmptyAPIError(apiError) {
+		if details != "" {
+			details += ": "
+		}
+		details += printAPIError(apiError)
+	}
+
+	if len(alarm.ErrorDetails.RelatedErrors) > 0 {
+		details += "\nRelated errors:\n"
+		for _, relatedErr := range alarm.ErrorDetails.RelatedErrors {
+			details += fmt.Sprintf("%s ", printRelatedAPIError(model.RelatedApiError{
+				ErrorCode:    relatedErr.ErrorCode,
+				ErrorMessage: relatedErr.ErrorMessage,
+			}))
+		}
+	}
+
+	return details
+}
+
 func logRawVapiErrorData(message string, vapiType *errors.ErrorTypeEnum, apiErrorDataValue *data.StructValue) error {
 	dataValueToJSONEncoder := cleanjson.NewDataValueToJsonEncoder()
 	errorStr, convErr := dataValueToJSONEncoder.Encode(apiErrorDataValue)
@@ -103,6 +147,9 @@ func logVapiErrorData(message string, vapiMessages []std.LocalizableMessage, vap
 		}
 	}
 	log.Printf("[ERROR]: %s", details)
+	if apiError.ErrorCode != nil {
+		return &policyAPIError{message: details, code: *apiError.ErrorCode}
+	}
 	return fmt.Errorf(details)
 }
 
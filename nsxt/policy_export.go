@@ -0,0 +1,433 @@
+/* Copyright © 2023 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/bindings"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/data/serializers/cleanjson"
+	nsx_policy "github.com/vmware/vsphere-automation-sdk-go/services/nsxt"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+// Policy object type supported by export
+type policyExportType struct {
+	resourceName string
+	// Resource for objects nested under gateway, if different
+	gatewayResourceName string
+	// Converts policy path to ID expected by resource importer
+	getImportID func(path string) string
+	// Parent object types required to retrieve the object from hierarchy
+	parentTypes []string
+}
+
+// Supported types by NSX resource type
+var policyExportTypes = map[string]policyExportType{
+	"Group":                     {resourceName: "nsxt_policy_group", getImportID: getPolicyNestedImportID, parentTypes: []string{"Domain"}},
+	"SecurityPolicy":            {resourceName: "nsxt_policy_security_policy", getImportID: getPolicyNestedImportID, parentTypes: []string{"Domain"}},
+	"GatewayPolicy":             {resourceName: "nsxt_policy_gateway_policy", getImportID: getPolicyNestedImportID, parentTypes: []string{"Domain"}},
+	"Service":                   {resourceName: "nsxt_policy_service", getImportID: getPolicyIDFromPath},
+	"PolicyContextProfile":      {resourceName: "nsxt_policy_context_profile", getImportID: getPolicyIDFromPath},
+	"IpAddressPool":             {resourceName: "nsxt_policy_ip_pool", getImportID: getPolicyIDFromPath},
+	"Tier0":                     {resourceName: "nsxt_policy_tier0_gateway", getImportID: getPolicyIDFromPath},
+	"Tier1":                     {resourceName: "nsxt_policy_tier1_gateway", getImportID: getPolicyIDFromPath},
+	"Segment":                   {resourceName: "nsxt_policy_segment", gatewayResourceName: "nsxt_policy_fixed_segment", getImportID: getPolicyNestedImportID, parentTypes: []string{"Tier1"}},
+	"DhcpV4StaticBindingConfig": {resourceName: "nsxt_policy_dhcp_v4_static_binding", getImportID: getPolicyNestedImportID, parentTypes: []string{"Tier1", "Segment"}},
+	"DhcpV6StaticBindingConfig": {resourceName: "nsxt_policy_dhcp_v6_static_binding", getImportID: getPolicyNestedImportID, parentTypes: []string{"Tier1", "Segment"}},
+}
+
+// Object discovered on NSX, along with its terraform address
+type policyExportObject struct {
+	path         string
+	resourceName string
+	name         string
+	importID     string
+	resource     *schema.Resource
+	data         *schema.ResourceData
+}
+
+func (o *policyExportObject) address() string {
+	return fmt.Sprintf("%s.%s", o.resourceName, o.name)
+}
+
+// Returns IDs of objects in policy path, skipping collection names
+func getPolicyPathIDs(path string) []string {
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	var ids []string
+	for i := 2; i < len(segments); i += 2 {
+		ids = append(ids, segments[i])
+	}
+	return ids
+}
+
+// Import ID for objects nested under domain, gateway or segment, in format
+// parentID/ID as expected by nsxtDomainResourceImporter, nsxtGatewayResourceImporter
+// and nsxtSegmentResourceImporter
+func getPolicyNestedImportID(path string) string {
+	return strings.Join(getPolicyPathIDs(path), "/")
+}
+
+func isPolicyGatewayNestedPath(path string) bool {
+	return strings.HasPrefix(path, "/infra/tier-1s/")
+}
+
+func getPolicyExportResourceNames() []string {
+	var names []string
+	for _, exportType := range policyExportTypes {
+		names = append(names, exportType.resourceName)
+		if exportType.gatewayResourceName != "" {
+			names = append(names, exportType.gatewayResourceName)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Returns NSX type filter for infra hierarchy based on requested resources
+func getPolicyExportTypeFilter(resourceNames []string) (string, error) {
+	for _, name := range resourceNames {
+		if !stringInList(name, getPolicyExportResourceNames()) {
+			return "", fmt.Errorf("Export of %s is not supported, supported resources are: %s", name, strings.Join(getPolicyExportResourceNames(), ", "))
+		}
+	}
+
+	var types []string
+	for resourceType, exportType := range policyExportTypes {
+		if len(resourceNames) > 0 && !stringInList(exportType.resourceName, resourceNames) && !stringInList(exportType.gatewayResourceName, resourceNames) {
+			continue
+		}
+		for _, parentType := range append(exportType.parentTypes, resourceType) {
+			if !stringInList(parentType, types) {
+				types = append(types, parentType)
+			}
+		}
+	}
+	sort.Strings(types)
+	return strings.Join(types, ","), nil
+}
+
+// Collects objects of supported types from infra hierarchy, decoded as JSON
+func collectPolicyExportObjects(node interface{}, resourceNames []string, objects map[string]string) {
+	switch value := node.(type) {
+	case map[string]interface{}:
+		resourceType, _ := value["resource_type"].(string)
+		path, _ := value["path"].(string)
+		systemOwned, _ := value["_system_owned"].(bool)
+		if exportType, ok := policyExportTypes[resourceType]; ok && path != "" && !systemOwned {
+			resourceName := exportType.resourceName
+			if exportType.gatewayResourceName != "" && isPolicyGatewayNestedPath(path) {
+				resourceName = exportType.gatewayResourceName
+			}
+			if len(resourceNames) == 0 || stringInList(resourceName, resourceNames) {
+				objects[path] = resourceName
+			}
+		}
+		for _, child := range value {
+			collectPolicyExportObjects(child, resourceNames, objects)
+		}
+	case []interface{}:
+		for _, child := range value {
+			collectPolicyExportObjects(child, resourceNames, objects)
+		}
+	}
+}
+
+func getPolicyExportInfra(m interface{}, filter string, typeFilter string) (interface{}, error) {
+	client := nsx_policy.NewInfraClient(getPolicyConnector(m))
+	var filterParam *string
+	if filter != "" {
+		filterParam = &filter
+	}
+	infra, err := client.Get(nil, filterParam, &typeFilter)
+	if err != nil {
+		return nil, err
+	}
+
+	dataValue, errs := bindings.NewTypeConverter().ConvertToVapi(infra, model.InfraBindingType())
+	if errs != nil {
+		return nil, errs[0]
+	}
+	encoded, err := cleanjson.NewDataValueToJsonEncoder().Encode(dataValue)
+	if err != nil {
+		return nil, err
+	}
+
+	var hierarchy interface{}
+	err = json.Unmarshal([]byte(encoded), &hierarchy)
+	return hierarchy, err
+}
+
+var policyExportNameRegex = regexp.MustCompile("[^a-z0-9_]+")
+
+// Terraform resource name based on display name of the object
+func getPolicyExportName(displayName string, id string, usedNames map[string]bool) string {
+	name := strings.Trim(policyExportNameRegex.ReplaceAllString(strings.ToLower(displayName), "_"), "_")
+	if name == "" {
+		name = strings.Trim(policyExportNameRegex.ReplaceAllString(strings.ToLower(id), "_"), "_")
+	}
+	if name == "" || (name[0] >= '0' && name[0] <= '9') {
+		name = "nsx_" + name
+	}
+
+	uniqueName := name
+	for i := 2; usedNames[uniqueName]; i++ {
+		uniqueName = fmt.Sprintf("%s_%d", name, i)
+	}
+	usedNames[uniqueName] = true
+	return uniqueName
+}
+
+// Reads object state via resource importer and read, as terraform import would
+func readPolicyExportObject(ctx context.Context, provider *schema.Provider, obj *policyExportObject) error {
+	r := obj.resource
+	d := r.Data(nil)
+	d.SetId(obj.importID)
+
+	imported := []*schema.ResourceData{d}
+	if r.Importer != nil && r.Importer.StateContext != nil {
+		var err error
+		imported, err = r.Importer.StateContext(ctx, d, provider.Meta())
+		if err != nil {
+			return err
+		}
+	}
+
+	d = imported[0]
+	diags := r.ReadContext(ctx, d, provider.Meta())
+	if diags.HasError() {
+		return fmt.Errorf("Failed to read %s: %s", obj.path, diags[0].Summary)
+	}
+	if d.Id() == "" {
+		return fmt.Errorf("Object %s not found", obj.path)
+	}
+
+	obj.data = d
+	return nil
+}
+
+// ExportPolicyConfig discovers policy objects on NSX and writes terraform
+// configuration for them, along with import blocks. Provider must be configured.
+func ExportPolicyConfig(ctx context.Context, provider *schema.Provider, resourceNames []string, filter string, w io.Writer) error {
+	m := provider.Meta()
+	if m == nil {
+		return fmt.Errorf("Provider is not configured")
+	}
+	if isPolicyGlobalManager(m) {
+		return fmt.Errorf("Export is not supported on global manager")
+	}
+
+	typeFilter, err := getPolicyExportTypeFilter(resourceNames)
+	if err != nil {
+		return err
+	}
+
+	hierarchy, err := getPolicyExportInfra(m, filter, typeFilter)
+	if err != nil {
+		return logAPIError("Failed to retrieve policy configuration", err)
+	}
+
+	discovered := make(map[string]string)
+	collectPolicyExportObjects(hierarchy, resourceNames, discovered)
+
+	var paths []string
+	for path := range discovered {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+
+	var objects []*policyExportObject
+	usedNames := make(map[string]map[string]bool)
+	references := make(map[string]string)
+	for _, path := range paths {
+		resourceName := discovered[path]
+		obj := &policyExportObject{
+			path:         path,
+			resourceName: resourceName,
+			resource:     provider.ResourcesMap[resourceName],
+		}
+		for _, exportType := range policyExportTypes {
+			if exportType.resourceName == resourceName || exportType.gatewayResourceName == resourceName {
+				obj.importID = exportType.getImportID(path)
+			}
+		}
+
+		err = readPolicyExportObject(ctx, provider, obj)
+		if err != nil {
+			log.Printf("[WARNING]: Skipping export of %s: %v", path, err)
+			continue
+		}
+
+		if usedNames[resourceName] == nil {
+			usedNames[resourceName] = make(map[string]bool)
+		}
+		displayName, _ := obj.data.Get("display_name").(string)
+		obj.name = getPolicyExportName(displayName, obj.data.Id(), usedNames[resourceName])
+		references[path] = obj.address() + ".path"
+		objects = append(objects, obj)
+	}
+
+	for i, obj := range objects {
+		writer := &policyExportWriter{references: references, self: obj.path}
+		if i > 0 {
+			writer.WriteString("\n")
+		}
+		writer.writeResource(obj)
+		if _, err := io.WriteString(w, writer.String()); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+type policyExportWriter struct {
+	strings.Builder
+	// Terraform expressions for policy paths of exported objects
+	references map[string]string
+	self       string
+}
+
+func (e *policyExportWriter) writeResource(obj *policyExportObject) {
+	fmt.Fprintf(e, "resource %q %q {\n", obj.resourceName, obj.name)
+	e.writeBody(1, obj.resource.Schema, func(key string) interface{} {
+		return obj.data.Get(key)
+	})
+	fmt.Fprintf(e, "}\n\nimport {\n  to = %s\n  id = %s\n}\n", obj.address(), quotePolicyExportString(obj.importID))
+}
+
+// Returns whether attribute should be written to configuration
+func isPolicyExportAttribute(s *schema.Schema, value interface{}) bool {
+	if !s.Optional && !s.Required {
+		return false
+	}
+	if s.Deprecated != "" || value == nil {
+		return false
+	}
+	if s.Default != nil {
+		return fmt.Sprint(s.Default) != fmt.Sprint(value)
+	}
+
+	switch v := value.(type) {
+	case string:
+		return v != ""
+	case int:
+		return v != 0
+	case float64:
+		return v != 0
+	case bool:
+		return v
+	case []interface{}:
+		return len(v) > 0
+	case *schema.Set:
+		return v.Len() > 0
+	case map[string]interface{}:
+		return len(v) > 0
+	}
+	return true
+}
+
+func (e *policyExportWriter) writeBody(level int, s map[string]*schema.Schema, get func(string) interface{}) {
+	indent := strings.Repeat("  ", level)
+	var attributes []string
+	var blocks []string
+	for key, attrSchema := range s {
+		if !isPolicyExportAttribute(attrSchema, get(key)) {
+			continue
+		}
+		if _, ok := attrSchema.Elem.(*schema.Resource); ok {
+			blocks = append(blocks, key)
+		} else {
+			attributes = append(attributes, key)
+		}
+	}
+	sort.Strings(attributes)
+	sort.Strings(blocks)
+
+	width := 0
+	for _, key := range attributes {
+		if len(key) > width {
+			width = len(key)
+		}
+	}
+	for _, key := range attributes {
+		fmt.Fprintf(e, "%s%-*s = %s\n", indent, width, key, e.formatValue(get(key)))
+	}
+
+	written := len(attributes) > 0
+	for _, key := range blocks {
+		elem := s[key].Elem.(*schema.Resource)
+		value := get(key)
+		if set, ok := value.(*schema.Set); ok {
+			value = set.List()
+		}
+		for _, item := range value.([]interface{}) {
+			itemMap, ok := item.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			if written {
+				e.WriteString("\n")
+			}
+			written = true
+			fmt.Fprintf(e, "%s%s {\n", indent, key)
+			e.writeBody(level+1, elem.Schema, func(key string) interface{} {
+				return itemMap[key]
+			})
+			fmt.Fprintf(e, "%s}\n", indent)
+		}
+	}
+}
+
+func (e *policyExportWriter) formatValue(value interface{}) string {
+	switch v := value.(type) {
+	case string:
+		if reference, ok := e.references[v]; ok && v != e.self {
+			return reference
+		}
+		return quotePolicyExportString(v)
+	case int:
+		return strconv.Itoa(v)
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case bool:
+		return strconv.FormatBool(v)
+	case *schema.Set:
+		return e.formatValue(v.List())
+	case []interface{}:
+		var items []string
+		for _, item := range v {
+			items = append(items, e.formatValue(item))
+		}
+		return "[" + strings.Join(items, ", ") + "]"
+	case map[string]interface{}:
+		var keys []string
+		for key := range v {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		var items []string
+		for _, key := range keys {
+			items = append(items, fmt.Sprintf("%s = %s", quotePolicyExportString(key), e.formatValue(v[key])))
+		}
+		return "{ " + strings.Join(items, ", ") + " }"
+	}
+	return quotePolicyExportString(fmt.Sprint(value))
+}
+
+// HCL string literal, with template sequences escaped
+func quotePolicyExportString(value string) string {
+	quoted := strconv.Quote(value)
+	quoted = strings.ReplaceAll(quoted, "${", "$${")
+	return strings.ReplaceAll(quoted, "%{", "%%{")
+}
@@ -0,0 +1,110 @@
+/* Copyright © 2023 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/data"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+func TestGetPolicyNestedImportID(t *testing.T) {
+	tests := map[string]string{
+		"/infra/domains/default/groups/web":                              "default/web",
+		"/infra/segments/seg1":                                           "seg1",
+		"/infra/tier-1s/t1/segments/seg1":                                "t1/seg1",
+		"/infra/segments/seg1/dhcp-static-binding-configs/b1":            "seg1/b1",
+		"/infra/tier-1s/t1/segments/seg1/dhcp-static-binding-configs/b1": "t1/seg1/b1",
+	}
+	for path, expected := range tests {
+		if importID := getPolicyNestedImportID(path); importID != expected {
+			t.Errorf("Expected import ID %s for %s, got %s", expected, path, importID)
+		}
+	}
+}
+
+func TestGetPolicyExportName(t *testing.T) {
+	usedNames := make(map[string]bool)
+	names := []string{
+		getPolicyExportName("Web Servers", "id1", usedNames),
+		getPolicyExportName("web-servers", "id2", usedNames),
+		getPolicyExportName("", "1-app", usedNames),
+	}
+	expected := []string{"web_servers", "web_servers_2", "nsx_1_app"}
+	for i := range names {
+		if names[i] != expected[i] {
+			t.Errorf("Expected name %s, got %s", expected[i], names[i])
+		}
+	}
+}
+
+func TestQuotePolicyExportString(t *testing.T) {
+	if quoted := quotePolicyExportString("a\"${b}%{c}"); quoted != `"a\"$${b}%%{c}"` {
+		t.Errorf("Unexpected quoted string %s", quoted)
+	}
+}
+
+func TestUnitExportPolicyConfig(t *testing.T) {
+	fake := newFakeNsxServer(t)
+	provider := Provider()
+	diags := provider.Configure(context.Background(), terraform.NewResourceConfigRaw(map[string]interface{}{
+		"host":                 fake.host(),
+		"username":             "admin",
+		"password":             "fake",
+		"allow_unverified_ssl": true,
+		"max_retries":          0,
+	}))
+	if diags.HasError() {
+		t.Fatalf("Failed to configure provider: %v", diags)
+	}
+
+	webName := "Web Servers"
+	appName := "app"
+	expression, err := buildGroupMemberPathData(map[string]interface{}{
+		"member_paths": schema.NewSet(schema.HashString, []interface{}{"/infra/domains/default/groups/web"}),
+	})
+	if err != nil {
+		t.Fatalf("Failed to build group expression: %v", err)
+	}
+	fake.putPolicyObject("/infra/domains/default/groups/web", model.Group{DisplayName: &webName})
+	fake.putPolicyObject("/infra/domains/default/groups/app", model.Group{
+		DisplayName: &appName,
+		Expression:  []*data.StructValue{expression},
+	})
+	fake.putPolicyObject("/infra/ip-pools/pool1", model.IpAddressPool{})
+
+	var output bytes.Buffer
+	err = ExportPolicyConfig(context.Background(), provider, []string{"nsxt_policy_group"}, "", &output)
+	if err != nil {
+		t.Fatalf("Export failed: %v", err)
+	}
+
+	hcl := output.String()
+	expected := []string{
+		`resource "nsxt_policy_group" "web_servers" {`,
+		`display_name = "Web Servers"`,
+		`nsx_id       = "web"`,
+		`member_paths = [nsxt_policy_group.web_servers.path]`,
+		"import {\n  to = nsxt_policy_group.app\n  id = \"default/app\"\n}",
+	}
+	for _, snippet := range expected {
+		if !strings.Contains(hcl, snippet) {
+			t.Errorf("Expected export to contain %s, got:\n%s", snippet, hcl)
+		}
+	}
+	if strings.Contains(hcl, "nsxt_policy_ip_pool") {
+		t.Errorf("Expected export to contain groups only, got:\n%s", hcl)
+	}
+
+	err = ExportPolicyConfig(context.Background(), provider, []string{"nsxt_policy_foo"}, "", &output)
+	if err == nil {
+		t.Errorf("Expected export of unsupported resource to fail")
+	}
+}
@@ -0,0 +1,131 @@
+/* Copyright © 2023 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strings"
+
+	vapiErrors "github.com/vmware/vsphere-automation-sdk-go/lib/vapi/std/errors"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/bindings"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/core"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/data"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/data/serializers/cleanjson"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+)
+
+// Generic policy API calls for object types that are not modeled by the provider.
+// Calls are invoked via policy connector with REST metadata built for given path,
+// and hence share authentication, retries and other connector settings with SDK
+// clients.
+
+const policyGenericServiceID = "com.vmware.nsx_policy.generic"
+
+func getPolicyGenericAPIPrefix(isGlobalManager bool) string {
+	if isGlobalManager {
+		return "/global-manager/api/v1"
+	}
+	return "/policy/api/v1"
+}
+
+func getPolicyGenericURLPath(path string, isGlobalManager bool) string {
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	for i, segment := range segments {
+		segments[i] = url.PathEscape(segment)
+	}
+	return getPolicyGenericAPIPrefix(isGlobalManager) + "/" + strings.Join(segments, "/")
+}
+
+func getPolicyGenericRestMetadata(method string, urlPath string, withBody bool, successCode int) protocol.OperationRestMetadata {
+	fields := map[string]bindings.BindingType{}
+	fieldNameMap := map[string]string{}
+	bodyParam := ""
+	if withBody {
+		bodyParam = "body"
+		fields[bodyParam] = bindings.NewDynamicStructType(nil)
+		fieldNameMap[bodyParam] = "Body"
+	}
+	return protocol.NewOperationRestMetadata(
+		fields,
+		fieldNameMap,
+		fields,
+		map[string]string{},
+		map[string]string{},
+		map[string]string{},
+		map[string]string{},
+		map[string]string{},
+		"",
+		bodyParam,
+		method,
+		urlPath,
+		"",
+		map[string]string{},
+		successCode,
+		"",
+		map[string]map[string]string{},
+		map[string]int{"com.vmware.vapi.std.errors.invalid_request": 400, "com.vmware.vapi.std.errors.unauthorized": 403, "com.vmware.vapi.std.errors.service_unavailable": 503, "com.vmware.vapi.std.errors.internal_server_error": 500, "com.vmware.vapi.std.errors.not_found": 404})
+}
+
+// Invokes policy API for given policy path and returns response body decoded as JSON
+func policyGenericInvoke(connector client.Connector, isGlobalManager bool, method string, path string, body map[string]interface{}) (map[string]interface{}, error) {
+	successCode := http.StatusOK
+	if method == http.MethodPatch {
+		successCode = http.StatusNoContent
+	}
+	withBody := body != nil
+	inputValue := data.NewStructValue("operation-input", nil)
+	if withBody {
+		bodyValue, err := cleanjson.NewJsonToDataValueDecoder().Decode(body)
+		if err != nil {
+			return nil, err
+		}
+		inputValue.SetField("body", bodyValue)
+	}
+
+	executionContext := connector.NewExecutionContext()
+	executionContext.SetConnectionMetadata(core.RESTMetadataKey, getPolicyGenericRestMetadata(method, getPolicyGenericURLPath(path, isGlobalManager), withBody, successCode))
+	executionContext.SetConnectionMetadata(core.ResponseTypeKey, core.NewResponseType(true, false))
+
+	operationID := strings.ToLower(method)
+	methodResult := connector.GetApiProvider().Invoke(policyGenericServiceID, operationID, inputValue, executionContext)
+	if !methodResult.IsSuccess() {
+		errorValue := methodResult.Error()
+		methodError, errs := connector.TypeConverter().ConvertToGolang(errorValue, vapiErrors.ERROR_BINDINGS_MAP[errorValue.Name()])
+		if errs != nil {
+			return nil, bindings.VAPIerrorsToError(errs)
+		}
+		return nil, methodError.(error)
+	}
+
+	result := make(map[string]interface{})
+	if methodResult.Output() == nil {
+		return result, nil
+	}
+	encoded, err := cleanjson.NewDataValueToJsonEncoder().Encode(methodResult.Output())
+	if err != nil {
+		return nil, err
+	}
+	if encoded == "" || encoded == "null" {
+		return result, nil
+	}
+	err = json.Unmarshal([]byte(encoded), &result)
+	return result, err
+}
+
+func policyGenericGet(connector client.Connector, isGlobalManager bool, path string) (map[string]interface{}, error) {
+	return policyGenericInvoke(connector, isGlobalManager, http.MethodGet, path, nil)
+}
+
+func policyGenericPatch(connector client.Connector, isGlobalManager bool, path string, body map[string]interface{}) error {
+	_, err := policyGenericInvoke(connector, isGlobalManager, http.MethodPatch, path, body)
+	return err
+}
+
+func policyGenericDelete(connector client.Connector, isGlobalManager bool, path string) error {
+	_, err := policyGenericInvoke(connector, isGlobalManager, http.MethodDelete, path, nil)
+	return err
+}
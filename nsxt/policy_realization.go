@@ -0,0 +1,593 @@
+/* Copyright © 2023 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	gm_infra "github.com/vmware/vsphere-automation-sdk-go/services/nsxt-gm/global_infra"
+	gm_realized_state "github.com/vmware/vsphere-automation-sdk-go/services/nsxt-gm/global_infra/realized_state"
+	gm_model "github.com/vmware/vsphere-automation-sdk-go/services/nsxt-gm/model"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/realized_state"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+// Some policy objects are never realized. If no realization info appears
+// within this number of checks, realization is assumed to be not applicable
+const policyRealizationMaxEmptyChecks = 10
+
+// Collect error messages reported for realized entity, if any
+func getPolicyRealizedEntityErrors(entity model.GenericPolicyRealizedResource) []string {
+	var messages []string
+	for _, alarm := range entity.Alarms {
+		if alarm.Message != nil {
+			messages = append(messages, *alarm.Message)
+		}
+	}
+	if entity.RuntimeError != nil && *entity.RuntimeError != "" {
+		messages = append(messages, *entity.RuntimeError)
+	}
+	if entity.PublishStatusError != nil && *entity.PublishStatusError != "" {
+		messages = append(messages, *entity.PublishStatusError)
+	}
+	for _, detail := range entity.PublishStatusErrorDetails {
+		if detail.FailureMessage != nil {
+			messages = append(messages, *detail.FailureMessage)
+		}
+	}
+
+	return messages
+}
+
+// Retrieve policy paths of sites the global object spans
+func getPolicyGlobalObjectSpan(connector client.Connector, path string) ([]string, error) {
+	client := gm_infra.NewSpanClient(connector)
+	span, err := client.Get(path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var sitePaths []string
+	for _, site := range span.Sites {
+		if site.SitePath != nil {
+			sitePaths = append(sitePaths, *site.SitePath)
+		}
+	}
+
+	return sitePaths, nil
+}
+
+// Get consolidated realization state of realized entities, along with errors if any
+func getPolicyRealizationState(realizationResult model.GenericPolicyRealizedResourceListResult) (string, []string) {
+	if len(realizationResult.Results) == 0 {
+		// Realization info not found yet
+		return "UNKNOWN", nil
+	}
+
+	state := "REALIZED"
+	var errorMessages []string
+	for _, objInList := range realizationResult.Results {
+		if objInList.State == nil {
+			state = "UNKNOWN"
+			continue
+		}
+		if *objInList.State == "ERROR" {
+			state = "ERROR"
+			errorMessages = append(errorMessages, getPolicyRealizedEntityErrors(objInList)...)
+		} else if *objInList.State != "REALIZED" && state != "ERROR" {
+			state = *objInList.State
+		}
+	}
+
+	return state, errorMessages
+}
+
+// Get realization state of a global object on single site, along with errors if any
+func getPolicyGlobalRealizationStateOnSite(connector client.Connector, path string, sitePath string) (string, []string, error) {
+	client := gm_realized_state.NewRealizedEntitiesClient(connector)
+	gmResults, err := client.List(path, &sitePath)
+	if err != nil {
+		return "", nil, err
+	}
+
+	lmResults, err := convertModelBindingType(gmResults, gm_model.GenericPolicyRealizedResourceListResultBindingType(), model.GenericPolicyRealizedResourceListResultBindingType())
+	if err != nil {
+		return "", nil, err
+	}
+
+	state, errorMessages := getPolicyRealizationState(lmResults.(model.GenericPolicyRealizedResourceListResult))
+	return state, errorMessages, nil
+}
+
+func nsxtPolicyWaitForLocalRealizationStateConf(connector client.Connector, path string, timeout time.Duration) *resource.StateChangeConf {
+	client := realized_state.NewRealizedEntitiesClient(connector)
+	pendingStates := []string{"UNKNOWN", "UNREALIZED"}
+	targetStates := []string{"REALIZED", "ERROR", "NONE"}
+	emptyChecks := 0
+	stateConf := &resource.StateChangeConf{
+		Pending: pendingStates,
+		Target:  targetStates,
+		Refresh: func() (interface{}, string, error) {
+			realizationResult, err := client.List(path, nil)
+			if err != nil {
+				return nil, "", err
+			}
+
+			state, errorMessages := getPolicyRealizationState(realizationResult)
+			log.Printf("[DEBUG] Realization state of %s is %s", path, state)
+			if state == "UNKNOWN" {
+				emptyChecks++
+				if emptyChecks >= policyRealizationMaxEmptyChecks {
+					log.Printf("[INFO] No realization info found for %s, assuming realization is not applicable", path)
+					return errorMessages, "NONE", nil
+				}
+			}
+			if state == "ERROR" && len(errorMessages) == 0 {
+				errorMessages = []string{"realization failed"}
+			}
+			return errorMessages, state, nil
+		},
+		Timeout:    timeout,
+		MinTimeout: 1 * time.Second,
+		Delay:      1 * time.Second,
+	}
+
+	return stateConf
+}
+
+// Wait until local object is realized
+func nsxtPolicyWaitForLocalRealization(ctx context.Context, connector client.Connector, path string, timeout time.Duration) error {
+	stateConf := nsxtPolicyWaitForLocalRealizationStateConf(connector, path, timeout)
+	result, err := stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		return fmt.Errorf("Failed to wait for realization of %s: %v", path, err)
+	}
+
+	errorMessages := result.([]string)
+	if len(errorMessages) > 0 {
+		return fmt.Errorf("Realization of %s failed: %s", path, strings.Join(errorMessages, "; "))
+	}
+
+	return nil
+}
+
+func nsxtPolicyWaitForGlobalRealizationStateConf(connector client.Connector, path string, sitePaths []string, timeout time.Duration) *resource.StateChangeConf {
+	pendingStates := []string{"UNKNOWN", "UNREALIZED"}
+	targetStates := []string{"REALIZED", "ERROR", "NONE"}
+	emptyChecks := 0
+	stateConf := &resource.StateChangeConf{
+		Pending: pendingStates,
+		Target:  targetStates,
+		Refresh: func() (interface{}, string, error) {
+			siteErrors := make(map[string][]string)
+			if len(sitePaths) == 0 {
+				// Span of newly created object may not be computed yet
+				var err error
+				sitePaths, err = getPolicyGlobalObjectSpan(connector, path)
+				if err != nil {
+					return nil, "", logAPIError("Failed to retrieve span", err)
+				}
+			}
+			if len(sitePaths) == 0 {
+				emptyChecks++
+				if emptyChecks >= policyRealizationMaxEmptyChecks {
+					log.Printf("[INFO] Span of %s contains no sites, assuming realization is not applicable", path)
+					return siteErrors, "NONE", nil
+				}
+				return siteErrors, "UNKNOWN", nil
+			}
+
+			// Object is considered realized when realized on all sites in its span,
+			// and errored when errored on at least one site, once pending sites are done
+			pending := false
+			empty := true
+			for _, sitePath := range sitePaths {
+				state, errorMessages, err := getPolicyGlobalRealizationStateOnSite(connector, path, sitePath)
+				if err != nil {
+					return nil, "", err
+				}
+				log.Printf("[DEBUG] Realization state of %s on site %s is %s", path, sitePath, state)
+				if state != "UNKNOWN" {
+					empty = false
+				}
+				if state == "ERROR" {
+					siteErrors[sitePath] = errorMessages
+				} else if state != "REALIZED" {
+					pending = true
+				}
+			}
+
+			if empty && pending {
+				emptyChecks++
+				if emptyChecks >= policyRealizationMaxEmptyChecks {
+					log.Printf("[INFO] No realization info found for %s, assuming realization is not applicable", path)
+					return siteErrors, "NONE", nil
+				}
+			}
+			if pending {
+				return siteErrors, "UNREALIZED", nil
+			}
+			if len(siteErrors) > 0 {
+				return siteErrors, "ERROR", nil
+			}
+			return siteErrors, "REALIZED", nil
+		},
+		Timeout:    timeout,
+		MinTimeout: 1 * time.Second,
+		Delay:      1 * time.Second,
+	}
+
+	return stateConf
+}
+
+func formatPolicyGlobalRealizationErrors(path string, siteErrors map[string][]string) error {
+	var sitePaths []string
+	for sitePath := range siteErrors {
+		sitePaths = append(sitePaths, sitePath)
+	}
+	sort.Strings(sitePaths)
+
+	var details []string
+	for _, sitePath := range sitePaths {
+		messages := siteErrors[sitePath]
+		if len(messages) == 0 {
+			messages = []string{"realization failed"}
+		}
+		details = append(details, fmt.Sprintf("%s: %s", sitePath, strings.Join(messages, "; ")))
+	}
+
+	return fmt.Errorf("Realization of %s failed on %d site(s):\n%s", path, len(sitePaths), strings.Join(details, "\n"))
+}
+
+// Wait until global object is realized on every site in its span
+func nsxtPolicyWaitForGlobalRealization(ctx context.Context, connector client.Connector, path string, timeout time.Duration) error {
+	sitePaths, err := getPolicyGlobalObjectSpan(connector, path)
+	if err != nil {
+		if isNotFoundError(err) {
+			// Not every global object has span, such objects are not realized on sites
+			log.Printf("[INFO] Skipping realization check for %s, since it has no span", path)
+			return nil
+		}
+		return fmt.Errorf("Failed to wait for realization of %s on all sites: %v", path, logAPIError("Failed to retrieve span", err))
+	}
+
+	stateConf := nsxtPolicyWaitForGlobalRealizationStateConf(connector, path, sitePaths, timeout)
+	result, err := stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		return fmt.Errorf("Failed to wait for realization of %s on all sites: %v", path, err)
+	}
+
+	siteErrors := result.(map[string][]string)
+	if len(siteErrors) > 0 {
+		return formatPolicyGlobalRealizationErrors(path, siteErrors)
+	}
+
+	return nil
+}
+
+func getPolicyRealizationWaitSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeBool,
+		Description: "Wait for realization of this resource on create and update, overriding provider setting",
+		Optional:    true,
+	}
+}
+
+// Realization wait can be enabled in provider and overridden per resource
+func isPolicyRealizationWaitNeeded(d *schema.ResourceData, m interface{}) bool {
+	// GetOkExists is needed in order to distinguish override to false from unset value
+	wait, isSet := d.GetOkExists("wait_for_realization")
+	if isSet {
+		return wait.(bool)
+	}
+
+	if isPolicyGlobalManager(m) && getPolicyGlobalRealizationWait(m) {
+		return true
+	}
+	return getPolicyRealizationWait(m)
+}
+
+func nsxtPolicyWaitForRealizationAfterApply(d *schema.ResourceData, m interface{}, timeout time.Duration) error {
+	if !isPolicyRealizationWaitNeeded(d, m) {
+		return nil
+	}
+
+	path, ok := d.Get("path").(string)
+	if !ok || path == "" {
+		return nil
+	}
+
+	connector := getPolicyConnector(m)
+	if isPolicyGlobalManager(m) {
+		// Global objects are realized on sites, realization state is available per site
+		return nsxtPolicyWaitForGlobalRealization(getProviderContext(m), connector, path, timeout)
+	}
+	return nsxtPolicyWaitForLocalRealization(getProviderContext(m), connector, path, timeout)
+}
+
+func listPolicyRealizationAlarms(connector client.Connector, isGlobalManager bool) ([]model.PolicyAlarmResource, error) {
+	var results []model.PolicyAlarmResource
+	var cursor *string
+
+	for {
+		var listResponse model.PolicyAlarmResourceListResult
+		if isGlobalManager {
+			client := gm_realized_state.NewAlarmsClient(connector)
+			gmListResponse, err := client.List(cursor, nil, nil, nil, nil)
+			if err != nil {
+				return results, err
+			}
+			lmListResponse, err := convertModelBindingType(gmListResponse, gm_model.PolicyAlarmResourceListResultBindingType(), model.PolicyAlarmResourceListResultBindingType())
+			if err != nil {
+				return results, err
+			}
+			listResponse = lmListResponse.(model.PolicyAlarmResourceListResult)
+		} else {
+			client := realized_state.NewAlarmsClient(connector)
+			var err error
+			listResponse, err = client.List(cursor, nil, nil, nil, nil)
+			if err != nil {
+				return results, err
+			}
+		}
+
+		if results == nil && listResponse.ResultCount != nil {
+			// Result count is only a hint, since alarms may change while paging
+			results = make([]model.PolicyAlarmResource, 0, int(*listResponse.ResultCount))
+		}
+		results = append(results, listResponse.Results...)
+		cursor = listResponse.Cursor
+		if cursor == nil || len(listResponse.Results) == 0 {
+			return results, nil
+		}
+	}
+}
+
+// Type of policy object, as it appears in its path, e.g. tier-1s for /infra/tier-1s/gw1
+func getPolicyObjectTypeFromPath(path string) string {
+	segments := strings.Split(strings.TrimSuffix(path, "/"), "/")
+	if len(segments) < 2 {
+		return ""
+	}
+	return segments[len(segments)-2]
+}
+
+func isPolicyPathWithinPrefix(path string, prefix string) bool {
+	if prefix == "" || path == prefix {
+		return true
+	}
+	return strings.HasPrefix(path, strings.TrimSuffix(prefix, "/")+"/")
+}
+
+// Filter alarms by path prefix of the source object and by its type
+func filterPolicyRealizationAlarms(alarms []model.PolicyAlarmResource, pathPrefix string, sourceType string) []model.PolicyAlarmResource {
+	var filtered []model.PolicyAlarmResource
+	for _, alarm := range alarms {
+		sourcePath := ""
+		if alarm.SourceReference != nil {
+			sourcePath = *alarm.SourceReference
+		}
+		if !isPolicyPathWithinPrefix(sourcePath, pathPrefix) {
+			continue
+		}
+		if sourceType != "" && getPolicyObjectTypeFromPath(sourcePath) != sourceType {
+			continue
+		}
+		filtered = append(filtered, alarm)
+	}
+
+	return filtered
+}
+
+// Retrieve open alarms on realized entities of policy object. Alarms are
+// filtered by intent path on NSX, which avoids paging through all alarms
+// on every read.
+func listPolicyRealizedEntityAlarms(connector client.Connector, isGlobalManager bool, path string) ([]model.PolicyAlarmResource, error) {
+	var realizationResult model.GenericPolicyRealizedResourceListResult
+	if isGlobalManager {
+		client := gm_realized_state.NewRealizedEntitiesClient(connector)
+		gmResults, err := client.List(path, nil)
+		if err != nil {
+			return nil, err
+		}
+		lmResults, err := convertModelBindingType(gmResults, gm_model.GenericPolicyRealizedResourceListResultBindingType(), model.GenericPolicyRealizedResourceListResultBindingType())
+		if err != nil {
+			return nil, err
+		}
+		realizationResult = lmResults.(model.GenericPolicyRealizedResourceListResult)
+	} else {
+		client := realized_state.NewRealizedEntitiesClient(connector)
+		var err error
+		realizationResult, err = client.List(path, nil)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var alarms []model.PolicyAlarmResource
+	for _, entity := range realizationResult.Results {
+		alarms = append(alarms, entity.Alarms...)
+	}
+	return alarms, nil
+}
+
+func getPolicyRealizationAlarmDiagnostics(d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if !getPolicyRealizationAlarmWarnings(m) {
+		return diags
+	}
+
+	path, ok := d.Get("path").(string)
+	if !ok || path == "" {
+		return diags
+	}
+
+	alarms, err := listPolicyRealizedEntityAlarms(getPolicyConnector(m), isPolicyGlobalManager(m), path)
+	if err != nil {
+		if !isNotFoundError(err) {
+			// Failure to retrieve alarms should not fail the read
+			log.Printf("[WARNING] %v", logAPIError("Failed to retrieve realization alarms", err))
+		}
+		return diags
+	}
+
+	for _, alarm := range alarms {
+		source := path
+		if alarm.SourceReference != nil {
+			source = *alarm.SourceReference
+		}
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("Realization alarm on %s", source),
+			Detail:   printPolicyAlarm(alarm),
+		})
+	}
+
+	return diags
+}
+
+// Add realization wait to Create and Update of policy resource,
+// and realization alarm warnings to its Read
+func wrapPolicyResourceRealization(name string, r *schema.Resource) {
+	if _, ok := r.Schema["path"]; !ok {
+		return
+	}
+
+	waitForRealization := func(timeoutKey string) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+		return func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+			return getOperationDiagnostics(m, nsxtPolicyWaitForRealizationAfterApply(d, m, d.Timeout(timeoutKey)))
+		}
+	}
+	waitAfterCreate := getContextOperation(name, "wait for realization", waitForRealization(schema.TimeoutCreate))
+	waitAfterUpdate := getContextOperation(name, "wait for realization", waitForRealization(schema.TimeoutUpdate))
+
+	r.Schema["wait_for_realization"] = getPolicyRealizationWaitSchema()
+
+	if r.CreateContext != nil {
+		create := r.CreateContext
+		r.CreateContext = func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+			diags := create(ctx, d, m)
+			if diags.HasError() {
+				return diags
+			}
+			return append(diags, waitAfterCreate(ctx, d, m)...)
+		}
+	}
+
+	if r.ReadContext != nil {
+		read := r.ReadContext
+		r.ReadContext = func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+			diags := read(ctx, d, m)
+			if diags.HasError() || d.Id() == "" {
+				// Read failed or object is gone
+				return diags
+			}
+			return append(diags, getPolicyRealizationAlarmDiagnostics(d, withProviderContext(m, ctx))...)
+		}
+	}
+
+	if r.UpdateContext != nil {
+		update := r.UpdateContext
+		r.UpdateContext = func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+			diags := update(ctx, d, m)
+			if diags.HasError() {
+				return diags
+			}
+			return append(diags, waitAfterUpdate(ctx, d, m)...)
+		}
+	}
+}
+
+// Resources with realization state on NSX. Other policy objects, such as drafts,
+// shares, sites or objects with dedicated state wait, are not realized as such
+// and are not wrapped with realization wait. IP address allocations and block
+// subnets are not wrapped either, since they wait for realization on create.
+var policyRealizedResources = []string{
+	"nsxt_policy_bgp_config",
+	"nsxt_policy_bgp_neighbor",
+	"nsxt_policy_bridge_profile",
+	"nsxt_policy_context_profile",
+	"nsxt_policy_dhcp_relay",
+	"nsxt_policy_dhcp_server",
+	"nsxt_policy_dhcp_v4_static_binding",
+	"nsxt_policy_dhcp_v6_static_binding",
+	"nsxt_policy_dns_forwarder_zone",
+	"nsxt_policy_evpn_config",
+	"nsxt_policy_evpn_tenant",
+	"nsxt_policy_evpn_tunnel_endpoint",
+	"nsxt_policy_firewall_scheduler",
+	"nsxt_policy_fixed_segment",
+	"nsxt_policy_gateway_community_list",
+	"nsxt_policy_gateway_dns_forwarder",
+	"nsxt_policy_gateway_policy",
+	"nsxt_policy_gateway_prefix_list",
+	"nsxt_policy_gateway_qos_profile",
+	"nsxt_policy_gateway_route_map",
+	"nsxt_policy_group",
+	"nsxt_policy_host_transport_node_profile",
+	"nsxt_policy_intrusion_service_policy",
+	"nsxt_policy_intrusion_service_profile",
+	"nsxt_policy_ip_block",
+	"nsxt_policy_ip_discovery_profile",
+	"nsxt_policy_ip_pool",
+	"nsxt_policy_ip_pool_static_subnet",
+	"nsxt_policy_ipsec_vpn_dpd_profile",
+	"nsxt_policy_ipsec_vpn_ike_profile",
+	"nsxt_policy_ipsec_vpn_local_endpoint",
+	"nsxt_policy_ipsec_vpn_service",
+	"nsxt_policy_ipsec_vpn_session",
+	"nsxt_policy_ipsec_vpn_tunnel_profile",
+	"nsxt_policy_l2_vpn_service",
+	"nsxt_policy_l2_vpn_session",
+	"nsxt_policy_l7_access_profile",
+	"nsxt_policy_lb_pool",
+	"nsxt_policy_lb_service",
+	"nsxt_policy_lb_virtual_server",
+	"nsxt_policy_lldp_host_switch_profile",
+	"nsxt_policy_mac_discovery_profile",
+	"nsxt_policy_nat_rule",
+	"nsxt_policy_nioc_host_switch_profile",
+	"nsxt_policy_ospf_area",
+	"nsxt_policy_ospf_config",
+	"nsxt_policy_predefined_gateway_policy",
+	"nsxt_policy_predefined_security_policy",
+	"nsxt_policy_qos_profile",
+	"nsxt_policy_security_policy",
+	"nsxt_policy_segment",
+	"nsxt_policy_segment_security_profile",
+	"nsxt_policy_service",
+	"nsxt_policy_spoof_guard_profile",
+	"nsxt_policy_static_route",
+	"nsxt_policy_static_route_bfd_peer",
+	"nsxt_policy_tier0_gateway",
+	"nsxt_policy_tier0_gateway_interface",
+	"nsxt_policy_tier0_inter_vrf_routing",
+	"nsxt_policy_tier1_gateway",
+	"nsxt_policy_tier1_gateway_interface",
+	"nsxt_policy_tier1_tls_inspection_binding",
+	"nsxt_policy_tls_inspection_policy",
+	"nsxt_policy_transport_zone",
+	"nsxt_policy_uplink_host_switch_profile",
+	"nsxt_policy_vlan_segment",
+}
+
+func wrapPolicyResourcesRealization(resources map[string]*schema.Resource) {
+	for _, name := range policyRealizedResources {
+		r, ok := resources[name]
+		if !ok || r.UpdateContext == nil {
+			// Realization wait setting can only be changed in place
+			continue
+		}
+		wrapPolicyResourceRealization(name, r)
+	}
+}
@@ -0,0 +1,159 @@
+/* Copyright © 2023 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+func testUnitPutRealizedEntityWithAlarm(fake *fakeNsxServer, intentPath string, message string) {
+	state := "ERROR"
+	fake.putRealizedEntity(intentPath, model.GenericPolicyRealizedResource{
+		State: &state,
+		Alarms: []model.PolicyAlarmResource{
+			{
+				Message:         &message,
+				SourceReference: &intentPath,
+			},
+		},
+	})
+}
+
+func TestUnitPolicyRealizationAlarmWarnings(t *testing.T) {
+	fake := newFakeNsxServer(t)
+	meta := testUnitConfigureProviderWithOptions(t, fake, map[string]interface{}{
+		"realization_alarm_warnings": true,
+	})
+	resourceName := "nsxt_policy_group"
+
+	state := testUnitApplyResource(t, meta, resourceName, nil, map[string]interface{}{
+		"display_name": "group1",
+	})
+	path := state.Attributes["path"]
+	testUnitPutRealizedEntityWithAlarm(fake, path, "group1 failed")
+	testUnitPutRealizedEntityWithAlarm(fake, "/infra/domains/default/groups/other", "other failed")
+
+	lookups := fake.realizedEntityLookups
+	_, diags := testUnitGetResource(t, resourceName).RefreshWithoutUpgrade(context.Background(), state, meta)
+	if diags.HasError() {
+		t.Fatalf("Failed to refresh %s: %v", resourceName, diags)
+	}
+	if len(diags) != 1 || diags[0].Detail != "group1 failed" {
+		t.Errorf("Expected single alarm warning for %s, got %v", path, diags)
+	}
+
+	// Alarms are retrieved for the object only, rather than listed for all objects
+	if fake.realizedEntityLookups != lookups+1 {
+		t.Errorf("Expected single realized entity lookup, got %d", fake.realizedEntityLookups-lookups)
+	}
+	if fake.alarmListRequests != 0 {
+		t.Errorf("Expected no alarm list requests, got %d", fake.alarmListRequests)
+	}
+}
+
+func TestUnitListPolicyRealizationAlarms(t *testing.T) {
+	fake := newFakeNsxServer(t)
+	meta := testUnitConfigureProvider(t, fake)
+	testUnitPutRealizedEntityWithAlarm(fake, "/infra/tier-1s/gw1", "gw1 failed")
+	testUnitPutRealizedEntityWithAlarm(fake, "/infra/tier-1s/gw2", "gw2 failed")
+
+	// Alarms resolved while paging leave result count above number of results,
+	// listing should stop on the last page regardless
+	fake.alarmListResultCount = 5
+	alarms, err := listPolicyRealizationAlarms(getPolicyConnector(meta), false)
+	if err != nil {
+		t.Fatalf("Failed to list alarms: %v", err)
+	}
+	if len(alarms) != 2 || fake.alarmListRequests != 1 {
+		t.Errorf("Expected 2 alarms in single request, got %d alarms in %d requests", len(alarms), fake.alarmListRequests)
+	}
+
+	// Listing follows the cursor when result count is missing or lower than
+	// number of alarms
+	testUnitPutRealizedEntityWithAlarm(fake, "/infra/tier-1s/gw3", "gw3 failed")
+	fake.alarmListPageSize = 2
+	for _, resultCount := range []int{-1, 1} {
+		fake.alarmListResultCount = resultCount
+		fake.alarmListRequests = 0
+		alarms, err = listPolicyRealizationAlarms(getPolicyConnector(meta), false)
+		if err != nil {
+			t.Fatalf("Failed to list alarms: %v", err)
+		}
+		if len(alarms) != 3 || *alarms[2].Message != "gw3 failed" || fake.alarmListRequests != 2 {
+			t.Errorf("Expected 3 alarms in 2 requests with result count %d, got %d alarms in %d requests", resultCount, len(alarms), fake.alarmListRequests)
+		}
+	}
+}
+
+func TestUnitPolicyRealizationWaitSchema(t *testing.T) {
+	provider := Provider()
+	for _, name := range policyRealizedResources {
+		r, ok := provider.ResourcesMap[name]
+		if !ok {
+			t.Errorf("Realized resource %s is not registered", name)
+			continue
+		}
+		waitSchema, ok := r.Schema["wait_for_realization"]
+		if !ok {
+			t.Errorf("Expected wait_for_realization in schema of %s", name)
+			continue
+		}
+		// Changing the setting should not recreate the object
+		if waitSchema.ForceNew {
+			t.Errorf("Expected wait_for_realization in schema of %s not to be ForceNew", name)
+		}
+	}
+
+	for _, name := range []string{"nsxt_policy_dfw_draft", "nsxt_policy_share", "nsxt_policy_shared_resource", "nsxt_policy_resource", "nsxt_policy_site"} {
+		if _, ok := provider.ResourcesMap[name].Schema["wait_for_realization"]; ok {
+			t.Errorf("Expected no wait_for_realization in schema of %s, which is not realized", name)
+		}
+	}
+
+	// Resources that wait for realization on their own
+	for _, name := range []string{"nsxt_policy_ip_address_allocation", "nsxt_policy_ip_pool_block_subnet"} {
+		if _, ok := provider.ResourcesMap[name].Schema["wait_for_realization"]; ok {
+			t.Errorf("Expected no wait_for_realization in schema of %s, which waits for realization on create", name)
+		}
+	}
+}
+
+func TestUnitPolicyWaitForGlobalRealization(t *testing.T) {
+	fake := newFakeNsxServer(t)
+	meta := testUnitConfigureProvider(t, fake)
+	connector := getPolicyConnector(meta)
+	path := "/global-infra/domains/default/groups/group1"
+
+	// Objects without span are not realized on sites
+	if err := nsxtPolicyWaitForGlobalRealization(context.Background(), connector, path, time.Minute); err != nil {
+		t.Errorf("Expected realization wait to be skipped for object without span, got %v", err)
+	}
+
+	// Other span lookup errors fail the wait
+	fake.globalSpanStatus = http.StatusForbidden
+	err := nsxtPolicyWaitForGlobalRealization(context.Background(), connector, path, time.Minute)
+	if err == nil || !strings.Contains(err.Error(), "span") {
+		t.Errorf("Expected span lookup error, got %v", err)
+	}
+	fake.globalSpanStatus = 0
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if err := nsxtPolicyWaitForGlobalRealization(ctx, getPolicyConnector(withProviderContext(meta, ctx)), path, time.Minute); err == nil {
+		t.Errorf("Expected cancelled realization wait to fail")
+	}
+
+	// Empty span is not considered realized
+	fake.globalSpans[path] = []string{}
+	err = nsxtPolicyWaitForGlobalRealization(context.Background(), connector, path, 2*time.Second)
+	if err == nil || !strings.Contains(err.Error(), "timeout") {
+		t.Errorf("Expected realization wait with empty span to time out, got %v", err)
+	}
+}
@@ -0,0 +1,65 @@
+/* Copyright © 2023 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Verifies that object on NSX still has the revision that was read into state
+// prior to the update. Objects patched via H-API additionally carry revision in
+// the request, so that NSX rejects concurrent modifications that happen between
+// the check and the update.
+func checkPolicyRevision(d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	path := d.Get("path").(string)
+	revision := d.Get("revision").(int)
+
+	obj, err := policyGenericGet(getPolicyConnector(m), isPolicyGlobalManager(m), path)
+	if err != nil {
+		if isNotFoundError(err) {
+			// Update will handle missing object
+			return nil
+		}
+		return diag.FromErr(logAPIError(fmt.Sprintf("Error retrieving revision of %s", path), err))
+	}
+
+	currentRevision, _ := obj["_revision"].(float64)
+	if int(currentRevision) == revision {
+		return nil
+	}
+
+	user, _ := obj["_last_modified_user"].(string)
+	return diag.Diagnostics{diag.Diagnostic{
+		Severity: diag.Error,
+		Summary:  fmt.Sprintf("Object %s was modified concurrently", path),
+		Detail:   fmt.Sprintf("Revision changed from %d to %d since the object was last read, last modified by %s. Refresh the state and plan again to avoid overwriting the modification.", revision, int(currentRevision), user),
+	}}
+}
+
+func wrapPolicyResourceRevision(r *schema.Resource) {
+	update := r.UpdateContext
+	r.UpdateContext = func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		if getPolicyEnforceRevision(m) {
+			diags := checkPolicyRevision(d, withProviderContext(m, ctx))
+			if diags.HasError() {
+				return diags
+			}
+		}
+
+		return update(ctx, d, m)
+	}
+}
+
+func wrapPolicyResourcesRevision(resources map[string]*schema.Resource) {
+	for name, r := range resources {
+		if strings.HasPrefix(name, "nsxt_policy_") && r.UpdateContext != nil && isPolicyRevisionTracked(r) {
+			wrapPolicyResourceRevision(r)
+		}
+	}
+}
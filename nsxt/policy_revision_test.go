@@ -0,0 +1,53 @@
+/* Copyright © 2023 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+func TestUnitPolicyResourceEnforceRevision(t *testing.T) {
+	fake := newFakeNsxServer(t)
+	clients := testUnitConfigureProvider(t, fake).(nsxtClients)
+	clients.PolicyEnforceRevision = true
+	resourceName := "nsxt_policy_group"
+
+	state := testUnitApplyResource(t, clients, resourceName, nil, map[string]interface{}{
+		"display_name": "group1",
+	})
+	state = testUnitApplyResource(t, clients, resourceName, state, map[string]interface{}{
+		"display_name": "group2",
+	})
+	testUnitCheckAttr(t, state, "display_name", "group2")
+
+	description := "changed in UI"
+	fake.putPolicyObject(state.Attributes["path"], model.Group{Description: &description})
+
+	config := map[string]interface{}{
+		"display_name": "group3",
+	}
+	r := testUnitGetResource(t, resourceName)
+	diff, err := r.Diff(context.Background(), state, terraform.NewResourceConfigRaw(config), clients)
+	if err != nil {
+		t.Fatalf("Failed to plan %s: %v", resourceName, err)
+	}
+	_, diags := r.Apply(context.Background(), state, diff, clients)
+	if !diags.HasError() || !strings.Contains(diags[0].Summary, "modified concurrently") {
+		t.Fatalf("Expected concurrent modification error, got %v", diags)
+	}
+	obj := fake.getObject(state.Attributes["path"])
+	if obj["display_name"] != "group2" {
+		t.Errorf("Expected object not to be updated, got display name %v", obj["display_name"])
+	}
+
+	// Update succeeds once modification is read into state
+	state = testUnitRefreshResource(t, clients, resourceName, state)
+	state = testUnitApplyResource(t, clients, resourceName, state, config)
+	testUnitCheckAttr(t, state, "display_name", "group3")
+}
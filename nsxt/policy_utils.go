@@ -4,6 +4,7 @@
 package nsxt
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -34,7 +35,7 @@ func getOrGenerateID(d *schema.ResourceData, m interface{}, presenceChecker func
 	}
 
 	if exists {
-		return "", fmt.Errorf("Resource with id %s already exists", id)
+		return "", newAttributeError("nsx_id", fmt.Errorf("Resource with id %s already exists", id))
 	}
 
 	return id, nil
@@ -128,6 +129,39 @@ func setPathListInMap(data map[string]interface{}, attrName string, pathList []s
 	}
 }
 
+// NSX does not preserve order of some lists. In order to avoid perpetual diff,
+// elements read from NSX are ordered as in current state, and elements that are
+// not in current state follow in order returned by NSX.
+func getListOrderedByState(current []interface{}, actual []interface{}, getKey func(interface{}) string) []interface{} {
+	used := make([]bool, len(actual))
+	ordered := make([]interface{}, 0, len(actual))
+	for _, elem := range current {
+		key := getKey(elem)
+		for i, actualElem := range actual {
+			if !used[i] && getKey(actualElem) == key {
+				ordered = append(ordered, actualElem)
+				used[i] = true
+				break
+			}
+		}
+	}
+
+	for i, actualElem := range actual {
+		if !used[i] {
+			ordered = append(ordered, actualElem)
+		}
+	}
+
+	return ordered
+}
+
+func getStringListOrderedByState(current []interface{}, actual []string) []string {
+	getKey := func(elem interface{}) string {
+		return elem.(string)
+	}
+	return interface2StringList(getListOrderedByState(current, stringList2Interface(actual), getKey))
+}
+
 func getDomainFromResourcePath(rPath string) string {
 	return getResourceIDFromResourcePath(rPath, "domains")
 }
@@ -142,7 +176,7 @@ func getResourceIDFromResourcePath(rPath string, rType string) string {
 	return ""
 }
 
-func nsxtDomainResourceImporter(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+func nsxtDomainResourceImporter(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	importDomain := defaultDomain
 	importID := d.Id()
 	s := strings.Split(importID, "/")
@@ -160,12 +194,15 @@ func nsxtDomainResourceImporter(d *schema.ResourceData, m interface{}) ([]*schem
 
 func isPolicyPath(policyPath string) bool {
 	pathSegs := strings.Split(policyPath, "/")
-	if len(pathSegs) < 4 {
+	if len(pathSegs) == 3 && pathSegs[0] == "" && pathSegs[1] == "orgs" && pathSegs[2] != "" {
+		// org path, such as /orgs/default
+		return true
+	} else if len(pathSegs) < 4 {
 		return false
 	} else if pathSegs[0] != "" || pathSegs[len(pathSegs)-1] == "" {
 		return false
-	} else if !strings.Contains(pathSegs[1], "infra") {
-		// must be infra or global-infra as of now
+	} else if !strings.Contains(pathSegs[1], "infra") && pathSegs[1] != "orgs" {
+		// must be infra, global-infra or orgs (multi-tenancy) as of now
 		return false
 	}
 	return true
@@ -255,7 +292,11 @@ func nsxtPolicyWaitForRealizationStateConf(connector client.Connector, d *schema
 }
 
 func getPolicyEnforcementPointPath(m interface{}) string {
-	return "/infra/sites/default/enforcement-points/" + getPolicyEnforcementPoint(m)
+	return getPolicyEnforcementPointPathForID(getPolicyEnforcementPoint(m))
+}
+
+func getPolicyEnforcementPointPathForID(epID string) string {
+	return "/infra/sites/default/enforcement-points/" + epID
 }
 
 func getGlobalPolicyEnforcementPointPathWithLocation(m interface{}, location string) string {
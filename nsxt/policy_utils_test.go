@@ -0,0 +1,35 @@
+/* Copyright © 2023 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestGetListOrderedByState(t *testing.T) {
+	current := []interface{}{"c", "a"}
+	actual := []string{"a", "b", "c"}
+	ordered := getStringListOrderedByState(current, actual)
+	expected := []string{"c", "a", "b"}
+	if !reflect.DeepEqual(ordered, expected) {
+		t.Errorf("Expected %v, got %v", expected, ordered)
+	}
+
+	getKey := func(elem interface{}) string {
+		return elem.(map[string]interface{})["cidr"].(string)
+	}
+	currentSubnets := []interface{}{
+		map[string]interface{}{"cidr": "2001::1/64"},
+		map[string]interface{}{"cidr": "10.0.0.1/24"},
+	}
+	actualSubnets := []interface{}{
+		map[string]interface{}{"cidr": "10.0.0.1/24", "network": "10.0.0.0/24"},
+		map[string]interface{}{"cidr": "2001::1/64", "network": "2001::/64"},
+	}
+	orderedSubnets := getListOrderedByState(currentSubnets, actualSubnets, getKey)
+	if getKey(orderedSubnets[0]) != "2001::1/64" || len(orderedSubnets) != 2 {
+		t.Errorf("Unexpected subnet order %v", orderedSubnets)
+	}
+}
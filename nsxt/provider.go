@@ -4,18 +4,19 @@
 package nsxt
 
 import (
+	"context"
 	"crypto/tls"
 	"crypto/x509"
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
 	"log"
-	"math/rand"
 	"net/http"
 	"regexp"
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	api "github.com/vmware/go-vmware-nsxt"
@@ -37,6 +38,10 @@ type commonProviderConfig struct {
 	MinRetryInterval       int
 	MaxRetryInterval       int
 	RetryStatusCodes       []int
+	Username               string
+	// Shared by policy and MP clients
+	RequestLimiter *requestLimiter
+	APITracer      *apiTracer
 }
 
 type nsxtClients struct {
@@ -57,11 +62,25 @@ type nsxtClients struct {
 	Host                   string
 	PolicyEnforcementPoint string
 	PolicyGlobalManager    bool
+	// Wait for policy objects to be realized on create and update
+	PolicyRealizationWait bool
+	// Wait for global objects to be realized on all sites in their span
+	PolicyGlobalRealizationWait bool
+	// Report open realization alarms as warnings on read
+	PolicyRealizationAlarmWarnings bool
+	// Fail updates of policy objects modified since they were last read
+	PolicyEnforceRevision bool
+	// Track users that modify policy objects, and warn on out of band modifications
+	PolicyTrackOutOfBandChanges bool
+	// NSX error resolver settings, nil if error resolver is not used
+	ErrorResolver *policyErrorResolver
+	// Context of current provider operation
+	Context context.Context
 }
 
 // Provider for VMWare NSX-T
 func Provider() *schema.Provider {
-	return &schema.Provider{
+	provider := &schema.Provider{
 
 		Schema: map[string]*schema.Schema{
 			"allow_unverified_ssl": {
@@ -139,6 +158,32 @@ func Provider() *schema.Provider {
 				},
 				// There is no support for default values/func for list, so it will be handled later
 			},
+			"api_trace_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "File to record API calls to NSX in JSON lines format",
+				DefaultFunc: schema.EnvDefaultFunc("NSXT_API_TRACE_FILE", nil),
+			},
+			"api_trace_otlp_endpoint": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "OTLP HTTP collector endpoint to export API call traces to, for example http://localhost:4318",
+				DefaultFunc: schema.EnvDefaultFunc("NSXT_API_TRACE_OTLP_ENDPOINT", nil),
+			},
+			"max_concurrent_requests": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Description:  "Maximum number of concurrent requests to NSX. Zero means unlimited",
+				DefaultFunc:  schema.EnvDefaultFunc("NSXT_MAX_CONCURRENT_REQUESTS", 0),
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"requests_per_second": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Description:  "Maximum rate of requests to NSX. Zero means unlimited",
+				DefaultFunc:  schema.EnvDefaultFunc("NSXT_REQUESTS_PER_SECOND", 0),
+				ValidateFunc: validation.IntAtLeast(0),
+			},
 			"tolerate_partial_success": {
 				Type:        schema.TypeBool,
 				Optional:    true,
@@ -176,6 +221,50 @@ func Provider() *schema.Provider {
 				Description: "Is this a policy global manager endpoint",
 				DefaultFunc: schema.EnvDefaultFunc("NSXT_GLOBAL_MANAGER", false),
 			},
+			"wait_for_realization": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Wait for policy objects to be realized on create and update",
+				DefaultFunc: schema.EnvDefaultFunc("NSXT_WAIT_FOR_REALIZATION", false),
+			},
+			"global_realization_wait": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Wait for global manager objects to be realized on all sites in their span",
+				DefaultFunc: schema.EnvDefaultFunc("NSXT_GLOBAL_REALIZATION_WAIT", false),
+			},
+			"realization_alarm_warnings": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Report open realization alarms on policy objects as warnings",
+				DefaultFunc: schema.EnvDefaultFunc("NSXT_REALIZATION_ALARM_WARNINGS", false),
+			},
+			"enforce_revision": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Fail policy updates if object was modified on NSX since it was last read",
+				DefaultFunc: schema.EnvDefaultFunc("NSXT_ENFORCE_REVISION", false),
+			},
+			"track_out_of_band_changes": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Track users that modify policy objects, and warn on refresh if object was modified on NSX outside of terraform",
+				DefaultFunc: schema.EnvDefaultFunc("NSXT_TRACK_OUT_OF_BAND_CHANGES", false),
+			},
+			"error_resolver_hints": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Look up NSX error resolver for failed API calls and add resolution hint to the error",
+				DefaultFunc: schema.EnvDefaultFunc("NSXT_ERROR_RESOLVER_HINTS", false),
+			},
+			"error_resolver_auto_resolve_codes": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Error codes for which NSX error resolver is invoked automatically, if resolver requires no user input",
+				Elem: &schema.Schema{
+					Type: schema.TypeInt,
+				},
+			},
 			"license_keys": {
 				Type:          schema.TypeList,
 				Optional:      true,
@@ -210,63 +299,76 @@ func Provider() *schema.Provider {
 		},
 
 		DataSourcesMap: map[string]*schema.Resource{
-			"nsxt_provider_info":                    dataSourceNsxtProviderInfo(),
-			"nsxt_transport_zone":                   dataSourceNsxtTransportZone(),
-			"nsxt_switching_profile":                dataSourceNsxtSwitchingProfile(),
-			"nsxt_logical_tier0_router":             dataSourceNsxtLogicalTier0Router(),
-			"nsxt_logical_tier1_router":             dataSourceNsxtLogicalTier1Router(),
-			"nsxt_mac_pool":                         dataSourceNsxtMacPool(),
-			"nsxt_ns_group":                         dataSourceNsxtNsGroup(),
-			"nsxt_ns_groups":                        dataSourceNsxtNsGroups(),
-			"nsxt_ns_service":                       dataSourceNsxtNsService(),
-			"nsxt_ns_services":                      dataSourceNsxtNsServices(),
-			"nsxt_edge_cluster":                     dataSourceNsxtEdgeCluster(),
-			"nsxt_certificate":                      dataSourceNsxtCertificate(),
-			"nsxt_ip_pool":                          dataSourceNsxtIPPool(),
-			"nsxt_firewall_section":                 dataSourceNsxtFirewallSection(),
-			"nsxt_management_cluster":               dataSourceNsxtManagementCluster(),
-			"nsxt_policy_edge_cluster":              dataSourceNsxtPolicyEdgeCluster(),
-			"nsxt_policy_edge_node":                 dataSourceNsxtPolicyEdgeNode(),
-			"nsxt_policy_tier0_gateway":             dataSourceNsxtPolicyTier0Gateway(),
-			"nsxt_policy_tier1_gateway":             dataSourceNsxtPolicyTier1Gateway(),
-			"nsxt_policy_service":                   dataSourceNsxtPolicyService(),
-			"nsxt_policy_realization_info":          dataSourceNsxtPolicyRealizationInfo(),
-			"nsxt_policy_segment_realization":       dataSourceNsxtPolicySegmentRealization(),
-			"nsxt_policy_transport_zone":            dataSourceNsxtPolicyTransportZone(),
-			"nsxt_policy_ip_discovery_profile":      dataSourceNsxtPolicyIPDiscoveryProfile(),
-			"nsxt_policy_spoofguard_profile":        dataSourceNsxtPolicySpoofGuardProfile(),
-			"nsxt_policy_qos_profile":               dataSourceNsxtPolicyQosProfile(),
-			"nsxt_policy_ipv6_ndra_profile":         dataSourceNsxtPolicyIpv6NdraProfile(),
-			"nsxt_policy_ipv6_dad_profile":          dataSourceNsxtPolicyIpv6DadProfile(),
-			"nsxt_policy_gateway_qos_profile":       dataSourceNsxtPolicyGatewayQosProfile(),
-			"nsxt_policy_segment_security_profile":  dataSourceNsxtPolicySegmentSecurityProfile(),
-			"nsxt_policy_mac_discovery_profile":     dataSourceNsxtPolicyMacDiscoveryProfile(),
-			"nsxt_policy_vm":                        dataSourceNsxtPolicyVM(),
-			"nsxt_policy_vms":                       dataSourceNsxtPolicyVMs(),
-			"nsxt_policy_lb_app_profile":            dataSourceNsxtPolicyLBAppProfile(),
-			"nsxt_policy_lb_client_ssl_profile":     dataSourceNsxtPolicyLBClientSslProfile(),
-			"nsxt_policy_lb_server_ssl_profile":     dataSourceNsxtPolicyLBServerSslProfile(),
-			"nsxt_policy_lb_monitor":                dataSourceNsxtPolicyLBMonitor(),
-			"nsxt_policy_certificate":               dataSourceNsxtPolicyCertificate(),
-			"nsxt_policy_lb_persistence_profile":    dataSourceNsxtPolicyLbPersistenceProfile(),
-			"nsxt_policy_vni_pool":                  dataSourceNsxtPolicyVniPool(),
-			"nsxt_policy_ip_block":                  dataSourceNsxtPolicyIPBlock(),
-			"nsxt_policy_ip_pool":                   dataSourceNsxtPolicyIPPool(),
-			"nsxt_policy_site":                      dataSourceNsxtPolicySite(),
-			"nsxt_policy_gateway_policy":            dataSourceNsxtPolicyGatewayPolicy(),
-			"nsxt_policy_security_policy":           dataSourceNsxtPolicySecurityPolicy(),
-			"nsxt_policy_group":                     dataSourceNsxtPolicyGroup(),
-			"nsxt_policy_context_profile":           dataSourceNsxtPolicyContextProfile(),
-			"nsxt_policy_dhcp_server":               dataSourceNsxtPolicyDhcpServer(),
-			"nsxt_policy_bfd_profile":               dataSourceNsxtPolicyBfdProfile(),
-			"nsxt_policy_intrusion_service_profile": dataSourceNsxtPolicyIntrusionServiceProfile(),
-			"nsxt_policy_lb_service":                dataSourceNsxtPolicyLbService(),
-			"nsxt_policy_gateway_locale_service":    dataSourceNsxtPolicyGatewayLocaleService(),
-			"nsxt_policy_bridge_profile":            dataSourceNsxtPolicyBridgeProfile(),
-			"nsxt_policy_ipsec_vpn_local_endpoint":  dataSourceNsxtPolicyIPSecVpnLocalEndpoint(),
-			"nsxt_policy_ipsec_vpn_service":         dataSourceNsxtPolicyIPSecVpnService(),
-			"nsxt_policy_l2_vpn_service":            dataSourceNsxtPolicyL2VpnService(),
-			"nsxt_policy_segment":                   dataSourceNsxtPolicySegment(),
+			"nsxt_provider_info":                            dataSourceNsxtProviderInfo(),
+			"nsxt_transport_zone":                           dataSourceNsxtTransportZone(),
+			"nsxt_switching_profile":                        dataSourceNsxtSwitchingProfile(),
+			"nsxt_logical_tier0_router":                     dataSourceNsxtLogicalTier0Router(),
+			"nsxt_logical_tier1_router":                     dataSourceNsxtLogicalTier1Router(),
+			"nsxt_mac_pool":                                 dataSourceNsxtMacPool(),
+			"nsxt_ns_group":                                 dataSourceNsxtNsGroup(),
+			"nsxt_ns_groups":                                dataSourceNsxtNsGroups(),
+			"nsxt_ns_service":                               dataSourceNsxtNsService(),
+			"nsxt_ns_services":                              dataSourceNsxtNsServices(),
+			"nsxt_edge_cluster":                             dataSourceNsxtEdgeCluster(),
+			"nsxt_certificate":                              dataSourceNsxtCertificate(),
+			"nsxt_ip_pool":                                  dataSourceNsxtIPPool(),
+			"nsxt_firewall_section":                         dataSourceNsxtFirewallSection(),
+			"nsxt_management_cluster":                       dataSourceNsxtManagementCluster(),
+			"nsxt_policy_edge_cluster":                      dataSourceNsxtPolicyEdgeCluster(),
+			"nsxt_policy_edge_node":                         dataSourceNsxtPolicyEdgeNode(),
+			"nsxt_policy_tier0_gateway":                     dataSourceNsxtPolicyTier0Gateway(),
+			"nsxt_policy_tier1_gateway":                     dataSourceNsxtPolicyTier1Gateway(),
+			"nsxt_policy_service":                           dataSourceNsxtPolicyService(),
+			"nsxt_policy_realization_info":                  dataSourceNsxtPolicyRealizationInfo(),
+			"nsxt_policy_segment_realization":               dataSourceNsxtPolicySegmentRealization(),
+			"nsxt_policy_transport_zone":                    dataSourceNsxtPolicyTransportZone(),
+			"nsxt_policy_ip_discovery_profile":              dataSourceNsxtPolicyIPDiscoveryProfile(),
+			"nsxt_policy_spoofguard_profile":                dataSourceNsxtPolicySpoofGuardProfile(),
+			"nsxt_policy_qos_profile":                       dataSourceNsxtPolicyQosProfile(),
+			"nsxt_policy_ipv6_ndra_profile":                 dataSourceNsxtPolicyIpv6NdraProfile(),
+			"nsxt_policy_ipv6_dad_profile":                  dataSourceNsxtPolicyIpv6DadProfile(),
+			"nsxt_policy_gateway_qos_profile":               dataSourceNsxtPolicyGatewayQosProfile(),
+			"nsxt_policy_segment_security_profile":          dataSourceNsxtPolicySegmentSecurityProfile(),
+			"nsxt_policy_mac_discovery_profile":             dataSourceNsxtPolicyMacDiscoveryProfile(),
+			"nsxt_policy_vm":                                dataSourceNsxtPolicyVM(),
+			"nsxt_policy_vms":                               dataSourceNsxtPolicyVMs(),
+			"nsxt_policy_lb_app_profile":                    dataSourceNsxtPolicyLBAppProfile(),
+			"nsxt_policy_lb_client_ssl_profile":             dataSourceNsxtPolicyLBClientSslProfile(),
+			"nsxt_policy_lb_server_ssl_profile":             dataSourceNsxtPolicyLBServerSslProfile(),
+			"nsxt_policy_lb_monitor":                        dataSourceNsxtPolicyLBMonitor(),
+			"nsxt_policy_certificate":                       dataSourceNsxtPolicyCertificate(),
+			"nsxt_policy_lb_persistence_profile":            dataSourceNsxtPolicyLbPersistenceProfile(),
+			"nsxt_policy_vni_pool":                          dataSourceNsxtPolicyVniPool(),
+			"nsxt_policy_ip_block":                          dataSourceNsxtPolicyIPBlock(),
+			"nsxt_policy_ip_pool":                           dataSourceNsxtPolicyIPPool(),
+			"nsxt_policy_site":                              dataSourceNsxtPolicySite(),
+			"nsxt_policy_gateway_policy":                    dataSourceNsxtPolicyGatewayPolicy(),
+			"nsxt_policy_security_policy":                   dataSourceNsxtPolicySecurityPolicy(),
+			"nsxt_policy_group":                             dataSourceNsxtPolicyGroup(),
+			"nsxt_policy_context_profile":                   dataSourceNsxtPolicyContextProfile(),
+			"nsxt_policy_dhcp_server":                       dataSourceNsxtPolicyDhcpServer(),
+			"nsxt_policy_bfd_profile":                       dataSourceNsxtPolicyBfdProfile(),
+			"nsxt_policy_intrusion_service_profile":         dataSourceNsxtPolicyIntrusionServiceProfile(),
+			"nsxt_policy_lb_service":                        dataSourceNsxtPolicyLbService(),
+			"nsxt_policy_gateway_locale_service":            dataSourceNsxtPolicyGatewayLocaleService(),
+			"nsxt_policy_bridge_profile":                    dataSourceNsxtPolicyBridgeProfile(),
+			"nsxt_policy_ipsec_vpn_local_endpoint":          dataSourceNsxtPolicyIPSecVpnLocalEndpoint(),
+			"nsxt_policy_ipsec_vpn_service":                 dataSourceNsxtPolicyIPSecVpnService(),
+			"nsxt_policy_l2_vpn_service":                    dataSourceNsxtPolicyL2VpnService(),
+			"nsxt_policy_segment":                           dataSourceNsxtPolicySegment(),
+			"nsxt_policy_tier1_gateway_advertised_networks": dataSourceNsxtPolicyTier1GatewayAdvertisedNetworks(),
+			"nsxt_policy_tier1_gateway_state":               dataSourceNsxtPolicyTier1GatewayState(),
+			"nsxt_policy_identity_store_directory":          dataSourceNsxtPolicyIdentityStoreDirectory(),
+			"nsxt_policy_url_category":                      dataSourceNsxtPolicyURLCategory(),
+			"nsxt_policy_uplink_host_switch_profile":        dataSourceNsxtPolicyUplinkHostSwitchProfile(),
+			"nsxt_policy_lldp_host_switch_profile":          dataSourceNsxtPolicyLldpHostSwitchProfile(),
+			"nsxt_policy_nioc_host_switch_profile":          dataSourceNsxtPolicyNiocHostSwitchProfile(),
+			"nsxt_policy_gm_operational_state":              dataSourceNsxtPolicyGmOperationalState(),
+			"nsxt_policy_span":                              dataSourceNsxtPolicySpan(),
+			"nsxt_policy_gm_full_sync":                      dataSourceNsxtPolicyGmFullSync(),
+			"nsxt_policy_realization_alarms":                dataSourceNsxtPolicyRealizationAlarms(),
+			"nsxt_policy_resource":                          dataSourceNsxtPolicyResource(),
+			"nsxt_policy_dfw_draft":                         dataSourceNsxtPolicyDfwDraft(),
 		},
 
 		ResourcesMap: map[string]*schema.Resource{
@@ -390,10 +492,44 @@ func Provider() *schema.Provider {
 			"nsxt_policy_segment_security_profile":         resourceNsxtPolicySegmentSecurityProfile(),
 			"nsxt_policy_spoof_guard_profile":              resourceNsxtPolicySpoofGuardProfile(),
 			"nsxt_policy_gateway_qos_profile":              resourceNsxtPolicyGatewayQosProfile(),
+			"nsxt_policy_tier0_inter_vrf_routing":          resourceNsxtPolicyTier0InterVRFRouting(),
+			"nsxt_policy_identity_store":                   resourceNsxtPolicyIdentityStore(),
+			"nsxt_policy_identity_store_ldap_server":       resourceNsxtPolicyIdentityStoreLdapServer(),
+			"nsxt_policy_tls_inspection_policy":            resourceNsxtPolicyTLSInspectionPolicy(),
+			"nsxt_policy_tls_inspection_external_profile":  resourceNsxtPolicyTLSInspectionExternalProfile(),
+			"nsxt_policy_tls_inspection_internal_profile":  resourceNsxtPolicyTLSInspectionInternalProfile(),
+			"nsxt_policy_tier1_tls_inspection_binding":     resourceNsxtPolicyTier1TLSInspectionBinding(),
+			"nsxt_policy_l7_access_profile":                resourceNsxtPolicyL7AccessProfile(),
+			"nsxt_policy_host_transport_node_profile":      resourceNsxtPolicyHostTransportNodeProfile(),
+			"nsxt_policy_host_transport_node_collection":   resourceNsxtPolicyHostTransportNodeCollection(),
+			"nsxt_policy_transport_zone":                   resourceNsxtPolicyTransportZone(),
+			"nsxt_policy_uplink_host_switch_profile":       resourceNsxtPolicyUplinkHostSwitchProfile(),
+			"nsxt_policy_lldp_host_switch_profile":         resourceNsxtPolicyLldpHostSwitchProfile(),
+			"nsxt_policy_nioc_host_switch_profile":         resourceNsxtPolicyNiocHostSwitchProfile(),
+			"nsxt_policy_edge_cluster":                     resourceNsxtPolicyEdgeCluster(),
+			"nsxt_policy_bridge_profile":                   resourceNsxtPolicyBridgeProfile(),
+			"nsxt_policy_project":                          resourceNsxtPolicyProject(),
+			"nsxt_policy_share":                            resourceNsxtPolicyShare(),
+			"nsxt_policy_shared_resource":                  resourceNsxtPolicySharedResource(),
+			"nsxt_policy_site":                             resourceNsxtPolicySite(),
+			"nsxt_policy_resource":                         resourceNsxtPolicyResource(),
+			"nsxt_policy_dfw_draft":                        resourceNsxtPolicyDfwDraft(),
+			"nsxt_policy_dfw_draft_publish":                resourceNsxtPolicyDfwDraftPublish(),
+			"nsxt_policy_firewall_scheduler":               resourceNsxtPolicyFirewallScheduler(),
+			"nsxt_policy_gm_full_sync":                     resourceNsxtPolicyGmFullSync(),
+			"nsxt_policy_region":                           resourceNsxtPolicyRegion(),
+			"nsxt_policy_span":                             resourceNsxtPolicySpan(),
 		},
 
-		ConfigureFunc: providerConfigure,
+		ConfigureContextFunc: providerConfigure,
 	}
+
+	wrapProviderContext(provider)
+	wrapPolicyResourcesRealization(provider.ResourcesMap)
+	wrapPolicyResourcesDrift(provider.ResourcesMap)
+	wrapPolicyResourcesRevision(provider.ResourcesMap)
+
+	return provider
 }
 
 func configureNsxtClient(d *schema.ResourceData, clients *nsxtClients) error {
@@ -413,14 +549,14 @@ func configureNsxtClient(d *schema.ResourceData, clients *nsxtClients) error {
 	needCreds := true
 	if len(clientAuthCertFile) > 0 {
 		if len(clientAuthKeyFile) == 0 {
-			return fmt.Errorf("Please provide key file for client certificate")
+			return newAttributeError("client_auth_key_file", fmt.Errorf("Please provide key file for client certificate"))
 		}
 		needCreds = false
 	}
 
 	if len(clientAuthCert) > 0 {
 		if len(clientAuthKey) == 0 {
-			return fmt.Errorf("Please provide key for client certificate")
+			return newAttributeError("client_auth_key", fmt.Errorf("Please provide key for client certificate"))
 		}
 		// only supported for policy resources
 		needCreds = false
@@ -432,11 +568,11 @@ func configureNsxtClient(d *schema.ResourceData, clients *nsxtClients) error {
 
 	if needCreds {
 		if username == "" {
-			return fmt.Errorf("username must be provided")
+			return newAttributeError("username", fmt.Errorf("username must be provided"))
 		}
 
 		if password == "" {
-			return fmt.Errorf("password must be provided")
+			return newAttributeError("password", fmt.Errorf("password must be provided"))
 		}
 	}
 
@@ -445,7 +581,7 @@ func configureNsxtClient(d *schema.ResourceData, clients *nsxtClients) error {
 	host = strings.TrimPrefix(host, "https://")
 
 	if host == "" {
-		return fmt.Errorf("host must be provided")
+		return newAttributeError("host", fmt.Errorf("host must be provided"))
 	}
 
 	caFile := d.Get("ca_file").(string)
@@ -453,12 +589,9 @@ func configureNsxtClient(d *schema.ResourceData, clients *nsxtClients) error {
 	sessionAuth := d.Get("session_auth").(bool)
 	skipSessionAuth := !sessionAuth
 
-	retriesConfig := api.ClientRetriesConfiguration{
-		MaxRetries:      clients.CommonConfig.MaxRetries,
-		RetryMinDelay:   clients.CommonConfig.MinRetryInterval,
-		RetryMaxDelay:   clients.CommonConfig.MaxRetryInterval,
-		RetryOnStatuses: clients.CommonConfig.RetryStatusCodes,
-	}
+	// Retries are performed by client transport with exponential back-off.
+	// The SDK still repeats request that failed to connect once, without delay.
+	retriesConfig := api.ClientRetriesConfiguration{}
 
 	clients.NsxtClientConfig = &api.Configuration{
 		BasePath:             "/api/v1",
@@ -484,9 +617,12 @@ func configureNsxtClient(d *schema.ResourceData, clients *nsxtClients) error {
 		return err
 	}
 
+	// Client is allocated by the SDK, hence rate limiting is applied to its transport
+	httpClient := clients.NsxtClientConfig.HTTPClient
+	httpClient.Transport = newRetryTransport(clients.CommonConfig, newLimitedTransport(clients.CommonConfig.RequestLimiter, newTracingTransport(clients.CommonConfig.APITracer, httpClient.Transport)))
 	clients.NsxtClient = nsxClient
 
-	return initNSXVersion(nsxClient)
+	return initNSXVersion(getOperationNsxtClient(*clients, getProviderContext(*clients)))
 }
 
 type jwtToken struct {
@@ -541,7 +677,7 @@ func getConnectorTLSConfig(d *schema.ResourceData) (*tls.Config, error) {
 
 		// cert and key are passed via filesystem
 		if len(clientAuthKeyFile) == 0 {
-			return nil, fmt.Errorf("Please provide key file for client certificate")
+			return nil, newAttributeError("client_auth_key_file", fmt.Errorf("Please provide key file for client certificate"))
 		}
 
 		cert, err := tls.LoadX509KeyPair(clientAuthCertFile, clientAuthKeyFile)
@@ -558,7 +694,7 @@ func getConnectorTLSConfig(d *schema.ResourceData) (*tls.Config, error) {
 	if len(clientAuthCert) > 0 {
 		// cert and key are passed as strings
 		if len(clientAuthKey) == 0 {
-			return nil, fmt.Errorf("Please provide key for client certificate")
+			return nil, newAttributeError("client_auth_key", fmt.Errorf("Please provide key for client certificate"))
 		}
 
 		cert, err := tls.X509KeyPair([]byte(clientAuthCert), []byte(clientAuthKey))
@@ -605,10 +741,15 @@ func configurePolicyConnectorData(d *schema.ResourceData, clients *nsxtClients)
 	clientAuthDefined := (len(clientAuthCertFile) > 0) || (len(clientAuthCert) > 0)
 	policyEnforcementPoint := d.Get("enforcement_point").(string)
 	policyGlobalManager := d.Get("global_manager").(bool)
+	policyRealizationWait := d.Get("wait_for_realization").(bool)
+	policyGlobalRealizationWait := d.Get("global_realization_wait").(bool)
+	policyRealizationAlarmWarnings := d.Get("realization_alarm_warnings").(bool)
+	policyEnforceRevision := d.Get("enforce_revision").(bool)
+	policyTrackOutOfBandChanges := d.Get("track_out_of_band_changes").(bool)
 	vmcAuthMode := d.Get("vmc_auth_mode").(string)
 
 	if host == "" {
-		return fmt.Errorf("host must be provided")
+		return newAttributeError("host", fmt.Errorf("host must be provided"))
 	}
 
 	if !strings.HasPrefix(host, "https://") {
@@ -624,7 +765,7 @@ func configurePolicyConnectorData(d *schema.ResourceData, clients *nsxtClients)
 	if securityContextNeeded {
 		if len(vmcAccessToken) > 0 {
 			if vmcAuthHost == "" {
-				return fmt.Errorf("vmc auth host must be provided if auth token is provided")
+				return newAttributeError("vmc_auth_host", fmt.Errorf("vmc auth host must be provided if auth token is provided"))
 			}
 
 			apiToken, err := getAPIToken(vmcAuthHost, vmcAccessToken)
@@ -641,11 +782,11 @@ func configurePolicyConnectorData(d *schema.ResourceData, clients *nsxtClients)
 			}
 		} else {
 			if username == "" {
-				return fmt.Errorf("username must be provided")
+				return newAttributeError("username", fmt.Errorf("username must be provided"))
 			}
 
 			if password == "" {
-				return fmt.Errorf("password must be provided")
+				return newAttributeError("password", fmt.Errorf("password must be provided"))
 			}
 
 			securityCtx.SetProperty(security.AUTHENTICATION_SCHEME_ID, security.USER_PASSWORD_SCHEME_ID)
@@ -664,7 +805,7 @@ func configurePolicyConnectorData(d *schema.ResourceData, clients *nsxtClients)
 		TLSClientConfig: tlsConfig,
 	}
 
-	httpClient := http.Client{Transport: tr}
+	httpClient := http.Client{Transport: newLimitedTransport(clients.CommonConfig.RequestLimiter, newTracingTransport(clients.CommonConfig.APITracer, tr))}
 	clients.PolicyHTTPClient = &httpClient
 	if securityContextNeeded {
 		clients.PolicySecurityContext = securityCtx
@@ -672,6 +813,11 @@ func configurePolicyConnectorData(d *schema.ResourceData, clients *nsxtClients)
 	clients.Host = host
 	clients.PolicyEnforcementPoint = policyEnforcementPoint
 	clients.PolicyGlobalManager = policyGlobalManager
+	clients.PolicyRealizationWait = policyRealizationWait
+	clients.PolicyGlobalRealizationWait = policyGlobalRealizationWait
+	clients.PolicyRealizationAlarmWarnings = policyRealizationAlarmWarnings
+	clients.PolicyEnforceRevision = policyEnforceRevision
+	clients.PolicyTrackOutOfBandChanges = policyTrackOutOfBandChanges
 
 	if (len(vmcAccessToken) > 0) || (vmcAuthMode == "Basic") {
 		// Special treatment for VMC since MP API is not available there
@@ -746,9 +892,9 @@ func applyLicense(c *api.APIClient, licenseKey string) error {
 // license keys are applied on terraform plan and are not removed
 func configureLicenses(d *schema.ResourceData, clients *nsxtClients) error {
 	for _, licKey := range d.Get("license_keys").([]interface{}) {
-		err := applyLicense(clients.NsxtClient, licKey.(string))
+		err := applyLicense(getOperationNsxtClient(*clients, getProviderContext(*clients)), licKey.(string))
 		if err != nil {
-			return fmt.Errorf("Error applying license key: %s. %s", licKey, err.Error())
+			return newAttributeError("license_keys", fmt.Errorf("Error applying license key: %s. %s", licKey, err.Error()))
 		}
 	}
 	return nil
@@ -760,6 +906,8 @@ func initCommonConfig(d *schema.ResourceData) commonProviderConfig {
 	maxRetries := d.Get("max_retries").(int)
 	retryMinDelay := d.Get("retry_min_delay").(int)
 	retryMaxDelay := d.Get("retry_max_delay").(int)
+	maxConcurrentRequests := d.Get("max_concurrent_requests").(int)
+	requestsPerSecond := d.Get("requests_per_second").(int)
 
 	statuses := d.Get("retry_on_status_codes").([]interface{})
 	retryStatuses := make([]int, 0, len(statuses))
@@ -779,37 +927,55 @@ func initCommonConfig(d *schema.ResourceData) commonProviderConfig {
 		MinRetryInterval:       retryMinDelay,
 		MaxRetryInterval:       retryMaxDelay,
 		RetryStatusCodes:       retryStatuses,
+		Username:               d.Get("username").(string),
+		RequestLimiter:         newRequestLimiter(maxConcurrentRequests, requestsPerSecond),
 	}
 }
 
-func providerConfigure(d *schema.ResourceData) (interface{}, error) {
+func providerConfigure(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
 	commonConfig := initCommonConfig(d)
+	apiTracer, err := newAPITracer(d.Get("api_trace_file").(string), d.Get("api_trace_otlp_endpoint").(string))
+	if err != nil {
+		return nil, getErrorDiagnostics(err)
+	}
+	commonConfig.APITracer = apiTracer
 	clients := nsxtClients{
 		CommonConfig: commonConfig,
+		// API calls during configuration are bound to configure context, which
+		// is not kept for resource operations
+		Context: ctx,
 	}
 
-	err := configureNsxtClient(d, &clients)
+	err = configureNsxtClient(d, &clients)
 	if err != nil {
-		return nil, err
+		return nil, getErrorDiagnostics(err)
 	}
 
 	err = configurePolicyConnectorData(d, &clients)
 	if err != nil {
-		return nil, err
+		return nil, getErrorDiagnostics(err)
 	}
 
 	err = configureLicenses(d, &clients)
 	if err != nil {
-		return nil, err
+		return nil, getErrorDiagnostics(err)
 	}
 
+	configurePolicyErrorResolver(d, &clients)
+	clients.Context = nil
+
 	return clients, nil
 }
 
 func getPolicyConnector(clients interface{}) client.Connector {
 	c := clients.(nsxtClients)
+	ctx := getProviderContext(clients)
 
 	retryFunc := func(retryContext retry.RetryContext) bool {
+		if ctx.Err() != nil {
+			return false
+		}
+
 		shouldRetry := false
 		if retryContext.Response != nil {
 			for _, code := range c.CommonConfig.RetryStatusCodes {
@@ -831,15 +997,27 @@ func getPolicyConnector(clients interface{}) client.Connector {
 		min := c.CommonConfig.MinRetryInterval
 		max := c.CommonConfig.MaxRetryInterval
 		if max > 0 {
-			interval := (rand.Intn(max-min) + min)
-			time.Sleep(time.Duration(interval) * time.Millisecond)
-			log.Printf("[DEBUG]: Waited %d ms before retrying", interval)
+			interval := getRetryBackoffInterval(retryContext.Attempt, min, max)
+			select {
+			case <-ctx.Done():
+				log.Printf("[DEBUG]: Operation cancelled, not retrying")
+				return false
+			case <-time.After(time.Duration(interval) * time.Millisecond):
+				log.Printf("[DEBUG]: Waited %d ms before retrying", interval)
+			}
 		}
 
 		return true
 	}
 
-	connectorOptions := []client.ConnectorOption{client.UsingRest(nil), client.WithHttpClient(c.PolicyHTTPClient), client.WithDecorators(retry.NewRetryDecorator(uint(c.CommonConfig.MaxRetries), retryFunc))}
+	var decorators []core.APIProviderDecorator
+	if c.CommonConfig.APITracer != nil {
+		// Attempts are counted under retry decorator
+		decorators = append(decorators, newTraceAttemptDecorator())
+	}
+	decorators = append(decorators, retry.NewRetryDecorator(uint(c.CommonConfig.MaxRetries), retryFunc), newContextDecorator(ctx))
+
+	connectorOptions := []client.ConnectorOption{client.UsingRest(nil), client.WithHttpClient(c.PolicyHTTPClient), client.WithDecorators(decorators...)}
 	var requestProcessors []core.RequestProcessor
 	if c.PolicySecurityContext != nil {
 		connectorOptions = append(connectorOptions, client.WithSecurityContext(c.PolicySecurityContext))
@@ -879,6 +1057,26 @@ func isPolicyGlobalManager(clients interface{}) bool {
 	return clients.(nsxtClients).PolicyGlobalManager
 }
 
+func getPolicyRealizationWait(clients interface{}) bool {
+	return clients.(nsxtClients).PolicyRealizationWait
+}
+
+func getPolicyGlobalRealizationWait(clients interface{}) bool {
+	return clients.(nsxtClients).PolicyGlobalRealizationWait
+}
+
+func getPolicyRealizationAlarmWarnings(clients interface{}) bool {
+	return clients.(nsxtClients).PolicyRealizationAlarmWarnings
+}
+
+func getPolicyEnforceRevision(clients interface{}) bool {
+	return clients.(nsxtClients).PolicyEnforceRevision
+}
+
+func getPolicyTrackOutOfBandChanges(clients interface{}) bool {
+	return clients.(nsxtClients).PolicyTrackOutOfBandChanges
+}
+
 func getCommonProviderConfig(clients interface{}) commonProviderConfig {
 	return clients.(nsxtClients).CommonConfig
 }
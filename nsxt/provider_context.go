@@ -0,0 +1,205 @@
+/* Copyright © 2023 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"context"
+	"errors"
+	"log"
+	"net/http"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	api "github.com/vmware/go-vmware-nsxt"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/core"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/data"
+)
+
+// Error related to specific attribute of the resource, reported as diagnostic
+// with attribute path
+type attributeError struct {
+	attribute string
+	err       error
+}
+
+func (e *attributeError) Error() string {
+	return e.err.Error()
+}
+
+func (e *attributeError) Unwrap() error {
+	return e.err
+}
+
+func newAttributeError(attribute string, err error) error {
+	return &attributeError{attribute: attribute, err: err}
+}
+
+func getErrorDiagnostics(err error) diag.Diagnostics {
+	if err == nil {
+		return nil
+	}
+
+	var attrErr *attributeError
+	if errors.As(err, &attrErr) {
+		return diag.Diagnostics{
+			diag.Diagnostic{
+				Severity:      diag.Error,
+				Summary:       err.Error(),
+				AttributePath: cty.GetAttrPath(attrErr.attribute),
+			},
+		}
+	}
+
+	return diag.FromErr(err)
+}
+
+// Converts error of failed operation to diagnostics. This is where NSX error
+// resolver hint is added, rather than when API error is formatted, since the
+// error might be handled by the operation.
+func getOperationDiagnostics(m interface{}, err error) diag.Diagnostics {
+	return getErrorDiagnostics(resolvePolicyOperationError(m, err))
+}
+
+// Operation context is passed to CRUD implementation within provider clients,
+// since clients are copied per operation
+func withProviderContext(m interface{}, ctx context.Context) interface{} {
+	clients, ok := m.(nsxtClients)
+	if !ok {
+		return m
+	}
+
+	clients.Context = ctx
+	clients.NsxtClient = getOperationNsxtClient(clients, ctx)
+	return clients
+}
+
+func getProviderContext(m interface{}) context.Context {
+	clients, ok := m.(nsxtClients)
+	if !ok || clients.Context == nil {
+		return context.Background()
+	}
+
+	return clients.Context
+}
+
+// MP SDK does not attach context of the call to its requests, hence a copy of
+// the client is allocated per operation, with transport bound to operation context.
+// The copy reuses session of the shared client.
+func getOperationNsxtClient(clients nsxtClients, ctx context.Context) *api.APIClient {
+	if clients.NsxtClient == nil || clients.NsxtClientConfig == nil || clients.NsxtClientConfig.HTTPClient == nil {
+		return clients.NsxtClient
+	}
+
+	cfg := *clients.NsxtClientConfig
+	httpClient := *cfg.HTTPClient
+	httpClient.Transport = newContextTransport(ctx, httpClient.Transport)
+	cfg.HTTPClient = &httpClient
+	cfg.SkipSessionAuth = true
+
+	nsxClient, err := api.NewAPIClient(&cfg)
+	if err != nil {
+		log.Printf("[WARNING]: Failed to allocate NSX client for operation: %v", err)
+		return clients.NsxtClient
+	}
+	nsxClient.Context = apiCallContext{Context: ctx, values: nsxClient.Context}
+
+	return nsxClient
+}
+
+type contextTransport struct {
+	ctx  context.Context
+	next http.RoundTripper
+}
+
+func newContextTransport(ctx context.Context, next http.RoundTripper) http.RoundTripper {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+
+	return &contextTransport{
+		ctx:  ctx,
+		next: next,
+	}
+}
+
+func (t *contextTransport) RoundTrip(request *http.Request) (*http.Response, error) {
+	return t.next.RoundTrip(request.WithContext(apiCallContext{Context: t.ctx, values: request.Context()}))
+}
+
+func getContextOperation(name string, operation string, f func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+	return func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		ctx = startAPITraceOperation(ctx, m, name, operation)
+		diags := f(ctx, d, withProviderContext(m, ctx))
+		finishAPITraceOperation(ctx, m, diags.HasError())
+		return diags
+	}
+}
+
+// Provides operation context to CRUD implementation of the resource, and traces
+// the operation
+func wrapResourceContext(name string, r *schema.Resource) {
+	if r.CreateContext != nil {
+		r.CreateContext = getContextOperation(name, "create", r.CreateContext)
+	}
+	if r.ReadContext != nil {
+		r.ReadContext = getContextOperation(name, "read", r.ReadContext)
+	}
+	if r.UpdateContext != nil {
+		r.UpdateContext = getContextOperation(name, "update", r.UpdateContext)
+	}
+	if r.DeleteContext != nil {
+		r.DeleteContext = getContextOperation(name, "delete", r.DeleteContext)
+	}
+
+	if r.Importer != nil && r.Importer.StateContext != nil {
+		stateContext := r.Importer.StateContext
+		r.Importer.StateContext = func(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+			return stateContext(ctx, d, withProviderContext(m, ctx))
+		}
+	}
+}
+
+func wrapProviderContext(provider *schema.Provider) {
+	for name, r := range provider.ResourcesMap {
+		wrapResourceContext(name, r)
+	}
+	for name, r := range provider.DataSourcesMap {
+		wrapResourceContext(name, r)
+	}
+}
+
+// Connector decorator that propagates operation context to API calls
+type contextDecorator struct {
+	next core.APIProvider
+	ctx  context.Context
+}
+
+func newContextDecorator(ctx context.Context) core.APIProviderDecorator {
+	return func(next core.APIProvider) core.APIProvider {
+		return contextDecorator{
+			next: next,
+			ctx:  ctx,
+		}
+	}
+}
+
+// Context of API call carries cancellation of the operation context, while keeping
+// values of execution context, such as REST metadata of the call
+type apiCallContext struct {
+	context.Context
+	values context.Context
+}
+
+func (c apiCallContext) Value(key interface{}) interface{} {
+	if value := c.values.Value(key); value != nil {
+		return value
+	}
+	return c.Context.Value(key)
+}
+
+func (d contextDecorator) Invoke(serviceID string, operationID string, input data.DataValue, ctx *core.ExecutionContext) core.MethodResult {
+	ctx.WithContext(apiCallContext{Context: d.ctx, values: ctx.Context()})
+	return d.next.Invoke(serviceID, operationID, input, ctx)
+}
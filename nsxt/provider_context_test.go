@@ -0,0 +1,103 @@
+/* Copyright © 2023 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestUnitProviderContextCancel(t *testing.T) {
+	fake := newFakeNsxServer(t)
+	meta := testUnitConfigureProvider(t, fake)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	// MP resource
+	mpState := testUnitApplyResource(t, meta, "nsxt_ns_group", nil, map[string]interface{}{
+		"display_name": "nsgroup1",
+	})
+	_, diags := testUnitGetResource(t, "nsxt_ns_group").RefreshWithoutUpgrade(ctx, mpState, meta)
+	if !diags.HasError() || !strings.Contains(diags[0].Summary, context.Canceled.Error()) {
+		t.Errorf("Expected MP read to be cancelled, got %v", diags)
+	}
+
+	// Policy resource
+	policyState := testUnitApplyResource(t, meta, "nsxt_policy_firewall_scheduler", nil, map[string]interface{}{
+		"display_name": "scheduler1",
+		"start_time":   "09:00",
+		"end_time":     "17:00",
+		"start_date":   "01/01/2023",
+	})
+	_, diags = testUnitGetResource(t, "nsxt_policy_firewall_scheduler").RefreshWithoutUpgrade(ctx, policyState, meta)
+	if !diags.HasError() || !strings.Contains(diags[0].Summary, context.Canceled.Error()) {
+		t.Errorf("Expected policy read to be cancelled, got %v", diags)
+	}
+}
+
+func TestUnitProviderContextAttributeDiagnostics(t *testing.T) {
+	fake := newFakeNsxServer(t)
+	meta := testUnitConfigureProvider(t, fake)
+
+	r := testUnitGetResource(t, "nsxt_policy_firewall_scheduler")
+	ctx := context.Background()
+	diff, err := r.Diff(ctx, nil, terraform.NewResourceConfigRaw(map[string]interface{}{
+		"display_name": "scheduler1",
+		"recurring":    false,
+		"days":         []interface{}{"MONDAY"},
+		"start_time":   "09:00",
+		"end_time":     "17:00",
+		"start_date":   "01/01/2023",
+		"end_date":     "01/02/2023",
+	}), meta)
+	if err != nil {
+		t.Fatalf("Failed to plan: %v", err)
+	}
+
+	_, diags := r.Apply(ctx, nil, diff, meta)
+	if !diags.HasError() {
+		t.Fatalf("Expected create to fail")
+	}
+	if !diags[0].AttributePath.Equals(cty.GetAttrPath("days")) {
+		t.Errorf("Expected error on days attribute, got %v", diags[0].AttributePath)
+	}
+}
+
+func TestUnitProviderContextAwareOperations(t *testing.T) {
+	provider := Provider()
+	check := func(name string, r *schema.Resource) {
+		if r.Create != nil || r.Read != nil || r.Update != nil || r.Delete != nil {
+			t.Errorf("%s should implement context aware CRUD", name)
+		}
+		if r.Importer != nil && r.Importer.State != nil {
+			t.Errorf("%s should implement context aware importer", name)
+		}
+	}
+	for name, r := range provider.ResourcesMap {
+		check(name, r)
+	}
+	for name, r := range provider.DataSourcesMap {
+		check(name, r)
+	}
+}
+
+func TestUnitProviderConfigureAttributeDiagnostics(t *testing.T) {
+	fake := newFakeNsxServer(t)
+
+	diags := Provider().Configure(context.Background(), terraform.NewResourceConfigRaw(map[string]interface{}{
+		"host":                 fake.host(),
+		"username":             "admin",
+		"allow_unverified_ssl": true,
+		"session_auth":         false,
+	}))
+	if !diags.HasError() || !diags[0].AttributePath.Equals(cty.GetAttrPath("password")) {
+		t.Errorf("Expected configure to fail with error on password, got %v", diags)
+	}
+}
@@ -0,0 +1,262 @@
+/* Copyright © 2023 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"log"
+	"math"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// Minimal initial delay for exponential back-off, in milliseconds
+const minRetryBackoffInterval = 50
+
+// Limits concurrency and rate of requests towards NSX. The limiter is shared
+// by policy and MP clients of the provider.
+type requestLimiter struct {
+	// Semaphore for concurrent requests, nil if concurrency is unlimited
+	slots chan struct{}
+	// Token bucket, rate is zero if request rate is unlimited
+	rate        float64
+	burst       float64
+	tokens      float64
+	lastRefill  time.Time
+	pausedUntil time.Time
+	lock        sync.Mutex
+}
+
+func newRequestLimiter(maxConcurrentRequests int, requestsPerSecond int) *requestLimiter {
+	limiter := &requestLimiter{
+		rate:       float64(requestsPerSecond),
+		burst:      math.Max(1, float64(requestsPerSecond)),
+		lastRefill: time.Now(),
+	}
+	limiter.tokens = limiter.burst
+	if maxConcurrentRequests > 0 {
+		limiter.slots = make(chan struct{}, maxConcurrentRequests)
+	}
+
+	return limiter
+}
+
+// Returns time to wait until the request is allowed, and consumes a token
+// if the request is allowed right away
+func (l *requestLimiter) reserve() time.Duration {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	now := time.Now()
+	if now.Before(l.pausedUntil) {
+		return l.pausedUntil.Sub(now)
+	}
+
+	if l.rate <= 0 {
+		return 0
+	}
+
+	l.tokens = math.Min(l.burst, l.tokens+now.Sub(l.lastRefill).Seconds()*l.rate)
+	l.lastRefill = now
+	if l.tokens >= 1 {
+		l.tokens--
+		return 0
+	}
+
+	return time.Duration((1 - l.tokens) / l.rate * float64(time.Second))
+}
+
+func (l *requestLimiter) acquire(ctx context.Context) error {
+	if l.slots != nil {
+		select {
+		case l.slots <- struct{}{}:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+
+	for {
+		delay := l.reserve()
+		if delay == 0 {
+			return nil
+		}
+
+		select {
+		case <-time.After(delay):
+		case <-ctx.Done():
+			l.release()
+			return ctx.Err()
+		}
+	}
+}
+
+func (l *requestLimiter) release() {
+	if l.slots != nil {
+		<-l.slots
+	}
+}
+
+// Holds all requests until given time, as requested by NSX via Retry-After header
+func (l *requestLimiter) pauseUntil(until time.Time) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	if until.After(l.pausedUntil) {
+		l.pausedUntil = until
+	}
+}
+
+// Returns delay requested by Retry-After header, or zero if header is absent
+func getRetryAfterDelay(response *http.Response) time.Duration {
+	if response == nil {
+		return 0
+	}
+
+	value := response.Header.Get("Retry-After")
+	if value == "" {
+		return 0
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil {
+		return time.Duration(seconds) * time.Second
+	}
+
+	if date, err := http.ParseTime(value); err == nil {
+		return time.Until(date)
+	}
+
+	log.Printf("[WARNING]: Failed to parse Retry-After header value %s", value)
+	return 0
+}
+
+// Exponential back-off with jitter, in milliseconds. Delay is randomized
+// between minimum delay and exponentially growing cap, limited by maximum delay.
+func getRetryBackoffInterval(attempt uint, min int, max int) int {
+	backoff := math.Max(float64(min), minRetryBackoffInterval) * math.Pow(2, float64(attempt))
+	backoffCap := int(math.Min(float64(max), backoff))
+	if backoffCap <= min {
+		return backoffCap
+	}
+
+	return rand.Intn(backoffCap-min) + min
+}
+
+type limitedTransport struct {
+	limiter *requestLimiter
+	next    http.RoundTripper
+}
+
+func newLimitedTransport(limiter *requestLimiter, next http.RoundTripper) http.RoundTripper {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+
+	return &limitedTransport{
+		limiter: limiter,
+		next:    next,
+	}
+}
+
+func (t *limitedTransport) RoundTrip(request *http.Request) (*http.Response, error) {
+	err := t.limiter.acquire(request.Context())
+	if err != nil {
+		return nil, err
+	}
+	defer t.limiter.release()
+
+	response, err := t.next.RoundTrip(request)
+	if delay := getRetryAfterDelay(response); delay > 0 {
+		log.Printf("[DEBUG]: NSX requested to retry after %v, holding requests", delay)
+		t.limiter.pauseUntil(time.Now().Add(delay))
+	}
+
+	return response, err
+}
+
+// Retries MP requests with the same exponential back-off as policy connector.
+// Retries of MP SDK itself use uniform delay, and are disabled on status codes.
+type retryTransport struct {
+	maxRetries       int
+	minRetryInterval int
+	maxRetryInterval int
+	retryStatusCodes []int
+	next             http.RoundTripper
+}
+
+func newRetryTransport(config commonProviderConfig, next http.RoundTripper) http.RoundTripper {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+
+	return &retryTransport{
+		maxRetries:       config.MaxRetries,
+		minRetryInterval: config.MinRetryInterval,
+		maxRetryInterval: config.MaxRetryInterval,
+		retryStatusCodes: config.RetryStatusCodes,
+		next:             next,
+	}
+}
+
+func (t *retryTransport) shouldRetry(response *http.Response, err error) bool {
+	if err != nil {
+		log.Printf("[DEBUG]: Retrying request due to error")
+		return true
+	}
+
+	for _, code := range t.retryStatusCodes {
+		if response.StatusCode == code {
+			log.Printf("[DEBUG]: Retrying request due to error code %d", code)
+			return true
+		}
+	}
+
+	return false
+}
+
+func (t *retryTransport) RoundTrip(request *http.Request) (*http.Response, error) {
+	// Request body is consumed by each attempt
+	var body []byte
+	if request.Body != nil && request.Body != http.NoBody {
+		var err error
+		body, err = io.ReadAll(request.Body)
+		request.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	ctx := request.Context()
+	for attempt := 0; ; attempt++ {
+		attemptRequest := request
+		if body != nil {
+			attemptRequest = request.Clone(ctx)
+			attemptRequest.Body = io.NopCloser(bytes.NewReader(body))
+		}
+
+		response, err := t.next.RoundTrip(attemptRequest)
+		if attempt >= t.maxRetries || ctx.Err() != nil || !t.shouldRetry(response, err) {
+			return response, err
+		}
+		if response != nil {
+			io.Copy(io.Discard, response.Body)
+			response.Body.Close()
+		}
+
+		if t.maxRetryInterval > 0 {
+			interval := getRetryBackoffInterval(uint(attempt), t.minRetryInterval, t.maxRetryInterval)
+			select {
+			case <-ctx.Done():
+				log.Printf("[DEBUG]: Operation cancelled, not retrying")
+				return nil, ctx.Err()
+			case <-time.After(time.Duration(interval) * time.Millisecond):
+				log.Printf("[DEBUG]: Waited %d ms before retrying", interval)
+			}
+		}
+	}
+}
@@ -0,0 +1,171 @@
+/* Copyright © 2023 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestUnitRequestLimiterConcurrency(t *testing.T) {
+	limiter := newRequestLimiter(2, 0)
+
+	var current, peak int32
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := limiter.acquire(context.Background()); err != nil {
+				t.Errorf("Failed to acquire limiter: %v", err)
+				return
+			}
+			defer limiter.release()
+
+			value := atomic.AddInt32(&current, 1)
+			for {
+				old := atomic.LoadInt32(&peak)
+				if value <= old || atomic.CompareAndSwapInt32(&peak, old, value) {
+					break
+				}
+			}
+			time.Sleep(10 * time.Millisecond)
+			atomic.AddInt32(&current, -1)
+		}()
+	}
+	wg.Wait()
+
+	if peak != 2 {
+		t.Errorf("Expected 2 concurrent requests at most, got %d", peak)
+	}
+}
+
+func TestUnitRequestLimiterRate(t *testing.T) {
+	limiter := newRequestLimiter(0, 20)
+
+	// First 20 requests are allowed right away, next 10 take half a second
+	start := time.Now()
+	for i := 0; i < 30; i++ {
+		if err := limiter.acquire(context.Background()); err != nil {
+			t.Fatalf("Failed to acquire limiter: %v", err)
+		}
+		limiter.release()
+	}
+	elapsed := time.Since(start)
+
+	if elapsed < 400*time.Millisecond || elapsed > 2*time.Second {
+		t.Errorf("Expected 30 requests at rate 20/s to take about 500ms, took %v", elapsed)
+	}
+}
+
+func TestUnitRequestLimiterCancel(t *testing.T) {
+	limiter := newRequestLimiter(1, 0)
+	if err := limiter.acquire(context.Background()); err != nil {
+		t.Fatalf("Failed to acquire limiter: %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if err := limiter.acquire(ctx); err != context.DeadlineExceeded {
+		t.Errorf("Expected acquire to time out, got %v", err)
+	}
+
+	limiter.release()
+	if err := limiter.acquire(context.Background()); err != nil {
+		t.Errorf("Failed to acquire released limiter: %v", err)
+	}
+}
+
+func TestUnitRequestLimiterPause(t *testing.T) {
+	limiter := newRequestLimiter(0, 0)
+	limiter.pauseUntil(time.Now().Add(50 * time.Millisecond))
+
+	start := time.Now()
+	if err := limiter.acquire(context.Background()); err != nil {
+		t.Fatalf("Failed to acquire limiter: %v", err)
+	}
+	if elapsed := time.Since(start); elapsed < 40*time.Millisecond {
+		t.Errorf("Expected request to be held during pause, took %v", elapsed)
+	}
+}
+
+func TestUnitGetRetryBackoffInterval(t *testing.T) {
+	min := 100
+	max := 1000
+	for attempt := uint(0); attempt < 10; attempt++ {
+		backoffCap := min << attempt
+		if backoffCap > max {
+			backoffCap = max
+		}
+		for i := 0; i < 20; i++ {
+			interval := getRetryBackoffInterval(attempt, min, max)
+			if interval < min || interval > backoffCap {
+				t.Errorf("Expected interval for attempt %d to be within [%d, %d], got %d", attempt, min, backoffCap, interval)
+			}
+		}
+	}
+
+	// Minimal back-off applies when minimal interval is not configured
+	if interval := getRetryBackoffInterval(3, 0, 100); interval > 100 {
+		t.Errorf("Expected interval to be capped by maximum, got %d", interval)
+	}
+
+	if interval := getRetryBackoffInterval(0, 500, 200); interval != 200 {
+		t.Errorf("Expected interval to equal maximum when it is below minimum, got %d", interval)
+	}
+}
+
+func TestUnitRetryTransport(t *testing.T) {
+	var attempts int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		if string(body) != "payload" {
+			t.Errorf("Expected request body to be replayed, got %s", body)
+		}
+		if atomic.AddInt32(&attempts, 1) < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	config := commonProviderConfig{
+		MaxRetries:       4,
+		MinRetryInterval: 1,
+		MaxRetryInterval: 10,
+		RetryStatusCodes: []int{http.StatusServiceUnavailable},
+	}
+	client := http.Client{Transport: newRetryTransport(config, nil)}
+	response, err := client.Post(server.URL, "text/plain", strings.NewReader("payload"))
+	if err != nil {
+		t.Fatalf("Request failed: %v", err)
+	}
+	response.Body.Close()
+
+	if response.StatusCode != http.StatusOK || attempts != 3 {
+		t.Errorf("Expected success on third attempt, got status %d after %d attempts", response.StatusCode, attempts)
+	}
+
+	// Retries are limited
+	atomic.StoreInt32(&attempts, -10)
+	config.MaxRetries = 2
+	client = http.Client{Transport: newRetryTransport(config, nil)}
+	response, err = client.Post(server.URL, "text/plain", strings.NewReader("payload"))
+	if err != nil {
+		t.Fatalf("Request failed: %v", err)
+	}
+	response.Body.Close()
+
+	if response.StatusCode != http.StatusServiceUnavailable || attempts != -7 {
+		t.Errorf("Expected failure after 3 attempts, got status %d", response.StatusCode)
+	}
+}
@@ -4,10 +4,11 @@
 package nsxt
 
 import (
-	"fmt"
+	"context"
 	"log"
 	"net/http"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/vmware/go-vmware-nsxt/manager"
@@ -17,12 +18,12 @@ var algTypeValues = []string{"ORACLE_TNS", "FTP", "SUN_RPC_TCP", "SUN_RPC_UDP",
 
 func resourceNsxtAlgorithmTypeNsService() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtAlgorithmTypeNsServiceCreate,
-		Read:   resourceNsxtAlgorithmTypeNsServiceRead,
-		Update: resourceNsxtAlgorithmTypeNsServiceUpdate,
-		Delete: resourceNsxtAlgorithmTypeNsServiceDelete,
+		CreateContext: resourceNsxtAlgorithmTypeNsServiceCreate,
+		ReadContext:   resourceNsxtAlgorithmTypeNsServiceRead,
+		UpdateContext: resourceNsxtAlgorithmTypeNsServiceUpdate,
+		DeleteContext: resourceNsxtAlgorithmTypeNsServiceDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		DeprecationMessage: mpObjectResourceDeprecationMessage,
 		Schema: map[string]*schema.Schema{
@@ -69,10 +70,10 @@ func resourceNsxtAlgorithmTypeNsService() *schema.Resource {
 	}
 }
 
-func resourceNsxtAlgorithmTypeNsServiceCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtAlgorithmTypeNsServiceCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return getErrorDiagnostics(resourceNotSupportedError())
 	}
 
 	description := d.Get("description").(string)
@@ -100,25 +101,25 @@ func resourceNsxtAlgorithmTypeNsServiceCreate(d *schema.ResourceData, m interfac
 	nsService, resp, err := nsxClient.GroupingObjectsApi.CreateAlgTypeNSService(nsxClient.Context, nsService)
 
 	if err != nil {
-		return fmt.Errorf("Error during NsService create: %v", err)
+		return diag.Errorf("Error during NsService create: %v", err)
 	}
 
 	if resp.StatusCode != http.StatusCreated {
-		return fmt.Errorf("Unexpected status returned during NsService create: %v", resp.StatusCode)
+		return diag.Errorf("Unexpected status returned during NsService create: %v", resp.StatusCode)
 	}
 	d.SetId(nsService.Id)
-	return resourceNsxtAlgorithmTypeNsServiceRead(d, m)
+	return resourceNsxtAlgorithmTypeNsServiceRead(ctx, d, m)
 }
 
-func resourceNsxtAlgorithmTypeNsServiceRead(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtAlgorithmTypeNsServiceRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return getErrorDiagnostics(resourceNotSupportedError())
 	}
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining ns service id")
+		return diag.Errorf("Error obtaining ns service id")
 	}
 
 	nsService, resp, err := nsxClient.GroupingObjectsApi.ReadAlgTypeNSService(nsxClient.Context, id)
@@ -128,7 +129,7 @@ func resourceNsxtAlgorithmTypeNsServiceRead(d *schema.ResourceData, m interface{
 		return nil
 	}
 	if err != nil {
-		return fmt.Errorf("Error during NsService read: %v", err)
+		return diag.Errorf("Error during NsService read: %v", err)
 	}
 
 	nsserviceElement := nsService.NsserviceElement
@@ -145,15 +146,15 @@ func resourceNsxtAlgorithmTypeNsServiceRead(d *schema.ResourceData, m interface{
 	return nil
 }
 
-func resourceNsxtAlgorithmTypeNsServiceUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtAlgorithmTypeNsServiceUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return getErrorDiagnostics(resourceNotSupportedError())
 	}
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining ns service id")
+		return diag.Errorf("Error obtaining ns service id")
 	}
 
 	description := d.Get("description").(string)
@@ -182,28 +183,28 @@ func resourceNsxtAlgorithmTypeNsServiceUpdate(d *schema.ResourceData, m interfac
 
 	_, resp, err := nsxClient.GroupingObjectsApi.UpdateAlgTypeNSService(nsxClient.Context, id, nsService)
 	if err != nil || resp.StatusCode == http.StatusNotFound {
-		return fmt.Errorf("Error during NsService update: %v %v", err, resp)
+		return diag.Errorf("Error during NsService update: %v %v", err, resp)
 	}
 
-	return resourceNsxtAlgorithmTypeNsServiceRead(d, m)
+	return resourceNsxtAlgorithmTypeNsServiceRead(ctx, d, m)
 }
 
-func resourceNsxtAlgorithmTypeNsServiceDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtAlgorithmTypeNsServiceDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return getErrorDiagnostics(resourceNotSupportedError())
 	}
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining ns service id")
+		return diag.Errorf("Error obtaining ns service id")
 	}
 
 	localVarOptionals := make(map[string]interface{})
 	localVarOptionals["force"] = true
 	resp, err := nsxClient.GroupingObjectsApi.DeleteNSService(nsxClient.Context, id, localVarOptionals)
 	if err != nil {
-		return fmt.Errorf("Error during NsService delete: %v", err)
+		return diag.Errorf("Error during NsService delete: %v", err)
 	}
 
 	if resp.StatusCode == http.StatusNotFound {
@@ -4,22 +4,23 @@
 package nsxt
 
 import (
-	"fmt"
+	"context"
 	"log"
 	"net/http"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/vmware/go-vmware-nsxt/manager"
 )
 
 func resourceNsxtDhcpRelayService() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtDhcpRelayServiceCreate,
-		Read:   resourceNsxtDhcpRelayServiceRead,
-		Update: resourceNsxtDhcpRelayServiceUpdate,
-		Delete: resourceNsxtDhcpRelayServiceDelete,
+		CreateContext: resourceNsxtDhcpRelayServiceCreate,
+		ReadContext:   resourceNsxtDhcpRelayServiceRead,
+		UpdateContext: resourceNsxtDhcpRelayServiceUpdate,
+		DeleteContext: resourceNsxtDhcpRelayServiceDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		DeprecationMessage: mpObjectResourceDeprecationMessage,
 		Schema: map[string]*schema.Schema{
@@ -45,10 +46,10 @@ func resourceNsxtDhcpRelayService() *schema.Resource {
 	}
 }
 
-func resourceNsxtDhcpRelayServiceCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtDhcpRelayServiceCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return getErrorDiagnostics(resourceNotSupportedError())
 	}
 
 	description := d.Get("description").(string)
@@ -65,26 +66,26 @@ func resourceNsxtDhcpRelayServiceCreate(d *schema.ResourceData, m interface{}) e
 	dhcpRelayService, resp, err := nsxClient.LogicalRoutingAndServicesApi.CreateDhcpRelay(nsxClient.Context, dhcpRelayService)
 
 	if err != nil {
-		return fmt.Errorf("Error during DhcpRelayService create: %v", err)
+		return diag.Errorf("Error during DhcpRelayService create: %v", err)
 	}
 
 	if resp.StatusCode != http.StatusCreated {
-		return fmt.Errorf("Unexpected status returned during DhcpRelayService create: %v", resp.StatusCode)
+		return diag.Errorf("Unexpected status returned during DhcpRelayService create: %v", resp.StatusCode)
 	}
 	d.SetId(dhcpRelayService.Id)
 
-	return resourceNsxtDhcpRelayServiceRead(d, m)
+	return resourceNsxtDhcpRelayServiceRead(ctx, d, m)
 }
 
-func resourceNsxtDhcpRelayServiceRead(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtDhcpRelayServiceRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return getErrorDiagnostics(resourceNotSupportedError())
 	}
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining dhcp relay service id")
+		return diag.Errorf("Error obtaining dhcp relay service id")
 	}
 
 	dhcpRelayService, resp, err := nsxClient.LogicalRoutingAndServicesApi.ReadDhcpRelay(nsxClient.Context, id)
@@ -94,7 +95,7 @@ func resourceNsxtDhcpRelayServiceRead(d *schema.ResourceData, m interface{}) err
 		return nil
 	}
 	if err != nil {
-		return fmt.Errorf("Error during DhcpRelayService read: %v", err)
+		return diag.Errorf("Error during DhcpRelayService read: %v", err)
 	}
 
 	d.Set("revision", dhcpRelayService.Revision)
@@ -106,15 +107,15 @@ func resourceNsxtDhcpRelayServiceRead(d *schema.ResourceData, m interface{}) err
 	return nil
 }
 
-func resourceNsxtDhcpRelayServiceUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtDhcpRelayServiceUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return getErrorDiagnostics(resourceNotSupportedError())
 	}
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining dhcp relay service id")
+		return diag.Errorf("Error obtaining dhcp relay service id")
 	}
 
 	revision := int64(d.Get("revision").(int))
@@ -133,26 +134,26 @@ func resourceNsxtDhcpRelayServiceUpdate(d *schema.ResourceData, m interface{}) e
 	_, resp, err := nsxClient.LogicalRoutingAndServicesApi.UpdateDhcpRelay(nsxClient.Context, id, dhcpRelayService)
 
 	if err != nil || resp.StatusCode == http.StatusNotFound {
-		return fmt.Errorf("Error during DhcpRelayService update: %v", err)
+		return diag.Errorf("Error during DhcpRelayService update: %v", err)
 	}
 
-	return resourceNsxtDhcpRelayServiceRead(d, m)
+	return resourceNsxtDhcpRelayServiceRead(ctx, d, m)
 }
 
-func resourceNsxtDhcpRelayServiceDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtDhcpRelayServiceDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return getErrorDiagnostics(resourceNotSupportedError())
 	}
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining dhcp relay service id")
+		return diag.Errorf("Error obtaining dhcp relay service id")
 	}
 
 	resp, err := nsxClient.LogicalRoutingAndServicesApi.DeleteDhcpRelay(nsxClient.Context, id)
 	if err != nil {
-		return fmt.Errorf("Error during DhcpRelayService delete: %v", err)
+		return diag.Errorf("Error during DhcpRelayService delete: %v", err)
 	}
 
 	if resp.StatusCode == http.StatusNotFound {
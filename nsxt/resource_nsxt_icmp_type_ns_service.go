@@ -4,10 +4,11 @@
 package nsxt
 
 import (
-	"fmt"
+	"context"
 	"log"
 	"net/http"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/vmware/go-vmware-nsxt/manager"
@@ -17,12 +18,12 @@ var icmpProtocolValues = []string{"ICMPv4", "ICMPv6"}
 
 func resourceNsxtIcmpTypeNsService() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtIcmpTypeNsServiceCreate,
-		Read:   resourceNsxtIcmpTypeNsServiceRead,
-		Update: resourceNsxtIcmpTypeNsServiceUpdate,
-		Delete: resourceNsxtIcmpTypeNsServiceDelete,
+		CreateContext: resourceNsxtIcmpTypeNsServiceCreate,
+		ReadContext:   resourceNsxtIcmpTypeNsServiceRead,
+		UpdateContext: resourceNsxtIcmpTypeNsServiceUpdate,
+		DeleteContext: resourceNsxtIcmpTypeNsServiceDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		DeprecationMessage: mpObjectResourceDeprecationMessage,
 		Schema: map[string]*schema.Schema{
@@ -66,10 +67,10 @@ func resourceNsxtIcmpTypeNsService() *schema.Resource {
 	}
 }
 
-func resourceNsxtIcmpTypeNsServiceCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtIcmpTypeNsServiceCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return getErrorDiagnostics(resourceNotSupportedError())
 	}
 
 	description := d.Get("description").(string)
@@ -95,30 +96,30 @@ func resourceNsxtIcmpTypeNsServiceCreate(d *schema.ResourceData, m interface{})
 
 	nsService, resp, err := nsxClient.GroupingObjectsApi.CreateIcmpTypeNSService(nsxClient.Context, nsService)
 	if resp != nil && resp.StatusCode != http.StatusCreated {
-		return fmt.Errorf("Unexpected status returned during NsService create: %v", resp.StatusCode)
+		return diag.Errorf("Unexpected status returned during NsService create: %v", resp.StatusCode)
 	}
 	if err != nil {
-		return fmt.Errorf("Error during NsService create: %v", err)
+		return diag.Errorf("Error during NsService create: %v", err)
 	}
 
 	d.SetId(nsService.Id)
-	return resourceNsxtIcmpTypeNsServiceRead(d, m)
+	return resourceNsxtIcmpTypeNsServiceRead(ctx, d, m)
 }
 
-func resourceNsxtIcmpTypeNsServiceRead(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtIcmpTypeNsServiceRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return getErrorDiagnostics(resourceNotSupportedError())
 	}
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining ns service id")
+		return diag.Errorf("Error obtaining ns service id")
 	}
 
 	nsService, resp, err := nsxClient.GroupingObjectsApi.ReadIcmpTypeNSService(nsxClient.Context, id)
 	if err != nil {
-		return fmt.Errorf("Error during NsService read: %v", err)
+		return diag.Errorf("Error during NsService read: %v", err)
 	}
 	if resp.StatusCode == http.StatusNotFound {
 		log.Printf("[DEBUG] NsService %s not found", id)
@@ -140,15 +141,15 @@ func resourceNsxtIcmpTypeNsServiceRead(d *schema.ResourceData, m interface{}) er
 	return nil
 }
 
-func resourceNsxtIcmpTypeNsServiceUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtIcmpTypeNsServiceUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return getErrorDiagnostics(resourceNotSupportedError())
 	}
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining ns service id")
+		return diag.Errorf("Error obtaining ns service id")
 	}
 
 	description := d.Get("description").(string)
@@ -176,28 +177,28 @@ func resourceNsxtIcmpTypeNsServiceUpdate(d *schema.ResourceData, m interface{})
 
 	_, resp, err := nsxClient.GroupingObjectsApi.UpdateIcmpTypeNSService(nsxClient.Context, id, nsService)
 	if err != nil || resp.StatusCode == http.StatusNotFound {
-		return fmt.Errorf("Error during NsService update: %v %v", err, resp)
+		return diag.Errorf("Error during NsService update: %v %v", err, resp)
 	}
 
-	return resourceNsxtIcmpTypeNsServiceRead(d, m)
+	return resourceNsxtIcmpTypeNsServiceRead(ctx, d, m)
 }
 
-func resourceNsxtIcmpTypeNsServiceDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtIcmpTypeNsServiceDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return getErrorDiagnostics(resourceNotSupportedError())
 	}
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining ns service id")
+		return diag.Errorf("Error obtaining ns service id")
 	}
 
 	localVarOptionals := make(map[string]interface{})
 	localVarOptionals["force"] = true
 	resp, err := nsxClient.GroupingObjectsApi.DeleteNSService(nsxClient.Context, id, localVarOptionals)
 	if err != nil {
-		return fmt.Errorf("Error during NsService delete: %v", err)
+		return diag.Errorf("Error during NsService delete: %v", err)
 	}
 
 	if resp.StatusCode == http.StatusNotFound {
@@ -4,22 +4,23 @@
 package nsxt
 
 import (
-	"fmt"
+	"context"
 	"log"
 	"net/http"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/vmware/go-vmware-nsxt/manager"
 )
 
 func resourceNsxtIgmpTypeNsService() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtIgmpTypeNsServiceCreate,
-		Read:   resourceNsxtIgmpTypeNsServiceRead,
-		Update: resourceNsxtIgmpTypeNsServiceUpdate,
-		Delete: resourceNsxtIgmpTypeNsServiceDelete,
+		CreateContext: resourceNsxtIgmpTypeNsServiceCreate,
+		ReadContext:   resourceNsxtIgmpTypeNsServiceRead,
+		UpdateContext: resourceNsxtIgmpTypeNsServiceUpdate,
+		DeleteContext: resourceNsxtIgmpTypeNsServiceDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		DeprecationMessage: mpObjectResourceDeprecationMessage,
 		Schema: map[string]*schema.Schema{
@@ -45,10 +46,10 @@ func resourceNsxtIgmpTypeNsService() *schema.Resource {
 	}
 }
 
-func resourceNsxtIgmpTypeNsServiceCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtIgmpTypeNsServiceCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return getErrorDiagnostics(resourceNotSupportedError())
 	}
 
 	description := d.Get("description").(string)
@@ -69,25 +70,25 @@ func resourceNsxtIgmpTypeNsServiceCreate(d *schema.ResourceData, m interface{})
 	nsService, resp, err := nsxClient.GroupingObjectsApi.CreateIgmpTypeNSService(nsxClient.Context, nsService)
 
 	if err != nil {
-		return fmt.Errorf("Error during NsService create: %v", err)
+		return diag.Errorf("Error during NsService create: %v", err)
 	}
 
 	if resp.StatusCode != http.StatusCreated {
-		return fmt.Errorf("Unexpected status returned during NsService create: %v", resp.StatusCode)
+		return diag.Errorf("Unexpected status returned during NsService create: %v", resp.StatusCode)
 	}
 	d.SetId(nsService.Id)
-	return resourceNsxtIgmpTypeNsServiceRead(d, m)
+	return resourceNsxtIgmpTypeNsServiceRead(ctx, d, m)
 }
 
-func resourceNsxtIgmpTypeNsServiceRead(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtIgmpTypeNsServiceRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return getErrorDiagnostics(resourceNotSupportedError())
 	}
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining ns service id")
+		return diag.Errorf("Error obtaining ns service id")
 	}
 
 	nsService, resp, err := nsxClient.GroupingObjectsApi.ReadIgmpTypeNSService(nsxClient.Context, id)
@@ -97,7 +98,7 @@ func resourceNsxtIgmpTypeNsServiceRead(d *schema.ResourceData, m interface{}) er
 		return nil
 	}
 	if err != nil {
-		return fmt.Errorf("Error during NsService read: %v", err)
+		return diag.Errorf("Error during NsService read: %v", err)
 	}
 
 	d.Set("revision", nsService.Revision)
@@ -109,15 +110,15 @@ func resourceNsxtIgmpTypeNsServiceRead(d *schema.ResourceData, m interface{}) er
 	return nil
 }
 
-func resourceNsxtIgmpTypeNsServiceUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtIgmpTypeNsServiceUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return getErrorDiagnostics(resourceNotSupportedError())
 	}
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining ns service id")
+		return diag.Errorf("Error obtaining ns service id")
 	}
 
 	description := d.Get("description").(string)
@@ -139,28 +140,28 @@ func resourceNsxtIgmpTypeNsServiceUpdate(d *schema.ResourceData, m interface{})
 
 	_, resp, err := nsxClient.GroupingObjectsApi.UpdateIgmpTypeNSService(nsxClient.Context, id, nsService)
 	if err != nil || resp.StatusCode == http.StatusNotFound {
-		return fmt.Errorf("Error during NsService update: %v %v", err, resp)
+		return diag.Errorf("Error during NsService update: %v %v", err, resp)
 	}
 
-	return resourceNsxtIgmpTypeNsServiceRead(d, m)
+	return resourceNsxtIgmpTypeNsServiceRead(ctx, d, m)
 }
 
-func resourceNsxtIgmpTypeNsServiceDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtIgmpTypeNsServiceDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return getErrorDiagnostics(resourceNotSupportedError())
 	}
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining ns service id")
+		return diag.Errorf("Error obtaining ns service id")
 	}
 
 	localVarOptionals := make(map[string]interface{})
 	localVarOptionals["force"] = true
 	resp, err := nsxClient.GroupingObjectsApi.DeleteNSService(nsxClient.Context, id, localVarOptionals)
 	if err != nil {
-		return fmt.Errorf("Error during NsService delete: %v", err)
+		return diag.Errorf("Error during NsService delete: %v", err)
 	}
 
 	if resp.StatusCode == http.StatusNotFound {
@@ -4,10 +4,11 @@
 package nsxt
 
 import (
-	"fmt"
+	"context"
 	"log"
 	"net/http"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/vmware/go-vmware-nsxt/manager"
@@ -15,12 +16,12 @@ import (
 
 func resourceNsxtIPDiscoverySwitchingProfile() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtIPDiscoverySwitchingProfileCreate,
-		Read:   resourceNsxtIPDiscoverySwitchingProfileRead,
-		Update: resourceNsxtIPDiscoverySwitchingProfileUpdate,
-		Delete: resourceNsxtIPDiscoverySwitchingProfileDelete,
+		CreateContext: resourceNsxtIPDiscoverySwitchingProfileCreate,
+		ReadContext:   resourceNsxtIPDiscoverySwitchingProfileRead,
+		UpdateContext: resourceNsxtIPDiscoverySwitchingProfileUpdate,
+		DeleteContext: resourceNsxtIPDiscoverySwitchingProfileDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		DeprecationMessage: mpObjectResourceDeprecationMessage,
 		Schema: map[string]*schema.Schema{
@@ -66,10 +67,10 @@ func resourceNsxtIPDiscoverySwitchingProfile() *schema.Resource {
 	}
 }
 
-func resourceNsxtIPDiscoverySwitchingProfileCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtIPDiscoverySwitchingProfileCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return getErrorDiagnostics(resourceNotSupportedError())
 	}
 
 	description := d.Get("description").(string)
@@ -93,26 +94,26 @@ func resourceNsxtIPDiscoverySwitchingProfileCreate(d *schema.ResourceData, m int
 	switchingProfile, resp, err := nsxClient.LogicalSwitchingApi.CreateIpDiscoverySwitchingProfile(nsxClient.Context, switchingProfile)
 
 	if err != nil {
-		return fmt.Errorf("Error during IPDiscoverySwitchingProfile create: %v", err)
+		return diag.Errorf("Error during IPDiscoverySwitchingProfile create: %v", err)
 	}
 
 	if resp.StatusCode != http.StatusCreated {
-		return fmt.Errorf("Unexpected status returned during IPDiscoverySwitchingProfile create: %v", resp.StatusCode)
+		return diag.Errorf("Unexpected status returned during IPDiscoverySwitchingProfile create: %v", resp.StatusCode)
 	}
 	d.SetId(switchingProfile.Id)
 
-	return resourceNsxtIPDiscoverySwitchingProfileRead(d, m)
+	return resourceNsxtIPDiscoverySwitchingProfileRead(ctx, d, m)
 }
 
-func resourceNsxtIPDiscoverySwitchingProfileRead(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtIPDiscoverySwitchingProfileRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return getErrorDiagnostics(resourceNotSupportedError())
 	}
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining logical object id")
+		return diag.Errorf("Error obtaining logical object id")
 	}
 
 	switchingProfile, resp, err := nsxClient.LogicalSwitchingApi.GetIpDiscoverySwitchingProfile(nsxClient.Context, id)
@@ -122,7 +123,7 @@ func resourceNsxtIPDiscoverySwitchingProfileRead(d *schema.ResourceData, m inter
 		return nil
 	}
 	if err != nil {
-		return fmt.Errorf("Error during IPDiscoverySwitchingProfile read: %v", err)
+		return diag.Errorf("Error during IPDiscoverySwitchingProfile read: %v", err)
 	}
 
 	d.Set("revision", switchingProfile.Revision)
@@ -137,15 +138,15 @@ func resourceNsxtIPDiscoverySwitchingProfileRead(d *schema.ResourceData, m inter
 	return nil
 }
 
-func resourceNsxtIPDiscoverySwitchingProfileUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtIPDiscoverySwitchingProfileUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return getErrorDiagnostics(resourceNotSupportedError())
 	}
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining logical object id")
+		return diag.Errorf("Error obtaining logical object id")
 	}
 
 	description := d.Get("description").(string)
@@ -171,26 +172,26 @@ func resourceNsxtIPDiscoverySwitchingProfileUpdate(d *schema.ResourceData, m int
 	_, resp, err := nsxClient.LogicalSwitchingApi.UpdateIpDiscoverySwitchingProfile(nsxClient.Context, id, switchingProfile)
 
 	if err != nil || resp.StatusCode == http.StatusNotFound {
-		return fmt.Errorf("Error during IPDiscoverySwitchingProfile update: %v", err)
+		return diag.Errorf("Error during IPDiscoverySwitchingProfile update: %v", err)
 	}
 
-	return resourceNsxtIPDiscoverySwitchingProfileRead(d, m)
+	return resourceNsxtIPDiscoverySwitchingProfileRead(ctx, d, m)
 }
 
-func resourceNsxtIPDiscoverySwitchingProfileDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtIPDiscoverySwitchingProfileDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return getErrorDiagnostics(resourceNotSupportedError())
 	}
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining logical object id")
+		return diag.Errorf("Error obtaining logical object id")
 	}
 
 	resp, err := nsxClient.LogicalSwitchingApi.DeleteSwitchingProfile(nsxClient.Context, id, nil)
 	if err != nil {
-		return fmt.Errorf("Error during IPDiscoverySwitchingProfile delete: %v", err)
+		return diag.Errorf("Error during IPDiscoverySwitchingProfile delete: %v", err)
 	}
 
 	if resp.StatusCode == http.StatusNotFound {
@@ -4,23 +4,25 @@
 package nsxt
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"net/http"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/vmware/go-vmware-nsxt/manager"
 )
 
 func resourceNsxtIPPool() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtIPPoolCreate,
-		Read:   resourceNsxtIPPoolRead,
-		Update: resourceNsxtIPPoolUpdate,
-		Delete: resourceNsxtIPPoolDelete,
+		CreateContext: resourceNsxtIPPoolCreate,
+		ReadContext:   resourceNsxtIPPoolRead,
+		UpdateContext: resourceNsxtIPPoolUpdate,
+		DeleteContext: resourceNsxtIPPoolDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		DeprecationMessage: mpObjectResourceDeprecationMessage,
 		Schema: map[string]*schema.Schema{
@@ -149,10 +151,10 @@ func setSubnetsInSchema(d *schema.ResourceData, subnets []manager.IpPoolSubnet)
 	return err
 }
 
-func resourceNsxtIPPoolCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtIPPoolCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return getErrorDiagnostics(resourceNotSupportedError())
 	}
 
 	displayName := d.Get("display_name").(string)
@@ -169,26 +171,26 @@ func resourceNsxtIPPoolCreate(d *schema.ResourceData, m interface{}) error {
 	ipPool, resp, err := nsxClient.PoolManagementApi.CreateIpPool(nsxClient.Context, ipPool)
 
 	if err != nil {
-		return fmt.Errorf("Error during IpPool create: %v", err)
+		return diag.Errorf("Error during IpPool create: %v", err)
 	}
 
 	if resp.StatusCode != http.StatusCreated {
-		return fmt.Errorf("Unexpected status returned during IpPool create: %v", resp.StatusCode)
+		return diag.Errorf("Unexpected status returned during IpPool create: %v", resp.StatusCode)
 	}
 	d.SetId(ipPool.Id)
 
-	return resourceNsxtIPPoolRead(d, m)
+	return resourceNsxtIPPoolRead(ctx, d, m)
 }
 
-func resourceNsxtIPPoolRead(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtIPPoolRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return getErrorDiagnostics(resourceNotSupportedError())
 	}
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining logical object id")
+		return diag.Errorf("Error obtaining logical object id")
 	}
 
 	ipPool, resp, err := nsxClient.PoolManagementApi.ReadIpPool(nsxClient.Context, id)
@@ -198,7 +200,7 @@ func resourceNsxtIPPoolRead(d *schema.ResourceData, m interface{}) error {
 		return nil
 	}
 	if err != nil {
-		return fmt.Errorf("Error during IpPool read: %v", err)
+		return diag.Errorf("Error during IpPool read: %v", err)
 	}
 
 	d.Set("display_name", ipPool.DisplayName)
@@ -207,21 +209,21 @@ func resourceNsxtIPPoolRead(d *schema.ResourceData, m interface{}) error {
 	setTagsInSchema(d, ipPool.Tags)
 	err = setSubnetsInSchema(d, ipPool.Subnets)
 	if err != nil {
-		return fmt.Errorf("Error during IpPool set in schema: %v", err)
+		return diag.Errorf("Error during IpPool set in schema: %v", err)
 	}
 
 	return nil
 }
 
-func resourceNsxtIPPoolUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtIPPoolUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return getErrorDiagnostics(resourceNotSupportedError())
 	}
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining logical object id")
+		return diag.Errorf("Error obtaining logical object id")
 	}
 
 	displayName := d.Get("display_name").(string)
@@ -240,27 +242,27 @@ func resourceNsxtIPPoolUpdate(d *schema.ResourceData, m interface{}) error {
 	_, resp, err := nsxClient.PoolManagementApi.UpdateIpPool(nsxClient.Context, id, ipPool)
 
 	if err != nil || resp.StatusCode == http.StatusNotFound {
-		return fmt.Errorf("Error during IpPool update: %v", err)
+		return diag.Errorf("Error during IpPool update: %v", err)
 	}
 
-	return resourceNsxtIPPoolRead(d, m)
+	return resourceNsxtIPPoolRead(ctx, d, m)
 }
 
-func resourceNsxtIPPoolDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtIPPoolDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return getErrorDiagnostics(resourceNotSupportedError())
 	}
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining logical object id")
+		return diag.Errorf("Error obtaining logical object id")
 	}
 
 	localVarOptionals := make(map[string]interface{})
 	resp, err := nsxClient.PoolManagementApi.DeleteIpPool(nsxClient.Context, id, localVarOptionals)
 	if err != nil {
-		return fmt.Errorf("Error during IpPool delete: %v", err)
+		return diag.Errorf("Error during IpPool delete: %v", err)
 	}
 
 	if resp.StatusCode == http.StatusNotFound {
@@ -4,10 +4,11 @@
 package nsxt
 
 import (
-	"fmt"
+	"context"
 	"log"
 	"net/http"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/vmware/go-vmware-nsxt/manager"
@@ -15,12 +16,12 @@ import (
 
 func resourceNsxtIPProtocolNsService() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtIPProtocolNsServiceCreate,
-		Read:   resourceNsxtIPProtocolNsServiceRead,
-		Update: resourceNsxtIPProtocolNsServiceUpdate,
-		Delete: resourceNsxtIPProtocolNsServiceDelete,
+		CreateContext: resourceNsxtIPProtocolNsServiceCreate,
+		ReadContext:   resourceNsxtIPProtocolNsServiceRead,
+		UpdateContext: resourceNsxtIPProtocolNsServiceUpdate,
+		DeleteContext: resourceNsxtIPProtocolNsServiceDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		DeprecationMessage: mpObjectResourceDeprecationMessage,
 		Schema: map[string]*schema.Schema{
@@ -52,10 +53,10 @@ func resourceNsxtIPProtocolNsService() *schema.Resource {
 	}
 }
 
-func resourceNsxtIPProtocolNsServiceCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtIPProtocolNsServiceCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return getErrorDiagnostics(resourceNotSupportedError())
 	}
 
 	description := d.Get("description").(string)
@@ -78,25 +79,25 @@ func resourceNsxtIPProtocolNsServiceCreate(d *schema.ResourceData, m interface{}
 	nsService, resp, err := nsxClient.GroupingObjectsApi.CreateIpProtocolNSService(nsxClient.Context, nsService)
 
 	if err != nil {
-		return fmt.Errorf("Error during NsService create: %v", err)
+		return diag.Errorf("Error during NsService create: %v", err)
 	}
 
 	if resp.StatusCode != http.StatusCreated {
-		return fmt.Errorf("Unexpected status returned during NsService create: %v", resp.StatusCode)
+		return diag.Errorf("Unexpected status returned during NsService create: %v", resp.StatusCode)
 	}
 	d.SetId(nsService.Id)
-	return resourceNsxtIPProtocolNsServiceRead(d, m)
+	return resourceNsxtIPProtocolNsServiceRead(ctx, d, m)
 }
 
-func resourceNsxtIPProtocolNsServiceRead(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtIPProtocolNsServiceRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return getErrorDiagnostics(resourceNotSupportedError())
 	}
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining ns service id")
+		return diag.Errorf("Error obtaining ns service id")
 	}
 
 	nsService, resp, err := nsxClient.GroupingObjectsApi.ReadIpProtocolNSService(nsxClient.Context, id)
@@ -106,7 +107,7 @@ func resourceNsxtIPProtocolNsServiceRead(d *schema.ResourceData, m interface{})
 		return nil
 	}
 	if err != nil {
-		return fmt.Errorf("Error during NsService read: %v", err)
+		return diag.Errorf("Error during NsService read: %v", err)
 	}
 
 	nsserviceElement := nsService.NsserviceElement
@@ -121,15 +122,15 @@ func resourceNsxtIPProtocolNsServiceRead(d *schema.ResourceData, m interface{})
 	return nil
 }
 
-func resourceNsxtIPProtocolNsServiceUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtIPProtocolNsServiceUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return getErrorDiagnostics(resourceNotSupportedError())
 	}
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining ns service id")
+		return diag.Errorf("Error obtaining ns service id")
 	}
 
 	description := d.Get("description").(string)
@@ -153,28 +154,28 @@ func resourceNsxtIPProtocolNsServiceUpdate(d *schema.ResourceData, m interface{}
 
 	_, resp, err := nsxClient.GroupingObjectsApi.UpdateIpProtocolNSService(nsxClient.Context, id, nsService)
 	if err != nil || resp.StatusCode == http.StatusNotFound {
-		return fmt.Errorf("Error during NsService update: %v %v", err, resp)
+		return diag.Errorf("Error during NsService update: %v %v", err, resp)
 	}
 
-	return resourceNsxtIPProtocolNsServiceRead(d, m)
+	return resourceNsxtIPProtocolNsServiceRead(ctx, d, m)
 }
 
-func resourceNsxtIPProtocolNsServiceDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtIPProtocolNsServiceDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return getErrorDiagnostics(resourceNotSupportedError())
 	}
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining ns service id")
+		return diag.Errorf("Error obtaining ns service id")
 	}
 
 	localVarOptionals := make(map[string]interface{})
 	localVarOptionals["force"] = true
 	resp, err := nsxClient.GroupingObjectsApi.DeleteNSService(nsxClient.Context, id, localVarOptionals)
 	if err != nil {
-		return fmt.Errorf("Error during NsService delete: %v", err)
+		return diag.Errorf("Error during NsService delete: %v", err)
 	}
 
 	if resp.StatusCode == http.StatusNotFound {
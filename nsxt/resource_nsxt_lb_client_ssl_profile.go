@@ -4,22 +4,23 @@
 package nsxt
 
 import (
-	"fmt"
+	"context"
 	"log"
 	"net/http"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/vmware/go-vmware-nsxt/loadbalancer"
 )
 
 func resourceNsxtLbClientSslProfile() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtLbClientSslProfileCreate,
-		Read:   resourceNsxtLbClientSslProfileRead,
-		Update: resourceNsxtLbClientSslProfileUpdate,
-		Delete: resourceNsxtLbClientSslProfileDelete,
+		CreateContext: resourceNsxtLbClientSslProfileCreate,
+		ReadContext:   resourceNsxtLbClientSslProfileRead,
+		UpdateContext: resourceNsxtLbClientSslProfileUpdate,
+		DeleteContext: resourceNsxtLbClientSslProfileDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		DeprecationMessage: mpObjectResourceDeprecationMessage,
 		Schema: map[string]*schema.Schema{
@@ -61,10 +62,10 @@ func resourceNsxtLbClientSslProfile() *schema.Resource {
 	}
 }
 
-func resourceNsxtLbClientSslProfileCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtLbClientSslProfileCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return getErrorDiagnostics(resourceNotSupportedError())
 	}
 
 	description := d.Get("description").(string)
@@ -89,26 +90,26 @@ func resourceNsxtLbClientSslProfileCreate(d *schema.ResourceData, m interface{})
 	lbClientSslProfile, resp, err := nsxClient.ServicesApi.CreateLoadBalancerClientSslProfile(nsxClient.Context, lbClientSslProfile)
 
 	if err != nil {
-		return fmt.Errorf("Error during LbClientSslProfile create: %v", err)
+		return diag.Errorf("Error during LbClientSslProfile create: %v", err)
 	}
 
 	if resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusOK {
-		return fmt.Errorf("Unexpected status returned during LbClientSslProfile create: %v", resp.StatusCode)
+		return diag.Errorf("Unexpected status returned during LbClientSslProfile create: %v", resp.StatusCode)
 	}
 	d.SetId(lbClientSslProfile.Id)
 
-	return resourceNsxtLbClientSslProfileRead(d, m)
+	return resourceNsxtLbClientSslProfileRead(ctx, d, m)
 }
 
-func resourceNsxtLbClientSslProfileRead(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtLbClientSslProfileRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return getErrorDiagnostics(resourceNotSupportedError())
 	}
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining logical object id")
+		return diag.Errorf("Error obtaining logical object id")
 	}
 
 	lbClientSslProfile, resp, err := nsxClient.ServicesApi.ReadLoadBalancerClientSslProfile(nsxClient.Context, id)
@@ -118,7 +119,7 @@ func resourceNsxtLbClientSslProfileRead(d *schema.ResourceData, m interface{}) e
 		return nil
 	}
 	if err != nil {
-		return fmt.Errorf("Error during LbClientSslProfile read: %v", err)
+		return diag.Errorf("Error during LbClientSslProfile read: %v", err)
 	}
 
 	d.Set("revision", lbClientSslProfile.Revision)
@@ -135,15 +136,15 @@ func resourceNsxtLbClientSslProfileRead(d *schema.ResourceData, m interface{}) e
 	return nil
 }
 
-func resourceNsxtLbClientSslProfileUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtLbClientSslProfileUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return getErrorDiagnostics(resourceNotSupportedError())
 	}
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining logical object id")
+		return diag.Errorf("Error obtaining logical object id")
 	}
 
 	revision := int32(d.Get("revision").(int))
@@ -170,26 +171,26 @@ func resourceNsxtLbClientSslProfileUpdate(d *schema.ResourceData, m interface{})
 	_, resp, err := nsxClient.ServicesApi.UpdateLoadBalancerClientSslProfile(nsxClient.Context, id, lbClientSslProfile)
 
 	if err != nil || resp.StatusCode == http.StatusNotFound {
-		return fmt.Errorf("Error during LbClientSslProfile update: %v", err)
+		return diag.Errorf("Error during LbClientSslProfile update: %v", err)
 	}
 
-	return resourceNsxtLbClientSslProfileRead(d, m)
+	return resourceNsxtLbClientSslProfileRead(ctx, d, m)
 }
 
-func resourceNsxtLbClientSslProfileDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtLbClientSslProfileDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return getErrorDiagnostics(resourceNotSupportedError())
 	}
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining logical object id")
+		return diag.Errorf("Error obtaining logical object id")
 	}
 
 	resp, err := nsxClient.ServicesApi.DeleteLoadBalancerClientSslProfile(nsxClient.Context, id)
 	if err != nil {
-		return fmt.Errorf("Error during LbClientSslProfile delete: %v", err)
+		return diag.Errorf("Error during LbClientSslProfile delete: %v", err)
 	}
 
 	if resp.StatusCode == http.StatusNotFound {
@@ -4,10 +4,11 @@
 package nsxt
 
 import (
-	"fmt"
+	"context"
 	"log"
 	"net/http"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/vmware/go-vmware-nsxt/loadbalancer"
@@ -18,12 +19,12 @@ var cookieExpiryTypes = []string{"SESSION_COOKIE_TIME", "PERSISTENCE_COOKIE_TIME
 
 func resourceNsxtLbCookiePersistenceProfile() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtLbCookiePersistenceProfileCreate,
-		Read:   resourceNsxtLbCookiePersistenceProfileRead,
-		Update: resourceNsxtLbCookiePersistenceProfileUpdate,
-		Delete: resourceNsxtLbCookiePersistenceProfileDelete,
+		CreateContext: resourceNsxtLbCookiePersistenceProfileCreate,
+		ReadContext:   resourceNsxtLbCookiePersistenceProfileRead,
+		UpdateContext: resourceNsxtLbCookiePersistenceProfileUpdate,
+		DeleteContext: resourceNsxtLbCookiePersistenceProfileDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		DeprecationMessage: mpObjectResourceDeprecationMessage,
 		Schema: map[string]*schema.Schema{
@@ -168,10 +169,10 @@ func setInsertParamsInSchema(d *schema.ResourceData, cookieDomain string, cookie
 	}
 }
 
-func resourceNsxtLbCookiePersistenceProfileCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtLbCookiePersistenceProfileCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return getErrorDiagnostics(resourceNotSupportedError())
 	}
 
 	description := d.Get("description").(string)
@@ -200,26 +201,26 @@ func resourceNsxtLbCookiePersistenceProfileCreate(d *schema.ResourceData, m inte
 	lbCookiePersistenceProfile, resp, err := nsxClient.ServicesApi.CreateLoadBalancerCookiePersistenceProfile(nsxClient.Context, lbCookiePersistenceProfile)
 
 	if err != nil {
-		return fmt.Errorf("Error during LbCookiePersistenceProfile create: %v", err)
+		return diag.Errorf("Error during LbCookiePersistenceProfile create: %v", err)
 	}
 
 	if resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusOK {
-		return fmt.Errorf("Unexpected status returned during LbCookiePersistenceProfile create: %v", resp.StatusCode)
+		return diag.Errorf("Unexpected status returned during LbCookiePersistenceProfile create: %v", resp.StatusCode)
 	}
 	d.SetId(lbCookiePersistenceProfile.Id)
 
-	return resourceNsxtLbCookiePersistenceProfileRead(d, m)
+	return resourceNsxtLbCookiePersistenceProfileRead(ctx, d, m)
 }
 
-func resourceNsxtLbCookiePersistenceProfileRead(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtLbCookiePersistenceProfileRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return getErrorDiagnostics(resourceNotSupportedError())
 	}
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining logical object id")
+		return diag.Errorf("Error obtaining logical object id")
 	}
 
 	lbCookiePersistenceProfile, resp, err := nsxClient.ServicesApi.ReadLoadBalancerCookiePersistenceProfile(nsxClient.Context, id)
@@ -229,7 +230,7 @@ func resourceNsxtLbCookiePersistenceProfileRead(d *schema.ResourceData, m interf
 		return nil
 	}
 	if err != nil {
-		return fmt.Errorf("Error during LbCookiePersistenceProfile read: %v", err)
+		return diag.Errorf("Error during LbCookiePersistenceProfile read: %v", err)
 	}
 
 	d.Set("revision", lbCookiePersistenceProfile.Revision)
@@ -246,15 +247,15 @@ func resourceNsxtLbCookiePersistenceProfileRead(d *schema.ResourceData, m interf
 	return nil
 }
 
-func resourceNsxtLbCookiePersistenceProfileUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtLbCookiePersistenceProfileUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return getErrorDiagnostics(resourceNotSupportedError())
 	}
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining logical object id")
+		return diag.Errorf("Error obtaining logical object id")
 	}
 
 	revision := int32(d.Get("revision").(int))
@@ -285,26 +286,26 @@ func resourceNsxtLbCookiePersistenceProfileUpdate(d *schema.ResourceData, m inte
 	_, resp, err := nsxClient.ServicesApi.UpdateLoadBalancerCookiePersistenceProfile(nsxClient.Context, id, lbCookiePersistenceProfile)
 
 	if err != nil || resp.StatusCode == http.StatusNotFound {
-		return fmt.Errorf("Error during LbCookiePersistenceProfile update: %v", err)
+		return diag.Errorf("Error during LbCookiePersistenceProfile update: %v", err)
 	}
 
-	return resourceNsxtLbCookiePersistenceProfileRead(d, m)
+	return resourceNsxtLbCookiePersistenceProfileRead(ctx, d, m)
 }
 
-func resourceNsxtLbCookiePersistenceProfileDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtLbCookiePersistenceProfileDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return getErrorDiagnostics(resourceNotSupportedError())
 	}
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining logical object id")
+		return diag.Errorf("Error obtaining logical object id")
 	}
 
 	resp, err := nsxClient.ServicesApi.DeleteLoadBalancerPersistenceProfile(nsxClient.Context, id)
 	if err != nil {
-		return fmt.Errorf("Error during LbCookiePersistenceProfile delete: %v", err)
+		return diag.Errorf("Error during LbCookiePersistenceProfile delete: %v", err)
 	}
 
 	if resp.StatusCode == http.StatusNotFound {
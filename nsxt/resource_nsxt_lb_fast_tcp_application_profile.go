@@ -4,10 +4,11 @@
 package nsxt
 
 import (
-	"fmt"
+	"context"
 	"log"
 	"net/http"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/vmware/go-vmware-nsxt/loadbalancer"
@@ -15,12 +16,12 @@ import (
 
 func resourceNsxtLbFastTCPApplicationProfile() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtLbFastTCPApplicationProfileCreate,
-		Read:   resourceNsxtLbFastTCPApplicationProfileRead,
-		Update: resourceNsxtLbFastTCPApplicationProfileUpdate,
-		Delete: resourceNsxtLbFastTCPApplicationProfileDelete,
+		CreateContext: resourceNsxtLbFastTCPApplicationProfileCreate,
+		ReadContext:   resourceNsxtLbFastTCPApplicationProfileRead,
+		UpdateContext: resourceNsxtLbFastTCPApplicationProfileUpdate,
+		DeleteContext: resourceNsxtLbFastTCPApplicationProfileDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		DeprecationMessage: mpObjectResourceDeprecationMessage,
 		Schema: map[string]*schema.Schema{
@@ -61,10 +62,10 @@ func resourceNsxtLbFastTCPApplicationProfile() *schema.Resource {
 	}
 }
 
-func resourceNsxtLbFastTCPApplicationProfileCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtLbFastTCPApplicationProfileCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return getErrorDiagnostics(resourceNotSupportedError())
 	}
 
 	description := d.Get("description").(string)
@@ -85,26 +86,26 @@ func resourceNsxtLbFastTCPApplicationProfileCreate(d *schema.ResourceData, m int
 	lbFastTCPProfile, resp, err := nsxClient.ServicesApi.CreateLoadBalancerFastTcpProfile(nsxClient.Context, lbFastTCPProfile)
 
 	if err != nil {
-		return fmt.Errorf("Error during LbFastTcpProfile create: %v", err)
+		return diag.Errorf("Error during LbFastTcpProfile create: %v", err)
 	}
 
 	if resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusOK {
-		return fmt.Errorf("Unexpected status returned during LbFastTcpProfile create: %v", resp.StatusCode)
+		return diag.Errorf("Unexpected status returned during LbFastTcpProfile create: %v", resp.StatusCode)
 	}
 	d.SetId(lbFastTCPProfile.Id)
 
-	return resourceNsxtLbFastTCPApplicationProfileRead(d, m)
+	return resourceNsxtLbFastTCPApplicationProfileRead(ctx, d, m)
 }
 
-func resourceNsxtLbFastTCPApplicationProfileRead(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtLbFastTCPApplicationProfileRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return getErrorDiagnostics(resourceNotSupportedError())
 	}
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining logical object id")
+		return diag.Errorf("Error obtaining logical object id")
 	}
 
 	lbFastTCPProfile, resp, err := nsxClient.ServicesApi.ReadLoadBalancerFastTcpProfile(nsxClient.Context, id)
@@ -114,7 +115,7 @@ func resourceNsxtLbFastTCPApplicationProfileRead(d *schema.ResourceData, m inter
 		return nil
 	}
 	if err != nil {
-		return fmt.Errorf("Error during LbFastTcpProfile read: %v", err)
+		return diag.Errorf("Error during LbFastTcpProfile read: %v", err)
 	}
 
 	d.Set("revision", lbFastTCPProfile.Revision)
@@ -128,15 +129,15 @@ func resourceNsxtLbFastTCPApplicationProfileRead(d *schema.ResourceData, m inter
 	return nil
 }
 
-func resourceNsxtLbFastTCPApplicationProfileUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtLbFastTCPApplicationProfileUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return getErrorDiagnostics(resourceNotSupportedError())
 	}
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining logical object id")
+		return diag.Errorf("Error obtaining logical object id")
 	}
 
 	revision := int32(d.Get("revision").(int))
@@ -159,26 +160,26 @@ func resourceNsxtLbFastTCPApplicationProfileUpdate(d *schema.ResourceData, m int
 	_, resp, err := nsxClient.ServicesApi.UpdateLoadBalancerFastTcpProfile(nsxClient.Context, id, lbFastTCPProfile)
 
 	if err != nil || resp.StatusCode == http.StatusNotFound {
-		return fmt.Errorf("Error during LbFastTcpProfile update: %v", err)
+		return diag.Errorf("Error during LbFastTcpProfile update: %v", err)
 	}
 
-	return resourceNsxtLbFastTCPApplicationProfileRead(d, m)
+	return resourceNsxtLbFastTCPApplicationProfileRead(ctx, d, m)
 }
 
-func resourceNsxtLbFastTCPApplicationProfileDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtLbFastTCPApplicationProfileDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return getErrorDiagnostics(resourceNotSupportedError())
 	}
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining logical object id")
+		return diag.Errorf("Error obtaining logical object id")
 	}
 
 	resp, err := nsxClient.ServicesApi.DeleteLoadBalancerApplicationProfile(nsxClient.Context, id)
 	if err != nil {
-		return fmt.Errorf("Error during LbFastTcpProfile delete: %v", err)
+		return diag.Errorf("Error during LbFastTcpProfile delete: %v", err)
 	}
 
 	if resp.StatusCode == http.StatusNotFound {
@@ -4,10 +4,11 @@
 package nsxt
 
 import (
-	"fmt"
+	"context"
 	"log"
 	"net/http"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/vmware/go-vmware-nsxt/loadbalancer"
@@ -15,12 +16,12 @@ import (
 
 func resourceNsxtLbFastUDPApplicationProfile() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtLbFastUDPApplicationProfileCreate,
-		Read:   resourceNsxtLbFastUDPApplicationProfileRead,
-		Update: resourceNsxtLbFastUDPApplicationProfileUpdate,
-		Delete: resourceNsxtLbFastUDPApplicationProfileDelete,
+		CreateContext: resourceNsxtLbFastUDPApplicationProfileCreate,
+		ReadContext:   resourceNsxtLbFastUDPApplicationProfileRead,
+		UpdateContext: resourceNsxtLbFastUDPApplicationProfileUpdate,
+		DeleteContext: resourceNsxtLbFastUDPApplicationProfileDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		DeprecationMessage: mpObjectResourceDeprecationMessage,
 		Schema: map[string]*schema.Schema{
@@ -54,10 +55,10 @@ func resourceNsxtLbFastUDPApplicationProfile() *schema.Resource {
 	}
 }
 
-func resourceNsxtLbFastUDPApplicationProfileCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtLbFastUDPApplicationProfileCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return getErrorDiagnostics(resourceNotSupportedError())
 	}
 
 	description := d.Get("description").(string)
@@ -75,31 +76,31 @@ func resourceNsxtLbFastUDPApplicationProfileCreate(d *schema.ResourceData, m int
 
 	lbFastUDPProfile, resp, err := nsxClient.ServicesApi.CreateLoadBalancerFastUdpProfile(nsxClient.Context, lbFastUDPProfile)
 	if resp != nil && resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusOK {
-		return fmt.Errorf("Unexpected status returned during LbFastUdpProfile create: %v", resp.StatusCode)
+		return diag.Errorf("Unexpected status returned during LbFastUdpProfile create: %v", resp.StatusCode)
 	}
 	if err != nil {
-		return fmt.Errorf("Error during LbFastUdpProfile create: %v", err)
+		return diag.Errorf("Error during LbFastUdpProfile create: %v", err)
 	}
 
 	d.SetId(lbFastUDPProfile.Id)
 
-	return resourceNsxtLbFastUDPApplicationProfileRead(d, m)
+	return resourceNsxtLbFastUDPApplicationProfileRead(ctx, d, m)
 }
 
-func resourceNsxtLbFastUDPApplicationProfileRead(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtLbFastUDPApplicationProfileRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return getErrorDiagnostics(resourceNotSupportedError())
 	}
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining logical object id")
+		return diag.Errorf("Error obtaining logical object id")
 	}
 
 	lbFastUDPProfile, resp, err := nsxClient.ServicesApi.ReadLoadBalancerFastUdpProfile(nsxClient.Context, id)
 	if err != nil {
-		return fmt.Errorf("Error during LbFastUdpProfile read: %v", err)
+		return diag.Errorf("Error during LbFastUdpProfile read: %v", err)
 	}
 
 	if resp.StatusCode == http.StatusNotFound {
@@ -117,15 +118,15 @@ func resourceNsxtLbFastUDPApplicationProfileRead(d *schema.ResourceData, m inter
 	return nil
 }
 
-func resourceNsxtLbFastUDPApplicationProfileUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtLbFastUDPApplicationProfileUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return getErrorDiagnostics(resourceNotSupportedError())
 	}
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining logical object id")
+		return diag.Errorf("Error obtaining logical object id")
 	}
 
 	revision := int32(d.Get("revision").(int))
@@ -146,26 +147,26 @@ func resourceNsxtLbFastUDPApplicationProfileUpdate(d *schema.ResourceData, m int
 	_, resp, err := nsxClient.ServicesApi.UpdateLoadBalancerFastUdpProfile(nsxClient.Context, id, lbFastUDPProfile)
 
 	if err != nil || resp.StatusCode == http.StatusNotFound {
-		return fmt.Errorf("Error during LbFastUdpProfile update: %v", err)
+		return diag.Errorf("Error during LbFastUdpProfile update: %v", err)
 	}
 
-	return resourceNsxtLbFastUDPApplicationProfileRead(d, m)
+	return resourceNsxtLbFastUDPApplicationProfileRead(ctx, d, m)
 }
 
-func resourceNsxtLbFastUDPApplicationProfileDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtLbFastUDPApplicationProfileDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return getErrorDiagnostics(resourceNotSupportedError())
 	}
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining logical object id")
+		return diag.Errorf("Error obtaining logical object id")
 	}
 
 	resp, err := nsxClient.ServicesApi.DeleteLoadBalancerApplicationProfile(nsxClient.Context, id)
 	if err != nil {
-		return fmt.Errorf("Error during LbFastUdpProfile delete: %v", err)
+		return diag.Errorf("Error during LbFastUdpProfile delete: %v", err)
 	}
 
 	if resp.StatusCode == http.StatusNotFound {
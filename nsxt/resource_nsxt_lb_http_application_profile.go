@@ -4,10 +4,11 @@
 package nsxt
 
 import (
-	"fmt"
+	"context"
 	"log"
 	"net/http"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/vmware/go-vmware-nsxt/loadbalancer"
@@ -17,12 +18,12 @@ var xForwardedValues = []string{"INSERT", "REPLACE"}
 
 func resourceNsxtLbHTTPApplicationProfile() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtLbHTTPApplicationProfileCreate,
-		Read:   resourceNsxtLbHTTPApplicationProfileRead,
-		Update: resourceNsxtLbHTTPApplicationProfileUpdate,
-		Delete: resourceNsxtLbHTTPApplicationProfileDelete,
+		CreateContext: resourceNsxtLbHTTPApplicationProfileCreate,
+		ReadContext:   resourceNsxtLbHTTPApplicationProfileRead,
+		UpdateContext: resourceNsxtLbHTTPApplicationProfileUpdate,
+		DeleteContext: resourceNsxtLbHTTPApplicationProfileDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		DeprecationMessage: mpObjectResourceDeprecationMessage,
 		Schema: map[string]*schema.Schema{
@@ -92,10 +93,10 @@ func resourceNsxtLbHTTPApplicationProfile() *schema.Resource {
 	}
 }
 
-func resourceNsxtLbHTTPApplicationProfileCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtLbHTTPApplicationProfileCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return getErrorDiagnostics(resourceNotSupportedError())
 	}
 
 	description := d.Get("description").(string)
@@ -126,26 +127,26 @@ func resourceNsxtLbHTTPApplicationProfileCreate(d *schema.ResourceData, m interf
 	lbHTTPApplicationProfile, resp, err := nsxClient.ServicesApi.CreateLoadBalancerHttpProfile(nsxClient.Context, lbHTTPApplicationProfile)
 
 	if err != nil {
-		return fmt.Errorf("Error during LbHTTPApplicationProfile create: %v", err)
+		return diag.Errorf("Error during LbHTTPApplicationProfile create: %v", err)
 	}
 
 	if resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusOK {
-		return fmt.Errorf("Unexpected status returned during LbHTTPApplicationProfile create: %v", resp.StatusCode)
+		return diag.Errorf("Unexpected status returned during LbHTTPApplicationProfile create: %v", resp.StatusCode)
 	}
 	d.SetId(lbHTTPApplicationProfile.Id)
 
-	return resourceNsxtLbHTTPApplicationProfileRead(d, m)
+	return resourceNsxtLbHTTPApplicationProfileRead(ctx, d, m)
 }
 
-func resourceNsxtLbHTTPApplicationProfileRead(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtLbHTTPApplicationProfileRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return getErrorDiagnostics(resourceNotSupportedError())
 	}
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining logical object id")
+		return diag.Errorf("Error obtaining logical object id")
 	}
 
 	lbHTTPApplicationProfile, resp, err := nsxClient.ServicesApi.ReadLoadBalancerHttpProfile(nsxClient.Context, id)
@@ -155,7 +156,7 @@ func resourceNsxtLbHTTPApplicationProfileRead(d *schema.ResourceData, m interfac
 		return nil
 	}
 	if err != nil {
-		return fmt.Errorf("Error during LbHTTPApplicationProfile read: %v", err)
+		return diag.Errorf("Error during LbHTTPApplicationProfile read: %v", err)
 	}
 
 	d.Set("revision", lbHTTPApplicationProfile.Revision)
@@ -174,15 +175,15 @@ func resourceNsxtLbHTTPApplicationProfileRead(d *schema.ResourceData, m interfac
 	return nil
 }
 
-func resourceNsxtLbHTTPApplicationProfileUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtLbHTTPApplicationProfileUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return getErrorDiagnostics(resourceNotSupportedError())
 	}
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining logical object id")
+		return diag.Errorf("Error obtaining logical object id")
 	}
 
 	revision := int32(d.Get("revision").(int))
@@ -215,26 +216,26 @@ func resourceNsxtLbHTTPApplicationProfileUpdate(d *schema.ResourceData, m interf
 	_, resp, err := nsxClient.ServicesApi.UpdateLoadBalancerHttpProfile(nsxClient.Context, id, lbHTTPApplicationProfile)
 
 	if err != nil || resp.StatusCode == http.StatusNotFound {
-		return fmt.Errorf("Error during LbHTTPApplicationProfile update: %v", err)
+		return diag.Errorf("Error during LbHTTPApplicationProfile update: %v", err)
 	}
 
-	return resourceNsxtLbHTTPApplicationProfileRead(d, m)
+	return resourceNsxtLbHTTPApplicationProfileRead(ctx, d, m)
 }
 
-func resourceNsxtLbHTTPApplicationProfileDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtLbHTTPApplicationProfileDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return getErrorDiagnostics(resourceNotSupportedError())
 	}
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining logical object id")
+		return diag.Errorf("Error obtaining logical object id")
 	}
 
 	resp, err := nsxClient.ServicesApi.DeleteLoadBalancerApplicationProfile(nsxClient.Context, id)
 	if err != nil {
-		return fmt.Errorf("Error during LbHTTPApplicationProfile delete: %v", err)
+		return diag.Errorf("Error during LbHTTPApplicationProfile delete: %v", err)
 	}
 
 	if resp.StatusCode == http.StatusNotFound {
@@ -4,22 +4,23 @@
 package nsxt
 
 import (
-	"fmt"
+	"context"
 	"log"
 	"net/http"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/vmware/go-vmware-nsxt/loadbalancer"
 )
 
 func resourceNsxtLbHTTPMonitor() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtLbHTTPMonitorCreate,
-		Read:   resourceNsxtLbHTTPMonitorRead,
-		Update: resourceNsxtLbHTTPMonitorUpdate,
-		Delete: resourceNsxtLbMonitorDelete,
+		CreateContext: resourceNsxtLbHTTPMonitorCreate,
+		ReadContext:   resourceNsxtLbHTTPMonitorRead,
+		UpdateContext: resourceNsxtLbHTTPMonitorUpdate,
+		DeleteContext: resourceNsxtLbMonitorDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		DeprecationMessage: mpObjectResourceDeprecationMessage,
 		Schema: map[string]*schema.Schema{
@@ -52,10 +53,10 @@ func resourceNsxtLbHTTPMonitor() *schema.Resource {
 	}
 }
 
-func resourceNsxtLbHTTPMonitorCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtLbHTTPMonitorCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return getErrorDiagnostics(resourceNotSupportedError())
 	}
 
 	description := d.Get("description").(string)
@@ -94,26 +95,26 @@ func resourceNsxtLbHTTPMonitorCreate(d *schema.ResourceData, m interface{}) erro
 	lbHTTPMonitor, resp, err := nsxClient.ServicesApi.CreateLoadBalancerHttpMonitor(nsxClient.Context, lbHTTPMonitor)
 
 	if err != nil {
-		return fmt.Errorf("Error during LbHttpMonitor create: %v", err)
+		return diag.Errorf("Error during LbHttpMonitor create: %v", err)
 	}
 
 	if resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusOK {
-		return fmt.Errorf("Unexpected status returned during LbHttpMonitor create: %v", resp.StatusCode)
+		return diag.Errorf("Unexpected status returned during LbHttpMonitor create: %v", resp.StatusCode)
 	}
 	d.SetId(lbHTTPMonitor.Id)
 
-	return resourceNsxtLbHTTPMonitorRead(d, m)
+	return resourceNsxtLbHTTPMonitorRead(ctx, d, m)
 }
 
-func resourceNsxtLbHTTPMonitorRead(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtLbHTTPMonitorRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return getErrorDiagnostics(resourceNotSupportedError())
 	}
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining logical object id")
+		return diag.Errorf("Error obtaining logical object id")
 	}
 
 	lbHTTPMonitor, resp, err := nsxClient.ServicesApi.ReadLoadBalancerHttpMonitor(nsxClient.Context, id)
@@ -123,7 +124,7 @@ func resourceNsxtLbHTTPMonitorRead(d *schema.ResourceData, m interface{}) error
 		return nil
 	}
 	if err != nil {
-		return fmt.Errorf("Error during LbHttpMonitor read: %v", err)
+		return diag.Errorf("Error during LbHttpMonitor read: %v", err)
 	}
 
 	d.Set("revision", lbHTTPMonitor.Revision)
@@ -146,15 +147,15 @@ func resourceNsxtLbHTTPMonitorRead(d *schema.ResourceData, m interface{}) error
 	return nil
 }
 
-func resourceNsxtLbHTTPMonitorUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtLbHTTPMonitorUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return getErrorDiagnostics(resourceNotSupportedError())
 	}
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining logical object id")
+		return diag.Errorf("Error obtaining logical object id")
 	}
 
 	revision := int32(d.Get("revision").(int))
@@ -195,8 +196,8 @@ func resourceNsxtLbHTTPMonitorUpdate(d *schema.ResourceData, m interface{}) erro
 	_, resp, err := nsxClient.ServicesApi.UpdateLoadBalancerHttpMonitor(nsxClient.Context, id, lbHTTPMonitor)
 
 	if err != nil || resp.StatusCode == http.StatusNotFound {
-		return fmt.Errorf("Error during LbHttpMonitor update: %v", err)
+		return diag.Errorf("Error during LbHttpMonitor update: %v", err)
 	}
 
-	return resourceNsxtLbHTTPMonitorRead(d, m)
+	return resourceNsxtLbHTTPMonitorRead(ctx, d, m)
 }
@@ -4,10 +4,11 @@
 package nsxt
 
 import (
-	"fmt"
+	"context"
 	"log"
 	"net/http"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/vmware/go-vmware-nsxt/loadbalancer"
@@ -15,12 +16,12 @@ import (
 
 func resourceNsxtLbHTTPRequestRewriteRule() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtLbHTTPRequestRewriteRuleCreate,
-		Read:   resourceNsxtLbHTTPRequestRewriteRuleRead,
-		Update: resourceNsxtLbHTTPRequestRewriteRuleUpdate,
-		Delete: resourceNsxtLbHTTPRuleDelete,
+		CreateContext: resourceNsxtLbHTTPRequestRewriteRuleCreate,
+		ReadContext:   resourceNsxtLbHTTPRequestRewriteRuleRead,
+		UpdateContext: resourceNsxtLbHTTPRequestRewriteRuleUpdate,
+		DeleteContext: resourceNsxtLbHTTPRuleDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		DeprecationMessage: mpObjectResourceDeprecationMessage,
 		Schema: map[string]*schema.Schema{
@@ -346,10 +347,10 @@ func setLbRuleRequestRewriteActionsInSchema(d *schema.ResourceData, actions []lo
 	return nil
 }
 
-func resourceNsxtLbHTTPRequestRewriteRuleCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtLbHTTPRequestRewriteRuleCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return getErrorDiagnostics(resourceNotSupportedError())
 	}
 
 	description := d.Get("description").(string)
@@ -373,26 +374,26 @@ func resourceNsxtLbHTTPRequestRewriteRuleCreate(d *schema.ResourceData, m interf
 	lbRule, resp, err := nsxClient.ServicesApi.CreateLoadBalancerRule(nsxClient.Context, lbRule)
 
 	if err != nil {
-		return fmt.Errorf("Error during LoadBalancerRule create: %v", err)
+		return diag.Errorf("Error during LoadBalancerRule create: %v", err)
 	}
 
 	if resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusOK {
-		return fmt.Errorf("Unexpected status returned during LoadBalancerRule create: %v", resp.StatusCode)
+		return diag.Errorf("Unexpected status returned during LoadBalancerRule create: %v", resp.StatusCode)
 	}
 	d.SetId(lbRule.Id)
 
-	return resourceNsxtLbHTTPRequestRewriteRuleRead(d, m)
+	return resourceNsxtLbHTTPRequestRewriteRuleRead(ctx, d, m)
 }
 
-func resourceNsxtLbHTTPRequestRewriteRuleRead(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtLbHTTPRequestRewriteRuleRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return getErrorDiagnostics(resourceNotSupportedError())
 	}
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining logical object id")
+		return diag.Errorf("Error obtaining logical object id")
 	}
 
 	lbRule, resp, err := nsxClient.ServicesApi.ReadLoadBalancerRule(nsxClient.Context, id)
@@ -402,7 +403,7 @@ func resourceNsxtLbHTTPRequestRewriteRuleRead(d *schema.ResourceData, m interfac
 		return nil
 	}
 	if err != nil {
-		return fmt.Errorf("Error during LoadBalancerRule read: %v", err)
+		return diag.Errorf("Error during LoadBalancerRule read: %v", err)
 	}
 
 	d.Set("revision", lbRule.Revision)
@@ -422,15 +423,15 @@ func resourceNsxtLbHTTPRequestRewriteRuleRead(d *schema.ResourceData, m interfac
 	return nil
 }
 
-func resourceNsxtLbHTTPRequestRewriteRuleUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtLbHTTPRequestRewriteRuleUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return getErrorDiagnostics(resourceNotSupportedError())
 	}
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining logical object id")
+		return diag.Errorf("Error obtaining logical object id")
 	}
 
 	revision := int32(d.Get("revision").(int))
@@ -456,8 +457,8 @@ func resourceNsxtLbHTTPRequestRewriteRuleUpdate(d *schema.ResourceData, m interf
 	_, resp, err := nsxClient.ServicesApi.UpdateLoadBalancerRule(nsxClient.Context, id, lbRule)
 
 	if err != nil || resp.StatusCode == http.StatusNotFound {
-		return fmt.Errorf("Error during LoadBalancerRule update: %v", err)
+		return diag.Errorf("Error during LoadBalancerRule update: %v", err)
 	}
 
-	return resourceNsxtLbHTTPRequestRewriteRuleRead(d, m)
+	return resourceNsxtLbHTTPRequestRewriteRuleRead(ctx, d, m)
 }
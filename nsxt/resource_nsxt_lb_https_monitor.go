@@ -4,10 +4,11 @@
 package nsxt
 
 import (
-	"fmt"
+	"context"
 	"log"
 	"net/http"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/vmware/go-vmware-nsxt/loadbalancer"
@@ -15,12 +16,12 @@ import (
 
 func resourceNsxtLbHTTPSMonitor() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtLbHTTPSMonitorCreate,
-		Read:   resourceNsxtLbHTTPSMonitorRead,
-		Update: resourceNsxtLbHTTPSMonitorUpdate,
-		Delete: resourceNsxtLbMonitorDelete,
+		CreateContext: resourceNsxtLbHTTPSMonitorCreate,
+		ReadContext:   resourceNsxtLbHTTPSMonitorRead,
+		UpdateContext: resourceNsxtLbHTTPSMonitorUpdate,
+		DeleteContext: resourceNsxtLbMonitorDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		DeprecationMessage: mpObjectResourceDeprecationMessage,
 		Schema: map[string]*schema.Schema{
@@ -71,10 +72,10 @@ func resourceNsxtLbHTTPSMonitor() *schema.Resource {
 	}
 }
 
-func resourceNsxtLbHTTPSMonitorCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtLbHTTPSMonitorCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return getErrorDiagnostics(resourceNotSupportedError())
 	}
 
 	description := d.Get("description").(string)
@@ -129,26 +130,26 @@ func resourceNsxtLbHTTPSMonitorCreate(d *schema.ResourceData, m interface{}) err
 	lbHTTPSMonitor, resp, err := nsxClient.ServicesApi.CreateLoadBalancerHttpsMonitor(nsxClient.Context, lbHTTPSMonitor)
 
 	if err != nil {
-		return fmt.Errorf("Error during LbHttpsMonitor create: %v", err)
+		return diag.Errorf("Error during LbHttpsMonitor create: %v", err)
 	}
 
 	if resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusOK {
-		return fmt.Errorf("Unexpected status returned during LbHttpsMonitor create: %v", resp.StatusCode)
+		return diag.Errorf("Unexpected status returned during LbHttpsMonitor create: %v", resp.StatusCode)
 	}
 	d.SetId(lbHTTPSMonitor.Id)
 
-	return resourceNsxtLbHTTPSMonitorRead(d, m)
+	return resourceNsxtLbHTTPSMonitorRead(ctx, d, m)
 }
 
-func resourceNsxtLbHTTPSMonitorRead(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtLbHTTPSMonitorRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return getErrorDiagnostics(resourceNotSupportedError())
 	}
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining logical object id")
+		return diag.Errorf("Error obtaining logical object id")
 	}
 
 	lbHTTPSMonitor, resp, err := nsxClient.ServicesApi.ReadLoadBalancerHttpsMonitor(nsxClient.Context, id)
@@ -158,7 +159,7 @@ func resourceNsxtLbHTTPSMonitorRead(d *schema.ResourceData, m interface{}) error
 		return nil
 	}
 	if err != nil {
-		return fmt.Errorf("Error during LbHttpsMonitor read: %v", err)
+		return diag.Errorf("Error during LbHttpsMonitor read: %v", err)
 	}
 
 	d.Set("revision", lbHTTPSMonitor.Revision)
@@ -189,15 +190,15 @@ func resourceNsxtLbHTTPSMonitorRead(d *schema.ResourceData, m interface{}) error
 	return nil
 }
 
-func resourceNsxtLbHTTPSMonitorUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtLbHTTPSMonitorUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return getErrorDiagnostics(resourceNotSupportedError())
 	}
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining logical object id")
+		return diag.Errorf("Error obtaining logical object id")
 	}
 
 	revision := int32(d.Get("revision").(int))
@@ -254,8 +255,8 @@ func resourceNsxtLbHTTPSMonitorUpdate(d *schema.ResourceData, m interface{}) err
 	_, resp, err := nsxClient.ServicesApi.UpdateLoadBalancerHttpsMonitor(nsxClient.Context, id, lbHTTPSMonitor)
 
 	if err != nil || resp.StatusCode == http.StatusNotFound {
-		return fmt.Errorf("Error during LbHttpsMonitor update: %v", err)
+		return diag.Errorf("Error during LbHttpsMonitor update: %v", err)
 	}
 
-	return resourceNsxtLbHTTPSMonitorRead(d, m)
+	return resourceNsxtLbHTTPSMonitorRead(ctx, d, m)
 }
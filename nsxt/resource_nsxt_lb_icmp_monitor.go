@@ -4,10 +4,11 @@
 package nsxt
 
 import (
-	"fmt"
+	"context"
 	"log"
 	"net/http"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/vmware/go-vmware-nsxt/loadbalancer"
@@ -15,12 +16,12 @@ import (
 
 func resourceNsxtLbIcmpMonitor() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtLbIcmpMonitorCreate,
-		Read:   resourceNsxtLbIcmpMonitorRead,
-		Update: resourceNsxtLbIcmpMonitorUpdate,
-		Delete: resourceNsxtLbMonitorDelete,
+		CreateContext: resourceNsxtLbIcmpMonitorCreate,
+		ReadContext:   resourceNsxtLbIcmpMonitorRead,
+		UpdateContext: resourceNsxtLbIcmpMonitorUpdate,
+		DeleteContext: resourceNsxtLbMonitorDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		DeprecationMessage: mpObjectResourceDeprecationMessage,
 		Schema: map[string]*schema.Schema{
@@ -53,10 +54,10 @@ func resourceNsxtLbIcmpMonitor() *schema.Resource {
 	}
 }
 
-func resourceNsxtLbIcmpMonitorCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtLbIcmpMonitorCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return getErrorDiagnostics(resourceNotSupportedError())
 	}
 
 	description := d.Get("description").(string)
@@ -82,31 +83,31 @@ func resourceNsxtLbIcmpMonitorCreate(d *schema.ResourceData, m interface{}) erro
 
 	lbIcmpMonitor, resp, err := nsxClient.ServicesApi.CreateLoadBalancerIcmpMonitor(nsxClient.Context, lbIcmpMonitor)
 	if resp != nil && resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusOK {
-		return fmt.Errorf("Unexpected status returned during LbMonitor create: %v", resp.StatusCode)
+		return diag.Errorf("Unexpected status returned during LbMonitor create: %v", resp.StatusCode)
 	}
 	if err != nil {
-		return fmt.Errorf("Error during LbMonitor create: %v", err)
+		return diag.Errorf("Error during LbMonitor create: %v", err)
 	}
 
 	d.SetId(lbIcmpMonitor.Id)
 
-	return resourceNsxtLbIcmpMonitorRead(d, m)
+	return resourceNsxtLbIcmpMonitorRead(ctx, d, m)
 }
 
-func resourceNsxtLbIcmpMonitorRead(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtLbIcmpMonitorRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return getErrorDiagnostics(resourceNotSupportedError())
 	}
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining logical object id")
+		return diag.Errorf("Error obtaining logical object id")
 	}
 
 	lbIcmpMonitor, resp, err := nsxClient.ServicesApi.ReadLoadBalancerIcmpMonitor(nsxClient.Context, id)
 	if err != nil {
-		return fmt.Errorf("Error during LbMonitor read: %v", err)
+		return diag.Errorf("Error during LbMonitor read: %v", err)
 	}
 	if resp.StatusCode == http.StatusNotFound {
 		log.Printf("[DEBUG] LbMonitor %s not found", id)
@@ -128,15 +129,15 @@ func resourceNsxtLbIcmpMonitorRead(d *schema.ResourceData, m interface{}) error
 	return nil
 }
 
-func resourceNsxtLbIcmpMonitorUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtLbIcmpMonitorUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return getErrorDiagnostics(resourceNotSupportedError())
 	}
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining logical object id")
+		return diag.Errorf("Error obtaining logical object id")
 	}
 
 	revision := int32(d.Get("revision").(int))
@@ -165,8 +166,8 @@ func resourceNsxtLbIcmpMonitorUpdate(d *schema.ResourceData, m interface{}) erro
 	_, resp, err := nsxClient.ServicesApi.UpdateLoadBalancerIcmpMonitor(nsxClient.Context, id, lbIcmpMonitor)
 
 	if err != nil || resp.StatusCode == http.StatusNotFound {
-		return fmt.Errorf("Error during LbMonitor update: %v", err)
+		return diag.Errorf("Error during LbMonitor update: %v", err)
 	}
 
-	return resourceNsxtLbIcmpMonitorRead(d, m)
+	return resourceNsxtLbIcmpMonitorRead(ctx, d, m)
 }
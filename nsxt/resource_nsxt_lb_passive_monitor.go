@@ -4,22 +4,23 @@
 package nsxt
 
 import (
-	"fmt"
+	"context"
 	"log"
 	"net/http"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/vmware/go-vmware-nsxt/loadbalancer"
 )
 
 func resourceNsxtLbPassiveMonitor() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtLbPassiveMonitorCreate,
-		Read:   resourceNsxtLbPassiveMonitorRead,
-		Update: resourceNsxtLbPassiveMonitorUpdate,
-		Delete: resourceNsxtLbMonitorDelete,
+		CreateContext: resourceNsxtLbPassiveMonitorCreate,
+		ReadContext:   resourceNsxtLbPassiveMonitorRead,
+		UpdateContext: resourceNsxtLbPassiveMonitorUpdate,
+		DeleteContext: resourceNsxtLbMonitorDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		DeprecationMessage: mpObjectResourceDeprecationMessage,
 		Schema: map[string]*schema.Schema{
@@ -52,10 +53,10 @@ func resourceNsxtLbPassiveMonitor() *schema.Resource {
 	}
 }
 
-func resourceNsxtLbPassiveMonitorCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtLbPassiveMonitorCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return getErrorDiagnostics(resourceNotSupportedError())
 	}
 
 	description := d.Get("description").(string)
@@ -74,26 +75,26 @@ func resourceNsxtLbPassiveMonitorCreate(d *schema.ResourceData, m interface{}) e
 	lbPassiveMonitor, resp, err := nsxClient.ServicesApi.CreateLoadBalancerPassiveMonitor(nsxClient.Context, lbPassiveMonitor)
 
 	if err != nil {
-		return fmt.Errorf("Error during LbMonitor create: %v", err)
+		return diag.Errorf("Error during LbMonitor create: %v", err)
 	}
 
 	if resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusOK {
-		return fmt.Errorf("Unexpected status returned during LbMonitor create: %v", resp.StatusCode)
+		return diag.Errorf("Unexpected status returned during LbMonitor create: %v", resp.StatusCode)
 	}
 	d.SetId(lbPassiveMonitor.Id)
 
-	return resourceNsxtLbPassiveMonitorRead(d, m)
+	return resourceNsxtLbPassiveMonitorRead(ctx, d, m)
 }
 
-func resourceNsxtLbPassiveMonitorRead(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtLbPassiveMonitorRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return getErrorDiagnostics(resourceNotSupportedError())
 	}
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining logical object id")
+		return diag.Errorf("Error obtaining logical object id")
 	}
 
 	lbPassiveMonitor, resp, err := nsxClient.ServicesApi.ReadLoadBalancerPassiveMonitor(nsxClient.Context, id)
@@ -103,7 +104,7 @@ func resourceNsxtLbPassiveMonitorRead(d *schema.ResourceData, m interface{}) err
 		return nil
 	}
 	if err != nil {
-		return fmt.Errorf("Error during LbMonitor read: %v", err)
+		return diag.Errorf("Error during LbMonitor read: %v", err)
 	}
 
 	d.Set("revision", lbPassiveMonitor.Revision)
@@ -116,15 +117,15 @@ func resourceNsxtLbPassiveMonitorRead(d *schema.ResourceData, m interface{}) err
 	return nil
 }
 
-func resourceNsxtLbPassiveMonitorUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtLbPassiveMonitorUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return getErrorDiagnostics(resourceNotSupportedError())
 	}
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining logical object id")
+		return diag.Errorf("Error obtaining logical object id")
 	}
 
 	revision := int32(d.Get("revision").(int))
@@ -145,8 +146,8 @@ func resourceNsxtLbPassiveMonitorUpdate(d *schema.ResourceData, m interface{}) e
 	_, resp, err := nsxClient.ServicesApi.UpdateLoadBalancerPassiveMonitor(nsxClient.Context, id, lbPassiveMonitor)
 
 	if err != nil || resp.StatusCode == http.StatusNotFound {
-		return fmt.Errorf("Error during LbMonitor update: %v", err)
+		return diag.Errorf("Error during LbMonitor update: %v", err)
 	}
 
-	return resourceNsxtLbPassiveMonitorRead(d, m)
+	return resourceNsxtLbPassiveMonitorRead(ctx, d, m)
 }
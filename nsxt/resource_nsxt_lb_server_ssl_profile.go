@@ -4,22 +4,23 @@
 package nsxt
 
 import (
-	"fmt"
+	"context"
 	"log"
 	"net/http"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/vmware/go-vmware-nsxt/loadbalancer"
 )
 
 func resourceNsxtLbServerSslProfile() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtLbServerSslProfileCreate,
-		Read:   resourceNsxtLbServerSslProfileRead,
-		Update: resourceNsxtLbServerSslProfileUpdate,
-		Delete: resourceNsxtLbServerSslProfileDelete,
+		CreateContext: resourceNsxtLbServerSslProfileCreate,
+		ReadContext:   resourceNsxtLbServerSslProfileRead,
+		UpdateContext: resourceNsxtLbServerSslProfileUpdate,
+		DeleteContext: resourceNsxtLbServerSslProfileDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		DeprecationMessage: mpObjectResourceDeprecationMessage,
 		Schema: map[string]*schema.Schema{
@@ -49,10 +50,10 @@ func resourceNsxtLbServerSslProfile() *schema.Resource {
 	}
 }
 
-func resourceNsxtLbServerSslProfileCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtLbServerSslProfileCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return getErrorDiagnostics(resourceNotSupportedError())
 	}
 
 	description := d.Get("description").(string)
@@ -73,26 +74,26 @@ func resourceNsxtLbServerSslProfileCreate(d *schema.ResourceData, m interface{})
 	lbServerSslProfile, resp, err := nsxClient.ServicesApi.CreateLoadBalancerServerSslProfile(nsxClient.Context, lbServerSslProfile)
 
 	if err != nil {
-		return fmt.Errorf("Error during LbServerSslProfile create: %v", err)
+		return diag.Errorf("Error during LbServerSslProfile create: %v", err)
 	}
 
 	if resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusOK {
-		return fmt.Errorf("Unexpected status returned during LbServerSslProfile create: %v", resp.StatusCode)
+		return diag.Errorf("Unexpected status returned during LbServerSslProfile create: %v", resp.StatusCode)
 	}
 	d.SetId(lbServerSslProfile.Id)
 
-	return resourceNsxtLbServerSslProfileRead(d, m)
+	return resourceNsxtLbServerSslProfileRead(ctx, d, m)
 }
 
-func resourceNsxtLbServerSslProfileRead(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtLbServerSslProfileRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return getErrorDiagnostics(resourceNotSupportedError())
 	}
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining logical object id")
+		return diag.Errorf("Error obtaining logical object id")
 	}
 
 	lbServerSslProfile, resp, err := nsxClient.ServicesApi.ReadLoadBalancerServerSslProfile(nsxClient.Context, id)
@@ -102,7 +103,7 @@ func resourceNsxtLbServerSslProfileRead(d *schema.ResourceData, m interface{}) e
 		return nil
 	}
 	if err != nil {
-		return fmt.Errorf("Error during LbServerSslProfile read: %v", err)
+		return diag.Errorf("Error during LbServerSslProfile read: %v", err)
 	}
 
 	d.Set("revision", lbServerSslProfile.Revision)
@@ -117,15 +118,15 @@ func resourceNsxtLbServerSslProfileRead(d *schema.ResourceData, m interface{}) e
 	return nil
 }
 
-func resourceNsxtLbServerSslProfileUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtLbServerSslProfileUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return getErrorDiagnostics(resourceNotSupportedError())
 	}
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining logical object id")
+		return diag.Errorf("Error obtaining logical object id")
 	}
 
 	revision := int32(d.Get("revision").(int))
@@ -148,26 +149,26 @@ func resourceNsxtLbServerSslProfileUpdate(d *schema.ResourceData, m interface{})
 	_, resp, err := nsxClient.ServicesApi.UpdateLoadBalancerServerSslProfile(nsxClient.Context, id, lbServerSslProfile)
 
 	if err != nil || resp.StatusCode == http.StatusNotFound {
-		return fmt.Errorf("Error during LbServerSslProfile update: %v", err)
+		return diag.Errorf("Error during LbServerSslProfile update: %v", err)
 	}
 
-	return resourceNsxtLbServerSslProfileRead(d, m)
+	return resourceNsxtLbServerSslProfileRead(ctx, d, m)
 }
 
-func resourceNsxtLbServerSslProfileDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtLbServerSslProfileDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return getErrorDiagnostics(resourceNotSupportedError())
 	}
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining logical object id")
+		return diag.Errorf("Error obtaining logical object id")
 	}
 
 	resp, err := nsxClient.ServicesApi.DeleteLoadBalancerServerSslProfile(nsxClient.Context, id)
 	if err != nil {
-		return fmt.Errorf("Error during LbServerSslProfile delete: %v", err)
+		return diag.Errorf("Error during LbServerSslProfile delete: %v", err)
 	}
 
 	if resp.StatusCode == http.StatusNotFound {
@@ -4,32 +4,33 @@
 package nsxt
 
 import (
-	"fmt"
+	"context"
 	"log"
 	"net/http"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/vmware/go-vmware-nsxt/loadbalancer"
 )
 
 func resourceNsxtLbTCPMonitor() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtLbTCPMonitorCreate,
-		Read:   resourceNsxtLbTCPMonitorRead,
-		Update: resourceNsxtLbTCPMonitorUpdate,
-		Delete: resourceNsxtLbMonitorDelete,
+		CreateContext: resourceNsxtLbTCPMonitorCreate,
+		ReadContext:   resourceNsxtLbTCPMonitorRead,
+		UpdateContext: resourceNsxtLbTCPMonitorUpdate,
+		DeleteContext: resourceNsxtLbMonitorDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		DeprecationMessage: mpObjectResourceDeprecationMessage,
 		Schema:             getLbL4MonitorSchema("tcp"),
 	}
 }
 
-func resourceNsxtLbTCPMonitorCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtLbTCPMonitorCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return getErrorDiagnostics(resourceNotSupportedError())
 	}
 
 	description := d.Get("description").(string)
@@ -58,26 +59,26 @@ func resourceNsxtLbTCPMonitorCreate(d *schema.ResourceData, m interface{}) error
 	lbTCPMonitor, resp, err := nsxClient.ServicesApi.CreateLoadBalancerTcpMonitor(nsxClient.Context, lbTCPMonitor)
 
 	if err != nil {
-		return fmt.Errorf("Error during LbMonitor create: %v", err)
+		return diag.Errorf("Error during LbMonitor create: %v", err)
 	}
 
 	if resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusOK {
-		return fmt.Errorf("Unexpected status returned during LbMonitor create: %v", resp.StatusCode)
+		return diag.Errorf("Unexpected status returned during LbMonitor create: %v", resp.StatusCode)
 	}
 	d.SetId(lbTCPMonitor.Id)
 
-	return resourceNsxtLbTCPMonitorRead(d, m)
+	return resourceNsxtLbTCPMonitorRead(ctx, d, m)
 }
 
-func resourceNsxtLbTCPMonitorRead(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtLbTCPMonitorRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return getErrorDiagnostics(resourceNotSupportedError())
 	}
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining logical object id")
+		return diag.Errorf("Error obtaining logical object id")
 	}
 
 	lbTCPMonitor, resp, err := nsxClient.ServicesApi.ReadLoadBalancerTcpMonitor(nsxClient.Context, id)
@@ -87,7 +88,7 @@ func resourceNsxtLbTCPMonitorRead(d *schema.ResourceData, m interface{}) error {
 		return nil
 	}
 	if err != nil {
-		return fmt.Errorf("Error during LbMonitor read: %v", err)
+		return diag.Errorf("Error during LbMonitor read: %v", err)
 	}
 
 	d.Set("revision", lbTCPMonitor.Revision)
@@ -105,15 +106,15 @@ func resourceNsxtLbTCPMonitorRead(d *schema.ResourceData, m interface{}) error {
 	return nil
 }
 
-func resourceNsxtLbTCPMonitorUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtLbTCPMonitorUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return getErrorDiagnostics(resourceNotSupportedError())
 	}
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining logical object id")
+		return diag.Errorf("Error obtaining logical object id")
 	}
 
 	revision := int32(d.Get("revision").(int))
@@ -144,8 +145,8 @@ func resourceNsxtLbTCPMonitorUpdate(d *schema.ResourceData, m interface{}) error
 	_, resp, err := nsxClient.ServicesApi.UpdateLoadBalancerTcpMonitor(nsxClient.Context, id, lbTCPMonitor)
 
 	if err != nil || resp.StatusCode == http.StatusNotFound {
-		return fmt.Errorf("Error during LbMonitor update: %v", err)
+		return diag.Errorf("Error during LbMonitor update: %v", err)
 	}
 
-	return resourceNsxtLbTCPMonitorRead(d, m)
+	return resourceNsxtLbTCPMonitorRead(ctx, d, m)
 }
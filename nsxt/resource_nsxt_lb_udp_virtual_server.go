@@ -4,22 +4,23 @@
 package nsxt
 
 import (
-	"fmt"
+	"context"
 	"log"
 	"net/http"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/vmware/go-vmware-nsxt/loadbalancer"
 )
 
 func resourceNsxtLbUDPVirtualServer() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtLbUDPVirtualServerCreate,
-		Read:   resourceNsxtLbUDPVirtualServerRead,
-		Update: resourceNsxtLbUDPVirtualServerUpdate,
-		Delete: resourceNsxtLbUDPVirtualServerDelete,
+		CreateContext: resourceNsxtLbUDPVirtualServerCreate,
+		ReadContext:   resourceNsxtLbUDPVirtualServerRead,
+		UpdateContext: resourceNsxtLbUDPVirtualServerUpdate,
+		DeleteContext: resourceNsxtLbUDPVirtualServerDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		DeprecationMessage: mpObjectResourceDeprecationMessage,
 		Schema: map[string]*schema.Schema{
@@ -107,10 +108,10 @@ func resourceNsxtLbUDPVirtualServer() *schema.Resource {
 	}
 }
 
-func resourceNsxtLbUDPVirtualServerCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtLbUDPVirtualServerCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return getErrorDiagnostics(resourceNotSupportedError())
 	}
 
 	description := d.Get("description").(string)
@@ -148,26 +149,26 @@ func resourceNsxtLbUDPVirtualServerCreate(d *schema.ResourceData, m interface{})
 	lbVirtualServer, resp, err := nsxClient.ServicesApi.CreateLoadBalancerVirtualServer(nsxClient.Context, lbVirtualServer)
 
 	if err != nil {
-		return fmt.Errorf("Error during LbVirtualServer create: %v", err)
+		return diag.Errorf("Error during LbVirtualServer create: %v", err)
 	}
 
 	if resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusOK {
-		return fmt.Errorf("Unexpected status returned during LbVirtualServer create: %v", resp.StatusCode)
+		return diag.Errorf("Unexpected status returned during LbVirtualServer create: %v", resp.StatusCode)
 	}
 	d.SetId(lbVirtualServer.Id)
 
-	return resourceNsxtLbUDPVirtualServerRead(d, m)
+	return resourceNsxtLbUDPVirtualServerRead(ctx, d, m)
 }
 
-func resourceNsxtLbUDPVirtualServerRead(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtLbUDPVirtualServerRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return getErrorDiagnostics(resourceNotSupportedError())
 	}
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining logical object id")
+		return diag.Errorf("Error obtaining logical object id")
 	}
 
 	lbVirtualServer, resp, err := nsxClient.ServicesApi.ReadLoadBalancerVirtualServer(nsxClient.Context, id)
@@ -177,7 +178,7 @@ func resourceNsxtLbUDPVirtualServerRead(d *schema.ResourceData, m interface{}) e
 		return nil
 	}
 	if err != nil {
-		return fmt.Errorf("Error during LbVirtualServer read: %v", err)
+		return diag.Errorf("Error during LbVirtualServer read: %v", err)
 	}
 
 	d.Set("revision", lbVirtualServer.Revision)
@@ -199,15 +200,15 @@ func resourceNsxtLbUDPVirtualServerRead(d *schema.ResourceData, m interface{}) e
 	return nil
 }
 
-func resourceNsxtLbUDPVirtualServerUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtLbUDPVirtualServerUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return getErrorDiagnostics(resourceNotSupportedError())
 	}
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining logical object id")
+		return diag.Errorf("Error obtaining logical object id")
 	}
 
 	revision := int32(d.Get("revision").(int))
@@ -247,28 +248,28 @@ func resourceNsxtLbUDPVirtualServerUpdate(d *schema.ResourceData, m interface{})
 	_, resp, err := nsxClient.ServicesApi.UpdateLoadBalancerVirtualServer(nsxClient.Context, id, lbVirtualServer)
 
 	if err != nil || resp.StatusCode == http.StatusNotFound {
-		return fmt.Errorf("Error during LbVirtualServer update: %v", err)
+		return diag.Errorf("Error during LbVirtualServer update: %v", err)
 	}
 
-	return resourceNsxtLbUDPVirtualServerRead(d, m)
+	return resourceNsxtLbUDPVirtualServerRead(ctx, d, m)
 }
 
 // TODO: move this and other common VS code to utils
-func resourceNsxtLbUDPVirtualServerDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtLbUDPVirtualServerDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return getErrorDiagnostics(resourceNotSupportedError())
 	}
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining logical object id")
+		return diag.Errorf("Error obtaining logical object id")
 	}
 
 	localVarOptionals := make(map[string]interface{})
 	resp, err := nsxClient.ServicesApi.DeleteLoadBalancerVirtualServer(nsxClient.Context, id, localVarOptionals)
 	if err != nil {
-		return fmt.Errorf("Error during LbVirtualServer delete: %v", err)
+		return diag.Errorf("Error during LbVirtualServer delete: %v", err)
 	}
 
 	if resp.StatusCode == http.StatusNotFound {
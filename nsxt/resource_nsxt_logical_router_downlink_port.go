@@ -4,12 +4,14 @@
 package nsxt
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"net/http"
 	"strconv"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/vmware/go-vmware-nsxt/manager"
@@ -19,12 +21,12 @@ var logicalRouterPortUrpfModeValues = []string{"NONE", "STRICT"}
 
 func resourceNsxtLogicalRouterDownLinkPort() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtLogicalRouterDownLinkPortCreate,
-		Read:   resourceNsxtLogicalRouterDownLinkPortRead,
-		Update: resourceNsxtLogicalRouterDownLinkPortUpdate,
-		Delete: resourceNsxtLogicalRouterDownLinkPortDelete,
+		CreateContext: resourceNsxtLogicalRouterDownLinkPortCreate,
+		ReadContext:   resourceNsxtLogicalRouterDownLinkPortRead,
+		UpdateContext: resourceNsxtLogicalRouterDownLinkPortUpdate,
+		DeleteContext: resourceNsxtLogicalRouterDownLinkPortDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		DeprecationMessage: mpObjectResourceDeprecationMessage,
 		Schema: map[string]*schema.Schema{
@@ -97,10 +99,10 @@ func setIPSubnetsInSchema(d *schema.ResourceData, subnets []manager.IpSubnet) {
 	}
 }
 
-func resourceNsxtLogicalRouterDownLinkPortCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtLogicalRouterDownLinkPortCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return getErrorDiagnostics(resourceNotSupportedError())
 	}
 
 	description := d.Get("description").(string)
@@ -127,26 +129,26 @@ func resourceNsxtLogicalRouterDownLinkPortCreate(d *schema.ResourceData, m inter
 	logicalRouterDownLinkPort, resp, err := nsxClient.LogicalRoutingAndServicesApi.CreateLogicalRouterDownLinkPort(nsxClient.Context, logicalRouterDownLinkPort)
 
 	if err != nil {
-		return fmt.Errorf("Error during LogicalRouterDownLinkPort create: %v", err)
+		return diag.Errorf("Error during LogicalRouterDownLinkPort create: %v", err)
 	}
 
 	if resp.StatusCode != http.StatusCreated {
-		return fmt.Errorf("Unexpected status returned during LogicalRouterDownLinkPort create: %v", resp.StatusCode)
+		return diag.Errorf("Unexpected status returned during LogicalRouterDownLinkPort create: %v", resp.StatusCode)
 	}
 	d.SetId(logicalRouterDownLinkPort.Id)
 
-	return resourceNsxtLogicalRouterDownLinkPortRead(d, m)
+	return resourceNsxtLogicalRouterDownLinkPortRead(ctx, d, m)
 }
 
-func resourceNsxtLogicalRouterDownLinkPortRead(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtLogicalRouterDownLinkPortRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return getErrorDiagnostics(resourceNotSupportedError())
 	}
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining logical router downlink port id while reading")
+		return diag.Errorf("Error obtaining logical router downlink port id while reading")
 	}
 
 	logicalRouterDownLinkPort, resp, err := nsxClient.LogicalRoutingAndServicesApi.ReadLogicalRouterDownLinkPort(nsxClient.Context, id)
@@ -156,7 +158,7 @@ func resourceNsxtLogicalRouterDownLinkPortRead(d *schema.ResourceData, m interfa
 		return nil
 	}
 	if err != nil {
-		return fmt.Errorf("Error during LogicalRouterDownLinkPort read: %v", err)
+		return diag.Errorf("Error during LogicalRouterDownLinkPort read: %v", err)
 	}
 
 	d.Set("revision", logicalRouterDownLinkPort.Revision)
@@ -170,21 +172,21 @@ func resourceNsxtLogicalRouterDownLinkPortRead(d *schema.ResourceData, m interfa
 	d.Set("urpf_mode", logicalRouterDownLinkPort.UrpfMode)
 	err = setServiceBindingsInSchema(d, logicalRouterDownLinkPort.ServiceBindings, "service_binding")
 	if err != nil {
-		return fmt.Errorf("Error during LogicalRouterDownLinkPort service_binding set in schema: %v", err)
+		return diag.Errorf("Error during LogicalRouterDownLinkPort service_binding set in schema: %v", err)
 	}
 
 	return nil
 }
 
-func resourceNsxtLogicalRouterDownLinkPortUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtLogicalRouterDownLinkPortUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return getErrorDiagnostics(resourceNotSupportedError())
 	}
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining logical router downlink port id while updating")
+		return diag.Errorf("Error obtaining logical router downlink port id while updating")
 	}
 
 	revision := int64(d.Get("revision").(int))
@@ -214,27 +216,27 @@ func resourceNsxtLogicalRouterDownLinkPortUpdate(d *schema.ResourceData, m inter
 	_, resp, err := nsxClient.LogicalRoutingAndServicesApi.UpdateLogicalRouterDownLinkPort(nsxClient.Context, id, logicalRouterDownLinkPort)
 
 	if err != nil || resp.StatusCode == http.StatusNotFound {
-		return fmt.Errorf("Error during LogicalRouterDownLinkPort update: %v", err)
+		return diag.Errorf("Error during LogicalRouterDownLinkPort update: %v", err)
 	}
 
-	return resourceNsxtLogicalRouterDownLinkPortRead(d, m)
+	return resourceNsxtLogicalRouterDownLinkPortRead(ctx, d, m)
 }
 
-func resourceNsxtLogicalRouterDownLinkPortDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtLogicalRouterDownLinkPortDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return getErrorDiagnostics(resourceNotSupportedError())
 	}
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining logical router downlink port id while deleting")
+		return diag.Errorf("Error obtaining logical router downlink port id while deleting")
 	}
 
 	localVarOptionals := make(map[string]interface{})
 	resp, err := nsxClient.LogicalRoutingAndServicesApi.DeleteLogicalRouterPort(nsxClient.Context, id, localVarOptionals)
 	if err != nil {
-		return fmt.Errorf("Error during LogicalRouterDownLinkPort delete: %v", err)
+		return diag.Errorf("Error during LogicalRouterDownLinkPort delete: %v", err)
 	}
 
 	if resp.StatusCode == http.StatusNotFound {
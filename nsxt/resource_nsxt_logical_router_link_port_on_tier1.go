@@ -4,22 +4,23 @@
 package nsxt
 
 import (
-	"fmt"
+	"context"
 	"log"
 	"net/http"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/vmware/go-vmware-nsxt/manager"
 )
 
 func resourceNsxtLogicalRouterLinkPortOnTier1() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtLogicalRouterLinkPortOnTier1Create,
-		Read:   resourceNsxtLogicalRouterLinkPortOnTier1Read,
-		Update: resourceNsxtLogicalRouterLinkPortOnTier1Update,
-		Delete: resourceNsxtLogicalRouterLinkPortOnTier1Delete,
+		CreateContext: resourceNsxtLogicalRouterLinkPortOnTier1Create,
+		ReadContext:   resourceNsxtLogicalRouterLinkPortOnTier1Read,
+		UpdateContext: resourceNsxtLogicalRouterLinkPortOnTier1Update,
+		DeleteContext: resourceNsxtLogicalRouterLinkPortOnTier1Delete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		DeprecationMessage: mpObjectResourceDeprecationMessage,
 		Schema: map[string]*schema.Schema{
@@ -52,10 +53,10 @@ func resourceNsxtLogicalRouterLinkPortOnTier1() *schema.Resource {
 	}
 }
 
-func resourceNsxtLogicalRouterLinkPortOnTier1Create(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtLogicalRouterLinkPortOnTier1Create(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return getErrorDiagnostics(resourceNotSupportedError())
 	}
 
 	description := d.Get("description").(string)
@@ -73,26 +74,26 @@ func resourceNsxtLogicalRouterLinkPortOnTier1Create(d *schema.ResourceData, m in
 
 	logicalRouterLinkPort, resp, err := nsxClient.LogicalRoutingAndServicesApi.CreateLogicalRouterLinkPortOnTier1(nsxClient.Context, logicalRouterLinkPort)
 	if resp != nil && resp.StatusCode != http.StatusCreated {
-		return fmt.Errorf("Unexpected status returned during LogicalRouterLinkPortOnTier1 create: %v", resp.StatusCode)
+		return diag.Errorf("Unexpected status returned during LogicalRouterLinkPortOnTier1 create: %v", resp.StatusCode)
 	}
 	if err != nil {
-		return fmt.Errorf("Error during LogicalRouterLinkPortOnTier1 create: %v", err)
+		return diag.Errorf("Error during LogicalRouterLinkPortOnTier1 create: %v", err)
 	}
 
 	d.SetId(logicalRouterLinkPort.Id)
 
-	return resourceNsxtLogicalRouterLinkPortOnTier1Read(d, m)
+	return resourceNsxtLogicalRouterLinkPortOnTier1Read(ctx, d, m)
 }
 
-func resourceNsxtLogicalRouterLinkPortOnTier1Read(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtLogicalRouterLinkPortOnTier1Read(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return getErrorDiagnostics(resourceNotSupportedError())
 	}
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining logical router link port on tier1 id")
+		return diag.Errorf("Error obtaining logical router link port on tier1 id")
 	}
 
 	logicalRouterLinkPort, resp, err := nsxClient.LogicalRoutingAndServicesApi.ReadLogicalRouterLinkPortOnTier1(nsxClient.Context, id)
@@ -102,7 +103,7 @@ func resourceNsxtLogicalRouterLinkPortOnTier1Read(d *schema.ResourceData, m inte
 		return nil
 	}
 	if err != nil {
-		return fmt.Errorf("Error during LogicalRouterLinkPortOnTier1 read: %v", err)
+		return diag.Errorf("Error during LogicalRouterLinkPortOnTier1 read: %v", err)
 	}
 
 	d.Set("revision", logicalRouterLinkPort.Revision)
@@ -115,15 +116,15 @@ func resourceNsxtLogicalRouterLinkPortOnTier1Read(d *schema.ResourceData, m inte
 	return nil
 }
 
-func resourceNsxtLogicalRouterLinkPortOnTier1Update(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtLogicalRouterLinkPortOnTier1Update(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return getErrorDiagnostics(resourceNotSupportedError())
 	}
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining logical router link port on tier1 id")
+		return diag.Errorf("Error obtaining logical router link port on tier1 id")
 	}
 
 	revision := int64(d.Get("revision").(int))
@@ -145,27 +146,27 @@ func resourceNsxtLogicalRouterLinkPortOnTier1Update(d *schema.ResourceData, m in
 	_, resp, err := nsxClient.LogicalRoutingAndServicesApi.UpdateLogicalRouterLinkPortOnTier1(nsxClient.Context, id, logicalRouterLinkPort)
 
 	if err != nil || resp.StatusCode == http.StatusNotFound {
-		return fmt.Errorf("Error during LogicalRouterLinkPortOnTier1 %v update: %v (%+v)", id, err, resp)
+		return diag.Errorf("Error during LogicalRouterLinkPortOnTier1 %v update: %v (%+v)", id, err, resp)
 	}
 
-	return resourceNsxtLogicalRouterLinkPortOnTier1Read(d, m)
+	return resourceNsxtLogicalRouterLinkPortOnTier1Read(ctx, d, m)
 }
 
-func resourceNsxtLogicalRouterLinkPortOnTier1Delete(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtLogicalRouterLinkPortOnTier1Delete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return getErrorDiagnostics(resourceNotSupportedError())
 	}
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining logical router link port on tier1 id")
+		return diag.Errorf("Error obtaining logical router link port on tier1 id")
 	}
 
 	localVarOptionals := make(map[string]interface{})
 	resp, err := nsxClient.LogicalRoutingAndServicesApi.DeleteLogicalRouterPort(nsxClient.Context, id, localVarOptionals)
 	if err != nil {
-		return fmt.Errorf("Error during LogicalRouterLinkPortOnTier1 delete: %v", err)
+		return diag.Errorf("Error during LogicalRouterLinkPortOnTier1 delete: %v", err)
 	}
 
 	if resp.StatusCode == http.StatusNotFound {
@@ -4,11 +4,13 @@
 package nsxt
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"net/http"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -34,12 +36,12 @@ and try again.
 // Vlan logical switch is represented with separate resource
 func resourceNsxtLogicalSwitch() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtLogicalSwitchCreate,
-		Read:   resourceNsxtLogicalSwitchRead,
-		Update: resourceNsxtLogicalSwitchUpdate,
-		Delete: resourceNsxtLogicalSwitchDelete,
+		CreateContext: resourceNsxtLogicalSwitchCreate,
+		ReadContext:   resourceNsxtLogicalSwitchRead,
+		UpdateContext: resourceNsxtLogicalSwitchUpdate,
+		DeleteContext: resourceNsxtLogicalSwitchDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		DeprecationMessage: mpObjectResourceDeprecationMessage,
 		Schema: map[string]*schema.Schema{
@@ -98,10 +100,10 @@ func resourceNsxtLogicalSwitch() *schema.Resource {
 	}
 }
 
-func resourceNsxtLogicalSwitchCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtLogicalSwitchCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return getErrorDiagnostics(resourceNotSupportedError())
 	}
 
 	description := d.Get("description").(string)
@@ -135,19 +137,19 @@ func resourceNsxtLogicalSwitchCreate(d *schema.ResourceData, m interface{}) erro
 	logicalSwitch, resp, err := nsxClient.LogicalSwitchingApi.CreateLogicalSwitch(nsxClient.Context, logicalSwitch)
 
 	if err != nil {
-		return fmt.Errorf("Error during LogicalSwitch create: %v", err)
+		return diag.Errorf("Error during LogicalSwitch create: %v", err)
 	}
 
 	if resp.StatusCode != http.StatusCreated {
-		return fmt.Errorf("Unexpected status returned during LogicalSwitch create: %v", resp.StatusCode)
+		return diag.Errorf("Unexpected status returned during LogicalSwitch create: %v", resp.StatusCode)
 	}
 
 	d.SetId(logicalSwitch.Id)
 
-	return resourceNsxtLogicalSwitchRead(d, m)
+	return resourceNsxtLogicalSwitchRead(ctx, d, m)
 }
 
-func resourceNsxtLogicalSwitchVerifyRealization(d *schema.ResourceData, nsxClient *api.APIClient, logicalSwitch *manager.LogicalSwitch, toleratePartialSuccess bool) error {
+func resourceNsxtLogicalSwitchVerifyRealization(ctx context.Context, d *schema.ResourceData, nsxClient *api.APIClient, logicalSwitch *manager.LogicalSwitch, toleratePartialSuccess bool) error {
 	// verifying switch realization on hypervisor
 	pendingStates := []string{"in_progress", "pending"}
 	targetStates := []string{"success"}
@@ -180,7 +182,7 @@ func resourceNsxtLogicalSwitchVerifyRealization(d *schema.ResourceData, nsxClien
 		MinTimeout: 1 * time.Second,
 		Delay:      1 * time.Second,
 	}
-	_, err := stateConf.WaitForState()
+	_, err := stateConf.WaitForStateContext(ctx)
 	if err != nil {
 		// Realization failed - rollback & delete the switch
 		log.Printf("[ERROR] Rollback switch %s creation due to unrealized state", logicalSwitch.Id)
@@ -196,15 +198,15 @@ func resourceNsxtLogicalSwitchVerifyRealization(d *schema.ResourceData, nsxClien
 	return nil
 }
 
-func resourceNsxtLogicalSwitchRead(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtLogicalSwitchRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return getErrorDiagnostics(resourceNotSupportedError())
 	}
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining logical switch id")
+		return diag.Errorf("Error obtaining logical switch id")
 	}
 
 	logicalSwitch, resp, err := nsxClient.LogicalSwitchingApi.GetLogicalSwitch(nsxClient.Context, id)
@@ -214,14 +216,14 @@ func resourceNsxtLogicalSwitchRead(d *schema.ResourceData, m interface{}) error
 		return nil
 	}
 	if err != nil {
-		return fmt.Errorf("Error during LogicalSwitch read: %v", err)
+		return diag.Errorf("Error during LogicalSwitch read: %v", err)
 	}
 
 	toleratePartialSuccess := getCommonProviderConfig(m.(nsxtClients)).ToleratePartialSuccess
-	err = resourceNsxtLogicalSwitchVerifyRealization(d, nsxClient, &logicalSwitch, toleratePartialSuccess)
+	err = resourceNsxtLogicalSwitchVerifyRealization(ctx, d, nsxClient, &logicalSwitch, toleratePartialSuccess)
 
 	if err != nil {
-		return err
+		return getOperationDiagnostics(m, err)
 	}
 
 	d.Set("revision", logicalSwitch.Revision)
@@ -230,7 +232,7 @@ func resourceNsxtLogicalSwitchRead(d *schema.ResourceData, m interface{}) error
 	setTagsInSchema(d, logicalSwitch.Tags)
 	err = setAddressBindingsInSchema(d, logicalSwitch.AddressBindings)
 	if err != nil {
-		return fmt.Errorf("Error during logical switch address bindings set in schema: %v", err)
+		return diag.Errorf("Error during logical switch address bindings set in schema: %v", err)
 	}
 	d.Set("admin_state", logicalSwitch.AdminState)
 	d.Set("ip_pool_id", logicalSwitch.IpPoolId)
@@ -238,7 +240,7 @@ func resourceNsxtLogicalSwitchRead(d *schema.ResourceData, m interface{}) error
 	d.Set("replication_mode", logicalSwitch.ReplicationMode)
 	err = setSwitchingProfileIdsInSchema(d, nsxClient, logicalSwitch.SwitchingProfileIds)
 	if err != nil {
-		return fmt.Errorf("Error during logical switch profiles set in schema: %v", err)
+		return diag.Errorf("Error during logical switch profiles set in schema: %v", err)
 	}
 	d.Set("transport_zone_id", logicalSwitch.TransportZoneId)
 	d.Set("vlan", logicalSwitch.Vlan)
@@ -247,15 +249,15 @@ func resourceNsxtLogicalSwitchRead(d *schema.ResourceData, m interface{}) error
 	return nil
 }
 
-func resourceNsxtLogicalSwitchUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtLogicalSwitchUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return getErrorDiagnostics(resourceNotSupportedError())
 	}
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining logical switch id")
+		return diag.Errorf("Error obtaining logical switch id")
 	}
 
 	description := d.Get("description").(string)
@@ -289,21 +291,21 @@ func resourceNsxtLogicalSwitchUpdate(d *schema.ResourceData, m interface{}) erro
 
 	_, resp, err := nsxClient.LogicalSwitchingApi.UpdateLogicalSwitch(nsxClient.Context, id, logicalSwitch)
 	if err != nil || resp.StatusCode == http.StatusNotFound {
-		return fmt.Errorf("Error during LogicalSwitch update: %v", err)
+		return diag.Errorf("Error during LogicalSwitch update: %v", err)
 	}
 
-	return resourceNsxtLogicalSwitchRead(d, m)
+	return resourceNsxtLogicalSwitchRead(ctx, d, m)
 }
 
-func resourceNsxtLogicalSwitchDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtLogicalSwitchDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return getErrorDiagnostics(resourceNotSupportedError())
 	}
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining logical switch id")
+		return diag.Errorf("Error obtaining logical switch id")
 	}
 
 	localVarOptionals := make(map[string]interface{})
@@ -311,7 +313,7 @@ func resourceNsxtLogicalSwitchDelete(d *schema.ResourceData, m interface{}) erro
 	localVarOptionals["detach"] = true
 	resp, err := nsxClient.LogicalSwitchingApi.DeleteLogicalSwitch(nsxClient.Context, id, localVarOptionals)
 	if err != nil {
-		return fmt.Errorf("Error during LogicalSwitch delete: %v", err)
+		return diag.Errorf("Error during LogicalSwitch delete: %v", err)
 	}
 
 	if resp.StatusCode == http.StatusNotFound {
@@ -4,10 +4,12 @@
 package nsxt
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"net/http"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	api "github.com/vmware/go-vmware-nsxt"
@@ -32,12 +34,12 @@ and try again.
 // TODO: add advanced config
 func resourceNsxtLogicalTier1Router() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtLogicalTier1RouterCreate,
-		Read:   resourceNsxtLogicalTier1RouterRead,
-		Update: resourceNsxtLogicalTier1RouterUpdate,
-		Delete: resourceNsxtLogicalTier1RouterDelete,
+		CreateContext: resourceNsxtLogicalTier1RouterCreate,
+		ReadContext:   resourceNsxtLogicalTier1RouterRead,
+		UpdateContext: resourceNsxtLogicalTier1RouterUpdate,
+		DeleteContext: resourceNsxtLogicalTier1RouterDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		DeprecationMessage: mpObjectResourceDeprecationMessage,
 		Schema: map[string]*schema.Schema{
@@ -173,10 +175,10 @@ func resourceNsxtLogicalTier1RouterUpdateAdv(d *schema.ResourceData, nsxClient *
 	return err
 }
 
-func resourceNsxtLogicalTier1RouterCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtLogicalTier1RouterCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return getErrorDiagnostics(resourceNotSupportedError())
 	}
 
 	description := d.Get("description").(string)
@@ -197,11 +199,11 @@ func resourceNsxtLogicalTier1RouterCreate(d *schema.ResourceData, m interface{})
 	logicalRouter, resp, err := nsxClient.LogicalRoutingAndServicesApi.CreateLogicalRouter(nsxClient.Context, logicalRouter)
 
 	if err != nil {
-		return fmt.Errorf("Error during LogicalTier1Router create: %v", err)
+		return diag.Errorf("Error during LogicalTier1Router create: %v", err)
 	}
 
 	if resp.StatusCode != http.StatusCreated {
-		return fmt.Errorf("Unexpected status returned: %d", resp.StatusCode)
+		return diag.Errorf("Unexpected status returned: %d", resp.StatusCode)
 	}
 
 	// Add advertisement config
@@ -213,25 +215,25 @@ func resourceNsxtLogicalTier1RouterCreate(d *schema.ResourceData, m interface{})
 		_, derr := nsxClient.LogicalRoutingAndServicesApi.DeleteLogicalRouter(nsxClient.Context, logicalRouter.Id, localVarOptionals)
 		if derr != nil {
 			// rollback failed
-			return fmt.Errorf(formatLogicalRouterRollbackError, logicalRouter.Id, err, derr)
+			return diag.Errorf(formatLogicalRouterRollbackError, logicalRouter.Id, err, derr)
 		}
-		return fmt.Errorf("Error while setting advertisement configuration: %v", err)
+		return diag.Errorf("Error while setting advertisement configuration: %v", err)
 	}
 
 	d.SetId(logicalRouter.Id)
 
-	return resourceNsxtLogicalTier1RouterRead(d, m)
+	return resourceNsxtLogicalTier1RouterRead(ctx, d, m)
 }
 
-func resourceNsxtLogicalTier1RouterRead(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtLogicalTier1RouterRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return getErrorDiagnostics(resourceNotSupportedError())
 	}
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining logical Tier-1 router id")
+		return diag.Errorf("Error obtaining logical Tier-1 router id")
 	}
 
 	logicalRouter, resp, err := nsxClient.LogicalRoutingAndServicesApi.ReadLogicalRouter(nsxClient.Context, id)
@@ -241,7 +243,7 @@ func resourceNsxtLogicalTier1RouterRead(d *schema.ResourceData, m interface{}) e
 		return nil
 	}
 	if err != nil {
-		return fmt.Errorf("Error during LogicalTier1Router read: %v", err)
+		return diag.Errorf("Error during LogicalTier1Router read: %v", err)
 	}
 
 	d.Set("revision", logicalRouter.Revision)
@@ -258,26 +260,26 @@ func resourceNsxtLogicalTier1RouterRead(d *schema.ResourceData, m interface{}) e
 	}
 	err = setResourceReferencesInSchema(d, logicalRouter.FirewallSections, "firewall_sections")
 	if err != nil {
-		return fmt.Errorf("Error during LogicalTier1Router firewall sections set in schema: %v", err)
+		return diag.Errorf("Error during LogicalTier1Router firewall sections set in schema: %v", err)
 	}
 
 	err = resourceNsxtLogicalTier1RouterReadAdv(d, nsxClient, id)
 	if err != nil {
-		return fmt.Errorf("Error during LogicalTier1Router read: %v", err)
+		return diag.Errorf("Error during LogicalTier1Router read: %v", err)
 	}
 
 	return nil
 }
 
-func resourceNsxtLogicalTier1RouterUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtLogicalTier1RouterUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return getErrorDiagnostics(resourceNotSupportedError())
 	}
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining logical tier1 router id")
+		return diag.Errorf("Error obtaining logical tier1 router id")
 	}
 
 	revision := int64(d.Get("revision").(int))
@@ -299,33 +301,33 @@ func resourceNsxtLogicalTier1RouterUpdate(d *schema.ResourceData, m interface{})
 	_, resp, err := nsxClient.LogicalRoutingAndServicesApi.UpdateLogicalRouter(nsxClient.Context, id, logicalRouter)
 
 	if err != nil || resp.StatusCode == http.StatusNotFound {
-		return fmt.Errorf("Error during LogicalTier1Router update error: %v, resp %+v", err, resp)
+		return diag.Errorf("Error during LogicalTier1Router update error: %v, resp %+v", err, resp)
 	}
 
 	// Update advertisement config
 	err = resourceNsxtLogicalTier1RouterUpdateAdv(d, nsxClient, id)
 	if err != nil {
-		return fmt.Errorf("Error while setting config advertisement state: %v", err)
+		return diag.Errorf("Error while setting config advertisement state: %v", err)
 	}
 
-	return resourceNsxtLogicalTier1RouterRead(d, m)
+	return resourceNsxtLogicalTier1RouterRead(ctx, d, m)
 }
 
-func resourceNsxtLogicalTier1RouterDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtLogicalTier1RouterDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return getErrorDiagnostics(resourceNotSupportedError())
 	}
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining logical tier1 router id")
+		return diag.Errorf("Error obtaining logical tier1 router id")
 	}
 
 	localVarOptionals := make(map[string]interface{})
 	resp, err := nsxClient.LogicalRoutingAndServicesApi.DeleteLogicalRouter(nsxClient.Context, id, localVarOptionals)
 	if err != nil {
-		return fmt.Errorf("Error during LogicalTier1Router delete: %v", err)
+		return diag.Errorf("Error during LogicalTier1Router delete: %v", err)
 	}
 
 	if resp.StatusCode == http.StatusNotFound {
@@ -4,11 +4,13 @@
 package nsxt
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"net/http"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/vmware/go-vmware-nsxt/manager"
@@ -27,12 +29,12 @@ var natRuleActionValues = []string{
 
 func resourceNsxtNatRule() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtNatRuleCreate,
-		Read:   resourceNsxtNatRuleRead,
-		Update: resourceNsxtNatRuleUpdate,
-		Delete: resourceNsxtNatRuleDelete,
+		CreateContext: resourceNsxtNatRuleCreate,
+		ReadContext:   resourceNsxtNatRuleRead,
+		UpdateContext: resourceNsxtNatRuleUpdate,
+		DeleteContext: resourceNsxtNatRuleDelete,
 		Importer: &schema.ResourceImporter{
-			State: resourceNsxtNatRuleImport,
+			StateContext: resourceNsxtNatRuleImport,
 		},
 		DeprecationMessage: mpObjectResourceDeprecationMessage,
 		Schema: map[string]*schema.Schema{
@@ -110,15 +112,15 @@ func resourceNsxtNatRule() *schema.Resource {
 	}
 }
 
-func resourceNsxtNatRuleCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtNatRuleCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return getErrorDiagnostics(resourceNotSupportedError())
 	}
 
 	logicalRouterID := d.Get("logical_router_id").(string)
 	if logicalRouterID == "" {
-		return fmt.Errorf("Error obtaining logical object id")
+		return diag.Errorf("Error obtaining logical object id")
 	}
 
 	description := d.Get("description").(string)
@@ -126,7 +128,7 @@ func resourceNsxtNatRuleCreate(d *schema.ResourceData, m interface{}) error {
 	tags := getTagsFromSchema(d)
 	action := d.Get("action").(string)
 	if action == "NO_NAT" && nsxVersionHigherOrEqual("3.0.0") {
-		return fmt.Errorf("NO_NAT action is not supported in NSX versions 3.0.0 and greater. Use NO_SNAT and NO_DNAT instead")
+		return diag.Errorf("NO_NAT action is not supported in NSX versions 3.0.0 and greater. Use NO_SNAT and NO_DNAT instead")
 	}
 	enabled := d.Get("enabled").(bool)
 	logging := d.Get("logging").(bool)
@@ -157,31 +159,31 @@ func resourceNsxtNatRuleCreate(d *schema.ResourceData, m interface{}) error {
 	natRule, resp, err := nsxClient.LogicalRoutingAndServicesApi.AddNatRule(nsxClient.Context, logicalRouterID, natRule)
 
 	if err != nil {
-		return fmt.Errorf("Error during NatRule create: %v", err)
+		return diag.Errorf("Error during NatRule create: %v", err)
 	}
 
 	if resp.StatusCode != http.StatusCreated {
-		return fmt.Errorf("Unexpected status returned during NatRule create: %v", resp.StatusCode)
+		return diag.Errorf("Unexpected status returned during NatRule create: %v", resp.StatusCode)
 	}
 	d.SetId(natRule.Id)
 
-	return resourceNsxtNatRuleRead(d, m)
+	return resourceNsxtNatRuleRead(ctx, d, m)
 }
 
-func resourceNsxtNatRuleRead(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtNatRuleRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return getErrorDiagnostics(resourceNotSupportedError())
 	}
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining logical object id")
+		return diag.Errorf("Error obtaining logical object id")
 	}
 
 	logicalRouterID := d.Get("logical_router_id").(string)
 	if logicalRouterID == "" {
-		return fmt.Errorf("Error obtaining logical object id")
+		return diag.Errorf("Error obtaining logical object id")
 	}
 
 	natRule, resp, err := nsxClient.LogicalRoutingAndServicesApi.GetNatRule(nsxClient.Context, logicalRouterID, id)
@@ -193,7 +195,7 @@ func resourceNsxtNatRuleRead(d *schema.ResourceData, m interface{}) error {
 		return nil
 	}
 	if err != nil {
-		return fmt.Errorf("Error during NatRule read: %v", err)
+		return diag.Errorf("Error during NatRule read: %v", err)
 	}
 
 	d.Set("revision", natRule.Revision)
@@ -215,20 +217,20 @@ func resourceNsxtNatRuleRead(d *schema.ResourceData, m interface{}) error {
 	return nil
 }
 
-func resourceNsxtNatRuleUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtNatRuleUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return getErrorDiagnostics(resourceNotSupportedError())
 	}
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining logical object id")
+		return diag.Errorf("Error obtaining logical object id")
 	}
 
 	logicalRouterID := d.Get("logical_router_id").(string)
 	if logicalRouterID == "" {
-		return fmt.Errorf("Error obtaining logical object id")
+		return diag.Errorf("Error obtaining logical object id")
 	}
 
 	revision := int64(d.Get("revision").(int))
@@ -237,7 +239,7 @@ func resourceNsxtNatRuleUpdate(d *schema.ResourceData, m interface{}) error {
 	tags := getTagsFromSchema(d)
 	action := d.Get("action").(string)
 	if action == "NO_NAT" && nsxVersionHigherOrEqual("3.0.0") {
-		return fmt.Errorf("NO_NAT action is not supported in NSX versions 3.0.0 and greater. Use NO_SNAT and NO_DNAT instead")
+		return diag.Errorf("NO_NAT action is not supported in NSX versions 3.0.0 and greater. Use NO_SNAT and NO_DNAT instead")
 	}
 	enabled := d.Get("enabled").(bool)
 	logging := d.Get("logging").(bool)
@@ -269,30 +271,30 @@ func resourceNsxtNatRuleUpdate(d *schema.ResourceData, m interface{}) error {
 	_, resp, err := nsxClient.LogicalRoutingAndServicesApi.UpdateNatRule(nsxClient.Context, logicalRouterID, id, natRule)
 
 	if err != nil || resp.StatusCode == http.StatusNotFound {
-		return fmt.Errorf("Error during NatRule update: %v", err)
+		return diag.Errorf("Error during NatRule update: %v", err)
 	}
 
-	return resourceNsxtNatRuleRead(d, m)
+	return resourceNsxtNatRuleRead(ctx, d, m)
 }
 
-func resourceNsxtNatRuleDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtNatRuleDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return getErrorDiagnostics(resourceNotSupportedError())
 	}
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining logical object id")
+		return diag.Errorf("Error obtaining logical object id")
 	}
 	logicalRouterID := d.Get("logical_router_id").(string)
 	if logicalRouterID == "" {
-		return fmt.Errorf("Error obtaining logical object id")
+		return diag.Errorf("Error obtaining logical object id")
 	}
 
 	resp, err := nsxClient.LogicalRoutingAndServicesApi.DeleteNatRule(nsxClient.Context, logicalRouterID, id)
 	if err != nil {
-		return fmt.Errorf("Error during NatRule delete: %v", err)
+		return diag.Errorf("Error during NatRule delete: %v", err)
 	}
 
 	if resp.StatusCode == http.StatusNotFound {
@@ -302,7 +304,7 @@ func resourceNsxtNatRuleDelete(d *schema.ResourceData, m interface{}) error {
 	return nil
 }
 
-func resourceNsxtNatRuleImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+func resourceNsxtNatRuleImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	importID := d.Id()
 	s := strings.Split(importID, "/")
 	if len(s) != 2 {
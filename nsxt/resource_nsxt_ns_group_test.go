@@ -344,3 +344,32 @@ resource "nsxt_ns_group" "test" {
   }
 }`, tzName, testAccNsxtNSGroupHelperName, name)
 }
+
+func TestUnitResourceNsxtNSGroup_basic(t *testing.T) {
+	fake := newFakeNsxServer(t)
+	meta := testUnitConfigureProvider(t, fake)
+	resourceName := "nsxt_ns_group"
+
+	state := testUnitApplyResource(t, meta, resourceName, nil, map[string]interface{}{
+		"display_name": "nsgroup1",
+		"description":  "created",
+	})
+	testUnitCheckAttr(t, state, "revision", "0")
+	path := fakeNsxManagerPrefix + "/ns-groups/" + state.ID
+	if fake.getObject(path) == nil {
+		t.Fatalf("NS group %s was not created on NSX", state.ID)
+	}
+
+	// Update is rejected by NSX unless it carries current revision
+	state = testUnitApplyResource(t, meta, resourceName, state, map[string]interface{}{
+		"display_name": "nsgroup1",
+		"description":  "updated",
+	})
+	testUnitCheckAttr(t, state, "description", "updated")
+	testUnitCheckAttr(t, state, "revision", "1")
+
+	testUnitDestroyResource(t, meta, resourceName, state)
+	if fake.getObject(path) != nil {
+		t.Fatalf("NS group %s was not deleted on NSX", state.ID)
+	}
+}
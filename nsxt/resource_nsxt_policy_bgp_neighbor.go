@@ -4,10 +4,12 @@
 package nsxt
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
@@ -30,12 +32,12 @@ var bgpNeighborConfigRouteFilteringAddressFamilyValues = []string{
 
 func resourceNsxtPolicyBgpNeighbor() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtPolicyBgpNeighborCreate,
-		Read:   resourceNsxtPolicyBgpNeighborRead,
-		Update: resourceNsxtPolicyBgpNeighborUpdate,
-		Delete: resourceNsxtPolicyBgpNeighborDelete,
+		CreateContext: resourceNsxtPolicyBgpNeighborCreate,
+		ReadContext:   resourceNsxtPolicyBgpNeighborRead,
+		UpdateContext: resourceNsxtPolicyBgpNeighborUpdate,
+		DeleteContext: resourceNsxtPolicyBgpNeighborDelete,
 		Importer: &schema.ResourceImporter{
-			State: resourceNsxtPolicyBgpNeighborImport,
+			StateContext: resourceNsxtPolicyBgpNeighborImport,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -244,13 +246,13 @@ func resourceNsxtPolicyBgpNeighborResourceDataToStruct(d *schema.ResourceData, i
 	var rFilters []model.BgpRouteFiltering
 	routeFiltering := d.Get("route_filtering").([]interface{})
 	if len(routeFiltering) > 1 && nsxVersionLower("3.0.0") {
-		return neighborStruct, fmt.Errorf("Only 1 element for 'route_filtering' is supported with NSX-T versions up to 3.0.0")
+		return neighborStruct, newAttributeError("route_filtering", fmt.Errorf("Only 1 element for 'route_filtering' is supported with NSX-T versions up to 3.0.0"))
 	}
 	for _, filter := range routeFiltering {
 		data := filter.(map[string]interface{})
 		addrFamily := data["address_family"].(string)
 		if addrFamily == model.BgpRouteFiltering_ADDRESS_FAMILY_L2VPN_EVPN && nsxVersionLower("3.0.0") {
-			return neighborStruct, fmt.Errorf("'%s' is not supported for 'address_family' with NSX-T versions less than 3.0.0", model.BgpRouteFiltering_ADDRESS_FAMILY_L2VPN_EVPN)
+			return neighborStruct, newAttributeError("route_filtering", fmt.Errorf("'%s' is not supported for 'address_family' with NSX-T versions less than 3.0.0", model.BgpRouteFiltering_ADDRESS_FAMILY_L2VPN_EVPN))
 		}
 		enabled := data["enabled"].(bool)
 
@@ -304,7 +306,7 @@ func resourceNsxtPolicyBgpNeighborConvertAndPatch(id string, d *schema.ResourceD
 	bgpPath := d.Get("bgp_path").(string)
 	t0ID, serviceID := resourceNsxtPolicyBgpNeighborParseIDs(bgpPath)
 	if t0ID == "" || serviceID == "" {
-		return fmt.Errorf("Invalid bgp_path %s", bgpPath)
+		return newAttributeError("bgp_path", fmt.Errorf("Invalid bgp_path %s", bgpPath))
 	}
 
 	obj, err := resourceNsxtPolicyBgpNeighborResourceDataToStruct(d, id)
@@ -334,7 +336,7 @@ func resourceNsxtPolicyBgpNeighborConvertAndPatch(id string, d *schema.ResourceD
 	return nil
 }
 
-func resourceNsxtPolicyBgpNeighborCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyBgpNeighborCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 	isGlobalManager := isPolicyGlobalManager(m)
 
@@ -345,39 +347,39 @@ func resourceNsxtPolicyBgpNeighborCreate(d *schema.ResourceData, m interface{})
 	bgpPath := d.Get("bgp_path").(string)
 	t0ID, serviceID := resourceNsxtPolicyBgpNeighborParseIDs(bgpPath)
 	if t0ID == "" || serviceID == "" {
-		return fmt.Errorf("Invalid bgp_path %s", bgpPath)
+		return getErrorDiagnostics(newAttributeError("bgp_path", fmt.Errorf("Invalid bgp_path %s", bgpPath)))
 	}
 	exists, err := resourceNsxtPolicyBgpNeighborExists(t0ID, serviceID, id, isGlobalManager, connector)
 	if err != nil {
-		return err
+		return getOperationDiagnostics(m, err)
 	}
 	if exists {
-		return fmt.Errorf("BGP Neighbor with ID %s already exists for Tier-O %s and Locale Service %s", id, t0ID, serviceID)
+		return diag.Errorf("BGP Neighbor with ID %s already exists for Tier-O %s and Locale Service %s", id, t0ID, serviceID)
 	}
 
 	err = resourceNsxtPolicyBgpNeighborConvertAndPatch(id, d, m)
 	if err != nil {
-		return err
+		return getOperationDiagnostics(m, err)
 	}
 
 	d.SetId(id)
 	d.Set("nsx_id", id)
 
-	return resourceNsxtPolicyBgpNeighborRead(d, m)
+	return resourceNsxtPolicyBgpNeighborRead(ctx, d, m)
 }
 
-func resourceNsxtPolicyBgpNeighborRead(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyBgpNeighborRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining BgpNeighbor ID")
+		return diag.Errorf("Error obtaining BgpNeighbor ID")
 	}
 
 	bgpPath := d.Get("bgp_path").(string)
 	t0ID, serviceID := resourceNsxtPolicyBgpNeighborParseIDs(bgpPath)
 	if t0ID == "" || serviceID == "" {
-		return fmt.Errorf("Invalid bgp_path %s", bgpPath)
+		return getErrorDiagnostics(newAttributeError("bgp_path", fmt.Errorf("Invalid bgp_path %s", bgpPath)))
 	}
 
 	var obj model.BgpNeighborConfig
@@ -385,11 +387,11 @@ func resourceNsxtPolicyBgpNeighborRead(d *schema.ResourceData, m interface{}) er
 		client := gm_bgp.NewNeighborsClient(connector)
 		gmObj, err := client.Get(t0ID, serviceID, id)
 		if err != nil {
-			return handleReadError(d, "BgpNeighbor", id, err)
+			return getOperationDiagnostics(m, handleReadError(d, "BgpNeighbor", id, err))
 		}
 		lmObj, err := convertModelBindingType(gmObj, gm_model.BgpNeighborConfigBindingType(), model.BgpNeighborConfigBindingType())
 		if err != nil {
-			return err
+			return getOperationDiagnostics(m, err)
 		}
 		obj = lmObj.(model.BgpNeighborConfig)
 
@@ -398,7 +400,7 @@ func resourceNsxtPolicyBgpNeighborRead(d *schema.ResourceData, m interface{}) er
 		client := bgp.NewNeighborsClient(connector)
 		obj, err = client.Get(t0ID, serviceID, id)
 		if err != nil {
-			return handleReadError(d, "BgpNeighbor", id, err)
+			return getOperationDiagnostics(m, handleReadError(d, "BgpNeighbor", id, err))
 		}
 	}
 
@@ -455,25 +457,25 @@ func resourceNsxtPolicyBgpNeighborRead(d *schema.ResourceData, m interface{}) er
 	return nil
 }
 
-func resourceNsxtPolicyBgpNeighborUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyBgpNeighborUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining BgpNeighbor ID")
+		return diag.Errorf("Error obtaining BgpNeighbor ID")
 	}
 
 	err := resourceNsxtPolicyBgpNeighborConvertAndPatch(id, d, m)
 	if err != nil {
-		return err
+		return getOperationDiagnostics(m, err)
 	}
 
-	return resourceNsxtPolicyBgpNeighborRead(d, m)
+	return resourceNsxtPolicyBgpNeighborRead(ctx, d, m)
 }
 
-func resourceNsxtPolicyBgpNeighborDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyBgpNeighborDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining BgpNeighbor ID")
+		return diag.Errorf("Error obtaining BgpNeighbor ID")
 	}
 
 	connector := getPolicyConnector(m)
@@ -481,7 +483,7 @@ func resourceNsxtPolicyBgpNeighborDelete(d *schema.ResourceData, m interface{})
 	bgpPath := d.Get("bgp_path").(string)
 	t0ID, serviceID := resourceNsxtPolicyBgpNeighborParseIDs(bgpPath)
 	if t0ID == "" || serviceID == "" {
-		return fmt.Errorf("Invalid bgp_path %s", bgpPath)
+		return getErrorDiagnostics(newAttributeError("bgp_path", fmt.Errorf("Invalid bgp_path %s", bgpPath)))
 	}
 
 	var err error
@@ -493,13 +495,13 @@ func resourceNsxtPolicyBgpNeighborDelete(d *schema.ResourceData, m interface{})
 		err = client.Delete(t0ID, serviceID, id, nil)
 	}
 	if err != nil {
-		return handleDeleteError("BgpNeighbor", id, err)
+		return getOperationDiagnostics(m, handleDeleteError("BgpNeighbor", id, err))
 	}
 
 	return nil
 }
 
-func resourceNsxtPolicyBgpNeighborImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+func resourceNsxtPolicyBgpNeighborImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	importID := d.Id()
 	s := strings.Split(importID, "/")
 	if len(s) != 3 {
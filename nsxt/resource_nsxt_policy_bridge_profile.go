@@ -0,0 +1,188 @@
+/* Copyright © 2023 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/sites/enforcement_points"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+var bridgeProfileFailoverModeValues = []string{
+	model.L2BridgeEndpointProfile_FAILOVER_MODE_PREEMPTIVE,
+	model.L2BridgeEndpointProfile_FAILOVER_MODE_NON_PREEMPTIVE,
+}
+
+func resourceNsxtPolicyBridgeProfile() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceNsxtPolicyBridgeProfileCreate,
+		ReadContext:   resourceNsxtPolicyBridgeProfileRead,
+		UpdateContext: resourceNsxtPolicyBridgeProfileUpdate,
+		DeleteContext: resourceNsxtPolicyBridgeProfileDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"nsx_id":                 getNsxIDSchema(),
+			"path":                   getPathSchema(),
+			"display_name":           getDisplayNameSchema(),
+			"description":            getDescriptionSchema(),
+			"revision":               getRevisionSchema(),
+			"tag":                    getTagsSchema(),
+			"primary_edge_node_path": getPolicyPathSchema(true, false, "Policy path of primary edge node for bridging"),
+			"backup_edge_node_path":  getPolicyPathSchema(false, false, "Policy path of backup edge node for bridging"),
+			"failover_mode": {
+				Type:         schema.TypeString,
+				Description:  "Failover mode for the edge bridge cluster",
+				Optional:     true,
+				Default:      model.L2BridgeEndpointProfile_FAILOVER_MODE_PREEMPTIVE,
+				ValidateFunc: validation.StringInSlice(bridgeProfileFailoverModeValues, false),
+			},
+		},
+	}
+}
+
+func resourceNsxtPolicyBridgeProfileExistsInEnforcementPoint(id string, epID string, connector client.Connector) (bool, error) {
+	client := enforcement_points.NewEdgeBridgeProfilesClient(connector)
+	_, err := client.Get(defaultSite, epID, id)
+	if err == nil {
+		return true, nil
+	}
+
+	if isNotFoundError(err) {
+		return false, nil
+	}
+
+	return false, logAPIError("Error retrieving Bridge Profile", err)
+}
+
+func resourceNsxtPolicyBridgeProfileExistsPartial(epID string) func(id string, connector client.Connector, isGlobalManager bool) (bool, error) {
+	return func(id string, connector client.Connector, isGlobalManager bool) (bool, error) {
+		return resourceNsxtPolicyBridgeProfileExistsInEnforcementPoint(id, epID, connector)
+	}
+}
+
+func policyBridgeProfilePatch(d *schema.ResourceData, m interface{}, id string) error {
+	connector := getPolicyConnector(m)
+
+	displayName := d.Get("display_name").(string)
+	description := d.Get("description").(string)
+	tags := getPolicyTagsFromSchema(d)
+	failoverMode := d.Get("failover_mode").(string)
+	haMode := model.L2BridgeEndpointProfile_HA_MODE_STANDBY
+	// Order of edge paths determines primary and backup roles
+	edgePaths := []string{d.Get("primary_edge_node_path").(string)}
+	backupPath := d.Get("backup_edge_node_path").(string)
+	if backupPath != "" {
+		edgePaths = append(edgePaths, backupPath)
+	}
+
+	obj := model.L2BridgeEndpointProfile{
+		DisplayName:  &displayName,
+		Description:  &description,
+		Tags:         tags,
+		EdgePaths:    edgePaths,
+		FailoverMode: &failoverMode,
+		HaMode:       &haMode,
+	}
+
+	client := enforcement_points.NewEdgeBridgeProfilesClient(connector)
+	return client.Patch(defaultSite, getPolicyEnforcementPoint(m), id, obj)
+}
+
+func resourceNsxtPolicyBridgeProfileCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if isPolicyGlobalManager(m) {
+		return getErrorDiagnostics(localManagerOnlyError())
+	}
+
+	// Initialize resource Id and verify this ID is not yet used
+	id, err := getOrGenerateID(d, m, resourceNsxtPolicyBridgeProfileExistsPartial(getPolicyEnforcementPoint(m)))
+	if err != nil {
+		return getOperationDiagnostics(m, err)
+	}
+
+	log.Printf("[INFO] Creating Bridge Profile with ID %s", id)
+	err = policyBridgeProfilePatch(d, m, id)
+	if err != nil {
+		return getOperationDiagnostics(m, handleCreateError("Bridge Profile", id, err))
+	}
+
+	d.SetId(id)
+	d.Set("nsx_id", id)
+
+	return resourceNsxtPolicyBridgeProfileRead(ctx, d, m)
+}
+
+func resourceNsxtPolicyBridgeProfileRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	connector := getPolicyConnector(m)
+	id := d.Id()
+	if id == "" {
+		return diag.Errorf("Error obtaining Bridge Profile ID")
+	}
+
+	client := enforcement_points.NewEdgeBridgeProfilesClient(connector)
+	obj, err := client.Get(defaultSite, getPolicyEnforcementPoint(m), id)
+	if err != nil {
+		return getOperationDiagnostics(m, handleReadError(d, "Bridge Profile", id, err))
+	}
+
+	d.Set("display_name", obj.DisplayName)
+	d.Set("description", obj.Description)
+	setPolicyTagsInSchema(d, obj.Tags)
+	d.Set("nsx_id", id)
+	d.Set("path", obj.Path)
+	d.Set("revision", obj.Revision)
+	d.Set("failover_mode", obj.FailoverMode)
+
+	primaryPath := ""
+	backupPath := ""
+	if len(obj.EdgePaths) > 0 {
+		primaryPath = obj.EdgePaths[0]
+	}
+	if len(obj.EdgePaths) > 1 {
+		backupPath = obj.EdgePaths[1]
+	}
+	d.Set("primary_edge_node_path", primaryPath)
+	d.Set("backup_edge_node_path", backupPath)
+
+	return nil
+}
+
+func resourceNsxtPolicyBridgeProfileUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	id := d.Id()
+	if id == "" {
+		return diag.Errorf("Error obtaining Bridge Profile ID")
+	}
+
+	log.Printf("[INFO] Updating Bridge Profile with ID %s", id)
+	err := policyBridgeProfilePatch(d, m, id)
+	if err != nil {
+		return getOperationDiagnostics(m, handleUpdateError("Bridge Profile", id, err))
+	}
+
+	return resourceNsxtPolicyBridgeProfileRead(ctx, d, m)
+}
+
+func resourceNsxtPolicyBridgeProfileDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	id := d.Id()
+	if id == "" {
+		return diag.Errorf("Error obtaining Bridge Profile ID")
+	}
+
+	connector := getPolicyConnector(m)
+	client := enforcement_points.NewEdgeBridgeProfilesClient(connector)
+	err := client.Delete(defaultSite, getPolicyEnforcementPoint(m), id)
+	if err != nil {
+		return getOperationDiagnostics(m, handleDeleteError("Bridge Profile", id, err))
+	}
+
+	return nil
+}
@@ -0,0 +1,101 @@
+/* Copyright © 2023 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccResourceNsxtPolicyBridgeProfile_basic(t *testing.T) {
+	testResourceName := "nsxt_policy_bridge_profile.test"
+	name := getAccTestResourceName()
+	updatedName := getAccTestResourceName()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccOnlyLocalManager(t)
+			testAccNSXVersion(t, "3.2.0")
+		},
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyResourceCheckDestroy(state, updatedName, "nsxt_policy_bridge_profile", resourceNsxtPolicyBridgeProfileExistsPartial(defaultEnforcementPoint))
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyBridgeProfileTemplate(name, "PREEMPTIVE"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyResourceExists(testResourceName, resourceNsxtPolicyBridgeProfileExistsPartial(defaultEnforcementPoint)),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", name),
+					resource.TestCheckResourceAttr(testResourceName, "description", "terraform created"),
+					resource.TestCheckResourceAttr(testResourceName, "failover_mode", "PREEMPTIVE"),
+					resource.TestCheckResourceAttrPair(testResourceName, "primary_edge_node_path", "data.nsxt_policy_edge_node.test", "path"),
+					resource.TestCheckResourceAttr(testResourceName, "backup_edge_node_path", ""),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "1"),
+					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+				),
+			},
+			{
+				Config: testAccNsxtPolicyBridgeProfileTemplate(updatedName, "NON_PREEMPTIVE"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyResourceExists(testResourceName, resourceNsxtPolicyBridgeProfileExistsPartial(defaultEnforcementPoint)),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", updatedName),
+					resource.TestCheckResourceAttr(testResourceName, "failover_mode", "NON_PREEMPTIVE"),
+					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNsxtPolicyBridgeProfile_importBasic(t *testing.T) {
+	name := getAccTestResourceName()
+	testResourceName := "nsxt_policy_bridge_profile.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccOnlyLocalManager(t)
+			testAccNSXVersion(t, "3.2.0")
+		},
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyResourceCheckDestroy(state, name, "nsxt_policy_bridge_profile", resourceNsxtPolicyBridgeProfileExistsPartial(defaultEnforcementPoint))
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyBridgeProfileTemplate(name, "PREEMPTIVE"),
+			},
+			{
+				ResourceName:      testResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicyBridgeProfileTemplate(name string, failoverMode string) string {
+	return testAccNsxtPolicyEdgeNodeReadTemplate(getEdgeClusterName()) + fmt.Sprintf(`
+
+resource "nsxt_policy_bridge_profile" "test" {
+  display_name           = "%s"
+  description            = "terraform created"
+  primary_edge_node_path = data.nsxt_policy_edge_node.test.path
+  failover_mode          = "%s"
+
+  tag {
+    scope = "scope1"
+    tag   = "tag1"
+  }
+}`, name, failoverMode)
+}
@@ -4,9 +4,11 @@
 package nsxt
 
 import (
+	"context"
 	"fmt"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
 	gm_infra "github.com/vmware/vsphere-automation-sdk-go/services/nsxt-gm/global_infra"
@@ -47,12 +49,12 @@ var subAttributeReverseKeyMap = map[string]string{
 
 func resourceNsxtPolicyContextProfile() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtPolicyContextProfileCreate,
-		Read:   resourceNsxtPolicyContextProfileRead,
-		Update: resourceNsxtPolicyContextProfileUpdate,
-		Delete: resourceNsxtPolicyContextProfileDelete,
+		CreateContext: resourceNsxtPolicyContextProfileCreate,
+		ReadContext:   resourceNsxtPolicyContextProfileRead,
+		UpdateContext: resourceNsxtPolicyContextProfileUpdate,
+		DeleteContext: resourceNsxtPolicyContextProfileDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -191,13 +193,13 @@ func resourceNsxtPolicyContextProfileExists(id string, connector client.Connecto
 	return false, logAPIError("Error retrieving ContextProfile", err)
 }
 
-func resourceNsxtPolicyContextProfileCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyContextProfileCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 
 	// Initialize resource Id and verify this ID is not yet used
 	id, err := getOrGenerateID(d, m, resourceNsxtPolicyContextProfileExists)
 	if err != nil {
-		return err
+		return getOperationDiagnostics(m, err)
 	}
 
 	displayName := d.Get("display_name").(string)
@@ -208,17 +210,17 @@ func resourceNsxtPolicyContextProfileCreate(d *schema.ResourceData, m interface{
 		if len(attributes) > 0 {
 			err = checkAttributesValid(attributes, m, key)
 			if err != nil {
-				return err
+				return getOperationDiagnostics(m, err)
 			}
 			attributeStructList, err := constructAttributesModelList(attributes, key)
 			if err != nil {
-				return err
+				return getOperationDiagnostics(m, err)
 			}
 			attributesStructList = append(attributesStructList, attributeStructList...)
 		}
 	}
 	if len(attributesStructList) == 0 {
-		return fmt.Errorf("At least one attribute should be set")
+		return diag.Errorf("At least one attribute should be set")
 	}
 
 	tags := getPolicyTagsFromSchema(d)
@@ -236,7 +238,7 @@ func resourceNsxtPolicyContextProfileCreate(d *schema.ResourceData, m interface{
 		client := gm_infra.NewContextProfilesClient(connector)
 		gmObj, err1 := convertModelBindingType(obj, model.PolicyContextProfileBindingType(), gm_model.PolicyContextProfileBindingType())
 		if err1 != nil {
-			return err1
+			return getErrorDiagnostics(err1)
 		}
 		err = client.Patch(id, gmObj.(gm_model.PolicyContextProfile), nil)
 	} else {
@@ -244,20 +246,20 @@ func resourceNsxtPolicyContextProfileCreate(d *schema.ResourceData, m interface{
 		err = client.Patch(id, obj, nil)
 	}
 	if err != nil {
-		return handleCreateError("ContextProfile", id, err)
+		return getOperationDiagnostics(m, handleCreateError("ContextProfile", id, err))
 	}
 
 	d.SetId(id)
 	d.Set("nsx_id", id)
 
-	return resourceNsxtPolicyContextProfileRead(d, m)
+	return resourceNsxtPolicyContextProfileRead(ctx, d, m)
 }
 
-func resourceNsxtPolicyContextProfileRead(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyContextProfileRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining ContextProfile ID")
+		return diag.Errorf("Error obtaining ContextProfile ID")
 	}
 
 	var obj model.PolicyContextProfile
@@ -265,11 +267,11 @@ func resourceNsxtPolicyContextProfileRead(d *schema.ResourceData, m interface{})
 		client := gm_infra.NewContextProfilesClient(connector)
 		gmObj, err := client.Get(id)
 		if err != nil {
-			return handleReadError(d, "ContextProfile", id, err)
+			return getOperationDiagnostics(m, handleReadError(d, "ContextProfile", id, err))
 		}
 		rawObj, err := convertModelBindingType(gmObj, gm_model.PolicyContextProfileBindingType(), model.PolicyContextProfileBindingType())
 		if err != nil {
-			return err
+			return getOperationDiagnostics(m, err)
 		}
 		obj = rawObj.(model.PolicyContextProfile)
 	} else {
@@ -277,7 +279,7 @@ func resourceNsxtPolicyContextProfileRead(d *schema.ResourceData, m interface{})
 		client := infra.NewContextProfilesClient(connector)
 		obj, err = client.Get(id)
 		if err != nil {
-			return handleReadError(d, "ContextProfile", id, err)
+			return getOperationDiagnostics(m, handleReadError(d, "ContextProfile", id, err))
 		}
 	}
 
@@ -292,11 +294,11 @@ func resourceNsxtPolicyContextProfileRead(d *schema.ResourceData, m interface{})
 	return nil
 }
 
-func resourceNsxtPolicyContextProfileUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyContextProfileUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining ContextProfile ID")
+		return diag.Errorf("Error obtaining ContextProfile ID")
 	}
 
 	// Read the rest of the configured parameters
@@ -308,11 +310,11 @@ func resourceNsxtPolicyContextProfileUpdate(d *schema.ResourceData, m interface{
 		attributes := d.Get(key).(*schema.Set).List()
 		err := checkAttributesValid(attributes, m, key)
 		if err != nil {
-			return err
+			return getOperationDiagnostics(m, err)
 		}
 		attributeStructList, err := constructAttributesModelList(attributes, key)
 		if err != nil {
-			return err
+			return getOperationDiagnostics(m, err)
 		}
 		attributesStructList = append(attributesStructList, attributeStructList...)
 	}
@@ -329,7 +331,7 @@ func resourceNsxtPolicyContextProfileUpdate(d *schema.ResourceData, m interface{
 	if isPolicyGlobalManager(m) {
 		rawObj, err1 := convertModelBindingType(obj, model.PolicyContextProfileBindingType(), gm_model.PolicyContextProfileBindingType())
 		if err1 != nil {
-			return err1
+			return getErrorDiagnostics(err1)
 		}
 		gmObj := rawObj.(gm_model.PolicyContextProfile)
 		client := gm_infra.NewContextProfilesClient(connector)
@@ -340,16 +342,16 @@ func resourceNsxtPolicyContextProfileUpdate(d *schema.ResourceData, m interface{
 	}
 
 	if err != nil {
-		return handleUpdateError("ContextProfile", id, err)
+		return getOperationDiagnostics(m, handleUpdateError("ContextProfile", id, err))
 	}
 
-	return resourceNsxtPolicyContextProfileRead(d, m)
+	return resourceNsxtPolicyContextProfileRead(ctx, d, m)
 }
 
-func resourceNsxtPolicyContextProfileDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyContextProfileDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining ContextProfile ID")
+		return diag.Errorf("Error obtaining ContextProfile ID")
 	}
 
 	connector := getPolicyConnector(m)
@@ -363,7 +365,7 @@ func resourceNsxtPolicyContextProfileDelete(d *schema.ResourceData, m interface{
 		err = client.Delete(id, &force, nil)
 	}
 	if err != nil {
-		return handleDeleteError("ContextProfile", id, err)
+		return getOperationDiagnostics(m, handleDeleteError("ContextProfile", id, err))
 	}
 
 	return nil
@@ -4,10 +4,12 @@
 package nsxt
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/vmware/vsphere-automation-sdk-go/lib/vapi/std/errors"
@@ -34,11 +36,11 @@ func makeCustomAttributeID(key string, attribute string) string {
 
 func resourceNsxtPolicyContextProfileCustomAttribute() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtPolicyContextProfileCustomAttributeCreate,
-		Read:   resourceNsxtPolicyContextProfileCustomAttributeRead,
-		Delete: resourceNsxtPolicyContextProfileCustomAttributeDelete,
+		CreateContext: resourceNsxtPolicyContextProfileCustomAttributeCreate,
+		ReadContext:   resourceNsxtPolicyContextProfileCustomAttributeRead,
+		DeleteContext: resourceNsxtPolicyContextProfileCustomAttributeDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -108,7 +110,7 @@ func resourceNsxtPolicyContextProfileCustomAttributeExists(id string, connector
 	return false, err
 }
 
-func resourceNsxtPolicyContextProfileCustomAttributeRead(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyContextProfileCustomAttributeRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	id := d.Id()
 	key, attribute := splitCustomAttributeID(id)
 
@@ -117,17 +119,17 @@ func resourceNsxtPolicyContextProfileCustomAttributeRead(d *schema.ResourceData,
 	connector := getPolicyConnector(m)
 	exists, err := resourceNsxtPolicyContextProfileCustomAttributeExists(id, connector, isPolicyGlobalManager(m))
 	if err != nil {
-		return err
+		return getOperationDiagnostics(m, err)
 	}
 	if !exists {
-		return errors.NotFound{}
+		return getErrorDiagnostics(errors.NotFound{})
 	}
 	d.Set("key", key)
 	d.Set("attribute", attribute)
 	return nil
 }
 
-func resourceNsxtPolicyContextProfileCustomAttributeCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyContextProfileCustomAttributeCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	var err error
 	key := d.Get("key").(string)
 	attribute := d.Get("attribute").(string)
@@ -147,7 +149,7 @@ func resourceNsxtPolicyContextProfileCustomAttributeCreate(d *schema.ResourceDat
 	if isPolicyGlobalManager(m) {
 		gmObj, err1 := convertModelBindingType(obj, model.PolicyCustomAttributesBindingType(), gm_model.PolicyCustomAttributesBindingType())
 		if err1 != nil {
-			return err1
+			return getErrorDiagnostics(err1)
 		}
 
 		client := gm_infra.NewDefaultClient(connector)
@@ -157,24 +159,23 @@ func resourceNsxtPolicyContextProfileCustomAttributeCreate(d *schema.ResourceDat
 		err = client.Create(obj, "add")
 	}
 	if err != nil {
-		return handleCreateError("ContextProfileCustomAttribute", attribute, err)
+		return getOperationDiagnostics(m, handleCreateError("ContextProfileCustomAttribute", attribute, err))
 	}
 
 	d.Set("key", key)
 	d.Set("attribute", attribute)
 	d.SetId(makeCustomAttributeID(key, attribute))
 
-	return resourceNsxtPolicyContextProfileCustomAttributeRead(d, m)
+	return resourceNsxtPolicyContextProfileCustomAttributeRead(ctx, d, m)
 }
 
-func resourceNsxtPolicyContextProfileCustomAttributeDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyContextProfileCustomAttributeDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	key, attribute := splitCustomAttributeID(d.Id())
 	log.Printf("[INFO] Deleting ContextProfileCustomAttribute with ID %s", attribute)
 	attributes := []string{attribute}
-	err := resourceNsxtPolicyContextProfileCustomAttributeRead(d, m)
-
-	if err != nil {
-		return err
+	diags := resourceNsxtPolicyContextProfileCustomAttributeRead(ctx, d, m)
+	if diags.HasError() {
+		return diags
 	}
 
 	connector := getPolicyConnector(m)
@@ -187,10 +188,11 @@ func resourceNsxtPolicyContextProfileCustomAttributeDelete(d *schema.ResourceDat
 	}
 
 	// PATCH the resource
+	var err error
 	if isPolicyGlobalManager(m) {
 		gmObj, err1 := convertModelBindingType(obj, model.PolicyCustomAttributesBindingType(), gm_model.PolicyCustomAttributesBindingType())
 		if err1 != nil {
-			return err1
+			return getErrorDiagnostics(err1)
 		}
 
 		client := gm_infra.NewDefaultClient(connector)
@@ -201,7 +203,7 @@ func resourceNsxtPolicyContextProfileCustomAttributeDelete(d *schema.ResourceDat
 	}
 
 	if err != nil {
-		return handleDeleteError("ContextProfileCustomAttribute", attribute, err)
+		return getOperationDiagnostics(m, handleDeleteError("ContextProfileCustomAttribute", attribute, err))
 	}
-	return err
+	return nil
 }
@@ -0,0 +1,167 @@
+/* Copyright © 2023 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+func resourceNsxtPolicyDfwDraft() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceNsxtPolicyDfwDraftCreate,
+		ReadContext:   resourceNsxtPolicyDfwDraftRead,
+		UpdateContext: resourceNsxtPolicyDfwDraftUpdate,
+		DeleteContext: resourceNsxtPolicyDfwDraftDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"nsx_id":       getNsxIDSchema(),
+			"path":         getPathSchema(),
+			"display_name": getDisplayNameSchema(),
+			"description":  getDescriptionSchema(),
+			"revision":     getRevisionSchema(),
+			"tag":          getTagsSchema(),
+			"ref_draft_path": {
+				Type:         schema.TypeString,
+				Description:  "Path of draft to create this draft from. If not specified, draft is created from current published configuration",
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validatePolicyPath(),
+			},
+			"keepers": {
+				Type:        schema.TypeMap,
+				Description: "Arbitrary values that, when changed, trigger creation of new draft",
+				Optional:    true,
+				ForceNew:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func resourceNsxtPolicyDfwDraftExists(id string, connector client.Connector, isGlobalManager bool) (bool, error) {
+	client := infra.NewDraftsClient(connector)
+
+	_, err := client.Get(id)
+	if err == nil {
+		return true, nil
+	}
+
+	if isNotFoundError(err) {
+		return false, nil
+	}
+
+	return false, logAPIError("Error retrieving DFW Draft", err)
+}
+
+func resourceNsxtPolicyDfwDraftRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	connector := getPolicyConnector(m)
+	client := infra.NewDraftsClient(connector)
+
+	id := d.Id()
+	if id == "" {
+		return diag.Errorf("Error obtaining DFW Draft ID")
+	}
+
+	obj, err := client.Get(id)
+	if err != nil {
+		return getOperationDiagnostics(m, handleReadError(d, "DFW Draft", id, err))
+	}
+
+	d.Set("display_name", obj.DisplayName)
+	d.Set("description", obj.Description)
+	setPolicyTagsInSchema(d, obj.Tags)
+	d.Set("nsx_id", obj.Id)
+	d.Set("path", obj.Path)
+	d.Set("revision", obj.Revision)
+	d.Set("ref_draft_path", obj.RefDraftPath)
+
+	return nil
+}
+
+func policyDfwDraftPatch(id string, d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+	client := infra.NewDraftsClient(connector)
+
+	displayName := d.Get("display_name").(string)
+	description := d.Get("description").(string)
+	tags := getPolicyTagsFromSchema(d)
+
+	obj := model.PolicyDraft{
+		DisplayName: &displayName,
+		Description: &description,
+		Tags:        tags,
+	}
+
+	refDraftPath := d.Get("ref_draft_path").(string)
+	if refDraftPath != "" {
+		obj.RefDraftPath = &refDraftPath
+	}
+
+	return client.Patch(id, obj)
+}
+
+func resourceNsxtPolicyDfwDraftCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if isPolicyGlobalManager(m) {
+		return getErrorDiagnostics(resourceNotSupportedError())
+	}
+
+	id, err := getOrGenerateID(d, m, resourceNsxtPolicyDfwDraftExists)
+	if err != nil {
+		return getOperationDiagnostics(m, err)
+	}
+
+	// Manual draft captures configuration at the time of its creation
+	log.Printf("[INFO] Creating DFW Draft with ID %s", id)
+	err = policyDfwDraftPatch(id, d, m)
+	if err != nil {
+		return getOperationDiagnostics(m, handleCreateError("DFW Draft", id, err))
+	}
+
+	d.SetId(id)
+	d.Set("nsx_id", id)
+	return resourceNsxtPolicyDfwDraftRead(ctx, d, m)
+}
+
+func resourceNsxtPolicyDfwDraftUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	id := d.Id()
+	if id == "" {
+		return diag.Errorf("Error obtaining DFW Draft ID")
+	}
+
+	log.Printf("[INFO] Updating DFW Draft with ID %s", id)
+	err := policyDfwDraftPatch(id, d, m)
+	if err != nil {
+		return getOperationDiagnostics(m, handleUpdateError("DFW Draft", id, err))
+	}
+
+	return resourceNsxtPolicyDfwDraftRead(ctx, d, m)
+}
+
+func resourceNsxtPolicyDfwDraftDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	id := d.Id()
+	if id == "" {
+		return diag.Errorf("Error obtaining DFW Draft ID")
+	}
+
+	connector := getPolicyConnector(m)
+	client := infra.NewDraftsClient(connector)
+	err := client.Delete(id)
+	if err != nil {
+		return getOperationDiagnostics(m, handleDeleteError("DFW Draft", id, err))
+	}
+
+	return nil
+}
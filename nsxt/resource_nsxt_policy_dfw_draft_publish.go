@@ -0,0 +1,87 @@
+/* Copyright © 2023 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+// Publishing a draft is one time operation that replaces current DFW configuration
+// with configuration of the draft. The resource only records the publish in state,
+// and its destroy does not revert published configuration.
+func resourceNsxtPolicyDfwDraftPublish() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceNsxtPolicyDfwDraftPublishCreate,
+		ReadContext:   resourceNsxtPolicyDfwDraftPublishRead,
+		DeleteContext: resourceNsxtPolicyDfwDraftPublishDelete,
+
+		Schema: map[string]*schema.Schema{
+			"draft_path": {
+				Type:         schema.TypeString,
+				Description:  "Path of draft to publish",
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validatePolicyPath(),
+			},
+			"keepers": {
+				Type:        schema.TypeMap,
+				Description: "Arbitrary values that, when changed, trigger publish of the draft again",
+				Optional:    true,
+				ForceNew:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func resourceNsxtPolicyDfwDraftPublishCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if isPolicyGlobalManager(m) {
+		return getErrorDiagnostics(resourceNotSupportedError())
+	}
+
+	connector := getPolicyConnector(m)
+	client := infra.NewDraftsClient(connector)
+
+	draftPath := d.Get("draft_path").(string)
+	draftID := getPolicyIDFromPath(draftPath)
+
+	// No additional changes on top of the draft
+	infraType := "Infra"
+	obj := model.Infra{
+		ResourceType: &infraType,
+	}
+
+	log.Printf("[INFO] Publishing DFW Draft %s", draftPath)
+	err := client.Publish(draftID, obj)
+	if err != nil {
+		return getOperationDiagnostics(m, handleCreateError("DFW Draft Publish", draftID, err))
+	}
+
+	d.SetId(newUUID())
+	return resourceNsxtPolicyDfwDraftPublishRead(ctx, d, m)
+}
+
+func resourceNsxtPolicyDfwDraftPublishRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	id := d.Id()
+	if id == "" {
+		return diag.Errorf("Error obtaining DFW Draft Publish ID")
+	}
+
+	// Published configuration may have been changed since, and draft itself
+	// may have been deleted. Neither affects the publish that already happened.
+	return nil
+}
+
+func resourceNsxtPolicyDfwDraftPublishDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Printf("[INFO] Removing publish of DFW Draft %s from state, published configuration is not reverted", d.Get("draft_path").(string))
+	return nil
+}
@@ -0,0 +1,142 @@
+/* Copyright © 2023 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccResourceNsxtPolicyDfwDraft_basic(t *testing.T) {
+	name := getAccTestResourceName()
+	updatedName := getAccTestResourceName()
+	testResourceName := "nsxt_policy_dfw_draft.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccOnlyLocalManager(t); testAccPreCheck(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyResourceCheckDestroy(state, updatedName, "nsxt_policy_dfw_draft", resourceNsxtPolicyDfwDraftExists)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyDfwDraftTemplate(name, "v1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyResourceExists(testResourceName, resourceNsxtPolicyDfwDraftExists),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", name),
+					resource.TestCheckResourceAttr(testResourceName, "description", "test draft"),
+					resource.TestCheckResourceAttr(testResourceName, "keepers.version", "v1"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "1"),
+					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+				),
+			},
+			{
+				Config: testAccNsxtPolicyDfwDraftTemplate(updatedName, "v2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyResourceExists(testResourceName, resourceNsxtPolicyDfwDraftExists),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", updatedName),
+					resource.TestCheckResourceAttr(testResourceName, "keepers.version", "v2"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+				),
+			},
+			{
+				Config: testAccNsxtPolicyDfwDraftPublishTemplate(updatedName, "v2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("nsxt_policy_dfw_draft_publish.test", "draft_path", testResourceName, "path"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNsxtPolicyDfwDraft_importBasic(t *testing.T) {
+	name := getAccTestResourceName()
+	testResourceName := "nsxt_policy_dfw_draft.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccOnlyLocalManager(t); testAccPreCheck(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyResourceCheckDestroy(state, name, "nsxt_policy_dfw_draft", resourceNsxtPolicyDfwDraftExists)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyDfwDraftTemplate(name, "v1"),
+			},
+			{
+				ResourceName:            testResourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"keepers"},
+			},
+		},
+	})
+}
+
+func TestUnitResourceNsxtPolicyDfwDraft_basic(t *testing.T) {
+	fake := newFakeNsxServer(t)
+	meta := testUnitConfigureProvider(t, fake)
+
+	config := map[string]interface{}{
+		"display_name": "draft1",
+		"keepers":      map[string]interface{}{"version": "v1"},
+	}
+	state := testUnitApplyResource(t, meta, "nsxt_policy_dfw_draft", nil, config)
+	path := "/infra/drafts/" + state.ID
+	testUnitCheckAttr(t, state, "path", path)
+	testUnitCheckAttr(t, state, "display_name", "draft1")
+
+	// Change of keepers creates new draft
+	config["keepers"] = map[string]interface{}{"version": "v2"}
+	newState := testUnitApplyResource(t, meta, "nsxt_policy_dfw_draft", state, config)
+	if newState.ID == state.ID {
+		t.Errorf("Expected new draft to be created upon change of keepers")
+	}
+	if fake.getObject(path) != nil {
+		t.Errorf("Expected draft %s to be deleted", path)
+	}
+
+	newPath := newState.Attributes["path"]
+	testUnitApplyResource(t, meta, "nsxt_policy_dfw_draft_publish", nil, map[string]interface{}{
+		"draft_path": newPath,
+	})
+	if len(fake.published) != 1 || fake.published[0] != newPath {
+		t.Errorf("Expected draft %s to be published, got %v", newPath, fake.published)
+	}
+
+	state = testUnitReadDataSource(t, meta, "nsxt_policy_dfw_draft", map[string]interface{}{
+		"display_name": "draft1",
+	})
+	testUnitCheckAttr(t, state, "path", newPath)
+}
+
+func testAccNsxtPolicyDfwDraftTemplate(name string, version string) string {
+	return fmt.Sprintf(`
+resource "nsxt_policy_dfw_draft" "test" {
+  display_name = "%s"
+  description  = "test draft"
+
+  keepers = {
+    version = "%s"
+  }
+
+  tag {
+    scope = "scope1"
+    tag   = "tag1"
+  }
+}`, name, version)
+}
+
+func testAccNsxtPolicyDfwDraftPublishTemplate(name string, version string) string {
+	return testAccNsxtPolicyDfwDraftTemplate(name, version) + `
+
+resource "nsxt_policy_dfw_draft_publish" "test" {
+  draft_path = nsxt_policy_dfw_draft.test.path
+}`
+}
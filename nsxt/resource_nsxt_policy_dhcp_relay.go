@@ -4,9 +4,10 @@
 package nsxt
 
 import (
-	"fmt"
+	"context"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
 	gm_infra "github.com/vmware/vsphere-automation-sdk-go/services/nsxt-gm/global_infra"
@@ -17,12 +18,12 @@ import (
 
 func resourceNsxtPolicyDhcpRelayConfig() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtPolicyDhcpRelayConfigCreate,
-		Read:   resourceNsxtPolicyDhcpRelayConfigRead,
-		Update: resourceNsxtPolicyDhcpRelayConfigUpdate,
-		Delete: resourceNsxtPolicyDhcpRelayConfigDelete,
+		CreateContext: resourceNsxtPolicyDhcpRelayConfigCreate,
+		ReadContext:   resourceNsxtPolicyDhcpRelayConfigRead,
+		UpdateContext: resourceNsxtPolicyDhcpRelayConfigUpdate,
+		DeleteContext: resourceNsxtPolicyDhcpRelayConfigDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -65,13 +66,13 @@ func resourceNsxtPolicyDhcpRelayConfigExists(id string, connector client.Connect
 	return false, logAPIError("Error retrieving resource", err)
 }
 
-func resourceNsxtPolicyDhcpRelayConfigCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyDhcpRelayConfigCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 
 	// Initialize resource Id and verify this ID is not yet used
 	id, err := getOrGenerateID(d, m, resourceNsxtPolicyDhcpRelayConfigExists)
 	if err != nil {
-		return err
+		return getOperationDiagnostics(m, err)
 	}
 
 	displayName := d.Get("display_name").(string)
@@ -91,7 +92,7 @@ func resourceNsxtPolicyDhcpRelayConfigCreate(d *schema.ResourceData, m interface
 	if isPolicyGlobalManager(m) {
 		gmObj, err1 := convertModelBindingType(obj, model.DhcpRelayConfigBindingType(), gm_model.DhcpRelayConfigBindingType())
 		if err1 != nil {
-			return err1
+			return getErrorDiagnostics(err1)
 		}
 
 		client := gm_infra.NewDhcpRelayConfigsClient(connector)
@@ -101,37 +102,37 @@ func resourceNsxtPolicyDhcpRelayConfigCreate(d *schema.ResourceData, m interface
 		err = client.Patch(id, obj)
 	}
 	if err != nil {
-		return handleCreateError("DhcpRelayConfig", id, err)
+		return getOperationDiagnostics(m, handleCreateError("DhcpRelayConfig", id, err))
 	}
 
 	d.SetId(id)
 	d.Set("nsx_id", id)
 
-	return resourceNsxtPolicyDhcpRelayConfigRead(d, m)
+	return resourceNsxtPolicyDhcpRelayConfigRead(ctx, d, m)
 }
 
-func resourceNsxtPolicyDhcpRelayConfigRead(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyDhcpRelayConfigRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 	client := infra.NewDhcpRelayConfigsClient(connector)
 
 	if client == nil {
-		return policyResourceNotSupportedError()
+		return getErrorDiagnostics(policyResourceNotSupportedError())
 	}
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining DhcpRelayConfig ID")
+		return diag.Errorf("Error obtaining DhcpRelayConfig ID")
 	}
 	var obj model.DhcpRelayConfig
 	if isPolicyGlobalManager(m) {
 		client := gm_infra.NewDhcpRelayConfigsClient(connector)
 		gmObj, err1 := client.Get(id)
 		if err1 != nil {
-			return handleReadError(d, "DhcpRelayConfig", id, err1)
+			return getOperationDiagnostics(m, handleReadError(d, "DhcpRelayConfig", id, err1))
 		}
 		rawObj, err := convertModelBindingType(gmObj, gm_model.DhcpRelayConfigBindingType(), model.DhcpRelayConfigBindingType())
 		if err != nil {
-			return err
+			return getOperationDiagnostics(m, err)
 		}
 		obj = rawObj.(model.DhcpRelayConfig)
 	} else {
@@ -139,7 +140,7 @@ func resourceNsxtPolicyDhcpRelayConfigRead(d *schema.ResourceData, m interface{}
 		client := infra.NewDhcpRelayConfigsClient(connector)
 		obj, err = client.Get(id)
 		if err != nil {
-			return handleReadError(d, "DhcpRelayConfig", id, err)
+			return getOperationDiagnostics(m, handleReadError(d, "DhcpRelayConfig", id, err))
 		}
 	}
 
@@ -155,16 +156,16 @@ func resourceNsxtPolicyDhcpRelayConfigRead(d *schema.ResourceData, m interface{}
 	return nil
 }
 
-func resourceNsxtPolicyDhcpRelayConfigUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyDhcpRelayConfigUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 	client := infra.NewDhcpRelayConfigsClient(connector)
 	if client == nil {
-		return policyResourceNotSupportedError()
+		return getErrorDiagnostics(policyResourceNotSupportedError())
 	}
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining DhcpRelayConfig ID")
+		return diag.Errorf("Error obtaining DhcpRelayConfig ID")
 	}
 
 	// Read the rest of the configured parameters
@@ -187,7 +188,7 @@ func resourceNsxtPolicyDhcpRelayConfigUpdate(d *schema.ResourceData, m interface
 	if isPolicyGlobalManager(m) {
 		gmObj, err1 := convertModelBindingType(obj, model.DhcpRelayConfigBindingType(), gm_model.DhcpRelayConfigBindingType())
 		if err1 != nil {
-			return err1
+			return getErrorDiagnostics(err1)
 		}
 
 		client := gm_infra.NewDhcpRelayConfigsClient(connector)
@@ -197,22 +198,22 @@ func resourceNsxtPolicyDhcpRelayConfigUpdate(d *schema.ResourceData, m interface
 		_, err = client.Update(id, obj)
 	}
 	if err != nil {
-		return handleUpdateError("DhcpRelayConfig", id, err)
+		return getOperationDiagnostics(m, handleUpdateError("DhcpRelayConfig", id, err))
 	}
 
-	return resourceNsxtPolicyDhcpRelayConfigRead(d, m)
+	return resourceNsxtPolicyDhcpRelayConfigRead(ctx, d, m)
 }
 
-func resourceNsxtPolicyDhcpRelayConfigDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyDhcpRelayConfigDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining DhcpRelayConfig ID")
+		return diag.Errorf("Error obtaining DhcpRelayConfig ID")
 	}
 
 	connector := getPolicyConnector(m)
 	client := infra.NewDhcpRelayConfigsClient(connector)
 	if client == nil {
-		return policyResourceNotSupportedError()
+		return getErrorDiagnostics(policyResourceNotSupportedError())
 	}
 
 	var err error
@@ -225,7 +226,7 @@ func resourceNsxtPolicyDhcpRelayConfigDelete(d *schema.ResourceData, m interface
 	}
 
 	if err != nil {
-		return handleDeleteError("DhcpRelayConfig", id, err)
+		return getOperationDiagnostics(m, handleDeleteError("DhcpRelayConfig", id, err))
 	}
 
 	return nil
@@ -4,9 +4,10 @@
 package nsxt
 
 import (
-	"fmt"
+	"context"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
@@ -18,12 +19,12 @@ import (
 
 func resourceNsxtPolicyDhcpServer() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtPolicyDhcpServerCreate,
-		Read:   resourceNsxtPolicyDhcpServerRead,
-		Update: resourceNsxtPolicyDhcpServerUpdate,
-		Delete: resourceNsxtPolicyDhcpServerDelete,
+		CreateContext: resourceNsxtPolicyDhcpServerCreate,
+		ReadContext:   resourceNsxtPolicyDhcpServerRead,
+		UpdateContext: resourceNsxtPolicyDhcpServerUpdate,
+		DeleteContext: resourceNsxtPolicyDhcpServerDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -118,13 +119,13 @@ func resourceNsxtPolicyDhcpServerSchemaToModel(d *schema.ResourceData) model.Dhc
 	return obj
 }
 
-func resourceNsxtPolicyDhcpServerCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyDhcpServerCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 
 	// Initialize resource Id and verify this ID is not yet used
 	id, err := getOrGenerateID(d, m, resourceNsxtPolicyDhcpServerExists)
 	if err != nil {
-		return err
+		return getOperationDiagnostics(m, err)
 	}
 
 	// Create the resource using PATCH
@@ -133,7 +134,7 @@ func resourceNsxtPolicyDhcpServerCreate(d *schema.ResourceData, m interface{}) e
 		obj := resourceNsxtPolicyDhcpServerSchemaToModel(d)
 		gmObj, err1 := convertModelBindingType(obj, model.DhcpServerConfigBindingType(), gm_model.DhcpServerConfigBindingType())
 		if err1 != nil {
-			return err1
+			return getErrorDiagnostics(err1)
 		}
 
 		client := gm_infra.NewDhcpServerConfigsClient(connector)
@@ -143,21 +144,21 @@ func resourceNsxtPolicyDhcpServerCreate(d *schema.ResourceData, m interface{}) e
 		err = client.Patch(id, resourceNsxtPolicyDhcpServerSchemaToModel(d))
 	}
 	if err != nil {
-		return handleCreateError("DhcpServer", id, err)
+		return getOperationDiagnostics(m, handleCreateError("DhcpServer", id, err))
 	}
 
 	d.SetId(id)
 	d.Set("nsx_id", id)
 
-	return resourceNsxtPolicyDhcpServerRead(d, m)
+	return resourceNsxtPolicyDhcpServerRead(ctx, d, m)
 }
 
-func resourceNsxtPolicyDhcpServerRead(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyDhcpServerRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining DhcpServer ID")
+		return diag.Errorf("Error obtaining DhcpServer ID")
 	}
 
 	var obj model.DhcpServerConfig
@@ -165,11 +166,11 @@ func resourceNsxtPolicyDhcpServerRead(d *schema.ResourceData, m interface{}) err
 		client := gm_infra.NewDhcpServerConfigsClient(connector)
 		gmObj, err := client.Get(id)
 		if err != nil {
-			return handleReadError(d, "DhcpServer", id, err)
+			return getOperationDiagnostics(m, handleReadError(d, "DhcpServer", id, err))
 		}
 		rawObj, err := convertModelBindingType(gmObj, gm_model.DhcpServerConfigBindingType(), model.DhcpServerConfigBindingType())
 		if err != nil {
-			return err
+			return getOperationDiagnostics(m, err)
 		}
 		obj = rawObj.(model.DhcpServerConfig)
 	} else {
@@ -177,7 +178,7 @@ func resourceNsxtPolicyDhcpServerRead(d *schema.ResourceData, m interface{}) err
 		client := infra.NewDhcpServerConfigsClient(connector)
 		obj, err = client.Get(id)
 		if err != nil {
-			return handleReadError(d, "DhcpServer", id, err)
+			return getOperationDiagnostics(m, handleReadError(d, "DhcpServer", id, err))
 		}
 	}
 
@@ -196,16 +197,16 @@ func resourceNsxtPolicyDhcpServerRead(d *schema.ResourceData, m interface{}) err
 	return nil
 }
 
-func resourceNsxtPolicyDhcpServerUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyDhcpServerUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 	client := infra.NewDhcpServerConfigsClient(connector)
 	if client == nil {
-		return policyResourceNotSupportedError()
+		return getErrorDiagnostics(policyResourceNotSupportedError())
 	}
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining DhcpServer ID")
+		return diag.Errorf("Error obtaining DhcpServer ID")
 	}
 
 	// Update the resource using PATCH
@@ -214,7 +215,7 @@ func resourceNsxtPolicyDhcpServerUpdate(d *schema.ResourceData, m interface{}) e
 		obj := resourceNsxtPolicyDhcpServerSchemaToModel(d)
 		gmObj, err1 := convertModelBindingType(obj, model.DhcpServerConfigBindingType(), gm_model.DhcpServerConfigBindingType())
 		if err1 != nil {
-			return err1
+			return getErrorDiagnostics(err1)
 		}
 
 		client := gm_infra.NewDhcpServerConfigsClient(connector)
@@ -224,16 +225,16 @@ func resourceNsxtPolicyDhcpServerUpdate(d *schema.ResourceData, m interface{}) e
 		err = client.Patch(id, resourceNsxtPolicyDhcpServerSchemaToModel(d))
 	}
 	if err != nil {
-		return handleUpdateError("DhcpServer", id, err)
+		return getOperationDiagnostics(m, handleUpdateError("DhcpServer", id, err))
 	}
 
-	return resourceNsxtPolicyDhcpServerRead(d, m)
+	return resourceNsxtPolicyDhcpServerRead(ctx, d, m)
 }
 
-func resourceNsxtPolicyDhcpServerDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyDhcpServerDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining DhcpServer ID")
+		return diag.Errorf("Error obtaining DhcpServer ID")
 	}
 
 	var err error
@@ -247,7 +248,7 @@ func resourceNsxtPolicyDhcpServerDelete(d *schema.ResourceData, m interface{}) e
 	}
 
 	if err != nil {
-		return handleDeleteError("DhcpServer", id, err)
+		return getOperationDiagnostics(m, handleDeleteError("DhcpServer", id, err))
 	}
 
 	return nil
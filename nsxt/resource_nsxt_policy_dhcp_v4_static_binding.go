@@ -4,10 +4,12 @@
 package nsxt
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/vmware/vsphere-automation-sdk-go/runtime/bindings"
@@ -22,12 +24,12 @@ import (
 
 func resourceNsxtPolicyDhcpV4StaticBinding() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtPolicyDhcpV4StaticBindingCreate,
-		Read:   resourceNsxtPolicyDhcpV4StaticBindingRead,
-		Update: resourceNsxtPolicyDhcpV4StaticBindingUpdate,
-		Delete: resourceNsxtPolicyDhcpStaticBindingDelete,
+		CreateContext: resourceNsxtPolicyDhcpV4StaticBindingCreate,
+		ReadContext:   resourceNsxtPolicyDhcpV4StaticBindingRead,
+		UpdateContext: resourceNsxtPolicyDhcpV4StaticBindingUpdate,
+		DeleteContext: resourceNsxtPolicyDhcpStaticBindingDelete,
 		Importer: &schema.ResourceImporter{
-			State: nsxtSegmentResourceImporter,
+			StateContext: nsxtSegmentResourceImporter,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -191,34 +193,34 @@ func getDhcpOptsFromSchema(d *schema.ResourceData) *model.DhcpV4Options {
 
 }
 
-func resourceNsxtPolicyDhcpV4StaticBindingCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyDhcpV4StaticBindingCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 
 	segmentPath := d.Get("segment_path").(string)
 	// Initialize resource Id and verify this ID is not yet used
 	id, err := getOrGenerateID(d, m, resourceNsxtPolicyDhcpStaticBindingExists(segmentPath))
 	if err != nil {
-		return err
+		return getOperationDiagnostics(m, err)
 	}
 
 	log.Printf("[INFO] Creating DhcpV4 Static Binding Config with ID %s on segment %s", id, segmentPath)
 	err = policyDhcpV4StaticBindingConvertAndPatch(d, segmentPath, id, m)
 
 	if err != nil {
-		return handleCreateError("DhcpV4 Static Binding Config", id, err)
+		return getOperationDiagnostics(m, handleCreateError("DhcpV4 Static Binding Config", id, err))
 	}
 
 	d.SetId(id)
 	d.Set("nsx_id", id)
 
-	return resourceNsxtPolicyDhcpV4StaticBindingRead(d, m)
+	return resourceNsxtPolicyDhcpV4StaticBindingRead(ctx, d, m)
 }
 
-func resourceNsxtPolicyDhcpV4StaticBindingRead(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyDhcpV4StaticBindingRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining DhcpV4 Static Binding Config ID")
+		return diag.Errorf("Error obtaining DhcpV4 Static Binding Config ID")
 	}
 
 	segmentPath := d.Get("segment_path").(string)
@@ -229,11 +231,11 @@ func resourceNsxtPolicyDhcpV4StaticBindingRead(d *schema.ResourceData, m interfa
 	var dhcpObj *data.StructValue
 	isT0, gwID, segmentID := parseSegmentPolicyPath(segmentPath)
 	if isT0 {
-		return fmt.Errorf("This resource is not applicable to segment %s", segmentPath)
+		return diag.Errorf("This resource is not applicable to segment %s", segmentPath)
 	}
 	if isPolicyGlobalManager(m) {
 		if gwID != "" {
-			return fmt.Errorf("This resource is not applicable to segment on Global Manager %s", segmentPath)
+			return diag.Errorf("This resource is not applicable to segment on Global Manager %s", segmentPath)
 		}
 		client := gm_segments.NewDhcpStaticBindingConfigsClient(connector)
 		dhcpObj, err = client.Get(segmentID, id)
@@ -250,17 +252,17 @@ func resourceNsxtPolicyDhcpV4StaticBindingRead(d *schema.ResourceData, m interfa
 		}
 	}
 	if err != nil {
-		return handleReadError(d, "DhcpV4 Static Binding Config", id, err)
+		return getOperationDiagnostics(m, handleReadError(d, "DhcpV4 Static Binding Config", id, err))
 	}
 
 	convObj, errs := converter.ConvertToGolang(dhcpObj, model.DhcpV4StaticBindingConfigBindingType())
 	if errs != nil {
-		return errs[0]
+		return getErrorDiagnostics(errs[0])
 	}
 	obj = convObj.(model.DhcpV4StaticBindingConfig)
 
 	if obj.ResourceType != "DhcpV4StaticBindingConfig" {
-		return handleReadError(d, "DhcpV4 Static Binding Config", id, fmt.Errorf("Unexpected ResourceType"))
+		return getOperationDiagnostics(m, handleReadError(d, "DhcpV4 Static Binding Config", id, fmt.Errorf("Unexpected ResourceType")))
 	}
 
 	d.Set("display_name", obj.DisplayName)
@@ -289,26 +291,26 @@ func resourceNsxtPolicyDhcpV4StaticBindingRead(d *schema.ResourceData, m interfa
 	return nil
 }
 
-func resourceNsxtPolicyDhcpV4StaticBindingUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyDhcpV4StaticBindingUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining DhcpV4 Static Binding Config ID")
+		return diag.Errorf("Error obtaining DhcpV4 Static Binding Config ID")
 	}
 	segmentPath := d.Get("segment_path").(string)
 
 	log.Printf("[INFO] Updating DhcpV4 Static Binding Config with ID %s", id)
 	err := policyDhcpV4StaticBindingConvertAndPatch(d, segmentPath, id, m)
 	if err != nil {
-		return handleUpdateError("DhcpV4 Static Binding Config", id, err)
+		return getOperationDiagnostics(m, handleUpdateError("DhcpV4 Static Binding Config", id, err))
 	}
 
-	return resourceNsxtPolicyDhcpV4StaticBindingRead(d, m)
+	return resourceNsxtPolicyDhcpV4StaticBindingRead(ctx, d, m)
 }
 
-func resourceNsxtPolicyDhcpStaticBindingDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyDhcpStaticBindingDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining Dhcp Static Binding Config ID")
+		return diag.Errorf("Error obtaining Dhcp Static Binding Config ID")
 	}
 	segmentPath := d.Get("segment_path").(string)
 	_, gwID, segmentID := parseSegmentPolicyPath(segmentPath)
@@ -331,13 +333,13 @@ func resourceNsxtPolicyDhcpStaticBindingDelete(d *schema.ResourceData, m interfa
 	}
 
 	if err != nil {
-		return handleDeleteError("Dhcp Static Binding Config", id, err)
+		return getOperationDiagnostics(m, handleDeleteError("Dhcp Static Binding Config", id, err))
 	}
 
 	return nil
 }
 
-func nsxtSegmentResourceImporter(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+func nsxtSegmentResourceImporter(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	importID := d.Id()
 	importSegment := ""
 	importGW := ""
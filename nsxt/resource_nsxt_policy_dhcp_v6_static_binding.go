@@ -4,9 +4,11 @@
 package nsxt
 
 import (
+	"context"
 	"fmt"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/vmware/vsphere-automation-sdk-go/runtime/bindings"
@@ -20,12 +22,12 @@ import (
 
 func resourceNsxtPolicyDhcpV6StaticBinding() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtPolicyDhcpV6StaticBindingCreate,
-		Read:   resourceNsxtPolicyDhcpV6StaticBindingRead,
-		Update: resourceNsxtPolicyDhcpV6StaticBindingUpdate,
-		Delete: resourceNsxtPolicyDhcpStaticBindingDelete,
+		CreateContext: resourceNsxtPolicyDhcpV6StaticBindingCreate,
+		ReadContext:   resourceNsxtPolicyDhcpV6StaticBindingRead,
+		UpdateContext: resourceNsxtPolicyDhcpV6StaticBindingUpdate,
+		DeleteContext: resourceNsxtPolicyDhcpStaticBindingDelete,
 		Importer: &schema.ResourceImporter{
-			State: nsxtSegmentResourceImporter,
+			StateContext: nsxtSegmentResourceImporter,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -151,41 +153,41 @@ func policyDhcpV6StaticBindingConvertAndPatch(d *schema.ResourceData, segmentPat
 	return client.Patch(gwID, segmentID, id, convObj.(*data.StructValue))
 }
 
-func resourceNsxtPolicyDhcpV6StaticBindingCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyDhcpV6StaticBindingCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 
 	segmentPath := d.Get("segment_path").(string)
 	segmentID := getPolicyIDFromPath(segmentPath)
 	// Initialize resource Id and verify this ID is not yet used
 	id, err := getOrGenerateID(d, m, resourceNsxtPolicyDhcpStaticBindingExists(segmentID))
 	if err != nil {
-		return err
+		return getOperationDiagnostics(m, err)
 	}
 
 	log.Printf("[INFO] Creating DhcpV6 Static Binding Config with ID %s", id)
 	err = policyDhcpV6StaticBindingConvertAndPatch(d, segmentPath, id, m)
 
 	if err != nil {
-		return handleCreateError("DhcpV6 Static Binding Config", id, err)
+		return getOperationDiagnostics(m, handleCreateError("DhcpV6 Static Binding Config", id, err))
 	}
 
 	d.SetId(id)
 	d.Set("nsx_id", id)
 
-	return resourceNsxtPolicyDhcpV6StaticBindingRead(d, m)
+	return resourceNsxtPolicyDhcpV6StaticBindingRead(ctx, d, m)
 }
 
-func resourceNsxtPolicyDhcpV6StaticBindingRead(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyDhcpV6StaticBindingRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining DhcpV6 Static Binding Config ID")
+		return diag.Errorf("Error obtaining DhcpV6 Static Binding Config ID")
 	}
 
 	segmentPath := d.Get("segment_path").(string)
 	isT0, gwID, segmentID := parseSegmentPolicyPath(segmentPath)
 	if isT0 {
-		return fmt.Errorf("This resource is not applicable to segment %s", segmentPath)
+		return diag.Errorf("This resource is not applicable to segment %s", segmentPath)
 	}
 
 	var obj model.DhcpV6StaticBindingConfig
@@ -208,17 +210,17 @@ func resourceNsxtPolicyDhcpV6StaticBindingRead(d *schema.ResourceData, m interfa
 		}
 	}
 	if err != nil {
-		return handleReadError(d, "DhcpV6 Static Binding Config", id, err)
+		return getOperationDiagnostics(m, handleReadError(d, "DhcpV6 Static Binding Config", id, err))
 	}
 
 	convObj, errs := converter.ConvertToGolang(dhcpObj, model.DhcpV6StaticBindingConfigBindingType())
 	if errs != nil {
-		return errs[0]
+		return getErrorDiagnostics(errs[0])
 	}
 	obj = convObj.(model.DhcpV6StaticBindingConfig)
 
 	if obj.ResourceType != "DhcpV6StaticBindingConfig" {
-		return handleReadError(d, "DhcpV6 Static Binding Config", id, fmt.Errorf("Unexpected ResourceType"))
+		return getOperationDiagnostics(m, handleReadError(d, "DhcpV6 Static Binding Config", id, fmt.Errorf("Unexpected ResourceType")))
 	}
 
 	d.Set("display_name", obj.DisplayName)
@@ -239,18 +241,18 @@ func resourceNsxtPolicyDhcpV6StaticBindingRead(d *schema.ResourceData, m interfa
 	return nil
 }
 
-func resourceNsxtPolicyDhcpV6StaticBindingUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyDhcpV6StaticBindingUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining DhcpV6 Static Binding Config ID")
+		return diag.Errorf("Error obtaining DhcpV6 Static Binding Config ID")
 	}
 	segmentPath := d.Get("segment_path").(string)
 
 	log.Printf("[INFO] Updating DhcpV6StaticBindingConfig with ID %s", id)
 	err := policyDhcpV6StaticBindingConvertAndPatch(d, segmentPath, id, m)
 	if err != nil {
-		return handleUpdateError("DhcpV6 Static Binding Config", id, err)
+		return getOperationDiagnostics(m, handleUpdateError("DhcpV6 Static Binding Config", id, err))
 	}
 
-	return resourceNsxtPolicyDhcpV6StaticBindingRead(d, m)
+	return resourceNsxtPolicyDhcpV6StaticBindingRead(ctx, d, m)
 }
@@ -4,9 +4,10 @@
 package nsxt
 
 import (
-	"fmt"
+	"context"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
@@ -18,12 +19,12 @@ import (
 
 func resourceNsxtPolicyDNSForwarderZone() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtPolicyDNSForwarderZoneCreate,
-		Read:   resourceNsxtPolicyDNSForwarderZoneRead,
-		Update: resourceNsxtPolicyDNSForwarderZoneUpdate,
-		Delete: resourceNsxtPolicyDNSForwarderZoneDelete,
+		CreateContext: resourceNsxtPolicyDNSForwarderZoneCreate,
+		ReadContext:   resourceNsxtPolicyDNSForwarderZoneRead,
+		UpdateContext: resourceNsxtPolicyDNSForwarderZoneUpdate,
+		DeleteContext: resourceNsxtPolicyDNSForwarderZoneDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -111,34 +112,34 @@ func policyDNSForwarderZonePatch(id string, d *schema.ResourceData, connector cl
 	return client.Patch(id, obj)
 }
 
-func resourceNsxtPolicyDNSForwarderZoneCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyDNSForwarderZoneCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 
 	// Initialize resource Id and verify this ID is not yet used
 	id, err := getOrGenerateID(d, m, resourceNsxtPolicyDNSForwarderZoneExists)
 	if err != nil {
-		return err
+		return getOperationDiagnostics(m, err)
 	}
 
 	log.Printf("[INFO] Creating Dns Forwarder Zone with ID %s", id)
 	err = policyDNSForwarderZonePatch(id, d, connector, isPolicyGlobalManager(m))
 
 	if err != nil {
-		return handleCreateError("Dns Forwarder Zone", id, err)
+		return getOperationDiagnostics(m, handleCreateError("Dns Forwarder Zone", id, err))
 	}
 
 	d.SetId(id)
 	d.Set("nsx_id", id)
 
-	return resourceNsxtPolicyDNSForwarderZoneRead(d, m)
+	return resourceNsxtPolicyDNSForwarderZoneRead(ctx, d, m)
 }
 
-func resourceNsxtPolicyDNSForwarderZoneRead(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyDNSForwarderZoneRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining Dns Forwarder Zone ID")
+		return diag.Errorf("Error obtaining Dns Forwarder Zone ID")
 	}
 
 	var obj model.PolicyDnsForwarderZone
@@ -146,12 +147,12 @@ func resourceNsxtPolicyDNSForwarderZoneRead(d *schema.ResourceData, m interface{
 		client := gm_infra.NewDnsForwarderZonesClient(connector)
 		gmObj, err := client.Get(id)
 		if err != nil {
-			return handleReadError(d, "Dns Forwarder Zone", id, err)
+			return getOperationDiagnostics(m, handleReadError(d, "Dns Forwarder Zone", id, err))
 		}
 
 		lmObj, err := convertModelBindingType(gmObj, gm_model.PolicyDnsForwarderZoneBindingType(), model.PolicyDnsForwarderZoneBindingType())
 		if err != nil {
-			return err
+			return getOperationDiagnostics(m, err)
 		}
 		obj = lmObj.(model.PolicyDnsForwarderZone)
 	} else {
@@ -159,7 +160,7 @@ func resourceNsxtPolicyDNSForwarderZoneRead(d *schema.ResourceData, m interface{
 		var err error
 		obj, err = client.Get(id)
 		if err != nil {
-			return handleReadError(d, "Dns Forwarder Zone", id, err)
+			return getOperationDiagnostics(m, handleReadError(d, "Dns Forwarder Zone", id, err))
 		}
 	}
 
@@ -177,27 +178,27 @@ func resourceNsxtPolicyDNSForwarderZoneRead(d *schema.ResourceData, m interface{
 	return nil
 }
 
-func resourceNsxtPolicyDNSForwarderZoneUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyDNSForwarderZoneUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining Dns Forwarder Zone ID")
+		return diag.Errorf("Error obtaining Dns Forwarder Zone ID")
 	}
 
 	log.Printf("[INFO] Updating Dns Forwarder Zone with ID %s", id)
 	err := policyDNSForwarderZonePatch(id, d, connector, isPolicyGlobalManager(m))
 	if err != nil {
-		return handleUpdateError("Dns Forwarder Zone", id, err)
+		return getOperationDiagnostics(m, handleUpdateError("Dns Forwarder Zone", id, err))
 	}
 
-	return resourceNsxtPolicyDNSForwarderZoneRead(d, m)
+	return resourceNsxtPolicyDNSForwarderZoneRead(ctx, d, m)
 }
 
-func resourceNsxtPolicyDNSForwarderZoneDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyDNSForwarderZoneDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining Dns Forwarder Zone ID")
+		return diag.Errorf("Error obtaining Dns Forwarder Zone ID")
 	}
 
 	connector := getPolicyConnector(m)
@@ -211,7 +212,7 @@ func resourceNsxtPolicyDNSForwarderZoneDelete(d *schema.ResourceData, m interfac
 	}
 
 	if err != nil {
-		return handleDeleteError("Dns Forwarder Zone", id, err)
+		return getOperationDiagnostics(m, handleDeleteError("Dns Forwarder Zone", id, err))
 	}
 
 	return nil
@@ -0,0 +1,315 @@
+/* Copyright © 2023 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"sort"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/go-vmware-nsxt/manager"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/sites/enforcement_points"
+)
+
+const edgeHighAvailabilityProfileType = "EdgeHighAvailabilityProfile"
+
+// Policy API exposes Edge Clusters as read-only objects, therefore this resource
+// manages the cluster via NSX Manager API and exposes policy path of the synced object
+func resourceNsxtPolicyEdgeCluster() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceNsxtPolicyEdgeClusterCreate,
+		ReadContext:   resourceNsxtPolicyEdgeClusterRead,
+		UpdateContext: resourceNsxtPolicyEdgeClusterUpdate,
+		DeleteContext: resourceNsxtPolicyEdgeClusterDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"path":         getPathSchema(),
+			"display_name": getDisplayNameSchema(),
+			"description":  getDescriptionSchema(),
+			"revision":     getRevisionSchema(),
+			"tag":          getTagsSchema(),
+			"edge_ha_profile_id": {
+				Type:        schema.TypeString,
+				Description: "ID of Edge High Availability Profile to bind to this cluster",
+				Optional:    true,
+				Computed:    true,
+			},
+			"member": {
+				Type:        schema.TypeList,
+				Description: "Edge cluster members, in order of member index",
+				Optional:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"transport_node_id": {
+							Type:        schema.TypeString,
+							Description: "Edge Transport Node ID",
+							Required:    true,
+						},
+						"display_name": {
+							Type:        schema.TypeString,
+							Description: "Display name of the member",
+							Optional:    true,
+							Computed:    true,
+						},
+						"description": {
+							Type:        schema.TypeString,
+							Description: "Description of the member",
+							Optional:    true,
+						},
+						"member_index": {
+							Type:        schema.TypeInt,
+							Description: "System generated index for cluster member",
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"deployment_type": {
+				Type:        schema.TypeString,
+				Description: "Edge cluster deployment type",
+				Computed:    true,
+			},
+			"member_node_type": {
+				Type:        schema.TypeString,
+				Description: "Type of transport nodes in this cluster",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func getEdgeClusterMembersFromSchema(d *schema.ResourceData) []manager.EdgeClusterMember {
+	var members []manager.EdgeClusterMember
+	oldMembers, _ := d.GetChange("member")
+	oldIndexes := make(map[string]int32)
+	for _, m := range oldMembers.([]interface{}) {
+		member := m.(map[string]interface{})
+		oldIndexes[member["transport_node_id"].(string)] = int32(member["member_index"].(int))
+	}
+
+	for _, m := range d.Get("member").([]interface{}) {
+		member := m.(map[string]interface{})
+		transportNodeID := member["transport_node_id"].(string)
+		elem := manager.EdgeClusterMember{
+			TransportNodeId: transportNodeID,
+			DisplayName:     member["display_name"].(string),
+			Description:     member["description"].(string),
+		}
+		// Member index is allocated by NSX; existing members need to retain theirs
+		if index, ok := oldIndexes[transportNodeID]; ok {
+			elem.MemberIndex = index
+		}
+		members = append(members, elem)
+	}
+
+	return members
+}
+
+func setEdgeClusterMembersInSchema(d *schema.ResourceData, members []manager.EdgeClusterMember) {
+	sort.Slice(members, func(i, j int) bool {
+		return members[i].MemberIndex < members[j].MemberIndex
+	})
+
+	var memberList []interface{}
+	for _, member := range members {
+		elem := make(map[string]interface{})
+		elem["transport_node_id"] = member.TransportNodeId
+		elem["display_name"] = member.DisplayName
+		elem["description"] = member.Description
+		elem["member_index"] = member.MemberIndex
+		memberList = append(memberList, elem)
+	}
+	d.Set("member", memberList)
+}
+
+func getEdgeClusterProfileBindingsFromSchema(d *schema.ResourceData) []manager.ClusterProfileTypeIdEntry {
+	profileID := d.Get("edge_ha_profile_id").(string)
+	if profileID == "" {
+		return nil
+	}
+
+	return []manager.ClusterProfileTypeIdEntry{
+		{
+			ProfileId:    profileID,
+			ResourceType: edgeHighAvailabilityProfileType,
+		},
+	}
+}
+
+func setEdgeClusterProfileBindingsInSchema(d *schema.ResourceData, bindings []manager.ClusterProfileTypeIdEntry) {
+	for _, binding := range bindings {
+		if binding.ResourceType == edgeHighAvailabilityProfileType {
+			d.Set("edge_ha_profile_id", binding.ProfileId)
+			return
+		}
+	}
+	d.Set("edge_ha_profile_id", "")
+}
+
+func policyEdgeClusterWaitForSync(ctx context.Context, d *schema.ResourceData, m interface{}, id string) error {
+	connector := getPolicyConnector(m)
+	client := enforcement_points.NewEdgeClustersClient(connector)
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"pending"},
+		Target:  []string{"synced"},
+		Refresh: func() (interface{}, string, error) {
+			obj, err := client.Get(defaultSite, getPolicyEnforcementPoint(m), id)
+			if err != nil {
+				if isNotFoundError(err) {
+					return obj, "pending", nil
+				}
+				return obj, "", logAPIError("Error while waiting for Edge Cluster to appear in Policy", err)
+			}
+			return obj, "synced", nil
+		},
+		Timeout:    d.Timeout(schema.TimeoutCreate),
+		MinTimeout: 1 * time.Second,
+		Delay:      1 * time.Second,
+	}
+	_, err := stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		return fmt.Errorf("Failed to wait for Edge Cluster %s to sync with Policy: %v", id, err)
+	}
+
+	return nil
+}
+
+func resourceNsxtPolicyEdgeClusterCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if isPolicyGlobalManager(m) {
+		return getErrorDiagnostics(localManagerOnlyError())
+	}
+	nsxClient := m.(nsxtClients).NsxtClient
+	if nsxClient == nil {
+		return getErrorDiagnostics(resourceNotSupportedError())
+	}
+
+	edgeCluster := manager.EdgeCluster{
+		DisplayName:            d.Get("display_name").(string),
+		Description:            d.Get("description").(string),
+		Tags:                   getTagsFromSchema(d),
+		Members:                getEdgeClusterMembersFromSchema(d),
+		ClusterProfileBindings: getEdgeClusterProfileBindingsFromSchema(d),
+	}
+
+	log.Printf("[INFO] Creating Edge Cluster %s", edgeCluster.DisplayName)
+	edgeCluster, resp, err := nsxClient.NetworkTransportApi.CreateEdgeCluster(nsxClient.Context, edgeCluster)
+	if err != nil {
+		return diag.Errorf("Error during Edge Cluster create: %v", err)
+	}
+
+	if resp.StatusCode != http.StatusCreated {
+		return diag.Errorf("Unexpected status returned during Edge Cluster create: %v", resp.StatusCode)
+	}
+	d.SetId(edgeCluster.Id)
+
+	err = policyEdgeClusterWaitForSync(ctx, d, m, edgeCluster.Id)
+	if err != nil {
+		return getOperationDiagnostics(m, err)
+	}
+
+	return resourceNsxtPolicyEdgeClusterRead(ctx, d, m)
+}
+
+func resourceNsxtPolicyEdgeClusterRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	nsxClient := m.(nsxtClients).NsxtClient
+	if nsxClient == nil {
+		return getErrorDiagnostics(resourceNotSupportedError())
+	}
+
+	id := d.Id()
+	if id == "" {
+		return diag.Errorf("Error obtaining Edge Cluster ID")
+	}
+
+	edgeCluster, resp, err := nsxClient.NetworkTransportApi.ReadEdgeCluster(nsxClient.Context, id)
+	if resp != nil && resp.StatusCode == http.StatusNotFound {
+		log.Printf("[DEBUG] Edge Cluster %s not found", id)
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return diag.Errorf("Error during Edge Cluster read: %v", err)
+	}
+
+	d.Set("revision", edgeCluster.Revision)
+	d.Set("display_name", edgeCluster.DisplayName)
+	d.Set("description", edgeCluster.Description)
+	setTagsInSchema(d, edgeCluster.Tags)
+	d.Set("deployment_type", edgeCluster.DeploymentType)
+	d.Set("member_node_type", edgeCluster.MemberNodeType)
+	setEdgeClusterMembersInSchema(d, edgeCluster.Members)
+	setEdgeClusterProfileBindingsInSchema(d, edgeCluster.ClusterProfileBindings)
+
+	connector := getPolicyConnector(m)
+	client := enforcement_points.NewEdgeClustersClient(connector)
+	policyObj, err := client.Get(defaultSite, getPolicyEnforcementPoint(m), id)
+	if err != nil {
+		log.Printf("[WARNING] Failed to retrieve policy path for Edge Cluster %s: %v", id, err)
+	} else {
+		d.Set("path", policyObj.Path)
+	}
+
+	return nil
+}
+
+func resourceNsxtPolicyEdgeClusterUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	nsxClient := m.(nsxtClients).NsxtClient
+	if nsxClient == nil {
+		return getErrorDiagnostics(resourceNotSupportedError())
+	}
+
+	id := d.Id()
+	if id == "" {
+		return diag.Errorf("Error obtaining Edge Cluster ID")
+	}
+
+	edgeCluster := manager.EdgeCluster{
+		Revision:               int64(d.Get("revision").(int)),
+		DisplayName:            d.Get("display_name").(string),
+		Description:            d.Get("description").(string),
+		Tags:                   getTagsFromSchema(d),
+		Members:                getEdgeClusterMembersFromSchema(d),
+		ClusterProfileBindings: getEdgeClusterProfileBindingsFromSchema(d),
+	}
+
+	_, resp, err := nsxClient.NetworkTransportApi.UpdateEdgeCluster(nsxClient.Context, id, edgeCluster)
+	if err != nil || resp.StatusCode == http.StatusNotFound {
+		return diag.Errorf("Error during Edge Cluster update: %v", err)
+	}
+
+	return resourceNsxtPolicyEdgeClusterRead(ctx, d, m)
+}
+
+func resourceNsxtPolicyEdgeClusterDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	nsxClient := m.(nsxtClients).NsxtClient
+	if nsxClient == nil {
+		return getErrorDiagnostics(resourceNotSupportedError())
+	}
+
+	id := d.Id()
+	if id == "" {
+		return diag.Errorf("Error obtaining Edge Cluster ID")
+	}
+
+	resp, err := nsxClient.NetworkTransportApi.DeleteEdgeCluster(nsxClient.Context, id)
+	if err != nil {
+		return diag.Errorf("Error during Edge Cluster delete: %v", err)
+	}
+
+	if resp.StatusCode == http.StatusNotFound {
+		log.Printf("[DEBUG] Edge Cluster %s not found", id)
+		d.SetId("")
+	}
+	return nil
+}
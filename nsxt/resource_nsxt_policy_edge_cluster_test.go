@@ -0,0 +1,152 @@
+/* Copyright © 2023 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func getTestEdgeTransportNodeID() string {
+	return os.Getenv("NSXT_TEST_EDGE_TRANSPORT_NODE_ID")
+}
+
+func TestAccResourceNsxtPolicyEdgeCluster_basic(t *testing.T) {
+	testResourceName := "nsxt_policy_edge_cluster.test"
+	name := getAccTestResourceName()
+	updatedName := getAccTestResourceName()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccOnlyLocalManager(t)
+			testAccEnvDefined(t, "NSXT_TEST_EDGE_TRANSPORT_NODE_ID")
+		},
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyEdgeClusterCheckDestroy(state, updatedName)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyEdgeClusterTemplate(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyEdgeClusterExists(testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", name),
+					resource.TestCheckResourceAttr(testResourceName, "description", "terraform created"),
+					resource.TestCheckResourceAttr(testResourceName, "member.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "member.0.transport_node_id", getTestEdgeTransportNodeID()),
+					resource.TestCheckResourceAttr(testResourceName, "member.0.member_index", "0"),
+					resource.TestCheckResourceAttrSet(testResourceName, "edge_ha_profile_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "member_node_type"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "1"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+				),
+			},
+			{
+				Config: testAccNsxtPolicyEdgeClusterTemplate(updatedName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyEdgeClusterExists(testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", updatedName),
+					resource.TestCheckResourceAttr(testResourceName, "member.#", "1"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNsxtPolicyEdgeCluster_importBasic(t *testing.T) {
+	name := getAccTestResourceName()
+	testResourceName := "nsxt_policy_edge_cluster.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccOnlyLocalManager(t)
+			testAccEnvDefined(t, "NSXT_TEST_EDGE_TRANSPORT_NODE_ID")
+		},
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyEdgeClusterCheckDestroy(state, name)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyEdgeClusterTemplate(name),
+			},
+			{
+				ResourceName:      testResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicyEdgeClusterExists(resourceName string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		nsxClient := testAccProvider.Meta().(nsxtClients).NsxtClient
+
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Edge Cluster resource %s not found in resources", resourceName)
+		}
+
+		resourceID := rs.Primary.ID
+		if resourceID == "" {
+			return fmt.Errorf("Edge Cluster resource ID not set in resources")
+		}
+
+		_, resp, err := nsxClient.NetworkTransportApi.ReadEdgeCluster(nsxClient.Context, resourceID)
+		if err != nil {
+			return fmt.Errorf("Error while retrieving Edge Cluster %s: %v", resourceID, err)
+		}
+
+		if resp.StatusCode != http.StatusOK {
+			return fmt.Errorf("Edge Cluster %s does not exist", resourceID)
+		}
+
+		return nil
+	}
+}
+
+func testAccNsxtPolicyEdgeClusterCheckDestroy(state *terraform.State, displayName string) error {
+	nsxClient := testAccProvider.Meta().(nsxtClients).NsxtClient
+	for _, rs := range state.RootModule().Resources {
+
+		if rs.Type != "nsxt_policy_edge_cluster" {
+			continue
+		}
+
+		resourceID := rs.Primary.Attributes["id"]
+		_, resp, err := nsxClient.NetworkTransportApi.ReadEdgeCluster(nsxClient.Context, resourceID)
+		if err == nil && resp.StatusCode != http.StatusNotFound {
+			return fmt.Errorf("Edge Cluster %s still exists", displayName)
+		}
+	}
+	return nil
+}
+
+func testAccNsxtPolicyEdgeClusterTemplate(name string) string {
+	return fmt.Sprintf(`
+resource "nsxt_policy_edge_cluster" "test" {
+  display_name = "%s"
+  description  = "terraform created"
+
+  member {
+    transport_node_id = "%s"
+  }
+
+  tag {
+    scope = "scope1"
+    tag   = "tag1"
+  }
+}`, name, getTestEdgeTransportNodeID())
+}
@@ -4,9 +4,11 @@
 package nsxt
 
 import (
+	"context"
 	"fmt"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
@@ -21,12 +23,12 @@ var policyEvpnConfigTypeValues = []string{
 
 func resourceNsxtPolicyEvpnConfig() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtPolicyEvpnConfigCreate,
-		Read:   resourceNsxtPolicyEvpnConfigRead,
-		Update: resourceNsxtPolicyEvpnConfigUpdate,
-		Delete: resourceNsxtPolicyEvpnConfigDelete,
+		CreateContext: resourceNsxtPolicyEvpnConfigCreate,
+		ReadContext:   resourceNsxtPolicyEvpnConfigRead,
+		UpdateContext: resourceNsxtPolicyEvpnConfigUpdate,
+		DeleteContext: resourceNsxtPolicyEvpnConfigDelete,
 		Importer: &schema.ResourceImporter{
-			State: resourceNsxtPolicyEvpnConfigImport,
+			StateContext: resourceNsxtPolicyEvpnConfigImport,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -59,22 +61,22 @@ func policyEvpnConfigGet(connector client.Connector, gwID string) (model.EvpnCon
 	return client.Get(gwID)
 }
 
-func resourceNsxtPolicyEvpnConfigRead(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyEvpnConfigRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 
 	gwPolicyPath := d.Get("gateway_path").(string)
 	isT0, gwID := parseGatewayPolicyPath(gwPolicyPath)
 	if gwID == "" {
-		return fmt.Errorf("gateway_path is not valid")
+		return getErrorDiagnostics(newAttributeError("gateway_path", fmt.Errorf("gateway_path is not valid")))
 	}
 	if !isT0 {
-		return fmt.Errorf("Tier0 gateway path expected, got %s", gwPolicyPath)
+		return diag.Errorf("Tier0 gateway path expected, got %s", gwPolicyPath)
 	}
 
 	obj, err := policyEvpnConfigGet(connector, gwID)
 
 	if err != nil {
-		return handleReadError(d, "Evpn Config", gwID, err)
+		return getOperationDiagnostics(m, handleReadError(d, "Evpn Config", gwID, err))
 	}
 
 	d.Set("display_name", obj.DisplayName)
@@ -125,16 +127,16 @@ func patchNsxtPolicyEvpnConfig(connector client.Connector, d *schema.ResourceDat
 	return client.Patch(gwID, obj)
 }
 
-func resourceNsxtPolicyEvpnConfigCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyEvpnConfigCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 
 	gwPolicyPath := d.Get("gateway_path").(string)
 	isT0, gwID := parseGatewayPolicyPath(gwPolicyPath)
 	if gwID == "" {
-		return fmt.Errorf("gateway_path is not valid")
+		return getErrorDiagnostics(newAttributeError("gateway_path", fmt.Errorf("gateway_path is not valid")))
 	}
 	if !isT0 {
-		return fmt.Errorf("Tier0 gateway path expected, got %s", gwPolicyPath)
+		return diag.Errorf("Tier0 gateway path expected, got %s", gwPolicyPath)
 	}
 
 	isGlobalManager := isPolicyGlobalManager(m)
@@ -143,50 +145,50 @@ func resourceNsxtPolicyEvpnConfigCreate(d *schema.ResourceData, m interface{}) e
 
 	err := patchNsxtPolicyEvpnConfig(connector, d, gwID, isGlobalManager)
 	if err != nil {
-		return handleCreateError("Evpn Config", gwID, err)
+		return getOperationDiagnostics(m, handleCreateError("Evpn Config", gwID, err))
 	}
 
 	d.SetId(gwID)
 
-	return resourceNsxtPolicyEvpnConfigRead(d, m)
+	return resourceNsxtPolicyEvpnConfigRead(ctx, d, m)
 }
 
-func resourceNsxtPolicyEvpnConfigUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyEvpnConfigUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 	gwPolicyPath := d.Get("gateway_path").(string)
 	_, gwID := parseGatewayPolicyPath(gwPolicyPath)
 	if gwID == "" {
-		return fmt.Errorf("gateway_path is not valid")
+		return getErrorDiagnostics(newAttributeError("gateway_path", fmt.Errorf("gateway_path is not valid")))
 	}
 
 	log.Printf("[INFO] Updating Evpn Config with ID %s", gwID)
 	err := patchNsxtPolicyEvpnConfig(connector, d, gwID, isPolicyGlobalManager(m))
 	if err != nil {
-		return handleUpdateError("Evpn Config", gwID, err)
+		return getOperationDiagnostics(m, handleUpdateError("Evpn Config", gwID, err))
 	}
 
-	return resourceNsxtPolicyEvpnConfigRead(d, m)
+	return resourceNsxtPolicyEvpnConfigRead(ctx, d, m)
 }
 
-func resourceNsxtPolicyEvpnConfigDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyEvpnConfigDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 
 	gwPolicyPath := d.Get("gateway_path").(string)
 	_, gwID := parseGatewayPolicyPath(gwPolicyPath)
 	if gwID == "" {
-		return fmt.Errorf("gateway_path is not valid")
+		return getErrorDiagnostics(newAttributeError("gateway_path", fmt.Errorf("gateway_path is not valid")))
 	}
 
 	// There is no DELETE API for this object - we need to just disable it
 	err := patchNsxtPolicyEvpnConfig(connector, nil, gwID, isPolicyGlobalManager(m))
 	if err != nil {
-		return handleDeleteError("Evpn Config", gwID, err)
+		return getOperationDiagnostics(m, handleDeleteError("Evpn Config", gwID, err))
 	}
 
 	return nil
 }
 
-func resourceNsxtPolicyEvpnConfigImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+func resourceNsxtPolicyEvpnConfigImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	gwPath := d.Id()
 
 	d.Set("gateway_path", gwPath)
@@ -4,9 +4,11 @@
 package nsxt
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
@@ -16,12 +18,12 @@ import (
 
 func resourceNsxtPolicyEvpnTunnelEndpoint() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtPolicyEvpnTunnelEndpointCreate,
-		Read:   resourceNsxtPolicyEvpnTunnelEndpointRead,
-		Update: resourceNsxtPolicyEvpnTunnelEndpointUpdate,
-		Delete: resourceNsxtPolicyEvpnTunnelEndpointDelete,
+		CreateContext: resourceNsxtPolicyEvpnTunnelEndpointCreate,
+		ReadContext:   resourceNsxtPolicyEvpnTunnelEndpointRead,
+		UpdateContext: resourceNsxtPolicyEvpnTunnelEndpointUpdate,
+		DeleteContext: resourceNsxtPolicyEvpnTunnelEndpointDelete,
 		Importer: &schema.ResourceImporter{
-			State: resourceNsxtPolicyEvpnTunnelEndpointImport,
+			StateContext: resourceNsxtPolicyEvpnTunnelEndpointImport,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -96,14 +98,14 @@ func resourceNsxtPolicyEvpnTunnelEndpointExists(connector client.Connector, gwID
 	return true, err
 }
 
-func resourceNsxtPolicyEvpnTunnelEndpointCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyEvpnTunnelEndpointCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 
 	id := d.Get("nsx_id").(string)
 	interfacePath := d.Get("external_interface_path").(string)
 	isT0, gwID, localeServiceID, _ := parseGatewayInterfacePolicyPath(interfacePath)
 	if !isT0 || gwID == "" {
-		return fmt.Errorf("Tier0 External Interface path expected, got %s", interfacePath)
+		return diag.Errorf("Tier0 External Interface path expected, got %s", interfacePath)
 	}
 
 	if id == "" {
@@ -111,16 +113,16 @@ func resourceNsxtPolicyEvpnTunnelEndpointCreate(d *schema.ResourceData, m interf
 	} else {
 		exists, err := resourceNsxtPolicyEvpnTunnelEndpointExists(connector, gwID, localeServiceID, id)
 		if exists {
-			return fmt.Errorf("EVPN Tunnel Endpoint with nsx_id '%s' already exists on Gateway %s and Locale Service %s", id, gwID, localeServiceID)
+			return getErrorDiagnostics(newAttributeError("nsx_id", fmt.Errorf("EVPN Tunnel Endpoint with nsx_id '%s' already exists on Gateway %s and Locale Service %s", id, gwID, localeServiceID)))
 		} else if err != nil {
-			return err
+			return getOperationDiagnostics(m, err)
 		}
 
 	}
 
 	err := policyEvpnTunnelEndpointPatch(d, m, gwID, localeServiceID, id)
 	if err != nil {
-		return handleCreateError("EVPN Tunnel Endpoint", id, err)
+		return getOperationDiagnostics(m, handleCreateError("EVPN Tunnel Endpoint", id, err))
 	}
 
 	d.Set("nsx_id", id)
@@ -129,10 +131,10 @@ func resourceNsxtPolicyEvpnTunnelEndpointCreate(d *schema.ResourceData, m interf
 	d.Set("external_interface_path", interfacePath)
 	d.SetId(id)
 
-	return resourceNsxtPolicyEvpnTunnelEndpointRead(d, m)
+	return resourceNsxtPolicyEvpnTunnelEndpointRead(ctx, d, m)
 }
 
-func resourceNsxtPolicyEvpnTunnelEndpointRead(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyEvpnTunnelEndpointRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 
 	id := d.Id()
@@ -142,7 +144,7 @@ func resourceNsxtPolicyEvpnTunnelEndpointRead(d *schema.ResourceData, m interfac
 	client := locale_services.NewEvpnTunnelEndpointsClient(connector)
 	obj, err := client.Get(gwID, localeServiceID, id)
 	if err != nil {
-		return handleReadError(d, "EVPN Tunnel Endpoint", id, err)
+		return getOperationDiagnostics(m, handleReadError(d, "EVPN Tunnel Endpoint", id, err))
 	}
 
 	d.Set("edge_node_path", obj.EdgePath)
@@ -162,44 +164,44 @@ func resourceNsxtPolicyEvpnTunnelEndpointRead(d *schema.ResourceData, m interfac
 	return nil
 }
 
-func resourceNsxtPolicyEvpnTunnelEndpointUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyEvpnTunnelEndpointUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 
 	id := d.Id()
 	gwID := d.Get("gateway_id").(string)
 	localeServiceID := d.Get("locale_service_id").(string)
 	if id == "" || gwID == "" || localeServiceID == "" {
-		return fmt.Errorf("Error obtaining Tier0 id or Locale Service id")
+		return diag.Errorf("Error obtaining Tier0 id or Locale Service id")
 	}
 
 	err := policyEvpnTunnelEndpointPatch(d, m, gwID, localeServiceID, id)
 	if err != nil {
-		return handleCreateError("EVPN Tunnel Endpoint", id, err)
+		return getOperationDiagnostics(m, handleCreateError("EVPN Tunnel Endpoint", id, err))
 	}
 
-	return resourceNsxtPolicyEvpnTunnelEndpointRead(d, m)
+	return resourceNsxtPolicyEvpnTunnelEndpointRead(ctx, d, m)
 }
 
-func resourceNsxtPolicyEvpnTunnelEndpointDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyEvpnTunnelEndpointDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 
 	id := d.Id()
 	gwID := d.Get("gateway_id").(string)
 	localeServiceID := d.Get("locale_service_id").(string)
 	if id == "" || gwID == "" || localeServiceID == "" {
-		return fmt.Errorf("Error obtaining Tier0 id or Locale Service id")
+		return diag.Errorf("Error obtaining Tier0 id or Locale Service id")
 	}
 
 	client := locale_services.NewEvpnTunnelEndpointsClient(connector)
 	err := client.Delete(gwID, localeServiceID, id)
 
 	if err != nil {
-		return handleDeleteError("EVPN Tunnel Endpoint", id, err)
+		return getOperationDiagnostics(m, handleDeleteError("EVPN Tunnel Endpoint", id, err))
 	}
 
 	return nil
 }
 
-func resourceNsxtPolicyEvpnTunnelEndpointImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+func resourceNsxtPolicyEvpnTunnelEndpointImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	importID := d.Id()
 	s := strings.Split(importID, "/")
 	if len(s) != 4 {
@@ -0,0 +1,326 @@
+/* Copyright © 2023 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	gm_infra "github.com/vmware/vsphere-automation-sdk-go/services/nsxt-gm/global_infra"
+	gm_model "github.com/vmware/vsphere-automation-sdk-go/services/nsxt-gm/model"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+var firewallSchedulerDayValues = []string{
+	model.PolicyFirewallScheduler_DAYS_SUNDAY,
+	model.PolicyFirewallScheduler_DAYS_MONDAY,
+	model.PolicyFirewallScheduler_DAYS_TUESDAY,
+	model.PolicyFirewallScheduler_DAYS_WEDNESDAY,
+	model.PolicyFirewallScheduler_DAYS_THURSDAY,
+	model.PolicyFirewallScheduler_DAYS_FRIDAY,
+	model.PolicyFirewallScheduler_DAYS_SATURDAY,
+}
+
+var firewallSchedulerTimezoneValues = []string{
+	model.PolicyFirewallScheduler_TIMEZONE_UTC,
+	model.PolicyFirewallScheduler_TIMEZONE_LOCAL,
+}
+
+// Time in 24 hour HH:MM format, in multiples of 30 minutes, as returned by NSX
+var firewallSchedulerTimeRegexp = regexp.MustCompile("^([01][0-9]|2[0-3]):(00|30)$")
+
+// Date in MM/DD/YYYY format
+var firewallSchedulerDateRegexp = regexp.MustCompile("^(0[1-9]|1[0-2])/(0[1-9]|[12][0-9]|3[01])/[0-9]{4}$")
+
+func resourceNsxtPolicyFirewallScheduler() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceNsxtPolicyFirewallSchedulerCreate,
+		ReadContext:   resourceNsxtPolicyFirewallSchedulerRead,
+		UpdateContext: resourceNsxtPolicyFirewallSchedulerUpdate,
+		DeleteContext: resourceNsxtPolicyFirewallSchedulerDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"nsx_id":       getNsxIDSchema(),
+			"path":         getPathSchema(),
+			"display_name": getDisplayNameSchema(),
+			"description":  getDescriptionSchema(),
+			"revision":     getRevisionSchema(),
+			"tag":          getTagsSchema(),
+			"recurring": {
+				Type:        schema.TypeBool,
+				Description: "Whether the schedule recurs daily or on given days of week, or is a one time interval",
+				Optional:    true,
+				Default:     true,
+			},
+			"days": {
+				Type:        schema.TypeSet,
+				Description: "Days of week on which rules are enforced. Only applicable to recurring schedule",
+				Optional:    true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(firewallSchedulerDayValues, false),
+				},
+			},
+			"start_time": {
+				Type:         schema.TypeString,
+				Description:  "Start of time window in 24 hour format, in multiples of 30 minutes",
+				Required:     true,
+				ValidateFunc: validation.StringMatch(firewallSchedulerTimeRegexp, "Must be time in HH:MM format, in multiples of 30 minutes"),
+			},
+			"end_time": {
+				Type:         schema.TypeString,
+				Description:  "End of time window in 24 hour format, in multiples of 30 minutes",
+				Required:     true,
+				ValidateFunc: validation.StringMatch(firewallSchedulerTimeRegexp, "Must be time in HH:MM format, in multiples of 30 minutes"),
+			},
+			"start_date": {
+				Type:         schema.TypeString,
+				Description:  "Date on which the schedule starts, in MM/DD/YYYY format",
+				Required:     true,
+				ValidateFunc: validation.StringMatch(firewallSchedulerDateRegexp, "Must be date in MM/DD/YYYY format"),
+			},
+			"end_date": {
+				Type:         schema.TypeString,
+				Description:  "Date on which the schedule ends, in MM/DD/YYYY format",
+				Optional:     true,
+				ValidateFunc: validation.StringMatch(firewallSchedulerDateRegexp, "Must be date in MM/DD/YYYY format"),
+			},
+			"timezone": {
+				Type:         schema.TypeString,
+				Description:  "Timezone of the host to be used to enforce the schedule",
+				Optional:     true,
+				Default:      model.PolicyFirewallScheduler_TIMEZONE_UTC,
+				ValidateFunc: validation.StringInSlice(firewallSchedulerTimezoneValues, false),
+			},
+		},
+	}
+}
+
+func resourceNsxtPolicyFirewallSchedulerExists(id string, connector client.Connector, isGlobalManager bool) (bool, error) {
+	var err error
+	if isGlobalManager {
+		client := gm_infra.NewFirewallSchedulersClient(connector)
+		_, err = client.Get(id)
+	} else {
+		client := infra.NewFirewallSchedulersClient(connector)
+		_, err = client.Get(id)
+	}
+	if err == nil {
+		return true, nil
+	}
+
+	if isNotFoundError(err) {
+		return false, nil
+	}
+
+	return false, logAPIError("Error retrieving Firewall Scheduler", err)
+}
+
+func getPolicyFirewallSchedulerFromSchema(d *schema.ResourceData) (model.PolicyFirewallScheduler, error) {
+	displayName := d.Get("display_name").(string)
+	description := d.Get("description").(string)
+	tags := getPolicyTagsFromSchema(d)
+	recurring := d.Get("recurring").(bool)
+	days := getStringListFromSchemaSet(d, "days")
+	startTime := d.Get("start_time").(string)
+	endTime := d.Get("end_time").(string)
+	startDate := d.Get("start_date").(string)
+	endDate := d.Get("end_date").(string)
+	timezone := d.Get("timezone").(string)
+
+	obj := model.PolicyFirewallScheduler{
+		DisplayName: &displayName,
+		Description: &description,
+		Tags:        tags,
+		Recurring:   &recurring,
+		StartDate:   &startDate,
+		Timezone:    &timezone,
+	}
+
+	if endDate != "" {
+		obj.EndDate = &endDate
+	}
+
+	if recurring {
+		obj.Days = days
+		obj.TimeInterval = []model.PolicyTimeIntervalValue{
+			{
+				StartInterval: &startTime,
+				EndInterval:   &endTime,
+			},
+		}
+	} else {
+		if len(days) > 0 {
+			return obj, newAttributeError("days", fmt.Errorf("days can only be specified for recurring schedule"))
+		}
+		if endDate == "" {
+			return obj, newAttributeError("end_date", fmt.Errorf("end_date is required for non-recurring schedule"))
+		}
+		obj.StartTime = &startTime
+		obj.EndTime = &endTime
+	}
+
+	return obj, nil
+}
+
+func resourceNsxtPolicyFirewallSchedulerRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	connector := getPolicyConnector(m)
+
+	id := d.Id()
+	if id == "" {
+		return diag.Errorf("Error obtaining Firewall Scheduler ID")
+	}
+
+	var obj model.PolicyFirewallScheduler
+	if isPolicyGlobalManager(m) {
+		client := gm_infra.NewFirewallSchedulersClient(connector)
+		gmObj, err := client.Get(id)
+		if err != nil {
+			return getOperationDiagnostics(m, handleReadError(d, "Firewall Scheduler", id, err))
+		}
+
+		lmObj, err := convertModelBindingType(gmObj, gm_model.PolicyFirewallSchedulerBindingType(), model.PolicyFirewallSchedulerBindingType())
+		if err != nil {
+			return getOperationDiagnostics(m, err)
+		}
+		obj = lmObj.(model.PolicyFirewallScheduler)
+	} else {
+		client := infra.NewFirewallSchedulersClient(connector)
+		var err error
+		obj, err = client.Get(id)
+		if err != nil {
+			return getOperationDiagnostics(m, handleReadError(d, "Firewall Scheduler", id, err))
+		}
+	}
+
+	d.Set("display_name", obj.DisplayName)
+	d.Set("description", obj.Description)
+	setPolicyTagsInSchema(d, obj.Tags)
+	d.Set("nsx_id", id)
+	d.Set("path", obj.Path)
+	d.Set("revision", obj.Revision)
+
+	// Schedule is recurring unless specified otherwise
+	recurring := obj.Recurring == nil || *obj.Recurring
+	d.Set("recurring", recurring)
+	d.Set("days", obj.Days)
+	if recurring {
+		if len(obj.TimeInterval) > 0 {
+			d.Set("start_time", obj.TimeInterval[0].StartInterval)
+			d.Set("end_time", obj.TimeInterval[0].EndInterval)
+		}
+	} else {
+		d.Set("start_time", obj.StartTime)
+		d.Set("end_time", obj.EndTime)
+	}
+	d.Set("start_date", obj.StartDate)
+	d.Set("end_date", obj.EndDate)
+	d.Set("timezone", obj.Timezone)
+
+	return nil
+}
+
+func resourceNsxtPolicyFirewallSchedulerCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	connector := getPolicyConnector(m)
+
+	id, err := getOrGenerateID(d, m, resourceNsxtPolicyFirewallSchedulerExists)
+	if err != nil {
+		return getOperationDiagnostics(m, err)
+	}
+
+	obj, err := getPolicyFirewallSchedulerFromSchema(d)
+	if err != nil {
+		return getOperationDiagnostics(m, err)
+	}
+
+	log.Printf("[INFO] Creating Firewall Scheduler with ID %s", id)
+	if isPolicyGlobalManager(m) {
+		gmObj, convErr := convertModelBindingType(obj, model.PolicyFirewallSchedulerBindingType(), gm_model.PolicyFirewallSchedulerBindingType())
+		if convErr != nil {
+			return getErrorDiagnostics(convErr)
+		}
+		client := gm_infra.NewFirewallSchedulersClient(connector)
+		err = client.Patch(id, gmObj.(gm_model.PolicyFirewallScheduler))
+	} else {
+		client := infra.NewFirewallSchedulersClient(connector)
+		err = client.Patch(id, obj)
+	}
+	if err != nil {
+		return getOperationDiagnostics(m, handleCreateError("Firewall Scheduler", id, err))
+	}
+
+	d.SetId(id)
+	d.Set("nsx_id", id)
+
+	return resourceNsxtPolicyFirewallSchedulerRead(ctx, d, m)
+}
+
+func resourceNsxtPolicyFirewallSchedulerUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	connector := getPolicyConnector(m)
+
+	id := d.Id()
+	if id == "" {
+		return diag.Errorf("Error obtaining Firewall Scheduler ID")
+	}
+
+	obj, err := getPolicyFirewallSchedulerFromSchema(d)
+	if err != nil {
+		return getOperationDiagnostics(m, err)
+	}
+	revision := int64(d.Get("revision").(int))
+	obj.Revision = &revision
+
+	// Update is used rather than patch, since properties of recurring and
+	// one time schedules are mutually exclusive
+	log.Printf("[INFO] Updating Firewall Scheduler with ID %s", id)
+	if isPolicyGlobalManager(m) {
+		gmObj, convErr := convertModelBindingType(obj, model.PolicyFirewallSchedulerBindingType(), gm_model.PolicyFirewallSchedulerBindingType())
+		if convErr != nil {
+			return getErrorDiagnostics(convErr)
+		}
+		client := gm_infra.NewFirewallSchedulersClient(connector)
+		_, err = client.Update(id, gmObj.(gm_model.PolicyFirewallScheduler))
+	} else {
+		client := infra.NewFirewallSchedulersClient(connector)
+		_, err = client.Update(id, obj)
+	}
+	if err != nil {
+		return getOperationDiagnostics(m, handleUpdateError("Firewall Scheduler", id, err))
+	}
+
+	return resourceNsxtPolicyFirewallSchedulerRead(ctx, d, m)
+}
+
+func resourceNsxtPolicyFirewallSchedulerDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	id := d.Id()
+	if id == "" {
+		return diag.Errorf("Error obtaining Firewall Scheduler ID")
+	}
+
+	connector := getPolicyConnector(m)
+	var err error
+	if isPolicyGlobalManager(m) {
+		client := gm_infra.NewFirewallSchedulersClient(connector)
+		err = client.Delete(id, nil)
+	} else {
+		client := infra.NewFirewallSchedulersClient(connector)
+		err = client.Delete(id, nil)
+	}
+
+	if err != nil {
+		return getOperationDiagnostics(m, handleDeleteError("Firewall Scheduler", id, err))
+	}
+
+	return nil
+}
@@ -0,0 +1,175 @@
+/* Copyright © 2023 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccResourceNsxtPolicyFirewallScheduler_basic(t *testing.T) {
+	name := getAccTestResourceName()
+	updatedName := getAccTestResourceName()
+	testResourceName := "nsxt_policy_firewall_scheduler.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyResourceCheckDestroy(state, updatedName, "nsxt_policy_firewall_scheduler", resourceNsxtPolicyFirewallSchedulerExists)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyFirewallSchedulerRecurringTemplate(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyResourceExists(testResourceName, resourceNsxtPolicyFirewallSchedulerExists),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", name),
+					resource.TestCheckResourceAttr(testResourceName, "description", "Acceptance Test"),
+					resource.TestCheckResourceAttr(testResourceName, "recurring", "true"),
+					resource.TestCheckResourceAttr(testResourceName, "days.#", "2"),
+					resource.TestCheckResourceAttr(testResourceName, "start_time", "22:00"),
+					resource.TestCheckResourceAttr(testResourceName, "end_time", "23:30"),
+					resource.TestCheckResourceAttr(testResourceName, "start_date", "01/01/2030"),
+					resource.TestCheckResourceAttr(testResourceName, "timezone", "UTC"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "1"),
+					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+				),
+			},
+			{
+				Config: testAccNsxtPolicyFirewallSchedulerOneTimeTemplate(updatedName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyResourceExists(testResourceName, resourceNsxtPolicyFirewallSchedulerExists),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", updatedName),
+					resource.TestCheckResourceAttr(testResourceName, "recurring", "false"),
+					resource.TestCheckResourceAttr(testResourceName, "days.#", "0"),
+					resource.TestCheckResourceAttr(testResourceName, "start_time", "09:00"),
+					resource.TestCheckResourceAttr(testResourceName, "end_time", "17:30"),
+					resource.TestCheckResourceAttr(testResourceName, "start_date", "01/01/2030"),
+					resource.TestCheckResourceAttr(testResourceName, "end_date", "01/31/2030"),
+					resource.TestCheckResourceAttr(testResourceName, "timezone", "LOCAL"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNsxtPolicyFirewallScheduler_importBasic(t *testing.T) {
+	name := getAccTestResourceName()
+	testResourceName := "nsxt_policy_firewall_scheduler.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyResourceCheckDestroy(state, name, "nsxt_policy_firewall_scheduler", resourceNsxtPolicyFirewallSchedulerExists)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyFirewallSchedulerRecurringTemplate(name),
+			},
+			{
+				ResourceName:      testResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestUnitResourceNsxtPolicyFirewallScheduler_basic(t *testing.T) {
+	fake := newFakeNsxServer(t)
+	meta := testUnitConfigureProvider(t, fake)
+
+	config := map[string]interface{}{
+		"display_name": "weekends",
+		"days":         []interface{}{"SATURDAY", "SUNDAY"},
+		"start_time":   "00:00",
+		"end_time":     "23:30",
+		"start_date":   "01/01/2030",
+	}
+	state := testUnitApplyResource(t, meta, "nsxt_policy_firewall_scheduler", nil, config)
+	path := state.Attributes["path"]
+	obj := fake.getObject(path)
+	intervals, _ := obj["time_interval"].([]interface{})
+	if len(intervals) != 1 || obj["start_time"] != nil {
+		t.Errorf("Expected recurring schedule with single time interval, got %v", obj)
+	}
+	testUnitCheckAttr(t, state, "recurring", "true")
+	testUnitCheckAttr(t, state, "days.#", "2")
+	testUnitCheckAttr(t, state, "start_time", "00:00")
+
+	// Switch to one time schedule replaces recurring properties
+	config = map[string]interface{}{
+		"display_name": "vendor-access",
+		"recurring":    false,
+		"start_time":   "09:00",
+		"end_time":     "17:00",
+		"start_date":   "01/01/2030",
+		"end_date":     "01/31/2030",
+	}
+	state = testUnitApplyResource(t, meta, "nsxt_policy_firewall_scheduler", state, config)
+	obj = fake.getObject(path)
+	if obj["time_interval"] != nil || obj["days"] != nil {
+		t.Errorf("Expected one time schedule without recurring properties, got %v", obj)
+	}
+	testUnitCheckAttr(t, state, "recurring", "false")
+	testUnitCheckAttr(t, state, "days.#", "0")
+	testUnitCheckAttr(t, state, "start_time", "09:00")
+	testUnitCheckAttr(t, state, "end_date", "01/31/2030")
+
+	r := testUnitGetResource(t, "nsxt_policy_firewall_scheduler")
+	d := r.TestResourceData()
+	d.Set("display_name", "invalid")
+	d.Set("recurring", false)
+	d.Set("days", []interface{}{"MONDAY"})
+	d.Set("start_date", "01/01/2030")
+	d.Set("end_date", "01/31/2030")
+	if _, err := getPolicyFirewallSchedulerFromSchema(d); err == nil {
+		t.Errorf("Expected error for days in one time schedule")
+	}
+
+	// Time is returned by NSX with two digit hours, hence other formats do not round-trip
+	for _, value := range []string{"9:00", "09:15", "24:00", "09:00:00"} {
+		if firewallSchedulerTimeRegexp.MatchString(value) {
+			t.Errorf("Expected time %s to be rejected", value)
+		}
+	}
+}
+
+func testAccNsxtPolicyFirewallSchedulerRecurringTemplate(name string) string {
+	return fmt.Sprintf(`
+resource "nsxt_policy_firewall_scheduler" "test" {
+  display_name = "%s"
+  description  = "Acceptance Test"
+  days         = ["SATURDAY", "SUNDAY"]
+  start_time   = "22:00"
+  end_time     = "23:30"
+  start_date   = "01/01/2030"
+
+  tag {
+    scope = "color"
+    tag   = "orange"
+  }
+}`, name)
+}
+
+func testAccNsxtPolicyFirewallSchedulerOneTimeTemplate(name string) string {
+	return fmt.Sprintf(`
+resource "nsxt_policy_firewall_scheduler" "test" {
+  display_name = "%s"
+  description  = "Acceptance Test"
+  recurring    = false
+  start_time   = "09:00"
+  end_time     = "17:30"
+  start_date   = "01/01/2030"
+  end_date     = "01/31/2030"
+  timezone     = "LOCAL"
+}`, name)
+}
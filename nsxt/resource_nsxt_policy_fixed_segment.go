@@ -4,44 +4,58 @@
 package nsxt
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceNsxtPolicyFixedSegment() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtPolicyFixedSegmentCreate,
-		Read:   resourceNsxtPolicyFixedSegmentRead,
-		Update: resourceNsxtPolicyFixedSegmentUpdate,
-		Delete: resourceNsxtPolicyFixedSegmentDelete,
+		CreateContext: resourceNsxtPolicyFixedSegmentCreate,
+		ReadContext:   resourceNsxtPolicyFixedSegmentRead,
+		UpdateContext: resourceNsxtPolicyFixedSegmentUpdate,
+		DeleteContext: resourceNsxtPolicyFixedSegmentDelete,
 		Importer: &schema.ResourceImporter{
-			State: nsxtGatewayResourceImporter,
+			StateContext: nsxtGatewayResourceImporter,
 		},
 
 		Schema: getPolicyCommonSegmentSchema(false, true),
 	}
 }
 
-func resourceNsxtPolicyFixedSegmentCreate(d *schema.ResourceData, m interface{}) error {
-	return nsxtPolicySegmentCreate(d, m, false, true)
+func resourceNsxtPolicyFixedSegmentCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	return getOperationDiagnostics(m, nsxtPolicySegmentCreate(d, m, false, true))
 }
 
-func resourceNsxtPolicyFixedSegmentRead(d *schema.ResourceData, m interface{}) error {
-	return nsxtPolicySegmentRead(d, m, false, true)
+func resourceNsxtPolicyFixedSegmentRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	return getOperationDiagnostics(m, nsxtPolicySegmentRead(d, m, false, true))
 }
 
-func resourceNsxtPolicyFixedSegmentUpdate(d *schema.ResourceData, m interface{}) error {
-	return nsxtPolicySegmentUpdate(d, m, false, true)
+func resourceNsxtPolicyFixedSegmentUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	return getOperationDiagnostics(m, nsxtPolicySegmentUpdate(d, m, false, true))
 }
 
-func resourceNsxtPolicyFixedSegmentDelete(d *schema.ResourceData, m interface{}) error {
-	return nsxtPolicySegmentDelete(d, m, true)
+func resourceNsxtPolicyFixedSegmentDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	return getOperationDiagnostics(m, nsxtPolicySegmentDelete(d, m, true))
 }
 
-func nsxtGatewayResourceImporter(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+func nsxtGatewayResourceImporter(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	importID := d.Id()
+	if projectID := getPolicyProjectIDFromPath(importID); projectID != "" {
+		// Project-scoped segments are imported by full policy path
+		segIndex := strings.Index(importID, "/segments/")
+		if segIndex < 0 {
+			return []*schema.ResourceData{d}, fmt.Errorf("Full policy path of the segment expected, got %s", importID)
+		}
+		setPolicyProjectIDInSchema(d, projectID)
+		d.Set("connectivity_path", importID[:segIndex])
+		d.SetId(getPolicyIDFromPath(importID))
+		return []*schema.ResourceData{d}, nil
+	}
+
 	importGW := ""
 	s := strings.Split(importID, "/")
 	if len(s) < 2 {
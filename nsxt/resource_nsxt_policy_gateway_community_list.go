@@ -4,9 +4,10 @@
 package nsxt
 
 import (
-	"fmt"
+	"context"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
 	gm_tier0s "github.com/vmware/vsphere-automation-sdk-go/services/nsxt-gm/global_infra/tier_0s"
@@ -17,12 +18,12 @@ import (
 
 func resourceNsxtPolicyGatewayCommunityList() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtPolicyGatewayCommunityListCreate,
-		Read:   resourceNsxtPolicyGatewayCommunityListRead,
-		Update: resourceNsxtPolicyGatewayCommunityListUpdate,
-		Delete: resourceNsxtPolicyGatewayCommunityListDelete,
+		CreateContext: resourceNsxtPolicyGatewayCommunityListCreate,
+		ReadContext:   resourceNsxtPolicyGatewayCommunityListRead,
+		UpdateContext: resourceNsxtPolicyGatewayCommunityListUpdate,
+		DeleteContext: resourceNsxtPolicyGatewayCommunityListDelete,
 		Importer: &schema.ResourceImporter{
-			State: resourceNsxtPolicyTier0GatewayImporter,
+			StateContext: resourceNsxtPolicyTier0GatewayImporter,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -66,7 +67,7 @@ func resourceNsxtPolicyGatewayCommunityListExists(tier0Id string, id string, con
 	return false, logAPIError("Error retrieving resource", err)
 }
 
-func resourceNsxtPolicyGatewayCommunityListCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyGatewayCommunityListCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 
 	// Initialize resource Id and verify this ID is not yet used
@@ -74,7 +75,7 @@ func resourceNsxtPolicyGatewayCommunityListCreate(d *schema.ResourceData, m inte
 	gwPath := d.Get("gateway_path").(string)
 	isT0, gwID := parseGatewayPolicyPath(gwPath)
 	if !isT0 {
-		return fmt.Errorf("Tier0 Gateway path expected, got %s", gwPath)
+		return diag.Errorf("Tier0 Gateway path expected, got %s", gwPath)
 	}
 
 	if id == "" {
@@ -89,9 +90,9 @@ func resourceNsxtPolicyGatewayCommunityListCreate(d *schema.ResourceData, m inte
 			_, err = client.Get(gwID, id)
 		}
 		if err == nil {
-			return fmt.Errorf("Community List with ID '%s' already exists on Tier0 Gateway %s", id, gwID)
+			return diag.Errorf("Community List with ID '%s' already exists on Tier0 Gateway %s", id, gwID)
 		} else if !isNotFoundError(err) {
-			return err
+			return getOperationDiagnostics(m, err)
 		}
 	}
 
@@ -113,7 +114,7 @@ func resourceNsxtPolicyGatewayCommunityListCreate(d *schema.ResourceData, m inte
 	if isPolicyGlobalManager(m) {
 		gmObj, convErr := convertModelBindingType(obj, model.CommunityListBindingType(), gm_model.CommunityListBindingType())
 		if convErr != nil {
-			return convErr
+			return getErrorDiagnostics(convErr)
 		}
 		client := gm_tier0s.NewCommunityListsClient(connector)
 		err = client.Patch(gwID, id, gmObj.(gm_model.CommunityList))
@@ -122,26 +123,26 @@ func resourceNsxtPolicyGatewayCommunityListCreate(d *schema.ResourceData, m inte
 		err = client.Patch(gwID, id, obj)
 	}
 	if err != nil {
-		return handleCreateError("Community List", id, err)
+		return getOperationDiagnostics(m, handleCreateError("Community List", id, err))
 	}
 
 	d.SetId(id)
 	d.Set("nsx_id", id)
 
-	return resourceNsxtPolicyGatewayCommunityListRead(d, m)
+	return resourceNsxtPolicyGatewayCommunityListRead(ctx, d, m)
 }
 
-func resourceNsxtPolicyGatewayCommunityListRead(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyGatewayCommunityListRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining Gateway Community List ID")
+		return diag.Errorf("Error obtaining Gateway Community List ID")
 	}
 	gwPath := d.Get("gateway_path").(string)
 	isT0, gwID := parseGatewayPolicyPath(gwPath)
 	if !isT0 {
-		return fmt.Errorf("Tier0 Gateway path expected, got %s", gwPath)
+		return diag.Errorf("Tier0 Gateway path expected, got %s", gwPath)
 	}
 
 	var obj model.CommunityList
@@ -149,12 +150,12 @@ func resourceNsxtPolicyGatewayCommunityListRead(d *schema.ResourceData, m interf
 		client := gm_tier0s.NewCommunityListsClient(connector)
 		gmObj, err := client.Get(gwID, id)
 		if err != nil {
-			return handleReadError(d, "Gateway Community List", id, err)
+			return getOperationDiagnostics(m, handleReadError(d, "Gateway Community List", id, err))
 		}
 
 		lmObj, err := convertModelBindingType(gmObj, gm_model.CommunityListBindingType(), model.CommunityListBindingType())
 		if err != nil {
-			return err
+			return getOperationDiagnostics(m, err)
 		}
 		obj = lmObj.(model.CommunityList)
 	} else {
@@ -162,7 +163,7 @@ func resourceNsxtPolicyGatewayCommunityListRead(d *schema.ResourceData, m interf
 		var err error
 		obj, err = client.Get(gwID, id)
 		if err != nil {
-			return handleReadError(d, "Gateway Community List", id, err)
+			return getOperationDiagnostics(m, handleReadError(d, "Gateway Community List", id, err))
 		}
 	}
 
@@ -177,12 +178,12 @@ func resourceNsxtPolicyGatewayCommunityListRead(d *schema.ResourceData, m interf
 	return nil
 }
 
-func resourceNsxtPolicyGatewayCommunityListUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyGatewayCommunityListUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining GatewayCommunityList ID")
+		return diag.Errorf("Error obtaining GatewayCommunityList ID")
 	}
 	gwPath := d.Get("gateway_path").(string)
 	_, gwID := parseGatewayPolicyPath(gwPath)
@@ -207,7 +208,7 @@ func resourceNsxtPolicyGatewayCommunityListUpdate(d *schema.ResourceData, m inte
 	if isPolicyGlobalManager(m) {
 		gmObj, convErr := convertModelBindingType(obj, model.CommunityListBindingType(), gm_model.CommunityListBindingType())
 		if convErr != nil {
-			return convErr
+			return getErrorDiagnostics(convErr)
 		}
 		client := gm_tier0s.NewCommunityListsClient(connector)
 		_, err = client.Update(gwID, id, gmObj.(gm_model.CommunityList))
@@ -216,19 +217,19 @@ func resourceNsxtPolicyGatewayCommunityListUpdate(d *schema.ResourceData, m inte
 		_, err = client.Update(gwID, id, obj)
 	}
 	if err != nil {
-		return handleCreateError("Gateway Community List", id, err)
+		return getOperationDiagnostics(m, handleCreateError("Gateway Community List", id, err))
 	}
 
 	d.SetId(id)
 	d.Set("nsx_id", id)
 
-	return resourceNsxtPolicyGatewayCommunityListRead(d, m)
+	return resourceNsxtPolicyGatewayCommunityListRead(ctx, d, m)
 }
 
-func resourceNsxtPolicyGatewayCommunityListDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyGatewayCommunityListDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining GatewayCommunityList ID")
+		return diag.Errorf("Error obtaining GatewayCommunityList ID")
 	}
 	gwPath := d.Get("gateway_path").(string)
 	_, gwID := parseGatewayPolicyPath(gwPath)
@@ -244,7 +245,7 @@ func resourceNsxtPolicyGatewayCommunityListDelete(d *schema.ResourceData, m inte
 	}
 
 	if err != nil {
-		return handleDeleteError("GatewayCommunityList", id, err)
+		return getOperationDiagnostics(m, handleDeleteError("GatewayCommunityList", id, err))
 	}
 
 	return nil
@@ -4,9 +4,11 @@
 package nsxt
 
 import (
+	"context"
 	"fmt"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
@@ -28,12 +30,12 @@ var gatewayDNSForwarderLogLevelTypeValues = []string{
 
 func resourceNsxtPolicyGatewayDNSForwarder() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtPolicyGatewayDNSForwarderCreate,
-		Read:   resourceNsxtPolicyGatewayDNSForwarderRead,
-		Update: resourceNsxtPolicyGatewayDNSForwarderUpdate,
-		Delete: resourceNsxtPolicyGatewayDNSForwarderDelete,
+		CreateContext: resourceNsxtPolicyGatewayDNSForwarderCreate,
+		ReadContext:   resourceNsxtPolicyGatewayDNSForwarderRead,
+		UpdateContext: resourceNsxtPolicyGatewayDNSForwarderUpdate,
+		DeleteContext: resourceNsxtPolicyGatewayDNSForwarderDelete,
 		Importer: &schema.ResourceImporter{
-			State: resourceNsxtPolicyGatewayDNSForwarderImport,
+			StateContext: resourceNsxtPolicyGatewayDNSForwarderImport,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -108,19 +110,19 @@ func policyGatewayDNSForwarderGet(connector client.Connector, gwID string, isT0
 	return client.Get(gwID)
 }
 
-func resourceNsxtPolicyGatewayDNSForwarderRead(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyGatewayDNSForwarderRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 
 	gwPolicyPath := d.Get("gateway_path").(string)
 	isT0, gwID := parseGatewayPolicyPath(gwPolicyPath)
 	if gwID == "" {
-		return fmt.Errorf("gateway_path is not valid")
+		return getErrorDiagnostics(newAttributeError("gateway_path", fmt.Errorf("gateway_path is not valid")))
 	}
 
 	obj, err := policyGatewayDNSForwarderGet(connector, gwID, isT0, isPolicyGlobalManager(m))
 
 	if err != nil {
-		return handleReadError(d, "Gateway Dns Forwarder", gwID, err)
+		return getOperationDiagnostics(m, handleReadError(d, "Gateway Dns Forwarder", gwID, err))
 	}
 
 	d.Set("display_name", obj.DisplayName)
@@ -190,13 +192,13 @@ func patchNsxtPolicyGatewayDNSForwarder(connector client.Connector, d *schema.Re
 	return client.Patch(gwID, obj)
 }
 
-func resourceNsxtPolicyGatewayDNSForwarderCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyGatewayDNSForwarderCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 
 	gwPolicyPath := d.Get("gateway_path").(string)
 	isT0, gwID := parseGatewayPolicyPath(gwPolicyPath)
 	if gwID == "" {
-		return fmt.Errorf("gateway_path is not valid")
+		return getErrorDiagnostics(newAttributeError("gateway_path", fmt.Errorf("gateway_path is not valid")))
 	}
 	isGlobalManager := isPolicyGlobalManager(m)
 
@@ -220,48 +222,48 @@ func resourceNsxtPolicyGatewayDNSForwarderCreate(d *schema.ResourceData, m inter
 		}
 	}
 	if err == nil {
-		return fmt.Errorf("Gateway Dns Forwarder already exists for Gateway '%s'", gwID)
+		return diag.Errorf("Gateway Dns Forwarder already exists for Gateway '%s'", gwID)
 	} else if !isNotFoundError(err) {
-		return err
+		return getOperationDiagnostics(m, err)
 	}
 
 	log.Printf("[INFO] Creating Dns Forwarder for Gateway %s", gwID)
 
 	err = patchNsxtPolicyGatewayDNSForwarder(connector, d, gwID, isT0, isGlobalManager)
 	if err != nil {
-		return handleCreateError("Gateway Dns Forwarder", gwID, err)
+		return getOperationDiagnostics(m, handleCreateError("Gateway Dns Forwarder", gwID, err))
 	}
 
 	d.SetId(gwID)
 
-	return resourceNsxtPolicyGatewayDNSForwarderRead(d, m)
+	return resourceNsxtPolicyGatewayDNSForwarderRead(ctx, d, m)
 }
 
-func resourceNsxtPolicyGatewayDNSForwarderUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyGatewayDNSForwarderUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 	gwPolicyPath := d.Get("gateway_path").(string)
 	isT0, gwID := parseGatewayPolicyPath(gwPolicyPath)
 	if gwID == "" {
-		return fmt.Errorf("gateway_path is not valid")
+		return getErrorDiagnostics(newAttributeError("gateway_path", fmt.Errorf("gateway_path is not valid")))
 	}
 
 	log.Printf("[INFO] Updating Gateway Dns Forwarder with ID %s", gwID)
 	err := patchNsxtPolicyGatewayDNSForwarder(connector, d, gwID, isT0, isPolicyGlobalManager(m))
 	if err != nil {
-		return handleUpdateError("Gateway Dns Forwarder", gwID, err)
+		return getOperationDiagnostics(m, handleUpdateError("Gateway Dns Forwarder", gwID, err))
 	}
 
-	return resourceNsxtPolicyGatewayDNSForwarderRead(d, m)
+	return resourceNsxtPolicyGatewayDNSForwarderRead(ctx, d, m)
 }
 
-func resourceNsxtPolicyGatewayDNSForwarderDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyGatewayDNSForwarderDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 	isGlobalManager := isPolicyGlobalManager(m)
 
 	gwPolicyPath := d.Get("gateway_path").(string)
 	isT0, gwID := parseGatewayPolicyPath(gwPolicyPath)
 	if gwID == "" {
-		return fmt.Errorf("gateway_path is not valid")
+		return getErrorDiagnostics(newAttributeError("gateway_path", fmt.Errorf("gateway_path is not valid")))
 	}
 
 	var err error
@@ -283,13 +285,13 @@ func resourceNsxtPolicyGatewayDNSForwarderDelete(d *schema.ResourceData, m inter
 		}
 	}
 	if err != nil {
-		return handleDeleteError("Gateway Dns Forwarder", gwID, err)
+		return getOperationDiagnostics(m, handleDeleteError("Gateway Dns Forwarder", gwID, err))
 	}
 
 	return nil
 }
 
-func resourceNsxtPolicyGatewayDNSForwarderImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+func resourceNsxtPolicyGatewayDNSForwarderImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	gwPath := d.Id()
 
 	d.Set("gateway_path", gwPath)
@@ -4,9 +4,11 @@
 package nsxt
 
 import (
+	"context"
 	"fmt"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/vmware/vsphere-automation-sdk-go/runtime/data"
 	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
@@ -18,12 +20,12 @@ import (
 
 func resourceNsxtPolicyGatewayPolicy() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtPolicyGatewayPolicyCreate,
-		Read:   resourceNsxtPolicyGatewayPolicyRead,
-		Update: resourceNsxtPolicyGatewayPolicyUpdate,
-		Delete: resourceNsxtPolicyGatewayPolicyDelete,
+		CreateContext: resourceNsxtPolicyGatewayPolicyCreate,
+		ReadContext:   resourceNsxtPolicyGatewayPolicyRead,
+		UpdateContext: resourceNsxtPolicyGatewayPolicyUpdate,
+		DeleteContext: resourceNsxtPolicyGatewayPolicyDelete,
 		Importer: &schema.ResourceImporter{
-			State: nsxtDomainResourceImporter,
+			StateContext: nsxtDomainResourceImporter,
 		},
 
 		Schema: getPolicyGatewayPolicySchema(),
@@ -158,6 +160,12 @@ func policyGatewayPolicyBuildAndPatch(d *schema.ResourceData, m interface{}, con
 		obj.TcpStrict = &tcpStrict
 	}
 
+	// Empty path is sent explicitly to detach the scheduler, since the object is patched
+	schedulerPath := d.Get("scheduler_path").(string)
+	if schedulerPath != "" || d.HasChange("scheduler_path") {
+		obj.SchedulerPath = &schedulerPath
+	}
+
 	if len(d.Id()) > 0 {
 		// This is update flow
 		obj.Revision = &revision
@@ -174,37 +182,37 @@ func policyGatewayPolicyBuildAndPatch(d *schema.ResourceData, m interface{}, con
 	return gatewayPolicyInfraPatch(obj, domain, m)
 }
 
-func resourceNsxtPolicyGatewayPolicyCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyGatewayPolicyCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 
 	// Initialize resource Id and verify this ID is not yet used
 	id, err := getOrGenerateID(d, m, resourceNsxtPolicyGatewayPolicyExistsPartial(d.Get("domain").(string)))
 	if err != nil {
-		return err
+		return getOperationDiagnostics(m, err)
 	}
 
 	err = policyGatewayPolicyBuildAndPatch(d, m, connector, isPolicyGlobalManager(m), id)
 	if err != nil {
-		return handleCreateError("Gateway Policy", id, err)
+		return getOperationDiagnostics(m, handleCreateError("Gateway Policy", id, err))
 	}
 
 	d.SetId(id)
 	d.Set("nsx_id", id)
 
-	return resourceNsxtPolicyGatewayPolicyRead(d, m)
+	return resourceNsxtPolicyGatewayPolicyRead(ctx, d, m)
 }
 
-func resourceNsxtPolicyGatewayPolicyRead(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyGatewayPolicyRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining Gateway Policy ID")
+		return diag.Errorf("Error obtaining Gateway Policy ID")
 	}
 
 	obj, err := getGatewayPolicyInDomain(id, d.Get("domain").(string), connector, isPolicyGlobalManager(m))
 	if err != nil {
-		return handleReadError(d, "Gateway Policy", id, err)
+		return getOperationDiagnostics(m, handleReadError(d, "Gateway Policy", id, err))
 	}
 
 	d.Set("display_name", obj.DisplayName)
@@ -222,30 +230,31 @@ func resourceNsxtPolicyGatewayPolicyRead(d *schema.ResourceData, m interface{})
 		// tcp_strict is dependant on stateful and maybe nil
 		d.Set("tcp_strict", *obj.TcpStrict)
 	}
+	d.Set("scheduler_path", obj.SchedulerPath)
 	d.Set("revision", obj.Revision)
-	return setPolicyRulesInSchema(d, obj.Rules)
+	return getErrorDiagnostics(setPolicyRulesInSchema(d, obj.Rules, true))
 }
 
-func resourceNsxtPolicyGatewayPolicyUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyGatewayPolicyUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining Gateway Policy ID")
+		return diag.Errorf("Error obtaining Gateway Policy ID")
 	}
 
 	err := policyGatewayPolicyBuildAndPatch(d, m, connector, isPolicyGlobalManager(m), id)
 	if err != nil {
-		return handleUpdateError("Gateway Policy", id, err)
+		return getOperationDiagnostics(m, handleUpdateError("Gateway Policy", id, err))
 	}
 
-	return resourceNsxtPolicyGatewayPolicyRead(d, m)
+	return resourceNsxtPolicyGatewayPolicyRead(ctx, d, m)
 }
 
-func resourceNsxtPolicyGatewayPolicyDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyGatewayPolicyDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining Gateway Policy ID")
+		return diag.Errorf("Error obtaining Gateway Policy ID")
 	}
 
 	connector := getPolicyConnector(m)
@@ -258,7 +267,7 @@ func resourceNsxtPolicyGatewayPolicyDelete(d *schema.ResourceData, m interface{}
 		err = client.Delete(d.Get("domain").(string), id)
 	}
 	if err != nil {
-		return handleDeleteError("Gateway Policy", id, err)
+		return getOperationDiagnostics(m, handleDeleteError("Gateway Policy", id, err))
 	}
 
 	return nil
@@ -526,6 +526,73 @@ func TestAccResourceNsxtPolicyGatewayPolicy_withIPCidrRange(t *testing.T) {
 	})
 }
 
+func TestAccResourceNsxtPolicyGatewayPolicy_withL7AccessProfile(t *testing.T) {
+	name := getAccTestResourceName()
+	testResourceName := "nsxt_policy_gateway_policy.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccOnlyLocalManager(t)
+			testAccNSXVersion(t, "4.0.0")
+		},
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyGatewayPolicyCheckDestroy(state, name, defaultDomain)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyGatewayPolicyWithL7AccessProfile(name, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyGatewayPolicyExists(testResourceName, defaultDomain),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", name),
+					resource.TestCheckResourceAttr(testResourceName, "rule.#", "1"),
+					resource.TestCheckResourceAttrSet(testResourceName, "rule.0.l7_access_profile"),
+					resource.TestCheckResourceAttr(testResourceName, "rule.0.profiles.#", "0"),
+				),
+			},
+			{
+				Config: testAccNsxtPolicyGatewayPolicyWithL7AccessProfile(name, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyGatewayPolicyExists(testResourceName, defaultDomain),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", name),
+					resource.TestCheckResourceAttr(testResourceName, "rule.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "rule.0.l7_access_profile", ""),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNsxtPolicyGatewayPolicy_withScheduler(t *testing.T) {
+	name := getAccTestResourceName()
+	testResourceName := "nsxt_policy_gateway_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t); testAccOnlyLocalManager(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyGatewayPolicyCheckDestroy(state, name, defaultDomain)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyGatewayPolicyWithScheduler(name, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyGatewayPolicyExists(testResourceName, defaultDomain),
+					resource.TestCheckResourceAttrPair(testResourceName, "scheduler_path", "nsxt_policy_firewall_scheduler.test", "path"),
+				),
+			},
+			{
+				Config: testAccNsxtPolicyGatewayPolicyWithScheduler(name, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyGatewayPolicyExists(testResourceName, defaultDomain),
+					resource.TestCheckResourceAttr(testResourceName, "scheduler_path", ""),
+				),
+			},
+		},
+	})
+}
+
 func testAccNsxtPolicyGatewayPolicyExists(resourceName string, domainName string) resource.TestCheckFunc {
 	return func(state *terraform.State) error {
 
@@ -940,3 +1007,62 @@ func testAccNsxtPolicyGatewayPolicyWithIPCidrRange(name string, destIP string, d
 			}
 }`, name, destIP, destCidr, destIPRange, sourceIP, sourceCidr, sourceIPRange)
 }
+
+func testAccNsxtPolicyGatewayPolicyWithL7AccessProfile(name string, withProfile bool) string {
+	l7AccessProfile := ""
+	if withProfile {
+		l7AccessProfile = "l7_access_profile = nsxt_policy_l7_access_profile.test.path"
+	}
+	return fmt.Sprintf(`
+resource "nsxt_policy_tier1_gateway" "gwt1test" {
+  display_name = "tf-t1-gw"
+  description  = "Acceptance Test"
+}
+
+resource "nsxt_policy_l7_access_profile" "test" {
+  display_name   = "%s"
+  default_action = "ALLOW"
+
+  l7_access_entry {
+    action = "REJECT"
+
+    url_category {
+      value = ["Gambling"]
+    }
+  }
+}
+
+resource "nsxt_policy_gateway_policy" "test" {
+  display_name    = "%s"
+  description     = "Acceptance Test"
+  category        = "LocalGatewayRules"
+  sequence_number = 3
+
+  rule {
+    display_name = "%s"
+    scope        = [nsxt_policy_tier1_gateway.gwt1test.path]
+    %s
+  }
+}`, name, name, name, l7AccessProfile)
+}
+
+func testAccNsxtPolicyGatewayPolicyWithScheduler(name string, withScheduler bool) string {
+	schedulerPath := ""
+	if withScheduler {
+		schedulerPath = "scheduler_path = nsxt_policy_firewall_scheduler.test.path"
+	}
+	return fmt.Sprintf(`
+resource "nsxt_policy_firewall_scheduler" "test" {
+  display_name = "%s"
+  days         = ["SATURDAY", "SUNDAY"]
+  start_time   = "00:00"
+  end_time     = "23:30"
+  start_date   = "01/01/2030"
+}
+
+resource "nsxt_policy_gateway_policy" "test" {
+  display_name = "%s"
+  category     = "LocalGatewayRules"
+  %s
+}`, name, name, schedulerPath)
+}
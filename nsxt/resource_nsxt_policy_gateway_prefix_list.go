@@ -4,10 +4,12 @@
 package nsxt
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
@@ -26,12 +28,12 @@ var gatewayPrefixListActionTypeValues = []string{
 
 func resourceNsxtPolicyGatewayPrefixList() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtPolicyGatewayPrefixListCreate,
-		Read:   resourceNsxtPolicyGatewayPrefixListRead,
-		Update: resourceNsxtPolicyGatewayPrefixListUpdate,
-		Delete: resourceNsxtPolicyGatewayPrefixListDelete,
+		CreateContext: resourceNsxtPolicyGatewayPrefixListCreate,
+		ReadContext:   resourceNsxtPolicyGatewayPrefixListRead,
+		UpdateContext: resourceNsxtPolicyGatewayPrefixListUpdate,
+		DeleteContext: resourceNsxtPolicyGatewayPrefixListDelete,
 		Importer: &schema.ResourceImporter{
-			State: resourceNsxtPolicyTier0GatewayImporter,
+			StateContext: resourceNsxtPolicyTier0GatewayImporter,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -137,19 +139,19 @@ func getPrefixesFromSchema(d *schema.ResourceData) []model.PrefixEntry {
 	return entriesList
 }
 
-func resourceNsxtPolicyGatewayPrefixListDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyGatewayPrefixListDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 	isGlobalManager := isPolicyGlobalManager(m)
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining Gateway Prefix List ID")
+		return diag.Errorf("Error obtaining Gateway Prefix List ID")
 	}
 
 	gwPolicyPath := d.Get("gateway_path").(string)
 	_, gwID := parseGatewayPolicyPath(gwPolicyPath)
 	if gwID == "" {
-		return fmt.Errorf("gateway_path is not valid")
+		return getErrorDiagnostics(newAttributeError("gateway_path", fmt.Errorf("gateway_path is not valid")))
 	}
 
 	var err error
@@ -161,25 +163,25 @@ func resourceNsxtPolicyGatewayPrefixListDelete(d *schema.ResourceData, m interfa
 		err = client.Delete(gwID, id)
 	}
 	if err != nil {
-		return handleDeleteError("Gateway Prefix List", id, err)
+		return getOperationDiagnostics(m, handleDeleteError("Gateway Prefix List", id, err))
 	}
 
 	return nil
 }
 
-func resourceNsxtPolicyGatewayPrefixListRead(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyGatewayPrefixListRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 	isGlobalManager := isPolicyGlobalManager(m)
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining Gateway Prefix List ID")
+		return diag.Errorf("Error obtaining Gateway Prefix List ID")
 	}
 
 	gwPolicyPath := d.Get("gateway_path").(string)
 	_, gwID := parseGatewayPolicyPath(gwPolicyPath)
 	if gwID == "" {
-		return fmt.Errorf("gateway_path is not valid")
+		return getErrorDiagnostics(newAttributeError("gateway_path", fmt.Errorf("gateway_path is not valid")))
 	}
 
 	var obj model.PrefixList
@@ -190,18 +192,18 @@ func resourceNsxtPolicyGatewayPrefixListRead(d *schema.ResourceData, m interface
 		client := gm_tier_0s.NewPrefixListsClient(connector)
 		gmObj, err = client.Get(gwID, id)
 		if err != nil {
-			return handleReadError(d, "Gateway Prefix List", id, err)
+			return getOperationDiagnostics(m, handleReadError(d, "Gateway Prefix List", id, err))
 		}
 		rawObj, err = convertModelBindingType(gmObj, gm_model.PrefixListBindingType(), model.PrefixListBindingType())
 		if err != nil {
-			return handleReadError(d, "Gateway Prefix List", id, err)
+			return getOperationDiagnostics(m, handleReadError(d, "Gateway Prefix List", id, err))
 		}
 		obj = rawObj.(model.PrefixList)
 	} else {
 		client := tier_0s.NewPrefixListsClient(connector)
 		obj, err = client.Get(gwID, id)
 		if err != nil {
-			return handleReadError(d, "Gateway Prefix List", id, err)
+			return getOperationDiagnostics(m, handleReadError(d, "Gateway Prefix List", id, err))
 		}
 	}
 
@@ -230,13 +232,13 @@ func patchNsxtPolicyGatewayPrefixList(connector client.Connector, gwID string, p
 	return client.Patch(gwID, *prefixList.Id, prefixList)
 }
 
-func resourceNsxtPolicyGatewayPrefixListCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyGatewayPrefixListCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 
 	gwPolicyPath := d.Get("gateway_path").(string)
 	_, gwID := parseGatewayPolicyPath(gwPolicyPath)
 	if gwID == "" {
-		return fmt.Errorf("gateway_path is not valid")
+		return getErrorDiagnostics(newAttributeError("gateway_path", fmt.Errorf("gateway_path is not valid")))
 	}
 	isGlobalManager := isPolicyGlobalManager(m)
 
@@ -254,9 +256,9 @@ func resourceNsxtPolicyGatewayPrefixListCreate(d *schema.ResourceData, m interfa
 			_, err = client.Get(gwID, id)
 		}
 		if err == nil {
-			return fmt.Errorf("Gateway Prefix List with nsx_id '%s' already exists", id)
+			return getErrorDiagnostics(newAttributeError("nsx_id", fmt.Errorf("Gateway Prefix List with nsx_id '%s' already exists", id)))
 		} else if !isNotFoundError(err) {
-			return err
+			return getOperationDiagnostics(m, err)
 		}
 	}
 
@@ -277,27 +279,27 @@ func resourceNsxtPolicyGatewayPrefixListCreate(d *schema.ResourceData, m interfa
 
 	err := patchNsxtPolicyGatewayPrefixList(connector, gwID, prefixListStruct, isGlobalManager)
 	if err != nil {
-		return handleCreateError("Gateway Prefix List", id, err)
+		return getOperationDiagnostics(m, handleCreateError("Gateway Prefix List", id, err))
 	}
 
 	d.SetId(id)
 	d.Set("nsx_id", id)
 
-	return resourceNsxtPolicyGatewayPrefixListRead(d, m)
+	return resourceNsxtPolicyGatewayPrefixListRead(ctx, d, m)
 }
 
-func resourceNsxtPolicyGatewayPrefixListUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyGatewayPrefixListUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining Gateway Prefix List ID")
+		return diag.Errorf("Error obtaining Gateway Prefix List ID")
 	}
 
 	gwPolicyPath := d.Get("gateway_path").(string)
 	_, gwID := parseGatewayPolicyPath(gwPolicyPath)
 	if gwID == "" {
-		return fmt.Errorf("gateway_path is not valid")
+		return getErrorDiagnostics(newAttributeError("gateway_path", fmt.Errorf("gateway_path is not valid")))
 	}
 
 	displayName := d.Get("display_name").(string)
@@ -316,16 +318,16 @@ func resourceNsxtPolicyGatewayPrefixListUpdate(d *schema.ResourceData, m interfa
 	log.Printf("[INFO] Updating Gateway Prefix List with ID %s", id)
 	err := patchNsxtPolicyGatewayPrefixList(connector, gwID, prefixListStruct, isPolicyGlobalManager(m))
 	if err != nil {
-		return handleUpdateError("Gateway Prefix List", id, err)
+		return getOperationDiagnostics(m, handleUpdateError("Gateway Prefix List", id, err))
 	}
 
 	d.SetId(id)
 	d.Set("nsx_id", id)
 
-	return resourceNsxtPolicyGatewayPrefixListRead(d, m)
+	return resourceNsxtPolicyGatewayPrefixListRead(ctx, d, m)
 }
 
-func resourceNsxtPolicyTier0GatewayImporter(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+func resourceNsxtPolicyTier0GatewayImporter(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	importID := d.Id()
 	s := strings.Split(importID, "/")
 	if len(s) != 2 {
@@ -4,9 +4,11 @@
 package nsxt
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	gm_tier0s "github.com/vmware/vsphere-automation-sdk-go/services/nsxt-gm/global_infra/tier_0s"
 	gm_model "github.com/vmware/vsphere-automation-sdk-go/services/nsxt-gm/model"
@@ -16,12 +18,12 @@ import (
 
 func resourceNsxtPolicyGatewayRedistributionConfig() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtPolicyGatewayRedistributionConfigCreate,
-		Read:   resourceNsxtPolicyGatewayRedistributionConfigRead,
-		Update: resourceNsxtPolicyGatewayRedistributionConfigUpdate,
-		Delete: resourceNsxtPolicyGatewayRedistributionConfigDelete,
+		CreateContext: resourceNsxtPolicyGatewayRedistributionConfigCreate,
+		ReadContext:   resourceNsxtPolicyGatewayRedistributionConfigRead,
+		UpdateContext: resourceNsxtPolicyGatewayRedistributionConfigUpdate,
+		DeleteContext: resourceNsxtPolicyGatewayRedistributionConfigDelete,
 		Importer: &schema.ResourceImporter{
-			State: resourceNsxtPolicyGatewayRedistributionConfigImport,
+			StateContext: resourceNsxtPolicyGatewayRedistributionConfigImport,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -104,39 +106,39 @@ func policyGatewayRedistributionConfigPatch(d *schema.ResourceData, m interface{
 	return doPatch()
 }
 
-func resourceNsxtPolicyGatewayRedistributionConfigCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyGatewayRedistributionConfigCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 
 	gwPath := d.Get("gateway_path").(string)
 	sitePath := d.Get("site_path").(string)
 	isT0, gwID := parseGatewayPolicyPath(gwPath)
 	if !isT0 {
-		return fmt.Errorf("Tier0 Gateway path expected, got %s", gwPath)
+		return diag.Errorf("Tier0 Gateway path expected, got %s", gwPath)
 	}
 
 	localeServiceID := ""
 	if isPolicyGlobalManager(m) {
 		if sitePath == "" {
-			return attributeRequiredGlobalManagerError("site_path", "nsxt_policy_gateway_redistribution_config")
+			return getErrorDiagnostics(attributeRequiredGlobalManagerError("site_path", "nsxt_policy_gateway_redistribution_config"))
 		}
 		localeServices, err := listPolicyTier0GatewayLocaleServices(connector, gwID, true)
 		if err != nil {
-			return err
+			return getOperationDiagnostics(m, err)
 		}
 		localeServiceID, err = getGlobalPolicyGatewayLocaleServiceIDWithSite(localeServices, sitePath, gwID)
 		if err != nil {
-			return err
+			return getOperationDiagnostics(m, err)
 		}
 	} else {
 		if sitePath != "" {
-			return globalManagerOnlyError()
+			return getErrorDiagnostics(globalManagerOnlyError())
 		}
 		localeService, err := getPolicyTier0GatewayLocaleServiceWithEdgeCluster(gwID, connector)
 		if err != nil {
-			return err
+			return getOperationDiagnostics(m, err)
 		}
 		if localeService == nil {
-			return fmt.Errorf("Edge cluster is mandatory on gateway %s in order to create interfaces", gwID)
+			return diag.Errorf("Edge cluster is mandatory on gateway %s in order to create interfaces", gwID)
 		}
 		localeServiceID = *localeService.Id
 	}
@@ -144,24 +146,24 @@ func resourceNsxtPolicyGatewayRedistributionConfigCreate(d *schema.ResourceData,
 	id := newUUID()
 	err := policyGatewayRedistributionConfigPatch(d, m, gwID, localeServiceID)
 	if err != nil {
-		return handleCreateError("Tier0 Redistribution Config", id, err)
+		return getOperationDiagnostics(m, handleCreateError("Tier0 Redistribution Config", id, err))
 	}
 
 	d.SetId(id)
 	d.Set("gateway_id", gwID)
 	d.Set("locale_service_id", localeServiceID)
 
-	return resourceNsxtPolicyGatewayRedistributionConfigRead(d, m)
+	return resourceNsxtPolicyGatewayRedistributionConfigRead(ctx, d, m)
 }
 
-func resourceNsxtPolicyGatewayRedistributionConfigRead(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyGatewayRedistributionConfigRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 
 	id := d.Id()
 	gwID := d.Get("gateway_id").(string)
 	localeServiceID := d.Get("locale_service_id").(string)
 	if id == "" || gwID == "" || localeServiceID == "" {
-		return fmt.Errorf("Error obtaining Tier0 Gateway id or Locale Service id")
+		return diag.Errorf("Error obtaining Tier0 Gateway id or Locale Service id")
 	}
 
 	var obj model.LocaleServices
@@ -169,11 +171,11 @@ func resourceNsxtPolicyGatewayRedistributionConfigRead(d *schema.ResourceData, m
 		client := gm_tier0s.NewLocaleServicesClient(connector)
 		gmObj, err1 := client.Get(gwID, localeServiceID)
 		if err1 != nil {
-			return handleReadError(d, "Tier0 Redistribution Config", id, err1)
+			return getOperationDiagnostics(m, handleReadError(d, "Tier0 Redistribution Config", id, err1))
 		}
 		lmObj, err2 := convertModelBindingType(gmObj, model.LocaleServicesBindingType(), model.LocaleServicesBindingType())
 		if err2 != nil {
-			return err2
+			return getOperationDiagnostics(m, err2)
 		}
 		obj = lmObj.(model.LocaleServices)
 	} else {
@@ -181,7 +183,7 @@ func resourceNsxtPolicyGatewayRedistributionConfigRead(d *schema.ResourceData, m
 		client := tier_0s.NewLocaleServicesClient(connector)
 		obj, err = client.Get(gwID, localeServiceID)
 		if err != nil {
-			return handleReadError(d, "Tier0 Redistribution Config", id, err)
+			return getOperationDiagnostics(m, handleReadError(d, "Tier0 Redistribution Config", id, err))
 		}
 	}
 
@@ -201,31 +203,31 @@ func resourceNsxtPolicyGatewayRedistributionConfigRead(d *schema.ResourceData, m
 	return nil
 }
 
-func resourceNsxtPolicyGatewayRedistributionConfigUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyGatewayRedistributionConfigUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 
 	id := d.Id()
 	gwID := d.Get("gateway_id").(string)
 	localeServiceID := d.Get("locale_service_id").(string)
 	if id == "" || gwID == "" || localeServiceID == "" {
-		return fmt.Errorf("Error obtaining Tier0 Gateway id or Locale Service id")
+		return diag.Errorf("Error obtaining Tier0 Gateway id or Locale Service id")
 	}
 
 	err := policyGatewayRedistributionConfigPatch(d, m, gwID, localeServiceID)
 	if err != nil {
-		return handleUpdateError("Tier0 Redistribution Config", id, err)
+		return getOperationDiagnostics(m, handleUpdateError("Tier0 Redistribution Config", id, err))
 	}
 
-	return resourceNsxtPolicyGatewayRedistributionConfigRead(d, m)
+	return resourceNsxtPolicyGatewayRedistributionConfigRead(ctx, d, m)
 }
 
-func resourceNsxtPolicyGatewayRedistributionConfigDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyGatewayRedistributionConfigDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 
 	id := d.Id()
 	gwID := d.Get("gateway_id").(string)
 	localeServiceID := d.Get("locale_service_id").(string)
 	if id == "" || gwID == "" || localeServiceID == "" {
-		return fmt.Errorf("Error obtaining Tier0 Gateway id or Locale Service id")
+		return diag.Errorf("Error obtaining Tier0 Gateway id or Locale Service id")
 	}
 
 	// Update the locale service with empty Redistribution config using get/post
@@ -254,13 +256,13 @@ func resourceNsxtPolicyGatewayRedistributionConfigDelete(d *schema.ResourceData,
 	commonProviderConfig := getCommonProviderConfig(m)
 	err := retryUponPreconditionFailed(doUpdate, commonProviderConfig.MaxRetries)
 	if err != nil {
-		return handleDeleteError("Tier0 RedistributionConfig config", id, err)
+		return getOperationDiagnostics(m, handleDeleteError("Tier0 RedistributionConfig config", id, err))
 	}
 
 	return nil
 }
 
-func resourceNsxtPolicyGatewayRedistributionConfigImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+func resourceNsxtPolicyGatewayRedistributionConfigImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	importID := d.Id()
 	s := strings.Split(importID, "/")
 	if len(s) != 2 {
@@ -4,9 +4,11 @@
 package nsxt
 
 import (
+	"context"
 	"fmt"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
@@ -18,12 +20,12 @@ import (
 
 func resourceNsxtPolicyGatewayRouteMap() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtPolicyGatewayRouteMapCreate,
-		Read:   resourceNsxtPolicyGatewayRouteMapRead,
-		Update: resourceNsxtPolicyGatewayRouteMapUpdate,
-		Delete: resourceNsxtPolicyGatewayRouteMapDelete,
+		CreateContext: resourceNsxtPolicyGatewayRouteMapCreate,
+		ReadContext:   resourceNsxtPolicyGatewayRouteMapRead,
+		UpdateContext: resourceNsxtPolicyGatewayRouteMapUpdate,
+		DeleteContext: resourceNsxtPolicyGatewayRouteMapDelete,
 		Importer: &schema.ResourceImporter{
-			State: resourceNsxtPolicyTier0GatewayImporter,
+			StateContext: resourceNsxtPolicyTier0GatewayImporter,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -254,7 +256,7 @@ func resourceNsxtPolicyGatewayRouteMapPatch(gwID string, id string, d *schema.Re
 	return client.Patch(gwID, id, obj)
 }
 
-func resourceNsxtPolicyGatewayRouteMapCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyGatewayRouteMapCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 
 	// Initialize resource Id and verify this ID is not yet used
@@ -262,7 +264,7 @@ func resourceNsxtPolicyGatewayRouteMapCreate(d *schema.ResourceData, m interface
 	gwPath := d.Get("gateway_path").(string)
 	isT0, gwID := parseGatewayPolicyPath(gwPath)
 	if !isT0 {
-		return fmt.Errorf("Tier0 Gateway path expected, got %s", gwPath)
+		return diag.Errorf("Tier0 Gateway path expected, got %s", gwPath)
 	}
 
 	if id == "" {
@@ -277,35 +279,35 @@ func resourceNsxtPolicyGatewayRouteMapCreate(d *schema.ResourceData, m interface
 			_, err = client.Get(gwID, id)
 		}
 		if err == nil {
-			return fmt.Errorf("Route Map with ID '%s' already exists on Tier0 Gateway %s", id, gwID)
+			return diag.Errorf("Route Map with ID '%s' already exists on Tier0 Gateway %s", id, gwID)
 		} else if !isNotFoundError(err) {
-			return err
+			return getOperationDiagnostics(m, err)
 		}
 	}
 
 	log.Printf("[INFO] Creating Gateway Route Map with ID %s", id)
 	err := resourceNsxtPolicyGatewayRouteMapPatch(gwID, id, d, isPolicyGlobalManager(m), connector)
 	if err != nil {
-		return handleCreateError("Route Map", id, err)
+		return getOperationDiagnostics(m, handleCreateError("Route Map", id, err))
 	}
 
 	d.SetId(id)
 	d.Set("nsx_id", id)
 
-	return resourceNsxtPolicyGatewayRouteMapRead(d, m)
+	return resourceNsxtPolicyGatewayRouteMapRead(ctx, d, m)
 }
 
-func resourceNsxtPolicyGatewayRouteMapRead(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyGatewayRouteMapRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining Gateway Route Map ID")
+		return diag.Errorf("Error obtaining Gateway Route Map ID")
 	}
 	gwPath := d.Get("gateway_path").(string)
 	isT0, gwID := parseGatewayPolicyPath(gwPath)
 	if !isT0 {
-		return fmt.Errorf("Tier0 Gateway path expected, got %s", gwPath)
+		return diag.Errorf("Tier0 Gateway path expected, got %s", gwPath)
 	}
 
 	var obj model.Tier0RouteMap
@@ -313,12 +315,12 @@ func resourceNsxtPolicyGatewayRouteMapRead(d *schema.ResourceData, m interface{}
 		client := gm_tier0s.NewRouteMapsClient(connector)
 		gmObj, err := client.Get(gwID, id)
 		if err != nil {
-			return handleReadError(d, "Gateway Route Map", id, err)
+			return getOperationDiagnostics(m, handleReadError(d, "Gateway Route Map", id, err))
 		}
 
 		lmObj, err := convertModelBindingType(gmObj, gm_model.Tier0RouteMapBindingType(), model.Tier0RouteMapBindingType())
 		if err != nil {
-			return err
+			return getOperationDiagnostics(m, err)
 		}
 		obj = lmObj.(model.Tier0RouteMap)
 	} else {
@@ -326,7 +328,7 @@ func resourceNsxtPolicyGatewayRouteMapRead(d *schema.ResourceData, m interface{}
 		var err error
 		obj, err = client.Get(gwID, id)
 		if err != nil {
-			return handleReadError(d, "Gateway Route Map", id, err)
+			return getOperationDiagnostics(m, handleReadError(d, "Gateway Route Map", id, err))
 		}
 	}
 
@@ -379,12 +381,12 @@ func resourceNsxtPolicyGatewayRouteMapRead(d *schema.ResourceData, m interface{}
 	return nil
 }
 
-func resourceNsxtPolicyGatewayRouteMapUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyGatewayRouteMapUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining GatewayRouteMap ID")
+		return diag.Errorf("Error obtaining GatewayRouteMap ID")
 	}
 	gwPath := d.Get("gateway_path").(string)
 	_, gwID := parseGatewayPolicyPath(gwPath)
@@ -392,16 +394,16 @@ func resourceNsxtPolicyGatewayRouteMapUpdate(d *schema.ResourceData, m interface
 	log.Printf("[INFO] Updating Gateway Route Map with ID %s", id)
 	err := resourceNsxtPolicyGatewayRouteMapPatch(gwID, id, d, isPolicyGlobalManager(m), connector)
 	if err != nil {
-		return handleCreateError("Gateway Route Map", id, err)
+		return getOperationDiagnostics(m, handleCreateError("Gateway Route Map", id, err))
 	}
 
-	return resourceNsxtPolicyGatewayRouteMapRead(d, m)
+	return resourceNsxtPolicyGatewayRouteMapRead(ctx, d, m)
 }
 
-func resourceNsxtPolicyGatewayRouteMapDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyGatewayRouteMapDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining Gateway Route Map ID")
+		return diag.Errorf("Error obtaining Gateway Route Map ID")
 	}
 	gwPath := d.Get("gateway_path").(string)
 	_, gwID := parseGatewayPolicyPath(gwPath)
@@ -417,7 +419,7 @@ func resourceNsxtPolicyGatewayRouteMapDelete(d *schema.ResourceData, m interface
 	}
 
 	if err != nil {
-		return handleDeleteError("Gateway Route Map", id, err)
+		return getOperationDiagnostics(m, handleDeleteError("Gateway Route Map", id, err))
 	}
 
 	return nil
@@ -0,0 +1,132 @@
+/* Copyright © 2023 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	gm_sites "github.com/vmware/vsphere-automation-sdk-go/services/nsxt-gm/global_infra/sites"
+)
+
+// Full sync is one time operation between global manager and its sites. The resource
+// triggers full sync upon creation and waits for it to finish, and only records it in
+// state. Destroy does not affect sites.
+func resourceNsxtPolicyGmFullSync() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceNsxtPolicyGmFullSyncCreate,
+		ReadContext:   resourceNsxtPolicyGmFullSyncRead,
+		UpdateContext: resourceNsxtPolicyGmFullSyncUpdate,
+		DeleteContext: resourceNsxtPolicyGmFullSyncDelete,
+
+		Schema: map[string]*schema.Schema{
+			"site_paths": {
+				Type:        schema.TypeList,
+				Description: "Paths of sites to trigger full sync for. If not specified, full sync is triggered for all sites",
+				Optional:    true,
+				ForceNew:    true,
+				Elem:        getElemPolicyPathSchema(),
+			},
+			"keepers": {
+				Type:        schema.TypeMap,
+				Description: "Arbitrary values that, when changed, trigger full sync again",
+				Optional:    true,
+				ForceNew:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"timeout": {
+				Type:         schema.TypeInt,
+				Description:  "Timeout in seconds to wait for full sync to complete",
+				Optional:     true,
+				Default:      1200,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"delay": {
+				Type:         schema.TypeInt,
+				Description:  "Initial delay to start full sync checks in seconds",
+				Optional:     true,
+				Default:      1,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"state": getPolicyGmFullSyncStateSchema(),
+		},
+	}
+}
+
+func resourceNsxtPolicyGmFullSyncCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if !isPolicyGlobalManager(m) {
+		return getErrorDiagnostics(globalManagerOnlyError())
+	}
+
+	connector := getPolicyConnector(m)
+
+	// Remember full sync states that precede this trigger, in order to monitor new ones only
+	previousStates := make(map[string]bool)
+	states, err := listPolicyGmFullSyncStates(connector)
+	if err != nil {
+		return getErrorDiagnostics(logAPIError("Error listing full sync states", err))
+	}
+	for _, state := range states {
+		previousStates[getPolicyGmFullSyncStateKey(state)] = true
+	}
+
+	sitePaths := interface2StringList(d.Get("site_paths").([]interface{}))
+	if len(sitePaths) == 0 {
+		sitePaths, err = listPolicyGmSitePaths(connector)
+		if err != nil {
+			return getErrorDiagnostics(logAPIError("Error listing sites", err))
+		}
+	}
+
+	client := gm_sites.NewEnforcementPointsClient(connector)
+	for _, sitePath := range sitePaths {
+		siteID := getPolicyIDFromPath(sitePath)
+		log.Printf("[INFO] Triggering full sync for site %s", sitePath)
+		err = client.Fullsync(siteID, getPolicyEnforcementPoint(m))
+		if err != nil {
+			return getErrorDiagnostics(logAPIError(fmt.Sprintf("Error triggering full sync for site %s", sitePath), err))
+		}
+	}
+
+	states, err = policyGmFullSyncWait(ctx, connector, previousStates, true, d.Get("delay").(int), d.Get("timeout").(int))
+	if err != nil {
+		return getErrorDiagnostics(err)
+	}
+
+	// Failed full sync is not recorded in state, so that next apply triggers it again
+	err = setPolicyGmFullSyncStateInSchema(d, states)
+	if err != nil {
+		return getErrorDiagnostics(err)
+	}
+
+	d.SetId(newUUID())
+	return resourceNsxtPolicyGmFullSyncRead(ctx, d, m)
+}
+
+func resourceNsxtPolicyGmFullSyncRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	id := d.Id()
+	if id == "" {
+		return diag.Errorf("Error obtaining GM Full Sync ID")
+	}
+
+	// Full sync states are kept as recorded upon creation, later full syncs do not
+	// affect the one that already happened
+	return nil
+}
+
+func resourceNsxtPolicyGmFullSyncUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// Only wait settings can be updated, and they apply to next full sync
+	return resourceNsxtPolicyGmFullSyncRead(ctx, d, m)
+}
+
+func resourceNsxtPolicyGmFullSyncDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Printf("[INFO] Removing GM Full Sync %s from state, sites are not affected", d.Id())
+	return nil
+}
@@ -0,0 +1,57 @@
+/* Copyright © 2023 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccResourceNsxtPolicyGmFullSync_basic(t *testing.T) {
+	testResourceName := "nsxt_policy_gm_full_sync.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccOnlyGlobalManager(t)
+			testAccEnvDefined(t, "NSXT_TEST_SITE_NAME")
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyGmFullSyncTemplate(getTestSiteName(), "v1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(testResourceName, "id"),
+					resource.TestCheckResourceAttr(testResourceName, "site_paths.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "state.0.last_completed_stage", "COMPLETED"),
+				),
+			},
+			{
+				// Change of keepers triggers full sync again
+				Config: testAccNsxtPolicyGmFullSyncTemplate(getTestSiteName(), "v2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(testResourceName, "keepers.version", "v2"),
+					resource.TestCheckResourceAttr(testResourceName, "state.0.last_completed_stage", "COMPLETED"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicyGmFullSyncTemplate(siteName string, version string) string {
+	return fmt.Sprintf(`
+data "nsxt_policy_site" "test" {
+  display_name = "%s"
+}
+
+resource "nsxt_policy_gm_full_sync" "test" {
+  site_paths = [data.nsxt_policy_site.test.path]
+
+  keepers = {
+    version = "%s"
+  }
+}`, siteName, version)
+}
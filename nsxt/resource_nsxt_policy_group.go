@@ -4,10 +4,12 @@
 package nsxt
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/vmware/vsphere-automation-sdk-go/runtime/bindings"
@@ -17,6 +19,7 @@ import (
 	gm_model "github.com/vmware/vsphere-automation-sdk-go/services/nsxt-gm/model"
 	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/domains"
 	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+	project_domains "github.com/vmware/vsphere-automation-sdk-go/services/nsxt/orgs/projects/infra/domains"
 )
 
 var conditionKeyValues = []string{
@@ -85,12 +88,12 @@ var groupTypeValues = []string{
 
 func resourceNsxtPolicyGroup() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtPolicyGroupCreate,
-		Read:   resourceNsxtPolicyGroupRead,
-		Update: resourceNsxtPolicyGroupUpdate,
-		Delete: resourceNsxtPolicyGroupDelete,
+		CreateContext: resourceNsxtPolicyGroupCreate,
+		ReadContext:   resourceNsxtPolicyGroupRead,
+		UpdateContext: resourceNsxtPolicyGroupUpdate,
+		DeleteContext: resourceNsxtPolicyGroupDelete,
 		Importer: &schema.ResourceImporter{
-			State: nsxtDomainResourceImporter,
+			StateContext: nsxtDomainResourceImporterWithContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -100,6 +103,7 @@ func resourceNsxtPolicyGroup() *schema.Resource {
 			"description":  getDescriptionSchema(),
 			"revision":     getRevisionSchema(),
 			"tag":          getTagsSchema(),
+			"context":      getPolicyContextSchema(),
 			"domain":       getDomainNameSchema(),
 			"group_type": {
 				Type:         schema.TypeString,
@@ -317,10 +321,17 @@ func getExtendedCriteriaSetSchema() *schema.Resource {
 }
 
 func resourceNsxtPolicyGroupExistsInDomain(id string, domain string, connector client.Connector, isGlobalManager bool) (bool, error) {
+	return resourceNsxtPolicyGroupExistsInProjectDomain(id, "", domain, connector, isGlobalManager)
+}
+
+func resourceNsxtPolicyGroupExistsInProjectDomain(id string, projectID string, domain string, connector client.Connector, isGlobalManager bool) (bool, error) {
 	var err error
 	if isGlobalManager {
 		client := gm_domains.NewGroupsClient(connector)
 		_, err = client.Get(domain, id)
+	} else if projectID != "" {
+		client := project_domains.NewGroupsClient(connector)
+		_, err = client.Get(defaultOrgID, projectID, domain, id)
 	} else {
 		client := domains.NewGroupsClient(connector)
 		_, err = client.Get(domain, id)
@@ -337,9 +348,9 @@ func resourceNsxtPolicyGroupExistsInDomain(id string, domain string, connector c
 
 }
 
-func resourceNsxtPolicyGroupExistsInDomainPartial(domain string) func(id string, connector client.Connector, isGlobalManager bool) (bool, error) {
+func resourceNsxtPolicyGroupExistsInDomainPartial(projectID string, domain string) func(id string, connector client.Connector, isGlobalManager bool) (bool, error) {
 	return func(id string, connector client.Connector, isGlobalManager bool) (bool, error) {
-		return resourceNsxtPolicyGroupExistsInDomain(id, domain, connector, isGlobalManager)
+		return resourceNsxtPolicyGroupExistsInProjectDomain(id, projectID, domain, connector, isGlobalManager)
 	}
 }
 
@@ -833,13 +844,18 @@ func validateGroupCriteriaAndConjunctions(criteriaSets []interface{}, conjunctio
 	return criteriaMeta, nil
 }
 
-func resourceNsxtPolicyGroupCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyGroupCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
+	projectID := getPolicyProjectID(d)
+	err := validatePolicyProjectContext(projectID, m)
+	if err != nil {
+		return getOperationDiagnostics(m, err)
+	}
 
 	// Initialize resource Id and verify this ID is not yet used
-	id, err := getOrGenerateID(d, m, resourceNsxtPolicyGroupExistsInDomainPartial(d.Get("domain").(string)))
+	id, err := getOrGenerateID(d, m, resourceNsxtPolicyGroupExistsInDomainPartial(projectID, d.Get("domain").(string)))
 	if err != nil {
-		return err
+		return getOperationDiagnostics(m, err)
 	}
 
 	criteriaSets := d.Get("criteria").([]interface{})
@@ -847,22 +863,22 @@ func resourceNsxtPolicyGroupCreate(d *schema.ResourceData, m interface{}) error
 
 	criteriaMeta, err := validateGroupCriteriaAndConjunctions(criteriaSets, conjunctions)
 	if err != nil {
-		return err
+		return getOperationDiagnostics(m, err)
 	}
 
 	expressionData, err := buildGroupExpressionData(criteriaMeta, conjunctions)
 	if err != nil {
-		return err
+		return getOperationDiagnostics(m, err)
 	}
 
 	extendedCriteriaSets := d.Get("extended_criteria").([]interface{})
 	err = validateExtendedCriteriaLocalManager(extendedCriteriaSets, m)
 	if err != nil {
-		return err
+		return getOperationDiagnostics(m, err)
 	}
 	extendedExpressionList, err := buildGroupExtendedExpressionListData(extendedCriteriaSets)
 	if err != nil {
-		return err
+		return getOperationDiagnostics(m, err)
 	}
 	displayName := d.Get("display_name").(string)
 	description := d.Get("description").(string)
@@ -886,10 +902,13 @@ func resourceNsxtPolicyGroupCreate(d *schema.ResourceData, m interface{}) error
 	if isPolicyGlobalManager(m) {
 		gmObj, err1 := convertModelBindingType(obj, model.GroupBindingType(), gm_model.GroupBindingType())
 		if err1 != nil {
-			return err1
+			return getErrorDiagnostics(err1)
 		}
 		client := gm_domains.NewGroupsClient(connector)
 		err = client.Patch(d.Get("domain").(string), id, gmObj.(gm_model.Group))
+	} else if projectID != "" {
+		client := project_domains.NewGroupsClient(connector)
+		err = client.Patch(defaultOrgID, projectID, d.Get("domain").(string), id, obj)
 	} else {
 		client := domains.NewGroupsClient(connector)
 		err = client.Patch(d.Get("domain").(string), id, obj)
@@ -897,40 +916,48 @@ func resourceNsxtPolicyGroupCreate(d *schema.ResourceData, m interface{}) error
 	// Create the resource using PATCH
 	log.Printf("[INFO] Creating Group with ID %s", id)
 	if err != nil {
-		return handleCreateError("Group", id, err)
+		return getOperationDiagnostics(m, handleCreateError("Group", id, err))
 	}
 
 	d.SetId(id)
 	d.Set("nsx_id", id)
 
-	return resourceNsxtPolicyGroupRead(d, m)
+	return resourceNsxtPolicyGroupRead(ctx, d, m)
 }
 
-func resourceNsxtPolicyGroupRead(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyGroupRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 	id := d.Id()
 	domainName := d.Get("domain").(string)
+	projectID := getPolicyProjectID(d)
 	if id == "" {
-		return fmt.Errorf("Error obtaining Group ID")
+		return diag.Errorf("Error obtaining Group ID")
 	}
 	var obj model.Group
 	if isPolicyGlobalManager(m) {
 		client := gm_domains.NewGroupsClient(connector)
 		gmObj, err := client.Get(domainName, id)
 		if err != nil {
-			return handleReadError(d, "Group", id, err)
+			return getOperationDiagnostics(m, handleReadError(d, "Group", id, err))
 		}
 		rawObj, err := convertModelBindingType(gmObj, gm_model.GroupBindingType(), model.GroupBindingType())
 		if err != nil {
-			return err
+			return getOperationDiagnostics(m, err)
 		}
 		obj = rawObj.(model.Group)
+	} else if projectID != "" {
+		var err error
+		client := project_domains.NewGroupsClient(connector)
+		obj, err = client.Get(defaultOrgID, projectID, domainName, id)
+		if err != nil {
+			return getOperationDiagnostics(m, handleReadError(d, "Group", id, err))
+		}
 	} else {
 		var err error
 		client := domains.NewGroupsClient(connector)
 		obj, err = client.Get(domainName, id)
 		if err != nil {
-			return handleReadError(d, "Group", id, err)
+			return getOperationDiagnostics(m, handleReadError(d, "Group", id, err))
 		}
 	}
 	d.Set("display_name", obj.DisplayName)
@@ -939,6 +966,7 @@ func resourceNsxtPolicyGroupRead(d *schema.ResourceData, m interface{}) error {
 	d.Set("nsx_id", id)
 	d.Set("path", obj.Path)
 	d.Set("domain", getDomainFromResourcePath(*obj.Path))
+	setPolicyProjectIDInSchema(d, getPolicyProjectIDFromPath(*obj.Path))
 	d.Set("revision", obj.Revision)
 	groupType := ""
 	if len(obj.GroupType) > 0 && nsxVersionHigherOrEqual("3.2.0") {
@@ -948,14 +976,14 @@ func resourceNsxtPolicyGroupRead(d *schema.ResourceData, m interface{}) error {
 	criteria, conditions, err := fromGroupExpressionData(obj.Expression)
 	log.Printf("[INFO] Found %d criteria, %d conjunctions for group %s", len(criteria), len(conditions), id)
 	if err != nil {
-		return err
+		return getOperationDiagnostics(m, err)
 	}
 	d.Set("criteria", criteria)
 	d.Set("conjunction", conditions)
 	identityGroups, err := getIdentityGroupsData(obj.ExtendedExpression)
 	log.Printf("[INFO] Found %d identity groups for group %s", len(identityGroups), id)
 	if err != nil {
-		return err
+		return getOperationDiagnostics(m, err)
 	}
 	var extendedCriteria []map[string]interface{}
 	if len(identityGroups) > 0 {
@@ -968,12 +996,12 @@ func resourceNsxtPolicyGroupRead(d *schema.ResourceData, m interface{}) error {
 	return nil
 }
 
-func resourceNsxtPolicyGroupUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyGroupUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining Group ID")
+		return diag.Errorf("Error obtaining Group ID")
 	}
 
 	criteriaSets := d.Get("criteria").([]interface{})
@@ -981,22 +1009,22 @@ func resourceNsxtPolicyGroupUpdate(d *schema.ResourceData, m interface{}) error
 
 	criteriaMeta, err := validateGroupCriteriaAndConjunctions(criteriaSets, conjunctions)
 	if err != nil {
-		return err
+		return getOperationDiagnostics(m, err)
 	}
 
 	expressionData, err := buildGroupExpressionData(criteriaMeta, conjunctions)
 	if err != nil {
-		return err
+		return getOperationDiagnostics(m, err)
 	}
 
 	extendedCriteriaSets := d.Get("extended_criteria").([]interface{})
 	err = validateExtendedCriteriaLocalManager(extendedCriteriaSets, m)
 	if err != nil {
-		return err
+		return getOperationDiagnostics(m, err)
 	}
 	extendedExpressionList, err := buildGroupExtendedExpressionListData(extendedCriteriaSets)
 	if err != nil {
-		return err
+		return getOperationDiagnostics(m, err)
 	}
 
 	// Read the rest of the configured parameters
@@ -1022,13 +1050,18 @@ func resourceNsxtPolicyGroupUpdate(d *schema.ResourceData, m interface{}) error
 	if isPolicyGlobalManager(m) {
 		gmObj, err1 := convertModelBindingType(obj, model.GroupBindingType(), gm_model.GroupBindingType())
 		if err1 != nil {
-			return err1
+			return getErrorDiagnostics(err1)
 		}
 		gmGroup := gmObj.(gm_model.Group)
 		client := gm_domains.NewGroupsClient(connector)
 
 		// Update the resource using PATCH
 		err = client.Patch(d.Get("domain").(string), id, gmGroup)
+	} else if projectID := getPolicyProjectID(d); projectID != "" {
+		client := project_domains.NewGroupsClient(connector)
+
+		// Update the resource using PATCH
+		err = client.Patch(defaultOrgID, projectID, d.Get("domain").(string), id, obj)
 	} else {
 		client := domains.NewGroupsClient(connector)
 
@@ -1036,16 +1069,16 @@ func resourceNsxtPolicyGroupUpdate(d *schema.ResourceData, m interface{}) error
 		err = client.Patch(d.Get("domain").(string), id, obj)
 	}
 	if err != nil {
-		return handleUpdateError("Group", id, err)
+		return getOperationDiagnostics(m, handleUpdateError("Group", id, err))
 	}
 
-	return resourceNsxtPolicyGroupRead(d, m)
+	return resourceNsxtPolicyGroupRead(ctx, d, m)
 }
 
-func resourceNsxtPolicyGroupDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyGroupDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining Group ID")
+		return diag.Errorf("Error obtaining Group ID")
 	}
 
 	connector := getPolicyConnector(m)
@@ -1057,13 +1090,17 @@ func resourceNsxtPolicyGroupDelete(d *schema.ResourceData, m interface{}) error
 			client := gm_domains.NewGroupsClient(connector)
 			return client.Delete(d.Get("domain").(string), id, &failIfSubtreeExists, &forceDelete)
 		}
+		if projectID := getPolicyProjectID(d); projectID != "" {
+			client := project_domains.NewGroupsClient(connector)
+			return client.Delete(defaultOrgID, projectID, d.Get("domain").(string), id, &failIfSubtreeExists, &forceDelete)
+		}
 		client := domains.NewGroupsClient(connector)
 		return client.Delete(d.Get("domain").(string), id, &failIfSubtreeExists, &forceDelete)
 	}
 
 	err := doDelete()
 	if err != nil {
-		return handleDeleteError("Group", id, err)
+		return getOperationDiagnostics(m, handleDeleteError("Group", id, err))
 	}
 
 	return nil
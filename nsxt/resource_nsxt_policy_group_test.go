@@ -8,6 +8,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	gm_domains "github.com/vmware/vsphere-automation-sdk-go/services/nsxt-gm/global_infra/domains"
 	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/domains"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
 )
 
 func TestAccResourceNsxtPolicyGroup_basicImport(t *testing.T) {
@@ -33,6 +34,39 @@ func TestAccResourceNsxtPolicyGroup_basicImport(t *testing.T) {
 	})
 }
 
+func TestAccResourceNsxtPolicyGroup_realizationWait(t *testing.T) {
+	name := getAccTestResourceName()
+	testResourceName := "nsxt_policy_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccOnlyLocalManager(t)
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyGroupCheckDestroy(state, name, defaultDomain)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyGroupRealizationWaitTemplate(name, "true"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyGroupExists(testResourceName, defaultDomain),
+					resource.TestCheckResourceAttr(testResourceName, "wait_for_realization", "true"),
+					resource.TestCheckResourceAttr("data.nsxt_policy_realization_info.test", "state", "REALIZED"),
+				),
+			},
+			{
+				Config: testAccNsxtPolicyGroupRealizationWaitTemplate(name, "false"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyGroupExists(testResourceName, defaultDomain),
+					resource.TestCheckResourceAttr(testResourceName, "wait_for_realization", "false"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccResourceNsxtPolicyGroup_addressCriteria(t *testing.T) {
 	name := getAccTestResourceName()
 	testResourceName := "nsxt_policy_group.test"
@@ -702,6 +736,28 @@ resource "nsxt_policy_group" "test" {
 `, name)
 }
 
+func testAccNsxtPolicyGroupRealizationWaitTemplate(name string, wait string) string {
+	return fmt.Sprintf(`
+resource "nsxt_policy_group" "test" {
+  display_name         = "%s"
+  description          = "Acceptance Test"
+  wait_for_realization = %s
+
+  criteria {
+    ipaddress_expression {
+      ip_addresses = ["111.1.1.1"]
+    }
+  }
+}
+
+data "nsxt_policy_realization_info" "test" {
+  path    = nsxt_policy_group.test.path
+  delay   = 0
+  timeout = 5
+}
+`, name, wait)
+}
+
 func testAccNsxtPolicyGroupAddressCreateTemplate(name string) string {
 	return fmt.Sprintf(`
 resource "nsxt_policy_group" "test" {
@@ -1471,3 +1527,78 @@ resource "nsxt_policy_group" "test" {
 }
 `, name)
 }
+
+func TestUnitResourceNsxtPolicyGroup_basic(t *testing.T) {
+	fake := newFakeNsxServer(t)
+	meta := testUnitConfigureProvider(t, fake)
+	resourceName := "nsxt_policy_group"
+
+	state := testUnitApplyResource(t, meta, resourceName, nil, map[string]interface{}{
+		"display_name": "group1",
+		"description":  "created",
+	})
+	testUnitCheckAttr(t, state, "display_name", "group1")
+	testUnitCheckAttr(t, state, "domain", "default")
+	testUnitCheckAttr(t, state, "revision", "0")
+	path := state.Attributes["path"]
+	if fake.getObject(path) == nil {
+		t.Fatalf("Group %s was not created on NSX", path)
+	}
+
+	state = testUnitApplyResource(t, meta, resourceName, state, map[string]interface{}{
+		"display_name": "group1",
+		"description":  "updated",
+	})
+	testUnitCheckAttr(t, state, "description", "updated")
+	testUnitCheckAttr(t, state, "revision", "1")
+
+	// Out of band change on NSX is detected on refresh
+	displayName := "group1"
+	description := "changed on NSX"
+	fake.putPolicyObject(path, model.Group{
+		DisplayName: &displayName,
+		Description: &description,
+	})
+	state = testUnitRefreshResource(t, meta, resourceName, state)
+	testUnitCheckAttr(t, state, "description", "changed on NSX")
+
+	testUnitDestroyResource(t, meta, resourceName, state)
+	if fake.getObject(path) != nil {
+		t.Fatalf("Group %s was not deleted on NSX", path)
+	}
+}
+
+func TestUnitResourceNsxtPolicyGroup_terraform(t *testing.T) {
+	fake := newFakeNsxServer(t)
+	testResourceName := "nsxt_policy_group.test"
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:  func() { testUnitPreCheckTerraform(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testUnitProviderConfig(fake) + testUnitNsxtPolicyGroupTemplate("created"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(testResourceName, "display_name", "group1"),
+					resource.TestCheckResourceAttr(testResourceName, "description", "created"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+				),
+			},
+			{
+				Config: testUnitProviderConfig(fake) + testUnitNsxtPolicyGroupTemplate("updated"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(testResourceName, "description", "updated"),
+					resource.TestCheckResourceAttr(testResourceName, "revision", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testUnitNsxtPolicyGroupTemplate(description string) string {
+	return fmt.Sprintf(`
+resource "nsxt_policy_group" "test" {
+  display_name = "group1"
+  description  = "%s"
+}`, description)
+}
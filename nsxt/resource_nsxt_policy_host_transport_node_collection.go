@@ -0,0 +1,480 @@
+/* Copyright © 2023 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/sites/enforcement_points"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/sites/enforcement_points/host_transport_nodes"
+	tnc "github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/sites/enforcement_points/transport_node_collections"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+var hostTransportNodeCollectionFailedStates = []string{
+	model.TransportNodeCollectionState_STATE_FAILED_TO_CREATE,
+	model.TransportNodeCollectionState_STATE_FAILED_TO_REALIZE,
+	model.TransportNodeCollectionState_STATE_PROFILE_MISMATCH,
+}
+
+func resourceNsxtPolicyHostTransportNodeCollection() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceNsxtPolicyHostTransportNodeCollectionCreate,
+		ReadContext:   resourceNsxtPolicyHostTransportNodeCollectionRead,
+		UpdateContext: resourceNsxtPolicyHostTransportNodeCollectionUpdate,
+		DeleteContext: resourceNsxtPolicyHostTransportNodeCollectionDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"nsx_id":       getNsxIDSchema(),
+			"path":         getPathSchema(),
+			"display_name": getDisplayNameSchema(),
+			"description":  getDescriptionSchema(),
+			"revision":     getRevisionSchema(),
+			"tag":          getTagsSchema(),
+			"compute_collection_id": {
+				Type:        schema.TypeString,
+				Description: "Compute collection id",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"transport_node_profile_path": getPolicyPathSchema(true, false, "Policy path of Transport Node Profile"),
+			"remove_nsx_on_destroy": {
+				Type:        schema.TypeBool,
+				Description: "Uninstall NSX from hosts in the collection when this resource is destroyed",
+				Optional:    true,
+				Default:     false,
+			},
+			"state": {
+				Type:        schema.TypeString,
+				Description: "Realization state of transport node collection",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func resourceNsxtPolicyHostTransportNodeCollectionExistsInEnforcementPoint(id string, epID string, connector client.Connector) (bool, error) {
+	client := enforcement_points.NewTransportNodeCollectionsClient(connector)
+	_, err := client.Get(defaultSite, epID, id)
+	if err == nil {
+		return true, nil
+	}
+
+	if isNotFoundError(err) {
+		return false, nil
+	}
+
+	return false, logAPIError("Error retrieving Host Transport Node Collection", err)
+}
+
+func resourceNsxtPolicyHostTransportNodeCollectionExistsPartial(epID string) func(id string, connector client.Connector, isGlobalManager bool) (bool, error) {
+	return func(id string, connector client.Connector, isGlobalManager bool) (bool, error) {
+		return resourceNsxtPolicyHostTransportNodeCollectionExistsInEnforcementPoint(id, epID, connector)
+	}
+}
+
+// Host states that are not final yet. Host is in sync state once desired configuration
+// is received, but is not yet in effect.
+var hostTransportNodePendingStates = []string{
+	model.TransportNodeState_STATE_PENDING,
+	model.TransportNodeState_STATE_IN_PROGRESS,
+	model.TransportNodeState_STATE_IN_SYNC,
+}
+
+// Number of host state checks after error resolver was invoked on failed host,
+// before the failure is considered final
+const policyHostTransportNodeResolutionMaxChecks = 12
+
+type policyHostTransportNodeState struct {
+	displayName string
+	state       model.TransportNodeState
+}
+
+// Preparation status of the collection along with hosts that failed preparation
+type policyHostTransportNodeCollectionStatus struct {
+	state       model.TransportNodeCollectionState
+	failedHosts []policyHostTransportNodeState
+}
+
+// Lists states of host transport nodes that belong to compute collection
+func listPolicyHostTransportNodeStates(connector client.Connector, epID string, computeCollectionID string) ([]policyHostTransportNodeState, error) {
+	client := enforcement_points.NewHostTransportNodesClient(connector)
+	stateClient := host_transport_nodes.NewStateClient(connector)
+
+	var results []policyHostTransportNodeState
+	var cursor *string
+	for {
+		hosts, err := client.List(defaultSite, epID, cursor, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+		if err != nil {
+			return nil, err
+		}
+		for _, host := range hosts.Results {
+			if host.Id == nil || host.NodeDeploymentInfo == nil || host.NodeDeploymentInfo.ComputeCollectionId == nil || *host.NodeDeploymentInfo.ComputeCollectionId != computeCollectionID {
+				continue
+			}
+
+			hostState := policyHostTransportNodeState{displayName: *host.Id}
+			if host.DisplayName != nil {
+				hostState.displayName = *host.DisplayName
+			}
+			hostState.state, err = stateClient.Get(defaultSite, epID, *host.Id)
+			if err != nil && !isNotFoundError(err) {
+				return nil, err
+			}
+			if hostState.state.TransportNodeId == nil {
+				// State is not yet available right after host transport node creation
+				hostState.state.TransportNodeId = host.Id
+			}
+			results = append(results, hostState)
+		}
+
+		cursor = hosts.Cursor
+		if cursor == nil || len(hosts.Results) == 0 {
+			break
+		}
+	}
+
+	return results, nil
+}
+
+// Invokes error resolver for host failures with auto resolvable error codes, once per
+// host and error code. Returns whether any resolution is in progress, in which case
+// host state is re-checked for a limited number of times before the failure is final.
+func resolvePolicyHostTransportNodeErrors(m interface{}, id string, failedHosts []policyHostTransportNodeState, resolutionChecks map[string]int) bool {
+	resolving := false
+	for _, host := range failedHosts {
+		if host.state.FailureCode == nil {
+			continue
+		}
+		key := fmt.Sprintf("%d:%s", *host.state.FailureCode, *host.state.TransportNodeId)
+		checks, attempted := resolutionChecks[key]
+		if !attempted {
+			if resolvePolicyError(m, *host.state.FailureCode, *host.state.TransportNodeId) {
+				log.Printf("[INFO] Invoked error resolver for host %s of Host Transport Node Collection %s", host.displayName, id)
+				resolutionChecks[key] = 0
+				resolving = true
+			} else {
+				resolutionChecks[key] = policyHostTransportNodeResolutionMaxChecks
+			}
+		} else if checks < policyHostTransportNodeResolutionMaxChecks {
+			resolutionChecks[key] = checks + 1
+			resolving = true
+		}
+	}
+
+	return resolving
+}
+
+// Preparation is in progress as long as the collection or any of its hosts are in
+// progress. Once done, failure of the collection or of any of its hosts fails
+// preparation.
+func getPolicyHostTransportNodeCollectionRefreshFunc(m interface{}, id string, computeCollectionID string) resource.StateRefreshFunc {
+	connector := getPolicyConnector(m)
+	client := tnc.NewStateClient(connector)
+	resolutionChecks := make(map[string]int)
+
+	return func() (interface{}, string, error) {
+		var status policyHostTransportNodeCollectionStatus
+		var err error
+		status.state, err = client.Get(defaultSite, getPolicyEnforcementPoint(m), id)
+		if err != nil {
+			if isNotFoundError(err) {
+				// State is not yet available right after creation
+				return status, model.TransportNodeCollectionState_STATE_IN_PROGRESS, nil
+			}
+			return status, "", logAPIError("Error while waiting for Host Transport Node Collection state", err)
+		}
+		if status.state.State == nil || *status.state.State == model.TransportNodeCollectionState_STATE_IN_PROGRESS {
+			if status.state.AggregateProgressPercentage != nil {
+				log.Printf("[DEBUG] Host Transport Node Collection %s is in progress, progress %d%%", id, *status.state.AggregateProgressPercentage)
+			}
+			return status, model.TransportNodeCollectionState_STATE_IN_PROGRESS, nil
+		}
+
+		hosts, err := listPolicyHostTransportNodeStates(connector, getPolicyEnforcementPoint(m), computeCollectionID)
+		if err != nil {
+			return status, "", logAPIError("Error while waiting for Host Transport Node Collection host state", err)
+		}
+		for _, host := range hosts {
+			if host.state.State == nil || stringInList(*host.state.State, hostTransportNodePendingStates) {
+				log.Printf("[DEBUG] Host Transport Node %s of collection %s is not prepared yet", host.displayName, id)
+				return status, model.TransportNodeCollectionState_STATE_IN_PROGRESS, nil
+			}
+			if *host.state.State != model.TransportNodeState_STATE_SUCCESS {
+				status.failedHosts = append(status.failedHosts, host)
+			}
+		}
+
+		if resolvePolicyHostTransportNodeErrors(m, id, status.failedHosts, resolutionChecks) {
+			return status, model.TransportNodeCollectionState_STATE_IN_PROGRESS, nil
+		}
+		if len(status.failedHosts) > 0 || stringInList(*status.state.State, hostTransportNodeCollectionFailedStates) {
+			return status, "FAILED", nil
+		}
+		return status, model.TransportNodeCollectionState_STATE_SUCCESS, nil
+	}
+}
+
+func policyHostTransportNodeCollectionWaitForState(ctx context.Context, d *schema.ResourceData, m interface{}, id string) error {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{model.TransportNodeCollectionState_STATE_IN_PROGRESS},
+		Target:     []string{model.TransportNodeCollectionState_STATE_SUCCESS, "FAILED"},
+		Refresh:    getPolicyHostTransportNodeCollectionRefreshFunc(m, id, d.Get("compute_collection_id").(string)),
+		Timeout:    d.Timeout(schema.TimeoutCreate),
+		MinTimeout: 5 * time.Second,
+		Delay:      5 * time.Second,
+	}
+	result, err := stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		return fmt.Errorf("Failed to wait for Host Transport Node Collection %s preparation: %v", id, err)
+	}
+
+	status := result.(policyHostTransportNodeCollectionStatus)
+	d.Set("state", status.state.State)
+	if len(status.failedHosts) == 0 && *status.state.State == model.TransportNodeCollectionState_STATE_SUCCESS {
+		return nil
+	}
+
+	return fmt.Errorf("Host Transport Node Collection %s preparation failed with state %s: %s", id, *status.state.State, getPolicyHostTransportNodeCollectionStatusErrors(m, status))
+}
+
+func getPolicyHostTransportNodeCollectionStatusErrors(m interface{}, status policyHostTransportNodeCollectionStatus) string {
+	var errorMessages []string
+	if stateErrors := getPolicyHostTransportNodeCollectionStateErrors(status.state); stateErrors != "" {
+		errorMessages = append(errorMessages, stateErrors)
+	}
+	for _, host := range status.failedHosts {
+		message := fmt.Sprintf("host %s (%s) is in state %s", host.displayName, *host.state.TransportNodeId, *host.state.State)
+		if host.state.FailureMessage != nil {
+			message += ": " + *host.state.FailureMessage
+		}
+		if host.state.FailureCode != nil {
+			message += fmt.Sprintf(" (error code %d)", *host.state.FailureCode)
+			if resolution := getPolicyErrorResolution(m, *host.state.FailureCode, *host.state.TransportNodeId); resolution != "" {
+				message += fmt.Sprintf(". Resolution: %s", resolution)
+			}
+		}
+		errorMessages = append(errorMessages, message)
+	}
+	return strings.Join(errorMessages, "; ")
+}
+
+func getPolicyHostTransportNodeCollectionStateErrors(state model.TransportNodeCollectionState) string {
+	var errorMessages []string
+	if state.ClusterLevelError != nil {
+		errorMessages = append(errorMessages, *state.ClusterLevelError)
+	}
+	if state.VlcmTransitionError != nil {
+		errorMessages = append(errorMessages, *state.VlcmTransitionError)
+	}
+	for _, validationError := range state.ValidationErrors {
+		if validationError.ErrorMessage != nil {
+			errorMessages = append(errorMessages, *validationError.ErrorMessage)
+		}
+	}
+	return strings.Join(errorMessages, "; ")
+}
+
+// Wait until NSX is removed from hosts in the collection. NSX deletes the collection
+// once removal completes on all its hosts, while collection state reflects progress
+// and failures of the removal. Per host state is not used, since host transport
+// nodes are deleted as NSX is removed from them.
+func policyHostTransportNodeCollectionWaitForRemoval(ctx context.Context, d *schema.ResourceData, m interface{}, id string) error {
+	connector := getPolicyConnector(m)
+	client := enforcement_points.NewTransportNodeCollectionsClient(connector)
+	stateClient := tnc.NewStateClient(connector)
+
+	// State of the collection prior to removal may still be reported as failed,
+	// hence failure is only considered after removal was seen in progress
+	removalStarted := false
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"removing"},
+		Target:  []string{"removed", "failed"},
+		Refresh: func() (interface{}, string, error) {
+			_, err := client.Get(defaultSite, getPolicyEnforcementPoint(m), id)
+			if err != nil {
+				if isNotFoundError(err) {
+					return model.TransportNodeCollectionState{}, "removed", nil
+				}
+				return nil, "", logAPIError("Error while waiting for NSX removal from Host Transport Node Collection", err)
+			}
+
+			state, err := stateClient.Get(defaultSite, getPolicyEnforcementPoint(m), id)
+			if err != nil {
+				if isNotFoundError(err) {
+					return state, "removing", nil
+				}
+				return nil, "", logAPIError("Error while waiting for NSX removal from Host Transport Node Collection", err)
+			}
+			if state.State == nil {
+				return state, "removing", nil
+			}
+			if *state.State == model.TransportNodeCollectionState_STATE_IN_PROGRESS {
+				removalStarted = true
+			} else if removalStarted && stringInList(*state.State, hostTransportNodeCollectionFailedStates) {
+				return state, "failed", nil
+			}
+			if state.AggregateProgressPercentage != nil {
+				log.Printf("[DEBUG] NSX removal from Host Transport Node Collection %s is in state %s, progress %d%%", id, *state.State, *state.AggregateProgressPercentage)
+			}
+
+			return state, "removing", nil
+		},
+		Timeout:    d.Timeout(schema.TimeoutDelete),
+		MinTimeout: 5 * time.Second,
+		Delay:      5 * time.Second,
+	}
+	result, err := stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		return fmt.Errorf("Failed to wait for NSX removal from Host Transport Node Collection %s: %v", id, err)
+	}
+
+	state := result.(model.TransportNodeCollectionState)
+	if state.State == nil {
+		return nil
+	}
+	return fmt.Errorf("NSX removal from Host Transport Node Collection %s failed with state %s: %s", id, *state.State, getPolicyHostTransportNodeCollectionStateErrors(state))
+}
+
+func policyHostTransportNodeCollectionPatch(d *schema.ResourceData, m interface{}, id string) error {
+	connector := getPolicyConnector(m)
+
+	displayName := d.Get("display_name").(string)
+	description := d.Get("description").(string)
+	tags := getPolicyTagsFromSchema(d)
+	computeCollectionID := d.Get("compute_collection_id").(string)
+	profilePath := d.Get("transport_node_profile_path").(string)
+
+	obj := model.HostTransportNodeCollection{
+		DisplayName:            &displayName,
+		Description:            &description,
+		Tags:                   tags,
+		ComputeCollectionId:    &computeCollectionID,
+		TransportNodeProfileId: &profilePath,
+	}
+
+	client := enforcement_points.NewTransportNodeCollectionsClient(connector)
+	return client.Patch(defaultSite, getPolicyEnforcementPoint(m), id, obj)
+}
+
+func resourceNsxtPolicyHostTransportNodeCollectionCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if isPolicyGlobalManager(m) {
+		return getErrorDiagnostics(localManagerOnlyError())
+	}
+
+	// Initialize resource Id and verify this ID is not yet used
+	id, err := getOrGenerateID(d, m, resourceNsxtPolicyHostTransportNodeCollectionExistsPartial(getPolicyEnforcementPoint(m)))
+	if err != nil {
+		return getOperationDiagnostics(m, err)
+	}
+
+	log.Printf("[INFO] Creating Host Transport Node Collection with ID %s", id)
+	err = policyHostTransportNodeCollectionPatch(d, m, id)
+	if err != nil {
+		return getOperationDiagnostics(m, handleCreateError("Host Transport Node Collection", id, err))
+	}
+
+	d.SetId(id)
+	d.Set("nsx_id", id)
+
+	err = policyHostTransportNodeCollectionWaitForState(ctx, d, m, id)
+	if err != nil {
+		return getOperationDiagnostics(m, err)
+	}
+
+	return resourceNsxtPolicyHostTransportNodeCollectionRead(ctx, d, m)
+}
+
+func resourceNsxtPolicyHostTransportNodeCollectionRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	connector := getPolicyConnector(m)
+	id := d.Id()
+	if id == "" {
+		return diag.Errorf("Error obtaining Host Transport Node Collection ID")
+	}
+
+	client := enforcement_points.NewTransportNodeCollectionsClient(connector)
+	obj, err := client.Get(defaultSite, getPolicyEnforcementPoint(m), id)
+	if err != nil {
+		return getOperationDiagnostics(m, handleReadError(d, "Host Transport Node Collection", id, err))
+	}
+
+	d.Set("display_name", obj.DisplayName)
+	d.Set("description", obj.Description)
+	setPolicyTagsInSchema(d, obj.Tags)
+	d.Set("nsx_id", id)
+	d.Set("path", obj.Path)
+	d.Set("revision", obj.Revision)
+	d.Set("compute_collection_id", obj.ComputeCollectionId)
+	d.Set("transport_node_profile_path", obj.TransportNodeProfileId)
+
+	stateClient := tnc.NewStateClient(connector)
+	state, err := stateClient.Get(defaultSite, getPolicyEnforcementPoint(m), id)
+	if err != nil {
+		log.Printf("[WARNING] Failed to retrieve state for Host Transport Node Collection %s: %v", id, err)
+	} else {
+		d.Set("state", state.State)
+	}
+
+	return nil
+}
+
+func resourceNsxtPolicyHostTransportNodeCollectionUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	id := d.Id()
+	if id == "" {
+		return diag.Errorf("Error obtaining Host Transport Node Collection ID")
+	}
+
+	log.Printf("[INFO] Updating Host Transport Node Collection with ID %s", id)
+	err := policyHostTransportNodeCollectionPatch(d, m, id)
+	if err != nil {
+		return getOperationDiagnostics(m, handleUpdateError("Host Transport Node Collection", id, err))
+	}
+
+	if d.HasChange("transport_node_profile_path") {
+		err = policyHostTransportNodeCollectionWaitForState(ctx, d, m, id)
+		if err != nil {
+			return getOperationDiagnostics(m, err)
+		}
+	}
+
+	return resourceNsxtPolicyHostTransportNodeCollectionRead(ctx, d, m)
+}
+
+func resourceNsxtPolicyHostTransportNodeCollectionDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	id := d.Id()
+	if id == "" {
+		return diag.Errorf("Error obtaining Host Transport Node Collection ID")
+	}
+
+	connector := getPolicyConnector(m)
+	client := enforcement_points.NewTransportNodeCollectionsClient(connector)
+	var err error
+	if d.Get("remove_nsx_on_destroy").(bool) {
+		log.Printf("[INFO] Removing NSX from hosts in Host Transport Node Collection %s", id)
+		err = client.Removensx(defaultSite, getPolicyEnforcementPoint(m), id)
+		if err != nil {
+			return getOperationDiagnostics(m, handleDeleteError("Host Transport Node Collection", id, err))
+		}
+		return getOperationDiagnostics(m, policyHostTransportNodeCollectionWaitForRemoval(ctx, d, m, id))
+	}
+
+	// Detaches the profile only, existing transport nodes remain intact
+	err = client.Delete(defaultSite, getPolicyEnforcementPoint(m), id)
+	if err != nil {
+		return getOperationDiagnostics(m, handleDeleteError("Host Transport Node Collection", id, err))
+	}
+
+	return nil
+}
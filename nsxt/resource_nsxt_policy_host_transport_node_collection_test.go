@@ -0,0 +1,191 @@
+/* Copyright © 2023 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+func getTestComputeCollectionID() string {
+	return os.Getenv("NSXT_TEST_COMPUTE_COLLECTION_ID")
+}
+
+func TestAccResourceNsxtPolicyHostTransportNodeCollection_basic(t *testing.T) {
+	testResourceName := "nsxt_policy_host_transport_node_collection.test"
+	name := getAccTestResourceName()
+	updatedName := getAccTestResourceName()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccOnlyLocalManager(t)
+			testAccNSXVersion(t, "4.0.0")
+			testAccEnvDefined(t, "NSXT_TEST_HOST_SWITCH_ID")
+			testAccEnvDefined(t, "NSXT_TEST_IP_POOL")
+			testAccEnvDefined(t, "NSXT_TEST_COMPUTE_COLLECTION_ID")
+		},
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyResourceCheckDestroy(state, updatedName, "nsxt_policy_host_transport_node_collection", resourceNsxtPolicyHostTransportNodeCollectionExistsPartial(defaultEnforcementPoint))
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyHostTransportNodeCollectionTemplate(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyResourceExists(testResourceName, resourceNsxtPolicyHostTransportNodeCollectionExistsPartial(defaultEnforcementPoint)),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", name),
+					resource.TestCheckResourceAttr(testResourceName, "description", "terraform created"),
+					resource.TestCheckResourceAttr(testResourceName, "compute_collection_id", getTestComputeCollectionID()),
+					resource.TestCheckResourceAttr(testResourceName, "remove_nsx_on_destroy", "false"),
+					resource.TestCheckResourceAttr(testResourceName, "state", model.TransportNodeCollectionState_STATE_SUCCESS),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "1"),
+					resource.TestCheckResourceAttrPair(testResourceName, "transport_node_profile_path", "nsxt_policy_host_transport_node_profile.test", "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+				),
+			},
+			{
+				Config: testAccNsxtPolicyHostTransportNodeCollectionTemplate(updatedName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyResourceExists(testResourceName, resourceNsxtPolicyHostTransportNodeCollectionExistsPartial(defaultEnforcementPoint)),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", updatedName),
+					resource.TestCheckResourceAttr(testResourceName, "state", model.TransportNodeCollectionState_STATE_SUCCESS),
+					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicyHostTransportNodeCollectionTemplate(name string) string {
+	return testAccNsxtPolicyHostTransportNodeProfileTemplate("tnc-test-profile") + fmt.Sprintf(`
+resource "nsxt_policy_host_transport_node_collection" "test" {
+  display_name                = "%s"
+  description                 = "terraform created"
+  compute_collection_id       = "%s"
+  transport_node_profile_path = nsxt_policy_host_transport_node_profile.test.path
+
+  tag {
+    scope = "scope1"
+    tag   = "tag1"
+  }
+}`, name, getTestComputeCollectionID())
+}
+
+func testUnitPutHostTransportNode(fake *fakeNsxServer, id string, computeCollectionID string, state string, failureCode int64, failureMessage string) {
+	path := "/infra/sites/default/enforcement-points/default/host-transport-nodes/" + id
+	displayName := "esx-" + id
+	fake.putPolicyObject(path, model.HostTransportNode{
+		DisplayName: &displayName,
+		NodeDeploymentInfo: &model.FabricHostNode{
+			ComputeCollectionId: &computeCollectionID,
+		},
+	})
+
+	nodeState := model.TransportNodeState{
+		TransportNodeId: &id,
+		State:           &state,
+	}
+	if failureCode != 0 {
+		nodeState.FailureCode = &failureCode
+		nodeState.FailureMessage = &failureMessage
+	}
+	fake.putState(path, nodeState, model.TransportNodeStateBindingType())
+}
+
+func TestUnitPolicyHostTransportNodeCollectionWaitForState(t *testing.T) {
+	fake := newFakeNsxServer(t)
+	meta := testUnitConfigureProvider(t, fake)
+	refresh := getPolicyHostTransportNodeCollectionRefreshFunc(meta, "tnc1", "cc1")
+	collectionPath := "/infra/sites/default/enforcement-points/default/transport-node-collections/tnc1"
+
+	testUnitCheckRefresh := func(expectedState string) policyHostTransportNodeCollectionStatus {
+		result, state, err := refresh()
+		if err != nil {
+			t.Fatalf("Failed to refresh state: %v", err)
+		}
+		if state != expectedState {
+			t.Errorf("Expected state %s, got %s", expectedState, state)
+		}
+		return result.(policyHostTransportNodeCollectionStatus)
+	}
+
+	// State is not yet available
+	testUnitCheckRefresh(model.TransportNodeCollectionState_STATE_IN_PROGRESS)
+
+	// Collection is done, while its host is still in progress. Host of another
+	// compute collection is ignored.
+	collectionState := model.TransportNodeCollectionState_STATE_SUCCESS
+	fake.putState(collectionPath, model.TransportNodeCollectionState{State: &collectionState}, model.TransportNodeCollectionStateBindingType())
+	testUnitPutHostTransportNode(fake, "host1", "cc1", model.TransportNodeState_STATE_IN_PROGRESS, 0, "")
+	testUnitPutHostTransportNode(fake, "host2", "cc2", model.TransportNodeState_STATE_FAILED, 26080, "Other host failed")
+	testUnitCheckRefresh(model.TransportNodeCollectionState_STATE_IN_PROGRESS)
+
+	testUnitPutHostTransportNode(fake, "host1", "cc1", model.TransportNodeState_STATE_SUCCESS, 0, "")
+	status := testUnitCheckRefresh(model.TransportNodeCollectionState_STATE_SUCCESS)
+	if len(status.failedHosts) != 0 {
+		t.Errorf("Expected no failed hosts, got %v", status.failedHosts)
+	}
+
+	// Failed hosts are reported along with their errors
+	testUnitPutHostTransportNode(fake, "host3", "cc1", model.TransportNodeState_STATE_FAILED, 26080, "Host preparation failed")
+	status = testUnitCheckRefresh("FAILED")
+	errorMessage := getPolicyHostTransportNodeCollectionStatusErrors(meta, status)
+	if !strings.Contains(errorMessage, "host esx-host3 (host3) is in state failed: Host preparation failed (error code 26080)") || strings.Contains(errorMessage, "host2") {
+		t.Errorf("Expected failure of host3 only, got %s", errorMessage)
+	}
+}
+
+func TestUnitPolicyHostTransportNodeCollectionAutoResolve(t *testing.T) {
+	fake := newFakeNsxServer(t)
+	testUnitPutErrorResolvers(fake)
+	meta := testUnitConfigureProviderWithOptions(t, fake, map[string]interface{}{
+		"error_resolver_hints":              true,
+		"error_resolver_auto_resolve_codes": []interface{}{testErrorResolverCode},
+	})
+	refresh := getPolicyHostTransportNodeCollectionRefreshFunc(meta, "tnc1", "cc1")
+
+	collectionState := model.TransportNodeCollectionState_STATE_SUCCESS
+	fake.putState("/infra/sites/default/enforcement-points/default/transport-node-collections/tnc1", model.TransportNodeCollectionState{State: &collectionState}, model.TransportNodeCollectionStateBindingType())
+	testUnitPutHostTransportNode(fake, "host1", "cc1", model.TransportNodeState_STATE_FAILED, testErrorResolverCode, "Host preparation failed")
+	testUnitPutHostTransportNode(fake, "host2", "cc1", model.TransportNodeState_STATE_FAILED, testErrorResolverInputCode, "Host credentials expired")
+
+	// Resolver is invoked once for host1, and state is polled again
+	_, state, err := refresh()
+	if err != nil || state != model.TransportNodeCollectionState_STATE_IN_PROGRESS {
+		t.Fatalf("Expected preparation to be in progress after resolver was invoked, got %s: %v", state, err)
+	}
+	if len(fake.resolvedErrors) != 1 || fake.resolvedErrors[0] != "8800:host1" {
+		t.Errorf("Expected resolver to be invoked for host1 only, got %v", fake.resolvedErrors)
+	}
+
+	testUnitPutHostTransportNode(fake, "host1", "cc1", model.TransportNodeState_STATE_IN_PROGRESS, 0, "")
+	if _, state, _ = refresh(); state != model.TransportNodeCollectionState_STATE_IN_PROGRESS {
+		t.Errorf("Expected preparation to be in progress, got %s", state)
+	}
+
+	// Failure that is not auto resolvable remains, and is reported with resolution hint
+	testUnitPutHostTransportNode(fake, "host1", "cc1", model.TransportNodeState_STATE_SUCCESS, 0, "")
+	result, state, _ := refresh()
+	if state != "FAILED" {
+		t.Fatalf("Expected preparation to fail, got %s", state)
+	}
+	errorMessage := getPolicyHostTransportNodeCollectionStatusErrors(meta, result.(policyHostTransportNodeCollectionStatus))
+	if strings.Contains(errorMessage, "host1") || !strings.Contains(errorMessage, `Resolution: invoke NSX error resolver with POST /policy/api/v1/error-resolver?action=resolve_error and body {"errors":[{"entity_id":"host2","error_id":8801`) {
+		t.Errorf("Expected failure of host2 with resolution, got %s", errorMessage)
+	}
+	if len(fake.resolvedErrors) != 1 {
+		t.Errorf("Expected resolver to be invoked once, got %v", fake.resolvedErrors)
+	}
+}
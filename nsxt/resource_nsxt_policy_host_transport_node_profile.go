@@ -0,0 +1,562 @@
+/* Copyright © 2023 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/bindings"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/data"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+var hostSwitchModeValues = []string{
+	model.StandardHostSwitch_HOST_SWITCH_MODE_STANDARD,
+	model.StandardHostSwitch_HOST_SWITCH_MODE_ENS,
+	model.StandardHostSwitch_HOST_SWITCH_MODE_ENS_INTERRUPT,
+	model.StandardHostSwitch_HOST_SWITCH_MODE_LEGACY,
+}
+
+var hostSwitchTypeValues = []string{
+	model.StandardHostSwitch_HOST_SWITCH_TYPE_NVDS,
+	model.StandardHostSwitch_HOST_SWITCH_TYPE_VDS,
+}
+
+var hostSwitchProfileKeyMap = map[string]string{
+	model.PolicyBaseHostSwitchProfile_RESOURCE_TYPE_POLICYUPLINKHOSTSWITCHPROFILE:      model.HostSwitchProfileTypeIdEntry_KEY_UPLINKHOSTSWITCHPROFILE,
+	model.PolicyBaseHostSwitchProfile_RESOURCE_TYPE_POLICYLLDPHOSTSWITCHPROFILE:        model.HostSwitchProfileTypeIdEntry_KEY_LLDPHOSTSWITCHPROFILE,
+	model.PolicyBaseHostSwitchProfile_RESOURCE_TYPE_POLICYNIOCPROFILE:                  model.HostSwitchProfileTypeIdEntry_KEY_NIOCPROFILE,
+	model.PolicyBaseHostSwitchProfile_RESOURCE_TYPE_POLICYEXTRACONFIGHOSTSWITCHPROFILE: model.HostSwitchProfileTypeIdEntry_KEY_EXTRACONFIGHOSTSWITCHPROFILE,
+	model.PolicyBaseHostSwitchProfile_RESOURCE_TYPE_POLICYVTEPHAHOSTSWITCHPROFILE:      model.HostSwitchProfileTypeIdEntry_KEY_VTEPHAHOSTSWITCHPROFILE,
+}
+
+func resourceNsxtPolicyHostTransportNodeProfile() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceNsxtPolicyHostTransportNodeProfileCreate,
+		ReadContext:   resourceNsxtPolicyHostTransportNodeProfileRead,
+		UpdateContext: resourceNsxtPolicyHostTransportNodeProfileUpdate,
+		DeleteContext: resourceNsxtPolicyHostTransportNodeProfileDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"nsx_id":       getNsxIDSchema(),
+			"path":         getPathSchema(),
+			"display_name": getDisplayNameSchema(),
+			"description":  getDescriptionSchema(),
+			"revision":     getRevisionSchema(),
+			"tag":          getTagsSchema(),
+			"ignore_overridden_hosts": {
+				Type:        schema.TypeBool,
+				Description: "Whether to skip hosts with overridden configuration when applying this profile",
+				Optional:    true,
+				Default:     false,
+			},
+			"standard_host_switch": getPolicyStandardHostSwitchSchema(),
+		},
+	}
+}
+
+func getPolicyStandardHostSwitchSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Description: "Standard host switch specification",
+		Required:    true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"host_switch_id": {
+					Type:        schema.TypeString,
+					Description: "The host switch id. This ID will be used to reference a VDS from vCenter",
+					Optional:    true,
+					Computed:    true,
+				},
+				"host_switch_mode": {
+					Type:         schema.TypeString,
+					Description:  "Operational mode of host switch",
+					Optional:     true,
+					Default:      model.StandardHostSwitch_HOST_SWITCH_MODE_STANDARD,
+					ValidateFunc: validation.StringInSlice(hostSwitchModeValues, false),
+				},
+				"host_switch_type": {
+					Type:         schema.TypeString,
+					Description:  "Type of host switch",
+					Optional:     true,
+					Default:      model.StandardHostSwitch_HOST_SWITCH_TYPE_VDS,
+					ValidateFunc: validation.StringInSlice(hostSwitchTypeValues, false),
+				},
+				"host_switch_profile": {
+					Type:        schema.TypeList,
+					Description: "Policy paths of host switch profiles to be associated with this host switch",
+					Optional:    true,
+					Elem:        getElemPolicyPathSchema(),
+				},
+				"ip_assignment": getPolicyIPAssignmentSchema(),
+				"is_migrate_pnics": {
+					Type:        schema.TypeBool,
+					Description: "Migrate any pnics which are in use",
+					Optional:    true,
+					Default:     false,
+				},
+				"pnic": {
+					Type:        schema.TypeList,
+					Description: "Physical NICs connected to the host switch",
+					Optional:    true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"device_name": {
+								Type:        schema.TypeString,
+								Description: "Device name or key",
+								Required:    true,
+							},
+							"uplink_name": {
+								Type:        schema.TypeString,
+								Description: "Uplink name for this Pnic",
+								Required:    true,
+							},
+						},
+					},
+				},
+				"transport_zone_endpoint": {
+					Type:        schema.TypeList,
+					Description: "Transport zone endpoints",
+					Optional:    true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"transport_zone": getPolicyPathSchema(true, false, "Policy path of Transport Zone"),
+						},
+					},
+				},
+				"uplink": {
+					Type:        schema.TypeList,
+					Description: "Uplink/LAG of VMware vSphere Distributed Switch connected to the host switch",
+					Optional:    true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"uplink_name": {
+								Type:        schema.TypeString,
+								Description: "Uplink name from UplinkHostSwitch profile",
+								Required:    true,
+							},
+							"vds_lag_name": {
+								Type:        schema.TypeString,
+								Description: "Link Aggregation Group (LAG) name of Virtual Distributed Switch",
+								Optional:    true,
+							},
+							"vds_uplink_name": {
+								Type:        schema.TypeString,
+								Description: "Uplink name of VMware vSphere Distributed Switch (VDS)",
+								Optional:    true,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func getPolicyIPAssignmentSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Description: "Specification for IPs to be used with host switch virtual tunnel endpoints",
+		Optional:    true,
+		MaxItems:    1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"assigned_by_dhcp": {
+					Type:        schema.TypeBool,
+					Description: "Enables DHCP assignment",
+					Optional:    true,
+				},
+				"static_ip_pool": getPolicyPathSchema(false, false, "Policy path of IP Pool for static IP assignment"),
+			},
+		},
+	}
+}
+
+func resourceNsxtPolicyHostTransportNodeProfileExists(id string, connector client.Connector, isGlobalManager bool) (bool, error) {
+	client := infra.NewHostTransportNodeProfilesClient(connector)
+	_, err := client.Get(id)
+	if err == nil {
+		return true, nil
+	}
+
+	if isNotFoundError(err) {
+		return false, nil
+	}
+
+	return false, logAPIError("Error retrieving Host Transport Node Profile", err)
+}
+
+func getPolicyHostSwitchProfileIDsFromSchema(connector client.Connector, profilePaths []interface{}) ([]model.HostSwitchProfileTypeIdEntry, error) {
+	client := infra.NewHostSwitchProfilesClient(connector)
+	converter := bindings.NewTypeConverter()
+
+	var entries []model.HostSwitchProfileTypeIdEntry
+	for _, p := range profilePaths {
+		profilePath := p.(string)
+		// Profile type is not encoded in the path, hence needs to be retrieved
+		obj, err := client.Get(getPolicyIDFromPath(profilePath))
+		if err != nil {
+			return nil, logAPIError(fmt.Sprintf("Error retrieving Host Switch Profile %s", profilePath), err)
+		}
+		baseObj, errs := converter.ConvertToGolang(obj, model.PolicyBaseHostSwitchProfileBindingType())
+		if len(errs) > 0 {
+			return nil, errs[0]
+		}
+		resourceType := baseObj.(model.PolicyBaseHostSwitchProfile).ResourceType
+		key, ok := hostSwitchProfileKeyMap[resourceType]
+		if !ok {
+			return nil, fmt.Errorf("Unsupported Host Switch Profile type %s for %s", resourceType, profilePath)
+		}
+		entries = append(entries, model.HostSwitchProfileTypeIdEntry{
+			Key:   &key,
+			Value: &profilePath,
+		})
+	}
+
+	return entries, nil
+}
+
+func getPolicyIPAssignmentFromSchema(ipAssignment []interface{}) (*data.StructValue, error) {
+	if len(ipAssignment) == 0 || ipAssignment[0] == nil {
+		return nil, nil
+	}
+
+	converter := bindings.NewTypeConverter()
+	assignmentData := ipAssignment[0].(map[string]interface{})
+	ipPoolPath := assignmentData["static_ip_pool"].(string)
+	if ipPoolPath != "" {
+		spec := model.StaticIpPoolSpec{
+			IpPoolId:     &ipPoolPath,
+			ResourceType: model.IpAssignmentSpec_RESOURCE_TYPE_STATICIPPOOLSPEC,
+		}
+		dataValue, errs := converter.ConvertToVapi(spec, model.StaticIpPoolSpecBindingType())
+		if errs != nil {
+			return nil, errs[0]
+		}
+		return dataValue.(*data.StructValue), nil
+	}
+
+	if assignmentData["assigned_by_dhcp"].(bool) {
+		spec := model.AssignedByDhcp{
+			ResourceType: model.IpAssignmentSpec_RESOURCE_TYPE_ASSIGNEDBYDHCP,
+		}
+		dataValue, errs := converter.ConvertToVapi(spec, model.AssignedByDhcpBindingType())
+		if errs != nil {
+			return nil, errs[0]
+		}
+		return dataValue.(*data.StructValue), nil
+	}
+
+	return nil, fmt.Errorf("Either assigned_by_dhcp or static_ip_pool should be specified for ip_assignment")
+}
+
+func setPolicyIPAssignmentInMap(elem map[string]interface{}, ipAssignment *data.StructValue) error {
+	if ipAssignment == nil {
+		return nil
+	}
+
+	converter := bindings.NewTypeConverter()
+	baseObj, errs := converter.ConvertToGolang(ipAssignment, model.IpAssignmentSpecBindingType())
+	if len(errs) > 0 {
+		return errs[0]
+	}
+
+	assignment := make(map[string]interface{})
+	switch baseObj.(model.IpAssignmentSpec).ResourceType {
+	case model.IpAssignmentSpec_RESOURCE_TYPE_STATICIPPOOLSPEC:
+		obj, errs := converter.ConvertToGolang(ipAssignment, model.StaticIpPoolSpecBindingType())
+		if len(errs) > 0 {
+			return errs[0]
+		}
+		assignment["static_ip_pool"] = obj.(model.StaticIpPoolSpec).IpPoolId
+		assignment["assigned_by_dhcp"] = false
+	case model.IpAssignmentSpec_RESOURCE_TYPE_ASSIGNEDBYDHCP:
+		assignment["assigned_by_dhcp"] = true
+	default:
+		log.Printf("[WARNING] Unsupported IP assignment type %s", baseObj.(model.IpAssignmentSpec).ResourceType)
+		return nil
+	}
+
+	elem["ip_assignment"] = []interface{}{assignment}
+	return nil
+}
+
+func getPolicyHostSwitchSpecFromSchema(d *schema.ResourceData, m interface{}) (*data.StructValue, error) {
+	connector := getPolicyConnector(m)
+	converter := bindings.NewTypeConverter()
+
+	var hostSwitches []model.StandardHostSwitch
+	for _, hs := range d.Get("standard_host_switch").([]interface{}) {
+		hsData := hs.(map[string]interface{})
+		hostSwitchMode := hsData["host_switch_mode"].(string)
+		hostSwitchType := hsData["host_switch_type"].(string)
+		isMigratePnics := hsData["is_migrate_pnics"].(bool)
+
+		profileIDs, err := getPolicyHostSwitchProfileIDsFromSchema(connector, hsData["host_switch_profile"].([]interface{}))
+		if err != nil {
+			return nil, err
+		}
+
+		ipAssignment, err := getPolicyIPAssignmentFromSchema(hsData["ip_assignment"].([]interface{}))
+		if err != nil {
+			return nil, err
+		}
+
+		var pnics []model.Pnic
+		for _, p := range hsData["pnic"].([]interface{}) {
+			pnicData := p.(map[string]interface{})
+			deviceName := pnicData["device_name"].(string)
+			uplinkName := pnicData["uplink_name"].(string)
+			pnics = append(pnics, model.Pnic{
+				DeviceName: &deviceName,
+				UplinkName: &uplinkName,
+			})
+		}
+
+		var tzEndpoints []model.TransportZoneEndPoint
+		for _, tz := range hsData["transport_zone_endpoint"].([]interface{}) {
+			tzData := tz.(map[string]interface{})
+			tzPath := tzData["transport_zone"].(string)
+			tzEndpoints = append(tzEndpoints, model.TransportZoneEndPoint{
+				TransportZoneId: &tzPath,
+			})
+		}
+
+		var uplinks []model.VdsUplink
+		for _, u := range hsData["uplink"].([]interface{}) {
+			uplinkData := u.(map[string]interface{})
+			uplinkName := uplinkData["uplink_name"].(string)
+			uplink := model.VdsUplink{
+				UplinkName: &uplinkName,
+			}
+			vdsLagName := uplinkData["vds_lag_name"].(string)
+			if vdsLagName != "" {
+				uplink.VdsLagName = &vdsLagName
+			}
+			vdsUplinkName := uplinkData["vds_uplink_name"].(string)
+			if vdsUplinkName != "" {
+				uplink.VdsUplinkName = &vdsUplinkName
+			}
+			uplinks = append(uplinks, uplink)
+		}
+
+		hostSwitch := model.StandardHostSwitch{
+			HostSwitchMode:         &hostSwitchMode,
+			HostSwitchType:         &hostSwitchType,
+			HostSwitchProfileIds:   profileIDs,
+			IpAssignmentSpec:       ipAssignment,
+			IsMigratePnics:         &isMigratePnics,
+			Pnics:                  pnics,
+			TransportZoneEndpoints: tzEndpoints,
+			Uplinks:                uplinks,
+		}
+		hostSwitchID := hsData["host_switch_id"].(string)
+		if hostSwitchID != "" {
+			hostSwitch.HostSwitchId = &hostSwitchID
+		}
+
+		hostSwitches = append(hostSwitches, hostSwitch)
+	}
+
+	spec := model.StandardHostSwitchSpec{
+		HostSwitches: hostSwitches,
+		ResourceType: model.HostSwitchSpec_RESOURCE_TYPE_STANDARDHOSTSWITCHSPEC,
+	}
+	dataValue, errs := converter.ConvertToVapi(spec, model.StandardHostSwitchSpecBindingType())
+	if errs != nil {
+		return nil, errs[0]
+	}
+
+	return dataValue.(*data.StructValue), nil
+}
+
+func setPolicyHostSwitchSpecInSchema(d *schema.ResourceData, hostSwitchSpec *data.StructValue) error {
+	if hostSwitchSpec == nil {
+		return d.Set("standard_host_switch", nil)
+	}
+
+	converter := bindings.NewTypeConverter()
+	baseObj, errs := converter.ConvertToGolang(hostSwitchSpec, model.HostSwitchSpecBindingType())
+	if len(errs) > 0 {
+		return errs[0]
+	}
+	resourceType := baseObj.(model.HostSwitchSpec).ResourceType
+	if resourceType != model.HostSwitchSpec_RESOURCE_TYPE_STANDARDHOSTSWITCHSPEC {
+		return fmt.Errorf("Unsupported Host Switch Spec type %s", resourceType)
+	}
+
+	obj, errs := converter.ConvertToGolang(hostSwitchSpec, model.StandardHostSwitchSpecBindingType())
+	if len(errs) > 0 {
+		return errs[0]
+	}
+
+	var hostSwitchList []map[string]interface{}
+	for _, hostSwitch := range obj.(model.StandardHostSwitchSpec).HostSwitches {
+		elem := make(map[string]interface{})
+		elem["host_switch_id"] = hostSwitch.HostSwitchId
+		elem["host_switch_mode"] = hostSwitch.HostSwitchMode
+		elem["host_switch_type"] = hostSwitch.HostSwitchType
+		elem["is_migrate_pnics"] = hostSwitch.IsMigratePnics
+
+		var profiles []string
+		for _, entry := range hostSwitch.HostSwitchProfileIds {
+			profiles = append(profiles, *entry.Value)
+		}
+		elem["host_switch_profile"] = profiles
+
+		if err := setPolicyIPAssignmentInMap(elem, hostSwitch.IpAssignmentSpec); err != nil {
+			return err
+		}
+
+		var pnics []map[string]interface{}
+		for _, pnic := range hostSwitch.Pnics {
+			pnicElem := make(map[string]interface{})
+			pnicElem["device_name"] = pnic.DeviceName
+			pnicElem["uplink_name"] = pnic.UplinkName
+			pnics = append(pnics, pnicElem)
+		}
+		elem["pnic"] = pnics
+
+		var tzEndpoints []map[string]interface{}
+		for _, tzEndpoint := range hostSwitch.TransportZoneEndpoints {
+			tzElem := make(map[string]interface{})
+			tzElem["transport_zone"] = tzEndpoint.TransportZoneId
+			tzEndpoints = append(tzEndpoints, tzElem)
+		}
+		elem["transport_zone_endpoint"] = tzEndpoints
+
+		var uplinks []map[string]interface{}
+		for _, uplink := range hostSwitch.Uplinks {
+			uplinkElem := make(map[string]interface{})
+			uplinkElem["uplink_name"] = uplink.UplinkName
+			uplinkElem["vds_lag_name"] = uplink.VdsLagName
+			uplinkElem["vds_uplink_name"] = uplink.VdsUplinkName
+			uplinks = append(uplinks, uplinkElem)
+		}
+		elem["uplink"] = uplinks
+
+		hostSwitchList = append(hostSwitchList, elem)
+	}
+
+	return d.Set("standard_host_switch", hostSwitchList)
+}
+
+func policyHostTransportNodeProfileUpdate(d *schema.ResourceData, m interface{}, id string, isCreate bool) error {
+	connector := getPolicyConnector(m)
+
+	displayName := d.Get("display_name").(string)
+	description := d.Get("description").(string)
+	tags := getPolicyTagsFromSchema(d)
+	ignoreOverriddenHosts := d.Get("ignore_overridden_hosts").(bool)
+
+	hostSwitchSpec, err := getPolicyHostSwitchSpecFromSchema(d, m)
+	if err != nil {
+		return err
+	}
+
+	obj := model.PolicyHostTransportNodeProfile{
+		DisplayName:           &displayName,
+		Description:           &description,
+		Tags:                  tags,
+		IgnoreOverriddenHosts: &ignoreOverriddenHosts,
+		HostSwitchSpec:        hostSwitchSpec,
+	}
+	if !isCreate {
+		revision := int64(d.Get("revision").(int))
+		obj.Revision = &revision
+	}
+
+	// This API does not support PATCH
+	client := infra.NewHostTransportNodeProfilesClient(connector)
+	_, err = client.Update(id, obj, nil)
+	return err
+}
+
+func resourceNsxtPolicyHostTransportNodeProfileCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if isPolicyGlobalManager(m) {
+		return getErrorDiagnostics(localManagerOnlyError())
+	}
+
+	// Initialize resource Id and verify this ID is not yet used
+	id, err := getOrGenerateID(d, m, resourceNsxtPolicyHostTransportNodeProfileExists)
+	if err != nil {
+		return getOperationDiagnostics(m, err)
+	}
+
+	log.Printf("[INFO] Creating Host Transport Node Profile with ID %s", id)
+	err = policyHostTransportNodeProfileUpdate(d, m, id, true)
+	if err != nil {
+		return getOperationDiagnostics(m, handleCreateError("Host Transport Node Profile", id, err))
+	}
+
+	d.SetId(id)
+	d.Set("nsx_id", id)
+
+	return resourceNsxtPolicyHostTransportNodeProfileRead(ctx, d, m)
+}
+
+func resourceNsxtPolicyHostTransportNodeProfileRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	connector := getPolicyConnector(m)
+	id := d.Id()
+	if id == "" {
+		return diag.Errorf("Error obtaining Host Transport Node Profile ID")
+	}
+
+	client := infra.NewHostTransportNodeProfilesClient(connector)
+	obj, err := client.Get(id)
+	if err != nil {
+		return getOperationDiagnostics(m, handleReadError(d, "Host Transport Node Profile", id, err))
+	}
+
+	d.Set("display_name", obj.DisplayName)
+	d.Set("description", obj.Description)
+	setPolicyTagsInSchema(d, obj.Tags)
+	d.Set("nsx_id", id)
+	d.Set("path", obj.Path)
+	d.Set("revision", obj.Revision)
+	d.Set("ignore_overridden_hosts", obj.IgnoreOverriddenHosts)
+
+	return getErrorDiagnostics(setPolicyHostSwitchSpecInSchema(d, obj.HostSwitchSpec))
+}
+
+func resourceNsxtPolicyHostTransportNodeProfileUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	id := d.Id()
+	if id == "" {
+		return diag.Errorf("Error obtaining Host Transport Node Profile ID")
+	}
+
+	log.Printf("[INFO] Updating Host Transport Node Profile with ID %s", id)
+	err := policyHostTransportNodeProfileUpdate(d, m, id, false)
+	if err != nil {
+		return getOperationDiagnostics(m, handleUpdateError("Host Transport Node Profile", id, err))
+	}
+
+	return resourceNsxtPolicyHostTransportNodeProfileRead(ctx, d, m)
+}
+
+func resourceNsxtPolicyHostTransportNodeProfileDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	id := d.Id()
+	if id == "" {
+		return diag.Errorf("Error obtaining Host Transport Node Profile ID")
+	}
+
+	connector := getPolicyConnector(m)
+	client := infra.NewHostTransportNodeProfilesClient(connector)
+	err := client.Delete(id)
+	if err != nil {
+		return getOperationDiagnostics(m, handleDeleteError("Host Transport Node Profile", id, err))
+	}
+
+	return nil
+}
@@ -0,0 +1,130 @@
+/* Copyright © 2023 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func getTestHostSwitchID() string {
+	return os.Getenv("NSXT_TEST_HOST_SWITCH_ID")
+}
+
+func TestAccResourceNsxtPolicyHostTransportNodeProfile_basic(t *testing.T) {
+	testResourceName := "nsxt_policy_host_transport_node_profile.test"
+	name := getAccTestResourceName()
+	updatedName := getAccTestResourceName()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccOnlyLocalManager(t)
+			testAccNSXVersion(t, "4.0.0")
+			testAccEnvDefined(t, "NSXT_TEST_HOST_SWITCH_ID")
+			testAccEnvDefined(t, "NSXT_TEST_IP_POOL")
+		},
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyResourceCheckDestroy(state, updatedName, "nsxt_policy_host_transport_node_profile", resourceNsxtPolicyHostTransportNodeProfileExists)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyHostTransportNodeProfileTemplate(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyResourceExists(testResourceName, resourceNsxtPolicyHostTransportNodeProfileExists),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", name),
+					resource.TestCheckResourceAttr(testResourceName, "description", "terraform created"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "standard_host_switch.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "standard_host_switch.0.host_switch_id", getTestHostSwitchID()),
+					resource.TestCheckResourceAttr(testResourceName, "standard_host_switch.0.host_switch_profile.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "standard_host_switch.0.ip_assignment.#", "1"),
+					resource.TestCheckResourceAttrSet(testResourceName, "standard_host_switch.0.ip_assignment.0.static_ip_pool"),
+					resource.TestCheckResourceAttr(testResourceName, "standard_host_switch.0.transport_zone_endpoint.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "standard_host_switch.0.uplink.#", "1"),
+					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+				),
+			},
+			{
+				Config: testAccNsxtPolicyHostTransportNodeProfileTemplate(updatedName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyResourceExists(testResourceName, resourceNsxtPolicyHostTransportNodeProfileExists),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", updatedName),
+					resource.TestCheckResourceAttr(testResourceName, "standard_host_switch.#", "1"),
+					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNsxtPolicyHostTransportNodeProfile_importBasic(t *testing.T) {
+	name := getAccTestResourceName()
+	testResourceName := "nsxt_policy_host_transport_node_profile.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccOnlyLocalManager(t)
+			testAccNSXVersion(t, "4.0.0")
+			testAccEnvDefined(t, "NSXT_TEST_HOST_SWITCH_ID")
+			testAccEnvDefined(t, "NSXT_TEST_IP_POOL")
+		},
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyResourceCheckDestroy(state, name, "nsxt_policy_host_transport_node_profile", resourceNsxtPolicyHostTransportNodeProfileExists)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyHostTransportNodeProfileTemplate(name),
+			},
+			{
+				ResourceName:      testResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicyHostTransportNodeProfileTemplate(name string) string {
+	return testAccNSXPolicyTransportZoneReadTemplate(getOverlayTransportZoneName(), false, false) +
+		testAccNsxtPolicyIPPoolReadTemplate(getIPPoolName()) + fmt.Sprintf(`
+resource "nsxt_policy_host_transport_node_profile" "test" {
+  display_name = "%s"
+  description  = "terraform created"
+
+  standard_host_switch {
+    host_switch_id      = "%s"
+    host_switch_profile = ["/infra/host-switch-profiles/nsx-default-uplink-hostswitch-profile"]
+
+    ip_assignment {
+      static_ip_pool = data.nsxt_policy_ip_pool.test.path
+    }
+
+    transport_zone_endpoint {
+      transport_zone = data.nsxt_policy_transport_zone.test.path
+    }
+
+    uplink {
+      uplink_name     = "uplink-1"
+      vds_uplink_name = "uplink1"
+    }
+  }
+
+  tag {
+    scope = "scope1"
+    tag   = "tag1"
+  }
+}`, name, getTestHostSwitchID())
+}
@@ -0,0 +1,277 @@
+/* Copyright © 2023 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/bindings"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/data"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+func resourceNsxtPolicyIdentityStore() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceNsxtPolicyIdentityStoreCreate,
+		ReadContext:   resourceNsxtPolicyIdentityStoreRead,
+		UpdateContext: resourceNsxtPolicyIdentityStoreUpdate,
+		DeleteContext: resourceNsxtPolicyIdentityStoreDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"nsx_id":       getNsxIDSchema(),
+			"path":         getPathSchema(),
+			"display_name": getDisplayNameSchema(),
+			"description":  getDescriptionSchema(),
+			"revision":     getRevisionSchema(),
+			"tag":          getTagsSchema(),
+			"domain_name": {
+				Type:        schema.TypeString,
+				Description: "Fully qualified domain name of the directory, for example example.com",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"base_distinguished_name": {
+				Type:        schema.TypeString,
+				Description: "Base distinguished name of the directory domain, for example DC=example,DC=com",
+				Required:    true,
+			},
+			"netbios_name": {
+				Type:        schema.TypeString,
+				Description: "NetBIOS name of the directory domain",
+				Required:    true,
+			},
+			"sync_settings": {
+				Type:        schema.TypeList,
+				Description: "Directory domain synchronization settings",
+				Optional:    true,
+				Computed:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"delta_sync_interval": {
+							Type:        schema.TypeInt,
+							Description: "Interval in minutes between two delta synchronizations",
+							Optional:    true,
+							Computed:    true,
+						},
+						"full_sync_cron_expr": {
+							Type:        schema.TypeString,
+							Description: "Full synchronization schedule as cron expression",
+							Optional:    true,
+							Computed:    true,
+						},
+						"sync_delay": {
+							Type:        schema.TypeInt,
+							Description: "Delay in seconds for initial full synchronization after domain creation. -1 disables initial full synchronization",
+							Optional:    true,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceNsxtPolicyIdentityStoreExistsInEnforcementPoint(id string, epID string, connector client.Connector) (bool, error) {
+	client := infra.NewFirewallIdentityStoresClient(connector)
+	enforcementPointPath := getPolicyEnforcementPointPathForID(epID)
+	_, err := client.Get(id, &enforcementPointPath)
+	if err == nil {
+		return true, nil
+	}
+
+	if isNotFoundError(err) {
+		return false, nil
+	}
+
+	return false, logAPIError("Error retrieving Firewall Identity Store", err)
+}
+
+func resourceNsxtPolicyIdentityStoreExistsPartial(epID string) func(id string, connector client.Connector, isGlobalManager bool) (bool, error) {
+	return func(id string, connector client.Connector, isGlobalManager bool) (bool, error) {
+		return resourceNsxtPolicyIdentityStoreExistsInEnforcementPoint(id, epID, connector)
+	}
+}
+
+func getPolicyIdentityStorePath(id string) string {
+	return "/infra/firewall-identity-stores/" + id
+}
+
+func getPolicyIdentityStoreFromSchema(d *schema.ResourceData, id string) (*data.StructValue, error) {
+	converter := bindings.NewTypeConverter()
+
+	displayName := d.Get("display_name").(string)
+	description := d.Get("description").(string)
+	domainName := d.Get("domain_name").(string)
+	baseDN := d.Get("base_distinguished_name").(string)
+	netbiosName := d.Get("netbios_name").(string)
+	tags := getPolicyTagsFromSchema(d)
+
+	obj := model.DirectoryAdDomain{
+		Id:                    &id,
+		DisplayName:           &displayName,
+		Description:           &description,
+		Tags:                  tags,
+		ResourceType:          model.DirectoryAdDomain__TYPE_IDENTIFIER,
+		Name:                  &domainName,
+		BaseDistinguishedName: &baseDN,
+		NetbiosName:           &netbiosName,
+	}
+
+	for _, settings := range d.Get("sync_settings").([]interface{}) {
+		if settings == nil {
+			continue
+		}
+		settingsMap := settings.(map[string]interface{})
+		syncSettings := model.DirectoryDomainSyncSettings{}
+		deltaSyncInterval := int64(settingsMap["delta_sync_interval"].(int))
+		if deltaSyncInterval > 0 {
+			syncSettings.DeltaSyncInterval = &deltaSyncInterval
+		}
+		fullSyncCronExpr := settingsMap["full_sync_cron_expr"].(string)
+		if fullSyncCronExpr != "" {
+			syncSettings.FullSyncCronExpr = &fullSyncCronExpr
+		}
+		syncDelay := int64(settingsMap["sync_delay"].(int))
+		if syncDelay != 0 {
+			syncSettings.SyncDelayInSec = &syncDelay
+		}
+		obj.SyncSettings = &syncSettings
+	}
+
+	dataValue, errs := converter.ConvertToVapi(obj, model.DirectoryAdDomainBindingType())
+	if errs != nil {
+		return nil, fmt.Errorf("Error converting Firewall Identity Store: %v", errs[0])
+	}
+
+	return dataValue.(*data.StructValue), nil
+}
+
+func resourceNsxtPolicyIdentityStoreCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if isPolicyGlobalManager(m) {
+		return getErrorDiagnostics(localManagerOnlyError())
+	}
+
+	connector := getPolicyConnector(m)
+	id, err := getOrGenerateID(d, m, resourceNsxtPolicyIdentityStoreExistsPartial(getPolicyEnforcementPoint(m)))
+	if err != nil {
+		return getOperationDiagnostics(m, err)
+	}
+
+	dataValue, err := getPolicyIdentityStoreFromSchema(d, id)
+	if err != nil {
+		return getOperationDiagnostics(m, err)
+	}
+
+	log.Printf("[INFO] Creating Firewall Identity Store with ID %s", id)
+	enforcementPointPath := getPolicyEnforcementPointPath(m)
+	client := infra.NewFirewallIdentityStoresClient(connector)
+	err = client.Patch(id, dataValue, &enforcementPointPath)
+	if err != nil {
+		return getOperationDiagnostics(m, handleCreateError("Firewall Identity Store", id, err))
+	}
+
+	d.SetId(id)
+	d.Set("nsx_id", id)
+
+	return resourceNsxtPolicyIdentityStoreRead(ctx, d, m)
+}
+
+func resourceNsxtPolicyIdentityStoreRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	connector := getPolicyConnector(m)
+	converter := bindings.NewTypeConverter()
+
+	id := d.Id()
+	if id == "" {
+		return diag.Errorf("Error obtaining Firewall Identity Store ID")
+	}
+
+	enforcementPointPath := getPolicyEnforcementPointPath(m)
+	client := infra.NewFirewallIdentityStoresClient(connector)
+	storeData, err := client.Get(id, &enforcementPointPath)
+	if err != nil {
+		return getOperationDiagnostics(m, handleReadError(d, "Firewall Identity Store", id, err))
+	}
+
+	storeObj, errs := converter.ConvertToGolang(storeData, model.DirectoryAdDomainBindingType())
+	if len(errs) > 0 {
+		return diag.Errorf("Error converting Firewall Identity Store %s", errs[0])
+	}
+	obj := storeObj.(model.DirectoryAdDomain)
+
+	d.Set("display_name", obj.DisplayName)
+	d.Set("description", obj.Description)
+	setPolicyTagsInSchema(d, obj.Tags)
+	d.Set("nsx_id", id)
+	// Directory domain model does not include policy path
+	d.Set("path", getPolicyIdentityStorePath(id))
+	d.Set("revision", obj.Revision)
+	d.Set("domain_name", obj.Name)
+	d.Set("base_distinguished_name", obj.BaseDistinguishedName)
+	d.Set("netbios_name", obj.NetbiosName)
+
+	var syncSettingsList []map[string]interface{}
+	if obj.SyncSettings != nil {
+		elem := make(map[string]interface{})
+		elem["delta_sync_interval"] = obj.SyncSettings.DeltaSyncInterval
+		elem["full_sync_cron_expr"] = obj.SyncSettings.FullSyncCronExpr
+		elem["sync_delay"] = obj.SyncSettings.SyncDelayInSec
+		syncSettingsList = append(syncSettingsList, elem)
+	}
+
+	return getErrorDiagnostics(d.Set("sync_settings", syncSettingsList))
+}
+
+func resourceNsxtPolicyIdentityStoreUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	connector := getPolicyConnector(m)
+
+	id := d.Id()
+	if id == "" {
+		return diag.Errorf("Error obtaining Firewall Identity Store ID")
+	}
+
+	dataValue, err := getPolicyIdentityStoreFromSchema(d, id)
+	if err != nil {
+		return getOperationDiagnostics(m, err)
+	}
+
+	log.Printf("[INFO] Updating Firewall Identity Store with ID %s", id)
+	enforcementPointPath := getPolicyEnforcementPointPath(m)
+	client := infra.NewFirewallIdentityStoresClient(connector)
+	err = client.Patch(id, dataValue, &enforcementPointPath)
+	if err != nil {
+		return getOperationDiagnostics(m, handleUpdateError("Firewall Identity Store", id, err))
+	}
+
+	return resourceNsxtPolicyIdentityStoreRead(ctx, d, m)
+}
+
+func resourceNsxtPolicyIdentityStoreDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	connector := getPolicyConnector(m)
+
+	id := d.Id()
+	if id == "" {
+		return diag.Errorf("Error obtaining Firewall Identity Store ID")
+	}
+
+	log.Printf("[INFO] Deleting Firewall Identity Store with ID %s", id)
+	enforcementPointPath := getPolicyEnforcementPointPath(m)
+	client := infra.NewFirewallIdentityStoresClient(connector)
+	err := client.Delete(id, &enforcementPointPath)
+	if err != nil {
+		return getOperationDiagnostics(m, handleDeleteError("Firewall Identity Store", id, err))
+	}
+
+	return nil
+}
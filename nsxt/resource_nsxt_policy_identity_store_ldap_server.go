@@ -0,0 +1,251 @@
+/* Copyright © 2023 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/firewall_identity_stores"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+var identityStoreLdapServerProtocolValues = []string{
+	model.DirectoryLdapServer_PROTOCOL_LDAP,
+	model.DirectoryLdapServer_PROTOCOL_LDAPS,
+}
+
+func resourceNsxtPolicyIdentityStoreLdapServer() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceNsxtPolicyIdentityStoreLdapServerCreate,
+		ReadContext:   resourceNsxtPolicyIdentityStoreLdapServerRead,
+		UpdateContext: resourceNsxtPolicyIdentityStoreLdapServerUpdate,
+		DeleteContext: resourceNsxtPolicyIdentityStoreLdapServerDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceNsxtPolicyIdentityStoreLdapServerImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"nsx_id":       getNsxIDSchema(),
+			"display_name": getDisplayNameSchema(),
+			"description":  getDescriptionSchema(),
+			"revision":     getRevisionSchema(),
+			"tag":          getTagsSchema(),
+			"identity_store_id": {
+				Type:        schema.TypeString,
+				Description: "ID of the Firewall Identity Store this LDAP server belongs to",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"host": {
+				Type:        schema.TypeString,
+				Description: "IP address or hostname of the LDAP server",
+				Required:    true,
+			},
+			"port": {
+				Type:         schema.TypeInt,
+				Description:  "Port of the LDAP server",
+				Optional:     true,
+				Default:      389,
+				ValidateFunc: validation.IsPortNumber,
+			},
+			"protocol": {
+				Type:         schema.TypeString,
+				Description:  "LDAP protocol",
+				Optional:     true,
+				Default:      model.DirectoryLdapServer_PROTOCOL_LDAP,
+				ValidateFunc: validation.StringInSlice(identityStoreLdapServerProtocolValues, false),
+			},
+			"username": {
+				Type:        schema.TypeString,
+				Description: "Username used to bind to the LDAP server",
+				Required:    true,
+				Sensitive:   true,
+			},
+			"password": {
+				Type:        schema.TypeString,
+				Description: "Password used to bind to the LDAP server",
+				Required:    true,
+				Sensitive:   true,
+			},
+			"thumbprint": {
+				Type:        schema.TypeString,
+				Description: "SHA-256 thumbprint of the LDAP server certificate, required for LDAPS",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func resourceNsxtPolicyIdentityStoreLdapServerExists(storeID string, id string, connector client.Connector) (bool, error) {
+	client := firewall_identity_stores.NewLdapServersClient(connector)
+	_, err := client.Get(storeID, id, nil)
+	if err == nil {
+		return true, nil
+	}
+
+	if isNotFoundError(err) {
+		return false, nil
+	}
+
+	return false, logAPIError("Error retrieving Firewall Identity Store LDAP Server", err)
+}
+
+func getPolicyIdentityStoreLdapServerFromSchema(d *schema.ResourceData) model.DirectoryLdapServer {
+	displayName := d.Get("display_name").(string)
+	description := d.Get("description").(string)
+	host := d.Get("host").(string)
+	port := int64(d.Get("port").(int))
+	protocol := d.Get("protocol").(string)
+	username := d.Get("username").(string)
+	password := d.Get("password").(string)
+	thumbprint := d.Get("thumbprint").(string)
+	tags := getPolicyTagsFromSchema(d)
+
+	obj := model.DirectoryLdapServer{
+		DisplayName: &displayName,
+		Description: &description,
+		Tags:        tags,
+		Host:        &host,
+		Port:        &port,
+		Protocol:    &protocol,
+		Username:    &username,
+		Password:    &password,
+	}
+
+	if thumbprint != "" {
+		obj.Thumbprint = &thumbprint
+	}
+
+	return obj
+}
+
+func resourceNsxtPolicyIdentityStoreLdapServerCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if isPolicyGlobalManager(m) {
+		return getErrorDiagnostics(localManagerOnlyError())
+	}
+
+	connector := getPolicyConnector(m)
+	storeID := d.Get("identity_store_id").(string)
+
+	id := d.Get("nsx_id").(string)
+	if id == "" {
+		id = newUUID()
+	} else {
+		exists, err := resourceNsxtPolicyIdentityStoreLdapServerExists(storeID, id, connector)
+		if err != nil {
+			return getOperationDiagnostics(m, err)
+		}
+		if exists {
+			return getErrorDiagnostics(newAttributeError("nsx_id", fmt.Errorf("LDAP Server with ID '%s' already exists in Firewall Identity Store %s", id, storeID)))
+		}
+	}
+
+	obj := getPolicyIdentityStoreLdapServerFromSchema(d)
+
+	log.Printf("[INFO] Creating LDAP Server with ID %s in Firewall Identity Store %s", id, storeID)
+	enforcementPointPath := getPolicyEnforcementPointPath(m)
+	client := firewall_identity_stores.NewLdapServersClient(connector)
+	_, err := client.Patch(storeID, id, obj, &enforcementPointPath)
+	if err != nil {
+		return getOperationDiagnostics(m, handleCreateError("Firewall Identity Store LDAP Server", id, err))
+	}
+
+	d.SetId(id)
+	d.Set("nsx_id", id)
+
+	return resourceNsxtPolicyIdentityStoreLdapServerRead(ctx, d, m)
+}
+
+func resourceNsxtPolicyIdentityStoreLdapServerRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	connector := getPolicyConnector(m)
+	storeID := d.Get("identity_store_id").(string)
+
+	id := d.Id()
+	if id == "" || storeID == "" {
+		return diag.Errorf("Error obtaining Firewall Identity Store LDAP Server ID")
+	}
+
+	enforcementPointPath := getPolicyEnforcementPointPath(m)
+	client := firewall_identity_stores.NewLdapServersClient(connector)
+	obj, err := client.Get(storeID, id, &enforcementPointPath)
+	if err != nil {
+		return getOperationDiagnostics(m, handleReadError(d, "Firewall Identity Store LDAP Server", id, err))
+	}
+
+	d.Set("display_name", obj.DisplayName)
+	d.Set("description", obj.Description)
+	setPolicyTagsInSchema(d, obj.Tags)
+	d.Set("nsx_id", id)
+	d.Set("revision", obj.Revision)
+	d.Set("host", obj.Host)
+	d.Set("port", obj.Port)
+	d.Set("protocol", obj.Protocol)
+	d.Set("username", obj.Username)
+	d.Set("thumbprint", obj.Thumbprint)
+	// Password is not returned by NSX, hence configured value is retained
+
+	return nil
+}
+
+func resourceNsxtPolicyIdentityStoreLdapServerUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	connector := getPolicyConnector(m)
+	storeID := d.Get("identity_store_id").(string)
+
+	id := d.Id()
+	if id == "" || storeID == "" {
+		return diag.Errorf("Error obtaining Firewall Identity Store LDAP Server ID")
+	}
+
+	obj := getPolicyIdentityStoreLdapServerFromSchema(d)
+
+	log.Printf("[INFO] Updating LDAP Server with ID %s in Firewall Identity Store %s", id, storeID)
+	enforcementPointPath := getPolicyEnforcementPointPath(m)
+	client := firewall_identity_stores.NewLdapServersClient(connector)
+	_, err := client.Patch(storeID, id, obj, &enforcementPointPath)
+	if err != nil {
+		return getOperationDiagnostics(m, handleUpdateError("Firewall Identity Store LDAP Server", id, err))
+	}
+
+	return resourceNsxtPolicyIdentityStoreLdapServerRead(ctx, d, m)
+}
+
+func resourceNsxtPolicyIdentityStoreLdapServerDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	connector := getPolicyConnector(m)
+	storeID := d.Get("identity_store_id").(string)
+
+	id := d.Id()
+	if id == "" || storeID == "" {
+		return diag.Errorf("Error obtaining Firewall Identity Store LDAP Server ID")
+	}
+
+	log.Printf("[INFO] Deleting LDAP Server with ID %s from Firewall Identity Store %s", id, storeID)
+	enforcementPointPath := getPolicyEnforcementPointPath(m)
+	client := firewall_identity_stores.NewLdapServersClient(connector)
+	err := client.Delete(storeID, id, &enforcementPointPath)
+	if err != nil {
+		return getOperationDiagnostics(m, handleDeleteError("Firewall Identity Store LDAP Server", id, err))
+	}
+
+	return nil
+}
+
+func resourceNsxtPolicyIdentityStoreLdapServerImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	importID := d.Id()
+	s := strings.Split(importID, "/")
+	if len(s) != 2 {
+		return nil, fmt.Errorf("Please provide <identity-store-id>/<ldap-server-id> as an input")
+	}
+
+	d.Set("identity_store_id", s[0])
+	d.SetId(s[1])
+
+	return []*schema.ResourceData{d}, nil
+}
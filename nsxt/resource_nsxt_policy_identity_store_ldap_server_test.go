@@ -0,0 +1,171 @@
+/* Copyright © 2023 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccResourceNsxtPolicyIdentityStoreLdapServer_basic(t *testing.T) {
+	testResourceName := "nsxt_policy_identity_store_ldap_server.test"
+	displayName := getAccTestResourceName()
+	updatedName := getAccTestResourceName()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t); testAccOnlyLocalManager(t); testAccNSXLdapPrecheck(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyIdentityStoreLdapServerCheckDestroy(state, updatedName)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyIdentityStoreLdapServerTemplate(displayName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyIdentityStoreLdapServerExists(testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", displayName),
+					resource.TestCheckResourceAttr(testResourceName, "description", "terraform created"),
+					resource.TestCheckResourceAttr(testResourceName, "host", getTestLdapHost()),
+					resource.TestCheckResourceAttr(testResourceName, "port", "389"),
+					resource.TestCheckResourceAttr(testResourceName, "protocol", "LDAP"),
+					resource.TestCheckResourceAttrSet(testResourceName, "identity_store_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "1"),
+				),
+			},
+			{
+				Config: testAccNsxtPolicyIdentityStoreLdapServerTemplate(updatedName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyIdentityStoreLdapServerExists(testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", updatedName),
+					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNsxtPolicyIdentityStoreLdapServer_importBasic(t *testing.T) {
+	name := getAccTestResourceName()
+	testResourceName := "nsxt_policy_identity_store_ldap_server.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t); testAccOnlyLocalManager(t); testAccNSXLdapPrecheck(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyIdentityStoreLdapServerCheckDestroy(state, name)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyIdentityStoreLdapServerTemplate(name),
+			},
+			{
+				ResourceName:            testResourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"password"},
+				ImportStateIdFunc:       testAccNsxtPolicyIdentityStoreLdapServerImporterGetID,
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicyIdentityStoreLdapServerImporterGetID(s *terraform.State) (string, error) {
+	rs, ok := s.RootModule().Resources["nsxt_policy_identity_store_ldap_server.test"]
+	if !ok {
+		return "", fmt.Errorf("Policy LDAP Server resource not found in resources")
+	}
+	resourceID := rs.Primary.ID
+	if resourceID == "" {
+		return "", fmt.Errorf("Policy LDAP Server resource ID not set in resources ")
+	}
+	storeID := rs.Primary.Attributes["identity_store_id"]
+	if storeID == "" {
+		return "", fmt.Errorf("Policy LDAP Server identity_store_id not set in resources ")
+	}
+	return fmt.Sprintf("%s/%s", storeID, resourceID), nil
+}
+
+func testAccNsxtPolicyIdentityStoreLdapServerExists(resourceName string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+
+		connector := getPolicyConnector(testAccProvider.Meta().(nsxtClients))
+
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Policy LDAP Server resource %s not found in resources", resourceName)
+		}
+
+		resourceID := rs.Primary.ID
+		if resourceID == "" {
+			return fmt.Errorf("Policy LDAP Server resource ID not set in resources")
+		}
+		storeID := rs.Primary.Attributes["identity_store_id"]
+
+		exists, err := resourceNsxtPolicyIdentityStoreLdapServerExists(storeID, resourceID, connector)
+		if err != nil {
+			return err
+		}
+		if !exists {
+			return fmt.Errorf("Policy LDAP Server %s does not exist", resourceID)
+		}
+
+		return nil
+	}
+}
+
+func testAccNsxtPolicyIdentityStoreLdapServerCheckDestroy(state *terraform.State, displayName string) error {
+	connector := getPolicyConnector(testAccProvider.Meta().(nsxtClients))
+	for _, rs := range state.RootModule().Resources {
+
+		if rs.Type != "nsxt_policy_identity_store_ldap_server" {
+			continue
+		}
+
+		resourceID := rs.Primary.ID
+		storeID := rs.Primary.Attributes["identity_store_id"]
+		exists, err := resourceNsxtPolicyIdentityStoreLdapServerExists(storeID, resourceID, connector)
+		if err == nil {
+			return err
+		}
+
+		if exists {
+			return fmt.Errorf("Policy LDAP Server %s still exists", displayName)
+		}
+	}
+	return nil
+}
+
+func testAccNsxtPolicyIdentityStoreLdapDeps() string {
+	return fmt.Sprintf(`
+resource "nsxt_policy_identity_store" "test" {
+  display_name            = "tf-ldap-store"
+  domain_name             = "%s"
+  base_distinguished_name = "%s"
+  netbios_name            = "TFTEST"
+}`, getTestLdapDomain(), getTestLdapBaseDN())
+}
+
+func testAccNsxtPolicyIdentityStoreLdapServerTemplate(displayName string) string {
+	return testAccNsxtPolicyIdentityStoreLdapDeps() + fmt.Sprintf(`
+resource "nsxt_policy_identity_store_ldap_server" "test" {
+  display_name      = "%s"
+  description       = "terraform created"
+  identity_store_id = nsxt_policy_identity_store.test.id
+  host              = "%s"
+  username          = "%s"
+  password          = "%s"
+
+  tag {
+    scope = "scope1"
+    tag   = "tag1"
+  }
+}`, displayName, getTestLdapHost(), getTestLdapUser(), getTestLdapPassword())
+}
@@ -0,0 +1,126 @@
+/* Copyright © 2023 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccResourceNsxtPolicyIdentityStore_basic(t *testing.T) {
+	testResourceName := "nsxt_policy_identity_store.test"
+	displayName := getAccTestResourceName()
+	updatedName := getAccTestResourceName()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t); testAccOnlyLocalManager(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyResourceCheckDestroy(state, updatedName, "nsxt_policy_identity_store", resourceNsxtPolicyIdentityStoreExistsPartial(defaultEnforcementPoint))
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyIdentityStoreTemplate(displayName, "1440"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyResourceExists(testResourceName, resourceNsxtPolicyIdentityStoreExistsPartial(defaultEnforcementPoint)),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", displayName),
+					resource.TestCheckResourceAttr(testResourceName, "description", "terraform created"),
+					resource.TestCheckResourceAttr(testResourceName, "domain_name", "tf-test.example.com"),
+					resource.TestCheckResourceAttr(testResourceName, "base_distinguished_name", "DC=tf-test,DC=example,DC=com"),
+					resource.TestCheckResourceAttr(testResourceName, "netbios_name", "TFTEST"),
+					resource.TestCheckResourceAttr(testResourceName, "sync_settings.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "sync_settings.0.delta_sync_interval", "1440"),
+					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "1"),
+				),
+			},
+			{
+				Config: testAccNsxtPolicyIdentityStoreTemplate(updatedName, "720"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyResourceExists(testResourceName, resourceNsxtPolicyIdentityStoreExistsPartial(defaultEnforcementPoint)),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", updatedName),
+					resource.TestCheckResourceAttr(testResourceName, "sync_settings.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "sync_settings.0.delta_sync_interval", "720"),
+					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNsxtPolicyIdentityStore_importBasic(t *testing.T) {
+	name := getAccTestResourceName()
+	testResourceName := "nsxt_policy_identity_store.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t); testAccOnlyLocalManager(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyResourceCheckDestroy(state, name, "nsxt_policy_identity_store", resourceNsxtPolicyIdentityStoreExistsPartial(defaultEnforcementPoint))
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyIdentityStoreTemplate(name, "1440"),
+			},
+			{
+				ResourceName:      testResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicyIdentityStoreTemplate(displayName string, deltaSyncInterval string) string {
+	return fmt.Sprintf(`
+resource "nsxt_policy_identity_store" "test" {
+  display_name            = "%s"
+  description             = "terraform created"
+  domain_name             = "tf-test.example.com"
+  base_distinguished_name = "DC=tf-test,DC=example,DC=com"
+  netbios_name            = "TFTEST"
+
+  sync_settings {
+    delta_sync_interval = %s
+    sync_delay          = -1
+  }
+
+  tag {
+    scope = "scope1"
+    tag   = "tag1"
+  }
+}`, displayName, deltaSyncInterval)
+}
+
+func TestUnitResourceNsxtPolicyIdentityStore_enforcementPoint(t *testing.T) {
+	fake := newFakeNsxServer(t)
+	meta := testUnitConfigureProvider(t, fake)
+	resourceName := "nsxt_policy_identity_store"
+
+	state := testUnitApplyResource(t, meta, resourceName, nil, map[string]interface{}{
+		"display_name":            "store1",
+		"nsx_id":                  "store1",
+		"domain_name":             "example.com",
+		"base_distinguished_name": "DC=example,DC=com",
+		"netbios_name":            "EXAMPLE",
+	})
+	testUnitCheckAttr(t, state, "path", "/infra/firewall-identity-stores/store1")
+
+	// Existence is checked in the enforcement point the store was created in
+	exists, err := resourceNsxtPolicyIdentityStoreExistsPartial("ep1")("store1", getPolicyConnector(meta), false)
+	if err != nil || !exists {
+		t.Errorf("Expected store1 to exist, got %v: %v", exists, err)
+	}
+	if enforcementPointPath := fake.query.Get("enforcement_point_path"); enforcementPointPath != "/infra/sites/default/enforcement-points/ep1" {
+		t.Errorf("Expected existence check in enforcement point ep1, got %s", enforcementPointPath)
+	}
+}
@@ -4,9 +4,11 @@
 package nsxt
 
 import (
+	"context"
 	"fmt"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/vmware/vsphere-automation-sdk-go/runtime/bindings"
 	"github.com/vmware/vsphere-automation-sdk-go/runtime/data"
@@ -20,12 +22,12 @@ var policyIntrusionServiceRuleActionValues = []string{model.IdsRule_ACTION_DETEC
 
 func resourceNsxtPolicyIntrusionServicePolicy() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtPolicyIntrusionServicePolicyCreate,
-		Read:   resourceNsxtPolicyIntrusionServicePolicyRead,
-		Update: resourceNsxtPolicyIntrusionServicePolicyUpdate,
-		Delete: resourceNsxtPolicyIntrusionServicePolicyDelete,
+		CreateContext: resourceNsxtPolicyIntrusionServicePolicyCreate,
+		ReadContext:   resourceNsxtPolicyIntrusionServicePolicyRead,
+		UpdateContext: resourceNsxtPolicyIntrusionServicePolicyUpdate,
+		DeleteContext: resourceNsxtPolicyIntrusionServicePolicyDelete,
 		Importer: &schema.ResourceImporter{
-			State: nsxtDomainResourceImporter,
+			StateContext: nsxtDomainResourceImporter,
 		},
 		Schema: getPolicySecurityPolicySchema(true),
 	}
@@ -232,6 +234,12 @@ func updateIdsSecurityPolicy(id string, d *schema.ResourceData, m interface{}) e
 		ResourceType:   &resourceType,
 	}
 
+	if len(d.Id()) > 0 {
+		// This is update flow
+		revision := int64(d.Get("revision").(int))
+		obj.Revision = &revision
+	}
+
 	var childRules []*data.StructValue
 	if d.HasChange("rule") {
 		oldRules, _ := d.GetChange("rule")
@@ -300,42 +308,43 @@ func idsPolicyInfraPatch(policy model.IdsSecurityPolicy, domain string, m interf
 		ResourceType: &infraType,
 	}
 
-	return policyInfraPatch(infraObj, isPolicyGlobalManager(m), getPolicyConnector(m), false)
-
+	// Revision is only set in update flow
+	enforceRevision := getPolicyEnforceRevision(m) && policy.Revision != nil
+	return policyInfraPatch(infraObj, isPolicyGlobalManager(m), getPolicyConnector(m), enforceRevision)
 }
 
-func resourceNsxtPolicyIntrusionServicePolicyCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyIntrusionServicePolicyCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 
 	// Initialize resource Id and verify this ID is not yet used
 	id, err := getOrGenerateID(d, m, resourceNsxtPolicyIntrusionServicePolicyExistsPartial(d.Get("domain").(string)))
 	if err != nil {
-		return err
+		return getOperationDiagnostics(m, err)
 	}
 
 	log.Printf("[INFO] Creating Intrusion Service Policy with ID %s", id)
 	err = updateIdsSecurityPolicy(id, d, m)
 
 	if err != nil {
-		return handleCreateError("Intrusion Service Policy", id, err)
+		return getOperationDiagnostics(m, handleCreateError("Intrusion Service Policy", id, err))
 	}
 
 	d.SetId(id)
 	d.Set("nsx_id", id)
 
-	return resourceNsxtPolicyIntrusionServicePolicyRead(d, m)
+	return resourceNsxtPolicyIntrusionServicePolicyRead(ctx, d, m)
 }
 
-func resourceNsxtPolicyIntrusionServicePolicyRead(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyIntrusionServicePolicyRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 	id := d.Id()
 	domainName := d.Get("domain").(string)
 	if id == "" {
-		return fmt.Errorf("Error obtaining Intrusion Service Policy id")
+		return diag.Errorf("Error obtaining Intrusion Service Policy id")
 	}
 	client := domains.NewIntrusionServicePoliciesClient(connector)
 	obj, err := client.Get(domainName, id)
 	if err != nil {
-		return handleReadError(d, "Intrusion Service Policy", id, err)
+		return getOperationDiagnostics(m, handleReadError(d, "Intrusion Service Policy", id, err))
 	}
 	d.Set("display_name", obj.DisplayName)
 	d.Set("description", obj.Description)
@@ -348,30 +357,30 @@ func resourceNsxtPolicyIntrusionServicePolicyRead(d *schema.ResourceData, m inte
 	d.Set("sequence_number", obj.SequenceNumber)
 	d.Set("stateful", obj.Stateful)
 	d.Set("revision", obj.Revision)
-	return setPolicyIdsRulesInSchema(d, obj.Rules)
+	return getErrorDiagnostics(setPolicyIdsRulesInSchema(d, obj.Rules))
 }
 
-func resourceNsxtPolicyIntrusionServicePolicyUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyIntrusionServicePolicyUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining Intrusion Service Policy id")
+		return diag.Errorf("Error obtaining Intrusion Service Policy id")
 	}
 
 	log.Printf("[INFO] Updating Intrusion Service Policy with ID %s", id)
 	err := updateIdsSecurityPolicy(id, d, m)
 
 	if err != nil {
-		return handleUpdateError("Intrusion Service Policy", id, err)
+		return getOperationDiagnostics(m, handleUpdateError("Intrusion Service Policy", id, err))
 	}
 
-	return resourceNsxtPolicyIntrusionServicePolicyRead(d, m)
+	return resourceNsxtPolicyIntrusionServicePolicyRead(ctx, d, m)
 }
 
-func resourceNsxtPolicyIntrusionServicePolicyDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyIntrusionServicePolicyDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining Intrusion Service Policy id")
+		return diag.Errorf("Error obtaining Intrusion Service Policy id")
 	}
 
 	connector := getPolicyConnector(m)
@@ -380,7 +389,7 @@ func resourceNsxtPolicyIntrusionServicePolicyDelete(d *schema.ResourceData, m in
 	err := client.Delete(d.Get("domain").(string), id)
 
 	if err != nil {
-		return handleDeleteError("Intrusion Service Policy", id, err)
+		return getOperationDiagnostics(m, handleDeleteError("Intrusion Service Policy", id, err))
 	}
 
 	return nil
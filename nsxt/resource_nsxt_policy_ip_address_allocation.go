@@ -4,11 +4,13 @@
 package nsxt
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
 
 	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
 	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra"
@@ -18,12 +20,12 @@ import (
 
 func resourceNsxtPolicyIPAddressAllocation() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtPolicyIPAddressAllocationCreate,
-		Read:   resourceNsxtPolicyIPAddressAllocationRead,
-		Update: resourceNsxtPolicyIPAddressAllocationUpdate,
-		Delete: resourceNsxtPolicyIPAddressAllocationDelete,
+		CreateContext: resourceNsxtPolicyIPAddressAllocationCreate,
+		ReadContext:   resourceNsxtPolicyIPAddressAllocationRead,
+		UpdateContext: resourceNsxtPolicyIPAddressAllocationUpdate,
+		DeleteContext: resourceNsxtPolicyIPAddressAllocationDelete,
 		Importer: &schema.ResourceImporter{
-			State: resourceNsxtPolicyIPAddressAllocationImport,
+			StateContext: resourceNsxtPolicyIPAddressAllocationImport,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -61,12 +63,12 @@ func resourceNsxtPolicyIPAddressAllocationExists(poolID string, allocationID str
 	return false, logAPIError("Error retrieving resource", err)
 }
 
-func resourceNsxtPolicyIPAddressAllocationCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyIPAddressAllocationCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 	client := ip_pools.NewIpAllocationsClient(connector)
 
 	if client == nil {
-		return policyResourceNotSupportedError()
+		return getErrorDiagnostics(policyResourceNotSupportedError())
 	}
 
 	poolID := getPolicyIDFromPath(d.Get("pool_path").(string))
@@ -79,10 +81,10 @@ func resourceNsxtPolicyIPAddressAllocationCreate(d *schema.ResourceData, m inter
 
 	exists, err := resourceNsxtPolicyIPAddressAllocationExists(poolID, id, connector)
 	if err != nil {
-		return err
+		return getOperationDiagnostics(m, err)
 	}
 	if exists {
-		return fmt.Errorf("Resource with ID %s already exists", id)
+		return diag.Errorf("Resource with ID %s already exists", id)
 	}
 
 	displayName := d.Get("display_name").(string)
@@ -104,33 +106,33 @@ func resourceNsxtPolicyIPAddressAllocationCreate(d *schema.ResourceData, m inter
 	log.Printf("[INFO] Creating IPAddressAllocation with ID %s", id)
 	err = client.Patch(poolID, id, obj)
 	if err != nil {
-		return handleCreateError("IPAddressAllocation", id, err)
+		return getOperationDiagnostics(m, handleCreateError("IPAddressAllocation", id, err))
 	}
 
 	d.SetId(id)
 	d.Set("nsx_id", id)
 
-	return resourceNsxtPolicyIPAddressAllocationRead(d, m)
+	return resourceNsxtPolicyIPAddressAllocationRead(ctx, d, m)
 }
 
-func resourceNsxtPolicyIPAddressAllocationRead(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyIPAddressAllocationRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 	client := ip_pools.NewIpAllocationsClient(connector)
 
 	if client == nil {
-		return policyResourceNotSupportedError()
+		return getErrorDiagnostics(policyResourceNotSupportedError())
 	}
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining IPAddressAllocation ID")
+		return diag.Errorf("Error obtaining IPAddressAllocation ID")
 	}
 
 	poolID := getPolicyIDFromPath(d.Get("pool_path").(string))
 
 	obj, err := client.Get(poolID, id)
 	if err != nil {
-		return handleReadError(d, "IPAddressAllocation", id, err)
+		return getOperationDiagnostics(m, handleReadError(d, "IPAddressAllocation", id, err))
 	}
 
 	d.Set("display_name", obj.DisplayName)
@@ -147,9 +149,9 @@ func resourceNsxtPolicyIPAddressAllocationRead(d *schema.ResourceData, m interfa
 		log.Printf("[DEBUG] Waiting for realization of IP Address for IP Allocation with ID %s", id)
 
 		stateConf := nsxtPolicyWaitForRealizationStateConf(connector, d, d.Get("path").(string))
-		entity, err := stateConf.WaitForState()
+		entity, err := stateConf.WaitForStateContext(ctx)
 		if err != nil {
-			return err
+			return getOperationDiagnostics(m, err)
 		}
 		realizedResource := entity.(model.GenericPolicyRealizedResource)
 		for _, attr := range realizedResource.ExtendedAttributes {
@@ -158,23 +160,23 @@ func resourceNsxtPolicyIPAddressAllocationRead(d *schema.ResourceData, m interfa
 				return nil
 			}
 		}
-		return fmt.Errorf("Failed to get realized IP for path %s", d.Get("path"))
+		return diag.Errorf("Failed to get realized IP for path %s", d.Get("path"))
 	}
 
 	return nil
 }
 
-func resourceNsxtPolicyIPAddressAllocationUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyIPAddressAllocationUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 	client := ip_pools.NewIpAllocationsClient(connector)
 
 	if client == nil {
-		return policyResourceNotSupportedError()
+		return getErrorDiagnostics(policyResourceNotSupportedError())
 	}
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining Group ID")
+		return diag.Errorf("Error obtaining Group ID")
 	}
 
 	displayName := d.Get("display_name").(string)
@@ -192,35 +194,35 @@ func resourceNsxtPolicyIPAddressAllocationUpdate(d *schema.ResourceData, m inter
 	log.Printf("[INFO] Updating IPAddressAllocation with ID %s", id)
 	err := client.Patch(poolID, id, obj)
 	if err != nil {
-		return handleUpdateError("IPAddressAllocation", id, err)
+		return getOperationDiagnostics(m, handleUpdateError("IPAddressAllocation", id, err))
 	}
 
-	return resourceNsxtPolicyIPAddressAllocationRead(d, m)
+	return resourceNsxtPolicyIPAddressAllocationRead(ctx, d, m)
 }
 
-func resourceNsxtPolicyIPAddressAllocationDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyIPAddressAllocationDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 	client := ip_pools.NewIpAllocationsClient(connector)
 	if client == nil {
-		return policyResourceNotSupportedError()
+		return getErrorDiagnostics(policyResourceNotSupportedError())
 	}
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining IPAddressAllocation ID")
+		return diag.Errorf("Error obtaining IPAddressAllocation ID")
 	}
 
 	poolID := getPolicyIDFromPath(d.Get("pool_path").(string))
 
 	err := client.Delete(poolID, id)
 	if err != nil {
-		return handleDeleteError("IPAddressAllocation", id, err)
+		return getOperationDiagnostics(m, handleDeleteError("IPAddressAllocation", id, err))
 	}
 
 	return nil
 }
 
-func resourceNsxtPolicyIPAddressAllocationImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+func resourceNsxtPolicyIPAddressAllocationImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	importID := d.Id()
 	s := strings.Split(importID, "/")
 	if len(s) != 2 {
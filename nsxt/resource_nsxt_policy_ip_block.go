@@ -4,9 +4,10 @@
 package nsxt
 
 import (
-	"fmt"
+	"context"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
 	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra"
@@ -15,12 +16,12 @@ import (
 
 func resourceNsxtPolicyIPBlock() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtPolicyIPBlockCreate,
-		Read:   resourceNsxtPolicyIPBlockRead,
-		Update: resourceNsxtPolicyIPBlockUpdate,
-		Delete: resourceNsxtPolicyIPBlockDelete,
+		CreateContext: resourceNsxtPolicyIPBlockCreate,
+		ReadContext:   resourceNsxtPolicyIPBlockRead,
+		UpdateContext: resourceNsxtPolicyIPBlockUpdate,
+		DeleteContext: resourceNsxtPolicyIPBlockDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -55,18 +56,18 @@ func resourceNsxtPolicyIPBlockExists(id string, connector client.Connector, isGl
 	return false, logAPIError("Error retrieving IP Block", err)
 }
 
-func resourceNsxtPolicyIPBlockRead(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyIPBlockRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 	client := infra.NewIpBlocksClient(connector)
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining IP Block ID")
+		return diag.Errorf("Error obtaining IP Block ID")
 	}
 
 	block, err := client.Get(id)
 	if err != nil {
-		return handleReadError(d, "IP Block", id, err)
+		return getOperationDiagnostics(m, handleReadError(d, "IP Block", id, err))
 	}
 
 	d.Set("display_name", block.DisplayName)
@@ -80,13 +81,13 @@ func resourceNsxtPolicyIPBlockRead(d *schema.ResourceData, m interface{}) error
 	return nil
 }
 
-func resourceNsxtPolicyIPBlockCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyIPBlockCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 	client := infra.NewIpBlocksClient(connector)
 
 	id, err := getOrGenerateID(d, m, resourceNsxtPolicyIPBlockExists)
 	if err != nil {
-		return err
+		return getOperationDiagnostics(m, err)
 	}
 
 	displayName := d.Get("display_name").(string)
@@ -105,21 +106,21 @@ func resourceNsxtPolicyIPBlockCreate(d *schema.ResourceData, m interface{}) erro
 	log.Printf("[INFO] Creating IP Block with ID %s", id)
 	err = client.Patch(id, obj)
 	if err != nil {
-		return handleCreateError("IP Block", id, err)
+		return getOperationDiagnostics(m, handleCreateError("IP Block", id, err))
 	}
 
 	d.SetId(id)
 	d.Set("nsx_id", id)
-	return resourceNsxtPolicyIPBlockRead(d, m)
+	return resourceNsxtPolicyIPBlockRead(ctx, d, m)
 }
 
-func resourceNsxtPolicyIPBlockUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyIPBlockUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 	client := infra.NewIpBlocksClient(connector)
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining IP Block ID")
+		return diag.Errorf("Error obtaining IP Block ID")
 	}
 
 	// Read the rest of the configured parameters
@@ -140,23 +141,23 @@ func resourceNsxtPolicyIPBlockUpdate(d *schema.ResourceData, m interface{}) erro
 
 	_, err := client.Update(id, obj)
 	if err != nil {
-		return handleUpdateError("IP Block", id, err)
+		return getOperationDiagnostics(m, handleUpdateError("IP Block", id, err))
 	}
-	return resourceNsxtPolicyIPBlockRead(d, m)
+	return resourceNsxtPolicyIPBlockRead(ctx, d, m)
 
 }
 
-func resourceNsxtPolicyIPBlockDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyIPBlockDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining IP Block ID")
+		return diag.Errorf("Error obtaining IP Block ID")
 	}
 
 	connector := getPolicyConnector(m)
 	client := infra.NewIpBlocksClient(connector)
 	err := client.Delete(id)
 	if err != nil {
-		return handleDeleteError("IP Block", id, err)
+		return getOperationDiagnostics(m, handleDeleteError("IP Block", id, err))
 	}
 
 	return nil
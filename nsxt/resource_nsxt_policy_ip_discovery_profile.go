@@ -4,9 +4,10 @@
 package nsxt
 
 import (
-	"fmt"
+	"context"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
@@ -18,12 +19,12 @@ import (
 
 func resourceNsxtPolicyIPDiscoveryProfile() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtPolicyIPDiscoveryProfileCreate,
-		Read:   resourceNsxtPolicyIPDiscoveryProfileRead,
-		Update: resourceNsxtPolicyIPDiscoveryProfileUpdate,
-		Delete: resourceNsxtPolicyIPDiscoveryProfileDelete,
+		CreateContext: resourceNsxtPolicyIPDiscoveryProfileCreate,
+		ReadContext:   resourceNsxtPolicyIPDiscoveryProfileRead,
+		UpdateContext: resourceNsxtPolicyIPDiscoveryProfileUpdate,
+		DeleteContext: resourceNsxtPolicyIPDiscoveryProfileDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -173,13 +174,13 @@ func resourceNsxtPolicyIPDiscoveryProfileExists(id string, connector client.Conn
 	return false, logAPIError("Error retrieving resource", err)
 }
 
-func resourceNsxtPolicyIPDiscoveryProfileCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyIPDiscoveryProfileCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 
 	// Initialize resource Id and verify this ID is not yet used
 	id, err := getOrGenerateID(d, m, resourceNsxtPolicyIPDiscoveryProfileExists)
 	if err != nil {
-		return err
+		return getOperationDiagnostics(m, err)
 	}
 
 	obj := ipDiscoveryProfileObjFromSchema(d)
@@ -190,7 +191,7 @@ func resourceNsxtPolicyIPDiscoveryProfileCreate(d *schema.ResourceData, m interf
 	if isPolicyGlobalManager(m) {
 		gmObj, err1 := convertModelBindingType(obj, model.IPDiscoveryProfileBindingType(), gm_model.IPDiscoveryProfileBindingType())
 		if err1 != nil {
-			return err1
+			return getErrorDiagnostics(err1)
 		}
 
 		client := gm_infra.NewIpDiscoveryProfilesClient(connector)
@@ -200,21 +201,21 @@ func resourceNsxtPolicyIPDiscoveryProfileCreate(d *schema.ResourceData, m interf
 		err = client.Patch(id, obj, &boolFalse)
 	}
 	if err != nil {
-		return handleCreateError("IPDiscoveryProfile", id, err)
+		return getOperationDiagnostics(m, handleCreateError("IPDiscoveryProfile", id, err))
 	}
 
 	d.SetId(id)
 	d.Set("nsx_id", id)
 
-	return resourceNsxtPolicyIPDiscoveryProfileRead(d, m)
+	return resourceNsxtPolicyIPDiscoveryProfileRead(ctx, d, m)
 }
 
-func resourceNsxtPolicyIPDiscoveryProfileRead(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyIPDiscoveryProfileRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining IPDiscoveryProfile ID")
+		return diag.Errorf("Error obtaining IPDiscoveryProfile ID")
 	}
 
 	var obj model.IPDiscoveryProfile
@@ -222,11 +223,11 @@ func resourceNsxtPolicyIPDiscoveryProfileRead(d *schema.ResourceData, m interfac
 		client := gm_infra.NewIpDiscoveryProfilesClient(connector)
 		gmObj, err := client.Get(id)
 		if err != nil {
-			return handleReadError(d, "IPDiscoveryProfile", id, err)
+			return getOperationDiagnostics(m, handleReadError(d, "IPDiscoveryProfile", id, err))
 		}
 		rawObj, err := convertModelBindingType(gmObj, gm_model.IPDiscoveryProfileBindingType(), model.IPDiscoveryProfileBindingType())
 		if err != nil {
-			return err
+			return getOperationDiagnostics(m, err)
 		}
 		obj = rawObj.(model.IPDiscoveryProfile)
 
@@ -235,7 +236,7 @@ func resourceNsxtPolicyIPDiscoveryProfileRead(d *schema.ResourceData, m interfac
 		client := infra.NewIpDiscoveryProfilesClient(connector)
 		obj, err = client.Get(id)
 		if err != nil {
-			return handleReadError(d, "IPDiscoveryProfile", id, err)
+			return getOperationDiagnostics(m, handleReadError(d, "IPDiscoveryProfile", id, err))
 		}
 	}
 
@@ -261,16 +262,16 @@ func resourceNsxtPolicyIPDiscoveryProfileRead(d *schema.ResourceData, m interfac
 	return nil
 }
 
-func resourceNsxtPolicyIPDiscoveryProfileUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyIPDiscoveryProfileUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 	client := infra.NewIpDiscoveryProfilesClient(connector)
 	if client == nil {
-		return policyResourceNotSupportedError()
+		return getErrorDiagnostics(policyResourceNotSupportedError())
 	}
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining IPDiscoveryProfile ID")
+		return diag.Errorf("Error obtaining IPDiscoveryProfile ID")
 	}
 
 	// Read the rest of the configured parameters
@@ -283,7 +284,7 @@ func resourceNsxtPolicyIPDiscoveryProfileUpdate(d *schema.ResourceData, m interf
 	if isPolicyGlobalManager(m) {
 		gmObj, err1 := convertModelBindingType(obj, model.IPDiscoveryProfileBindingType(), gm_model.IPDiscoveryProfileBindingType())
 		if err1 != nil {
-			return err1
+			return getErrorDiagnostics(err1)
 		}
 
 		client := gm_infra.NewIpDiscoveryProfilesClient(connector)
@@ -293,16 +294,16 @@ func resourceNsxtPolicyIPDiscoveryProfileUpdate(d *schema.ResourceData, m interf
 		err = client.Patch(id, obj, &boolFalse)
 	}
 	if err != nil {
-		return handleUpdateError("IPDiscoveryProfile", id, err)
+		return getOperationDiagnostics(m, handleUpdateError("IPDiscoveryProfile", id, err))
 	}
 
-	return resourceNsxtPolicyIPDiscoveryProfileRead(d, m)
+	return resourceNsxtPolicyIPDiscoveryProfileRead(ctx, d, m)
 }
 
-func resourceNsxtPolicyIPDiscoveryProfileDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyIPDiscoveryProfileDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining IPDiscoveryProfile ID")
+		return diag.Errorf("Error obtaining IPDiscoveryProfile ID")
 	}
 
 	var err error
@@ -317,7 +318,7 @@ func resourceNsxtPolicyIPDiscoveryProfileDelete(d *schema.ResourceData, m interf
 	}
 
 	if err != nil {
-		return handleDeleteError("IPDiscoveryProfile", id, err)
+		return getOperationDiagnostics(m, handleDeleteError("IPDiscoveryProfile", id, err))
 	}
 
 	return nil
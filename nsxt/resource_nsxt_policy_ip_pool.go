@@ -4,9 +4,10 @@
 package nsxt
 
 import (
-	"fmt"
+	"context"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
 	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra"
@@ -15,12 +16,12 @@ import (
 
 func resourceNsxtPolicyIPPool() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtPolicyIPPoolCreate,
-		Read:   resourceNsxtPolicyIPPoolRead,
-		Update: resourceNsxtPolicyIPPoolUpdate,
-		Delete: resourceNsxtPolicyIPPoolDelete,
+		CreateContext: resourceNsxtPolicyIPPoolCreate,
+		ReadContext:   resourceNsxtPolicyIPPoolRead,
+		UpdateContext: resourceNsxtPolicyIPPoolUpdate,
+		DeleteContext: resourceNsxtPolicyIPPoolDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -49,13 +50,13 @@ func resourceNsxtPolicyIPPoolExists(id string, connector client.Connector, isGlo
 	return false, logAPIError("Error retrieving IP Pool", err)
 }
 
-func resourceNsxtPolicyIPPoolRead(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyIPPoolRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 	client := infra.NewIpPoolsClient(connector)
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining IP Pool ID")
+		return diag.Errorf("Error obtaining IP Pool ID")
 	}
 
 	pool, err := client.Get(id)
@@ -65,7 +66,7 @@ func resourceNsxtPolicyIPPoolRead(d *schema.ResourceData, m interface{}) error {
 			log.Printf("[DEBUG] IP Pool %s not found", id)
 			return nil
 		}
-		return handleReadError(d, "IP Pool", id, err)
+		return getOperationDiagnostics(m, handleReadError(d, "IP Pool", id, err))
 	}
 
 	d.Set("display_name", pool.DisplayName)
@@ -78,13 +79,13 @@ func resourceNsxtPolicyIPPoolRead(d *schema.ResourceData, m interface{}) error {
 	return nil
 }
 
-func resourceNsxtPolicyIPPoolCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyIPPoolCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 	client := infra.NewIpPoolsClient(connector)
 
 	id, err := getOrGenerateID(d, m, resourceNsxtPolicyIPPoolExists)
 	if err != nil {
-		return err
+		return getOperationDiagnostics(m, err)
 	}
 
 	displayName := d.Get("display_name").(string)
@@ -101,21 +102,21 @@ func resourceNsxtPolicyIPPoolCreate(d *schema.ResourceData, m interface{}) error
 	log.Printf("[INFO] Creating IP Pool with ID %s", id)
 	err = client.Patch(id, obj)
 	if err != nil {
-		return handleCreateError("IP Pool", id, err)
+		return getOperationDiagnostics(m, handleCreateError("IP Pool", id, err))
 	}
 
 	d.SetId(id)
 	d.Set("nsx_id", id)
-	return resourceNsxtPolicyIPPoolRead(d, m)
+	return resourceNsxtPolicyIPPoolRead(ctx, d, m)
 }
 
-func resourceNsxtPolicyIPPoolUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyIPPoolUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 	client := infra.NewIpPoolsClient(connector)
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining IP Pool ID")
+		return diag.Errorf("Error obtaining IP Pool ID")
 	}
 
 	displayName := d.Get("display_name").(string)
@@ -132,27 +133,27 @@ func resourceNsxtPolicyIPPoolUpdate(d *schema.ResourceData, m interface{}) error
 	log.Printf("[INFO] Updating IP Pool with ID %s", id)
 	err := client.Patch(id, obj)
 	if err != nil {
-		return handleUpdateError("IP Pool", id, err)
+		return getOperationDiagnostics(m, handleUpdateError("IP Pool", id, err))
 	}
 
 	d.SetId(id)
 	d.Set("nsx_id", id)
-	return resourceNsxtPolicyIPPoolRead(d, m)
+	return resourceNsxtPolicyIPPoolRead(ctx, d, m)
 }
 
-func resourceNsxtPolicyIPPoolDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyIPPoolDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 	client := infra.NewIpPoolsClient(connector)
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining IP Pool ID")
+		return diag.Errorf("Error obtaining IP Pool ID")
 	}
 
 	log.Printf("[INFO] Deleting IP Pool with ID %s", id)
 	err := client.Delete(id)
 	if err != nil {
-		return handleDeleteError("IP Pool", id, err)
+		return getOperationDiagnostics(m, handleDeleteError("IP Pool", id, err))
 	}
 
 	return nil
@@ -4,11 +4,13 @@
 package nsxt
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/vmware/vsphere-automation-sdk-go/runtime/bindings"
@@ -22,12 +24,12 @@ import (
 
 func resourceNsxtPolicyIPPoolBlockSubnet() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtPolicyIPPoolBlockSubnetCreate,
-		Read:   resourceNsxtPolicyIPPoolBlockSubnetRead,
-		Update: resourceNsxtPolicyIPPoolBlockSubnetUpdate,
-		Delete: resourceNsxtPolicyIPPoolBlockSubnetDelete,
+		CreateContext: resourceNsxtPolicyIPPoolBlockSubnetCreate,
+		ReadContext:   resourceNsxtPolicyIPPoolBlockSubnetRead,
+		UpdateContext: resourceNsxtPolicyIPPoolBlockSubnetUpdate,
+		DeleteContext: resourceNsxtPolicyIPPoolBlockSubnetDelete,
 		Importer: &schema.ResourceImporter{
-			State: resourceNsxtPolicyIPPoolSubnetImport,
+			StateContext: resourceNsxtPolicyIPPoolSubnetImport,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -86,7 +88,7 @@ func resourceNsxtPolicyIPPoolBlockSubnetSchemaToStructValue(d *schema.ResourceDa
 	return dataValue.(*data.StructValue), nil
 }
 
-func resourceNsxtPolicyIPPoolBlockSubnetRead(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyIPPoolBlockSubnetRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 	client := ip_pools.NewIpSubnetsClient(connector)
 	converter := bindings.NewTypeConverter()
@@ -96,7 +98,7 @@ func resourceNsxtPolicyIPPoolBlockSubnetRead(d *schema.ResourceData, m interface
 
 	id := d.Id()
 	if id == "" || poolID == "" {
-		return fmt.Errorf("Error obtaining Block Subnet ID")
+		return diag.Errorf("Error obtaining Block Subnet ID")
 	}
 
 	subnetData, err := client.Get(poolID, id)
@@ -106,12 +108,12 @@ func resourceNsxtPolicyIPPoolBlockSubnetRead(d *schema.ResourceData, m interface
 			log.Printf("[DEBUG] Block Subnet %s not found", id)
 			return nil
 		}
-		return handleReadError(d, "Block Subnet", id, err)
+		return getOperationDiagnostics(m, handleReadError(d, "Block Subnet", id, err))
 	}
 
 	snet, errs := converter.ConvertToGolang(subnetData, model.IpAddressPoolBlockSubnetBindingType())
 	if len(errs) > 0 {
-		return fmt.Errorf("Error converting Block Subnet %s", errs[0])
+		return diag.Errorf("Error converting Block Subnet %s", errs[0])
 	}
 	blockSubnet := snet.(model.IpAddressPoolBlockSubnet)
 
@@ -129,7 +131,7 @@ func resourceNsxtPolicyIPPoolBlockSubnetRead(d *schema.ResourceData, m interface
 	return nil
 }
 
-func resourceNsxtPolicyIPPoolBlockSubnetCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyIPPoolBlockSubnetCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 	client := ip_pools.NewIpSubnetsClient(connector)
 
@@ -142,29 +144,29 @@ func resourceNsxtPolicyIPPoolBlockSubnetCreate(d *schema.ResourceData, m interfa
 	} else {
 		_, err := client.Get(poolID, id)
 		if err == nil {
-			return fmt.Errorf("Block Subnet with ID '%s' already exists on Pool %s", id, poolID)
+			return diag.Errorf("Block Subnet with ID '%s' already exists on Pool %s", id, poolID)
 		} else if !isNotFoundError(err) {
-			return err
+			return getOperationDiagnostics(m, err)
 		}
 	}
 
 	dataValue, err := resourceNsxtPolicyIPPoolBlockSubnetSchemaToStructValue(d, id)
 	if err != nil {
-		return err
+		return getOperationDiagnostics(m, err)
 	}
 
 	log.Printf("[INFO] Creating IP Pool Block Subnet with ID %s", id)
 	err = client.Patch(poolID, id, dataValue)
 	if err != nil {
-		return handleCreateError("Block Subnet", id, err)
+		return getOperationDiagnostics(m, handleCreateError("Block Subnet", id, err))
 	}
 
 	d.SetId(id)
 	d.Set("nsx_id", id)
-	return resourceNsxtPolicyIPPoolBlockSubnetRead(d, m)
+	return resourceNsxtPolicyIPPoolBlockSubnetRead(ctx, d, m)
 }
 
-func resourceNsxtPolicyIPPoolBlockSubnetUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyIPPoolBlockSubnetUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 	client := ip_pools.NewIpSubnetsClient(connector)
 
@@ -173,26 +175,26 @@ func resourceNsxtPolicyIPPoolBlockSubnetUpdate(d *schema.ResourceData, m interfa
 
 	id := d.Id()
 	if id == "" || poolID == "" {
-		return fmt.Errorf("Error obtaining Block Subnet ID")
+		return diag.Errorf("Error obtaining Block Subnet ID")
 	}
 
 	dataValue, err := resourceNsxtPolicyIPPoolBlockSubnetSchemaToStructValue(d, id)
 	if err != nil {
-		return err
+		return getOperationDiagnostics(m, err)
 	}
 
 	log.Printf("[INFO] Creating IP Pool Block Subnet with ID %s", id)
 	err = client.Patch(poolID, id, dataValue)
 	if err != nil {
-		return handleUpdateError("Block Subnet", id, err)
+		return getOperationDiagnostics(m, handleUpdateError("Block Subnet", id, err))
 	}
 
 	d.SetId(id)
 	d.Set("nsx_id", id)
-	return resourceNsxtPolicyIPPoolBlockSubnetRead(d, m)
+	return resourceNsxtPolicyIPPoolBlockSubnetRead(ctx, d, m)
 }
 
-func resourceNsxtPolicyIPPoolBlockSubnetDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyIPPoolBlockSubnetDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 	client := ip_pools.NewIpSubnetsClient(connector)
 
@@ -201,20 +203,20 @@ func resourceNsxtPolicyIPPoolBlockSubnetDelete(d *schema.ResourceData, m interfa
 
 	id := d.Id()
 	if id == "" || poolID == "" {
-		return fmt.Errorf("Error obtaining Block Subnet ID")
+		return diag.Errorf("Error obtaining Block Subnet ID")
 	}
 
 	log.Printf("[INFO] Deleting Block Subnet with ID %s", id)
 	err := client.Delete(poolID, id)
 	if err != nil {
-		return handleDeleteError("Block Subnet", id, err)
+		return getOperationDiagnostics(m, handleDeleteError("Block Subnet", id, err))
 	}
 
-	return resourceNsxtPolicyIPPoolBlockSubnetVerifyDelete(d, connector)
+	return getOperationDiagnostics(m, resourceNsxtPolicyIPPoolBlockSubnetVerifyDelete(getProviderContext(m), d, connector))
 }
 
 // NOTE: This will not be needed when IPAM is handled by NSXT Policy
-func resourceNsxtPolicyIPPoolBlockSubnetVerifyDelete(d *schema.ResourceData, connector client.Connector) error {
+func resourceNsxtPolicyIPPoolBlockSubnetVerifyDelete(ctx context.Context, d *schema.ResourceData, connector client.Connector) error {
 
 	client := realized_state.NewRealizedEntitiesClient(connector)
 
@@ -243,7 +245,7 @@ func resourceNsxtPolicyIPPoolBlockSubnetVerifyDelete(d *schema.ResourceData, con
 		MinTimeout: 1 * time.Second,
 		Delay:      1 * time.Second,
 	}
-	_, err := stateConf.WaitForState()
+	_, err := stateConf.WaitForStateContext(ctx)
 	if err != nil {
 		return fmt.Errorf("Failed to confirm delete realization for %s: %v", path, err)
 	}
@@ -251,7 +253,7 @@ func resourceNsxtPolicyIPPoolBlockSubnetVerifyDelete(d *schema.ResourceData, con
 	return nil
 }
 
-func resourceNsxtPolicyIPPoolSubnetImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+func resourceNsxtPolicyIPPoolSubnetImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	importID := d.Id()
 	s := strings.Split(importID, "/")
 	if len(s) != 2 {
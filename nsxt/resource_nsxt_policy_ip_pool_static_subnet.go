@@ -4,9 +4,11 @@
 package nsxt
 
 import (
+	"context"
 	"fmt"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/vmware/vsphere-automation-sdk-go/runtime/bindings"
 	"github.com/vmware/vsphere-automation-sdk-go/runtime/data"
@@ -16,12 +18,12 @@ import (
 
 func resourceNsxtPolicyIPPoolStaticSubnet() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtPolicyIPPoolStaticSubnetCreate,
-		Read:   resourceNsxtPolicyIPPoolStaticSubnetRead,
-		Update: resourceNsxtPolicyIPPoolStaticSubnetUpdate,
-		Delete: resourceNsxtPolicyIPPoolStaticSubnetDelete,
+		CreateContext: resourceNsxtPolicyIPPoolStaticSubnetCreate,
+		ReadContext:   resourceNsxtPolicyIPPoolStaticSubnetRead,
+		UpdateContext: resourceNsxtPolicyIPPoolStaticSubnetUpdate,
+		DeleteContext: resourceNsxtPolicyIPPoolStaticSubnetDelete,
 		Importer: &schema.ResourceImporter{
-			State: resourceNsxtPolicyIPPoolSubnetImport,
+			StateContext: resourceNsxtPolicyIPPoolSubnetImport,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -117,7 +119,7 @@ func resourceNsxtPolicyIPPoolStaticSubnetSchemaToStructValue(d *schema.ResourceD
 	return dataValue.(*data.StructValue), nil
 }
 
-func resourceNsxtPolicyIPPoolStaticSubnetRead(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyIPPoolStaticSubnetRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 	client := ip_pools.NewIpSubnetsClient(connector)
 	converter := bindings.NewTypeConverter()
@@ -127,7 +129,7 @@ func resourceNsxtPolicyIPPoolStaticSubnetRead(d *schema.ResourceData, m interfac
 
 	id := d.Id()
 	if id == "" || poolID == "" {
-		return fmt.Errorf("Error obtaining Static Subnet ID")
+		return diag.Errorf("Error obtaining Static Subnet ID")
 	}
 
 	subnetData, err := client.Get(poolID, id)
@@ -137,12 +139,12 @@ func resourceNsxtPolicyIPPoolStaticSubnetRead(d *schema.ResourceData, m interfac
 			log.Printf("[DEBUG] Static Subnet %s not found", id)
 			return nil
 		}
-		return handleReadError(d, "Static Subnet", id, err)
+		return getOperationDiagnostics(m, handleReadError(d, "Static Subnet", id, err))
 	}
 
 	snet, errs := converter.ConvertToGolang(subnetData, model.IpAddressPoolStaticSubnetBindingType())
 	if len(errs) > 0 {
-		return fmt.Errorf("Error converting Static Subnet %s", errs[0])
+		return diag.Errorf("Error converting Static Subnet %s", errs[0])
 	}
 	staticSubnet := snet.(model.IpAddressPoolStaticSubnet)
 
@@ -170,7 +172,7 @@ func resourceNsxtPolicyIPPoolStaticSubnetRead(d *schema.ResourceData, m interfac
 	return nil
 }
 
-func resourceNsxtPolicyIPPoolStaticSubnetCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyIPPoolStaticSubnetCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 	client := ip_pools.NewIpSubnetsClient(connector)
 
@@ -183,29 +185,29 @@ func resourceNsxtPolicyIPPoolStaticSubnetCreate(d *schema.ResourceData, m interf
 	} else {
 		_, err := client.Get(poolID, id)
 		if err == nil {
-			return fmt.Errorf("Static Subnet with ID '%s' already exists on Pool %s", id, poolID)
+			return diag.Errorf("Static Subnet with ID '%s' already exists on Pool %s", id, poolID)
 		} else if !isNotFoundError(err) {
-			return err
+			return getOperationDiagnostics(m, err)
 		}
 	}
 
 	dataValue, err := resourceNsxtPolicyIPPoolStaticSubnetSchemaToStructValue(d, id)
 	if err != nil {
-		return err
+		return getOperationDiagnostics(m, err)
 	}
 
 	log.Printf("[INFO] Creating IP Pool Static Subnet with ID %s", id)
 	err = client.Patch(poolID, id, dataValue)
 	if err != nil {
-		return handleCreateError("Static Subnet", id, err)
+		return getOperationDiagnostics(m, handleCreateError("Static Subnet", id, err))
 	}
 
 	d.SetId(id)
 	d.Set("nsx_id", id)
-	return resourceNsxtPolicyIPPoolStaticSubnetRead(d, m)
+	return resourceNsxtPolicyIPPoolStaticSubnetRead(ctx, d, m)
 }
 
-func resourceNsxtPolicyIPPoolStaticSubnetUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyIPPoolStaticSubnetUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 	client := ip_pools.NewIpSubnetsClient(connector)
 
@@ -214,26 +216,26 @@ func resourceNsxtPolicyIPPoolStaticSubnetUpdate(d *schema.ResourceData, m interf
 
 	id := d.Id()
 	if id == "" || poolID == "" {
-		return fmt.Errorf("Error obtaining Static Subnet ID")
+		return diag.Errorf("Error obtaining Static Subnet ID")
 	}
 
 	dataValue, err := resourceNsxtPolicyIPPoolStaticSubnetSchemaToStructValue(d, id)
 	if err != nil {
-		return err
+		return getOperationDiagnostics(m, err)
 	}
 
 	log.Printf("[INFO] Updating IP Pool Static Subnet with ID %s", id)
 	err = client.Patch(poolID, id, dataValue)
 	if err != nil {
-		return handleUpdateError("Static Subnet", id, err)
+		return getOperationDiagnostics(m, handleUpdateError("Static Subnet", id, err))
 	}
 
 	d.SetId(id)
 	d.Set("nsx_id", id)
-	return resourceNsxtPolicyIPPoolStaticSubnetRead(d, m)
+	return resourceNsxtPolicyIPPoolStaticSubnetRead(ctx, d, m)
 }
 
-func resourceNsxtPolicyIPPoolStaticSubnetDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyIPPoolStaticSubnetDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 	client := ip_pools.NewIpSubnetsClient(connector)
 
@@ -242,13 +244,13 @@ func resourceNsxtPolicyIPPoolStaticSubnetDelete(d *schema.ResourceData, m interf
 
 	id := d.Id()
 	if id == "" || poolID == "" {
-		return fmt.Errorf("Error obtaining Static Subnet ID")
+		return diag.Errorf("Error obtaining Static Subnet ID")
 	}
 
 	log.Printf("[INFO] Deleting Static Subnet with ID %s", id)
 	err := client.Delete(poolID, id)
 	if err != nil {
-		return handleDeleteError("Static Subnet", id, err)
+		return getOperationDiagnostics(m, handleDeleteError("Static Subnet", id, err))
 	}
 
 	return nil
@@ -172,3 +172,33 @@ resource "nsxt_policy_ip_pool" "test" {
   }
 }`, name)
 }
+
+func TestUnitResourceNsxtPolicyIPPool_basic(t *testing.T) {
+	fake := newFakeNsxServer(t)
+	meta := testUnitConfigureProvider(t, fake)
+	resourceName := "nsxt_policy_ip_pool"
+
+	state := testUnitApplyResource(t, meta, resourceName, nil, map[string]interface{}{
+		"display_name": "pool1",
+		"nsx_id":       "pool1",
+	})
+	testUnitCheckAttr(t, state, "id", "pool1")
+	testUnitCheckAttr(t, state, "path", "/infra/ip-pools/pool1")
+
+	dataState := testUnitReadDataSource(t, meta, resourceName, map[string]interface{}{
+		"display_name": "pool1",
+	})
+	testUnitCheckAttr(t, dataState, "id", "pool1")
+
+	state = testUnitApplyResource(t, meta, resourceName, state, map[string]interface{}{
+		"display_name": "pool2",
+		"nsx_id":       "pool1",
+	})
+	testUnitCheckAttr(t, state, "display_name", "pool2")
+	testUnitCheckAttr(t, state, "revision", "1")
+
+	testUnitDestroyResource(t, meta, resourceName, state)
+	if fake.getObject("/infra/ip-pools/pool1") != nil {
+		t.Fatalf("IP pool was not deleted on NSX")
+	}
+}
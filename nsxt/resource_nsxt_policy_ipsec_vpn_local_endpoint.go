@@ -4,10 +4,12 @@
 package nsxt
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
@@ -20,12 +22,12 @@ import (
 
 func resourceNsxtPolicyIPSecVpnLocalEndpoint() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtPolicyIPSecVpnLocalEndpointCreate,
-		Read:   resourceNsxtPolicyIPSecVpnLocalEndpointRead,
-		Update: resourceNsxtPolicyIPSecVpnLocalEndpointUpdate,
-		Delete: resourceNsxtPolicyIPSecVpnLocalEndpointDelete,
+		CreateContext: resourceNsxtPolicyIPSecVpnLocalEndpointCreate,
+		ReadContext:   resourceNsxtPolicyIPSecVpnLocalEndpointRead,
+		UpdateContext: resourceNsxtPolicyIPSecVpnLocalEndpointUpdate,
+		DeleteContext: resourceNsxtPolicyIPSecVpnLocalEndpointDelete,
 		Importer: &schema.ResourceImporter{
-			State: nsxtVPNServiceResourceImporter,
+			StateContext: nsxtVPNServiceResourceImporter,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -223,13 +225,13 @@ func ipSecVpnLocalEndpointInitStruct(d *schema.ResourceData) model.IPSecVpnLocal
 	return obj
 }
 
-func resourceNsxtPolicyIPSecVpnLocalEndpointCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyIPSecVpnLocalEndpointCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 
 	servicePath := d.Get("service_path").(string)
 	id, err := getOrGenerateID(d, m, resourceNsxtPolicyIPSecVpnLocalEndpointExists(servicePath))
 	if err != nil {
-		return err
+		return getOperationDiagnostics(m, err)
 	}
 
 	obj := ipSecVpnLocalEndpointInitStruct(d)
@@ -237,35 +239,35 @@ func resourceNsxtPolicyIPSecVpnLocalEndpointCreate(d *schema.ResourceData, m int
 	log.Printf("[INFO] Creating IPSecVpnLocalEndpoint with ID %s", id)
 	client, err := newLocalEndpointClient(servicePath)
 	if err != nil {
-		return handleCreateError("IPSecVpnLocalEndpoint", id, err)
+		return getOperationDiagnostics(m, handleCreateError("IPSecVpnLocalEndpoint", id, err))
 	}
 	err = client.Patch(connector, id, obj)
 	if err != nil {
-		return handleCreateError("IPSecVpnLocalEndpoint", id, err)
+		return getOperationDiagnostics(m, handleCreateError("IPSecVpnLocalEndpoint", id, err))
 	}
 
 	d.SetId(id)
 	d.Set("nsx_id", id)
 
-	return resourceNsxtPolicyIPSecVpnLocalEndpointRead(d, m)
+	return resourceNsxtPolicyIPSecVpnLocalEndpointRead(ctx, d, m)
 }
 
-func resourceNsxtPolicyIPSecVpnLocalEndpointRead(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyIPSecVpnLocalEndpointRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining IPSecVpnLocalEndpoint ID")
+		return diag.Errorf("Error obtaining IPSecVpnLocalEndpoint ID")
 	}
 
 	servicePath := d.Get("service_path").(string)
 	client, err := newLocalEndpointClient(servicePath)
 	if err != nil {
-		return handleReadError(d, "IPSecVpnLocalEndpoint", id, err)
+		return getOperationDiagnostics(m, handleReadError(d, "IPSecVpnLocalEndpoint", id, err))
 	}
 	obj, err := client.Get(connector, id)
 	if err != nil {
-		return handleReadError(d, "IPSecVpnLocalEndpoint", id, err)
+		return getOperationDiagnostics(m, handleReadError(d, "IPSecVpnLocalEndpoint", id, err))
 	}
 
 	d.Set("display_name", obj.DisplayName)
@@ -283,19 +285,19 @@ func resourceNsxtPolicyIPSecVpnLocalEndpointRead(d *schema.ResourceData, m inter
 	return nil
 }
 
-func resourceNsxtPolicyIPSecVpnLocalEndpointUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyIPSecVpnLocalEndpointUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining IPSecVpnLocalEndpoint ID")
+		return diag.Errorf("Error obtaining IPSecVpnLocalEndpoint ID")
 	}
 
 	servicePath := d.Get("service_path").(string)
 
 	client, err := newLocalEndpointClient(servicePath)
 	if err != nil {
-		return handleUpdateError("IPSecVpnLocalEndpoint", id, err)
+		return getOperationDiagnostics(m, handleUpdateError("IPSecVpnLocalEndpoint", id, err))
 	}
 
 	obj := ipSecVpnLocalEndpointInitStruct(d)
@@ -305,34 +307,34 @@ func resourceNsxtPolicyIPSecVpnLocalEndpointUpdate(d *schema.ResourceData, m int
 	log.Printf("[INFO] Updating IPSecVpnLocalEndpoint with ID %s", id)
 	err = client.Patch(connector, id, obj)
 	if err != nil {
-		return handleUpdateError("IPSecVpnLocalEndpoint", id, err)
+		return getOperationDiagnostics(m, handleUpdateError("IPSecVpnLocalEndpoint", id, err))
 	}
 
-	return resourceNsxtPolicyIPSecVpnLocalEndpointRead(d, m)
+	return resourceNsxtPolicyIPSecVpnLocalEndpointRead(ctx, d, m)
 }
 
-func resourceNsxtPolicyIPSecVpnLocalEndpointDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyIPSecVpnLocalEndpointDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining IPSecVpnLocalEndpoint ID")
+		return diag.Errorf("Error obtaining IPSecVpnLocalEndpoint ID")
 	}
 
 	servicePath := d.Get("service_path").(string)
 	client, err := newLocalEndpointClient(servicePath)
 	if err != nil {
-		return handleUpdateError("IPSecVpnLocalEndpoint", id, err)
+		return getOperationDiagnostics(m, handleUpdateError("IPSecVpnLocalEndpoint", id, err))
 	}
 	connector := getPolicyConnector(m)
 	err = client.Delete(connector, id)
 
 	if err != nil {
-		return handleDeleteError("IPSecVpnLocalEndpoint", id, err)
+		return getOperationDiagnostics(m, handleDeleteError("IPSecVpnLocalEndpoint", id, err))
 	}
 
 	return nil
 }
 
-func nsxtVPNServiceResourceImporter(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+func nsxtVPNServiceResourceImporter(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	importID := d.Id()
 	err := fmt.Errorf("VPN Local Endpoint Path expected, got %s", importID)
 	// path format example: /infra/tier-1s/aaa/locale-services/default/ipsec-vpn-services/bbb/local-endpoints/ccc
@@ -4,10 +4,12 @@
 package nsxt
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/vmware/vsphere-automation-sdk-go/runtime/bindings"
@@ -63,12 +65,12 @@ var IPSecRulesActionValues = []string{
 
 func resourceNsxtPolicyIPSecVpnSession() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtPolicyIPSecVpnSessionCreate,
-		Read:   resourceNsxtPolicyIPSecVpnSessionRead,
-		Update: resourceNsxtPolicyIPSecVpnSessionUpdate,
-		Delete: resourceNsxtPolicyIPSecVpnSessionDelete,
+		CreateContext: resourceNsxtPolicyIPSecVpnSessionCreate,
+		ReadContext:   resourceNsxtPolicyIPSecVpnSessionRead,
+		UpdateContext: resourceNsxtPolicyIPSecVpnSessionUpdate,
+		DeleteContext: resourceNsxtPolicyIPSecVpnSessionDelete,
 		Importer: &schema.ResourceImporter{
-			State: nsxtVpnSessionImporter,
+			StateContext: nsxtVpnSessionImporter,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -192,7 +194,7 @@ func getIPSecVPNSessionFromSchema(d *schema.ResourceData) (*data.StructValue, er
 		tunnelInterface := interfaceListToStringList(d.Get("ip_addresses").([]interface{}))
 
 		if len(tunnelInterface) == 0 || prefixLengh == 0 {
-			return nil, fmt.Errorf("ip_addresses and prefix_length need to be specified for Route Based VPN session")
+			return nil, newAttributeError("ip_addresses", fmt.Errorf("ip_addresses and prefix_length need to be specified for Route Based VPN session"))
 		}
 		var ipSubnets []model.TunnelInterfaceIPSubnet
 		ipSubnet := model.TunnelInterfaceIPSubnet{
@@ -518,12 +520,12 @@ func getIPSecVPNRulesFromSchema(d *schema.ResourceData) []model.IPSecVpnRule {
 	return nil
 }
 
-func resourceNsxtPolicyIPSecVpnSessionCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyIPSecVpnSessionCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 
 	servicePath := d.Get("service_path").(string)
 	client, err := newIpsecSessionClient(servicePath)
 	if err != nil {
-		return err
+		return getOperationDiagnostics(m, err)
 	}
 
 	id := d.Get("nsx_id").(string)
@@ -535,24 +537,24 @@ func resourceNsxtPolicyIPSecVpnSessionCreate(d *schema.ResourceData, m interface
 
 	_, err = resourceNsxtPolicyIPSecVpnSessionExists(servicePath, id, connector)
 	if err == nil {
-		return fmt.Errorf("IPSecVpnSession with nsx_id '%s' already exists under IPSecVpnService '%s'", id, servicePath)
+		return getErrorDiagnostics(newAttributeError("nsx_id", fmt.Errorf("IPSecVpnSession with nsx_id '%s' already exists under IPSecVpnService '%s'", id, servicePath)))
 	} else if !isNotFoundError(err) {
-		return err
+		return getOperationDiagnostics(m, err)
 	}
 
 	obj, err := getIPSecVPNSessionFromSchema(d)
 	if err != nil {
-		return err
+		return getOperationDiagnostics(m, err)
 	}
 
 	err = client.Patch(connector, id, obj)
 	if err != nil {
-		return handleCreateError("IPSecVpnSession", id, err)
+		return getOperationDiagnostics(m, handleCreateError("IPSecVpnSession", id, err))
 	}
 	d.SetId(id)
 	d.Set("nsx_id", id)
 
-	return resourceNsxtPolicyIPSecVpnSessionRead(d, m)
+	return resourceNsxtPolicyIPSecVpnSessionRead(ctx, d, m)
 }
 
 func resourceNsxtPolicyIPSecVpnSessionExists(servicePath string, sessionID string, connector client.Connector) (bool, error) {
@@ -572,20 +574,20 @@ func resourceNsxtPolicyIPSecVpnSessionExists(servicePath string, sessionID strin
 	return false, logAPIError("Error retrieving resource", err)
 }
 
-func resourceNsxtPolicyIPSecVpnSessionRead(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyIPSecVpnSessionRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 	converter := bindings.NewTypeConverter()
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining IPSecVpnSession ID")
+		return diag.Errorf("Error obtaining IPSecVpnSession ID")
 	}
 
 	servicePath := d.Get("service_path").(string)
 
 	client, err := newIpsecSessionClient(servicePath)
 	if err != nil {
-		return handleReadError(d, "IpsecVpnSession", id, err)
+		return getOperationDiagnostics(m, handleReadError(d, "IpsecVpnSession", id, err))
 	}
 	obj, err := client.Get(connector, id)
 	if err != nil {
@@ -593,12 +595,12 @@ func resourceNsxtPolicyIPSecVpnSessionRead(d *schema.ResourceData, m interface{}
 			d.SetId("")
 			return nil
 		}
-		return handleReadError(d, "IpsecVpnSession", id, err)
+		return getOperationDiagnostics(m, handleReadError(d, "IpsecVpnSession", id, err))
 	}
 
 	baseObj, errs := converter.ConvertToGolang(obj, model.IPSecVpnSessionBindingType())
 	if len(errs) > 0 {
-		return fmt.Errorf("Error converting IpsecVpnSession %s", errs[0])
+		return diag.Errorf("Error converting IpsecVpnSession %s", errs[0])
 	}
 	baseVPN := baseObj.(model.IPSecVpnSession)
 	resourceType := baseVPN.ResourceType
@@ -606,7 +608,7 @@ func resourceNsxtPolicyIPSecVpnSessionRead(d *schema.ResourceData, m interface{}
 	if resourceType == model.IPSecVpnSession_RESOURCE_TYPE_ROUTEBASEDIPSECVPNSESSION {
 		interfaceVpn, errs := converter.ConvertToGolang(obj, model.RouteBasedIPSecVpnSessionBindingType())
 		if len(errs) > 0 {
-			return fmt.Errorf("Error converting IpsecVpnSession %s", errs[0])
+			return diag.Errorf("Error converting IpsecVpnSession %s", errs[0])
 		}
 		blockVPN := interfaceVpn.(model.RouteBasedIPSecVpnSession)
 
@@ -660,7 +662,7 @@ func resourceNsxtPolicyIPSecVpnSessionRead(d *schema.ResourceData, m interface{}
 		// Policy based ipsec vpn session
 		interfaceVpn, errs := converter.ConvertToGolang(obj, model.PolicyBasedIPSecVpnSessionBindingType())
 		if len(errs) > 0 {
-			return fmt.Errorf("Error converting IpsecVpnSession %s", errs[0])
+			return diag.Errorf("Error converting IpsecVpnSession %s", errs[0])
 		}
 		blockVPN := interfaceVpn.(model.PolicyBasedIPSecVpnSession)
 
@@ -696,55 +698,55 @@ func resourceNsxtPolicyIPSecVpnSessionRead(d *schema.ResourceData, m interface{}
 	return nil
 }
 
-func resourceNsxtPolicyIPSecVpnSessionUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyIPSecVpnSessionUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining IPSecVpnSession ID")
+		return diag.Errorf("Error obtaining IPSecVpnSession ID")
 	}
 
 	servicePath := d.Get("service_path").(string)
 	client, err := newIpsecSessionClient(servicePath)
 	if err != nil {
-		return handleUpdateError("IPSecVpnSession", id, err)
+		return getOperationDiagnostics(m, handleUpdateError("IPSecVpnSession", id, err))
 	}
 	obj, err := getIPSecVPNSessionFromSchema(d)
 	if err != nil {
-		return err
+		return getOperationDiagnostics(m, err)
 	}
 
 	err = client.Patch(connector, id, obj)
 	if err != nil {
-		return handleUpdateError("IPSecVpnSession", id, err)
+		return getOperationDiagnostics(m, handleUpdateError("IPSecVpnSession", id, err))
 	}
 
 	d.Set("nsx_id", id)
-	return resourceNsxtPolicyIPSecVpnSessionRead(d, m)
+	return resourceNsxtPolicyIPSecVpnSessionRead(ctx, d, m)
 
 }
 
-func resourceNsxtPolicyIPSecVpnSessionDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyIPSecVpnSessionDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining IPSecVpnSession ID")
+		return diag.Errorf("Error obtaining IPSecVpnSession ID")
 	}
 	servicePath := d.Get("service_path").(string)
 	client, err := newIpsecSessionClient(servicePath)
 	if err != nil {
-		return handleUpdateError("IPSecVpnSession", id, err)
+		return getOperationDiagnostics(m, handleUpdateError("IPSecVpnSession", id, err))
 	}
 	connector := getPolicyConnector(m)
 	err = client.Delete(connector, id)
 	if err != nil {
-		return handleDeleteError("IPSecVpnSession", id, err)
+		return getOperationDiagnostics(m, handleDeleteError("IPSecVpnSession", id, err))
 	}
 
 	return nil
 }
 
-func nsxtVpnSessionImporter(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+func nsxtVpnSessionImporter(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	importID := d.Id()
 	err := fmt.Errorf("Expected VPN session path, got %s", importID)
 	// Path should be like /infra/tier-1s/aaa/locale-services/default/ipsec-vpn-services/bbb/sessions/ccc
@@ -4,10 +4,12 @@
 package nsxt
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
@@ -25,12 +27,12 @@ var L2VpnServiceModes = []string{
 
 func resourceNsxtPolicyL2VpnService() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtPolicyL2VpnServiceCreate,
-		Read:   resourceNsxtPolicyL2VpnServiceRead,
-		Update: resourceNsxtPolicyL2VpnServiceUpdate,
-		Delete: resourceNsxtPolicyL2VpnServiceDelete,
+		CreateContext: resourceNsxtPolicyL2VpnServiceCreate,
+		ReadContext:   resourceNsxtPolicyL2VpnServiceRead,
+		UpdateContext: resourceNsxtPolicyL2VpnServiceUpdate,
+		DeleteContext: resourceNsxtPolicyL2VpnServiceDelete,
 		Importer: &schema.ResourceImporter{
-			State: resourceNsxtPolicyL2VpnServiceImport,
+			StateContext: resourceNsxtPolicyL2VpnServiceImport,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -128,7 +130,7 @@ func deleteNsxtPolicyL2VpnService(connector client.Connector, gwID string, local
 	return client.Delete(gwID, localeServiceID, id)
 }
 
-func resourceNsxtPolicyL2VpnServiceImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+func resourceNsxtPolicyL2VpnServiceImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	importID := d.Id()
 	s := strings.Split(importID, "/")
 	err := fmt.Errorf("Expected policy path for the L2 VPN Service, got %s", importID)
@@ -151,12 +153,12 @@ func resourceNsxtPolicyL2VpnServiceImport(d *schema.ResourceData, m interface{})
 	return []*schema.ResourceData{d}, nil
 }
 
-func resourceNsxtPolicyL2VpnServiceRead(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyL2VpnServiceRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining L2VpnService ID")
+		return diag.Errorf("Error obtaining L2VpnService ID")
 	}
 	gatewayPath, localeServicePath, err := getLocaleServiceAndGatewayPath(d)
 	if err != nil {
@@ -164,14 +166,14 @@ func resourceNsxtPolicyL2VpnServiceRead(d *schema.ResourceData, m interface{}) e
 	}
 	isT0, gwID, localeServiceID, err := parseLocaleServicePolicyPath(localeServicePath)
 	if err != nil && gatewayPath == "" {
-		return err
+		return getOperationDiagnostics(m, err)
 	}
 	if localeServiceID == "" {
 		isT0, gwID = parseGatewayPolicyPath(gatewayPath)
 	}
 	obj, err := getNsxtPolicyL2VpnServiceByID(connector, gwID, isT0, localeServiceID, id, isPolicyGlobalManager(m))
 	if err != nil {
-		return handleReadError(d, "L2VpnService", id, err)
+		return getOperationDiagnostics(m, handleReadError(d, "L2VpnService", id, err))
 	}
 	d.Set("display_name", obj.DisplayName)
 	d.Set("description", obj.Description)
@@ -188,7 +190,7 @@ func resourceNsxtPolicyL2VpnServiceRead(d *schema.ResourceData, m interface{}) e
 	return nil
 }
 
-func resourceNsxtPolicyL2VpnServiceCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyL2VpnServiceCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 	gatewayPath, localeServicePath, err := getLocaleServiceAndGatewayPath(d)
 	if err != nil {
@@ -196,7 +198,7 @@ func resourceNsxtPolicyL2VpnServiceCreate(d *schema.ResourceData, m interface{})
 	}
 	isT0, gwID, localeServiceID, err := parseLocaleServicePolicyPath(localeServicePath)
 	if err != nil && gatewayPath == "" {
-		return err
+		return getOperationDiagnostics(m, err)
 	}
 	if localeServiceID == "" {
 		isT0, gwID = parseGatewayPolicyPath(gatewayPath)
@@ -208,9 +210,9 @@ func resourceNsxtPolicyL2VpnServiceCreate(d *schema.ResourceData, m interface{})
 	} else {
 		_, err := getNsxtPolicyL2VpnServiceByID(connector, gwID, isT0, localeServiceID, id, isGlobalManager)
 		if err == nil {
-			return fmt.Errorf("L2VpnService with nsx_id '%s' already exists", id)
+			return getErrorDiagnostics(newAttributeError("nsx_id", fmt.Errorf("L2VpnService with nsx_id '%s' already exists", id)))
 		} else if !isNotFoundError(err) {
-			return err
+			return getOperationDiagnostics(m, err)
 		}
 	}
 
@@ -240,19 +242,19 @@ func resourceNsxtPolicyL2VpnServiceCreate(d *schema.ResourceData, m interface{})
 
 	err = patchNsxtPolicyL2VpnService(connector, gwID, localeServiceID, l2VpnService, isT0)
 	if err != nil {
-		return handleCreateError("L2VpnService", id, err)
+		return getOperationDiagnostics(m, handleCreateError("L2VpnService", id, err))
 	}
 	d.SetId(id)
 	d.Set("nsx_id", id)
-	return resourceNsxtPolicyL2VpnServiceRead(d, m)
+	return resourceNsxtPolicyL2VpnServiceRead(ctx, d, m)
 }
 
-func resourceNsxtPolicyL2VpnServiceUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyL2VpnServiceUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining L2 VPN Service ID")
+		return diag.Errorf("Error obtaining L2 VPN Service ID")
 	}
 	gatewayPath, localeServicePath, err := getLocaleServiceAndGatewayPath(d)
 	if err != nil {
@@ -260,7 +262,7 @@ func resourceNsxtPolicyL2VpnServiceUpdate(d *schema.ResourceData, m interface{})
 	}
 	isT0, gwID, localeServiceID, err := parseLocaleServicePolicyPath(localeServicePath)
 	if err != nil && gatewayPath == "" {
-		return err
+		return getOperationDiagnostics(m, err)
 	}
 	if localeServiceID == "" {
 		isT0, gwID = parseGatewayPolicyPath(gatewayPath)
@@ -295,17 +297,17 @@ func resourceNsxtPolicyL2VpnServiceUpdate(d *schema.ResourceData, m interface{})
 	log.Printf("[INFO] Updating L2VpnService with ID %s", id)
 	err = patchNsxtPolicyL2VpnService(connector, gwID, localeServiceID, l2VpnService, isT0)
 	if err != nil {
-		return handleUpdateError("L2VpnService", id, err)
+		return getOperationDiagnostics(m, handleUpdateError("L2VpnService", id, err))
 	}
 	d.SetId(id)
 	d.Set("nsx_id", id)
-	return resourceNsxtPolicyL2VpnServiceRead(d, m)
+	return resourceNsxtPolicyL2VpnServiceRead(ctx, d, m)
 }
 
-func resourceNsxtPolicyL2VpnServiceDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyL2VpnServiceDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining L2 VPN Service ID")
+		return diag.Errorf("Error obtaining L2 VPN Service ID")
 	}
 
 	gatewayPath, localeServicePath, err := getLocaleServiceAndGatewayPath(d)
@@ -314,7 +316,7 @@ func resourceNsxtPolicyL2VpnServiceDelete(d *schema.ResourceData, m interface{})
 	}
 	isT0, gwID, localeServiceID, err := parseLocaleServicePolicyPath(localeServicePath)
 	if err != nil && gatewayPath == "" {
-		return err
+		return getOperationDiagnostics(m, err)
 	}
 	if localeServiceID == "" {
 		isT0, gwID = parseGatewayPolicyPath(gatewayPath)
@@ -322,7 +324,7 @@ func resourceNsxtPolicyL2VpnServiceDelete(d *schema.ResourceData, m interface{})
 
 	err = deleteNsxtPolicyL2VpnService(getPolicyConnector(m), gwID, localeServiceID, isT0, id)
 	if err != nil {
-		return handleDeleteError("L2VpnService", id, err)
+		return getOperationDiagnostics(m, handleDeleteError("L2VpnService", id, err))
 	}
 	return nil
 }
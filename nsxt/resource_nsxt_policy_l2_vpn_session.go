@@ -4,9 +4,11 @@
 package nsxt
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
@@ -28,12 +30,12 @@ var L2VpnTunnelEncapsulationProtocal = []string{
 
 func resourceNsxtPolicyL2VPNSession() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtPolicyL2VPNSessionCreate,
-		Read:   resourceNsxtPolicyL2VPNSessionRead,
-		Update: resourceNsxtPolicyL2VPNSessionUpdate,
-		Delete: resourceNsxtPolicyL2VPNSessionDelete,
+		CreateContext: resourceNsxtPolicyL2VPNSessionCreate,
+		ReadContext:   resourceNsxtPolicyL2VPNSessionRead,
+		UpdateContext: resourceNsxtPolicyL2VPNSessionUpdate,
+		DeleteContext: resourceNsxtPolicyL2VPNSessionDelete,
 		Importer: &schema.ResourceImporter{
-			State: nsxtVpnSessionImporter,
+			StateContext: nsxtVpnSessionImporter,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -93,13 +95,13 @@ func resourceNsxtPolicyL2VPNSession() *schema.Resource {
 	}
 }
 
-func resourceNsxtPolicyL2VPNSessionCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyL2VPNSessionCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 
 	servicePath := d.Get("service_path").(string)
 	isT0, gwID, localeServiceID, serviceID, err := parseL2VPNServicePolicyPath(servicePath)
 	if err != nil {
-		return err
+		return getOperationDiagnostics(m, err)
 	}
 	transportTunnel := getStringListFromSchemaList(d, "transport_tunnels")
 	id := d.Get("nsx_id").(string)
@@ -108,9 +110,9 @@ func resourceNsxtPolicyL2VPNSessionCreate(d *schema.ResourceData, m interface{})
 	}
 	_, err = resourceNsxtPolicyL2VpnSessionExists(isT0, gwID, localeServiceID, serviceID, id, connector)
 	if err == nil {
-		return fmt.Errorf("L2VpnSession with nsx_id '%s' already exists.'", id)
+		return getErrorDiagnostics(newAttributeError("nsx_id", fmt.Errorf("L2VpnSession with nsx_id '%s' already exists.'", id)))
 	} else if !isNotFoundError(err) {
-		return err
+		return getOperationDiagnostics(m, err)
 	}
 	displayName := d.Get("display_name").(string)
 	description := d.Get("description").(string)
@@ -168,13 +170,13 @@ func resourceNsxtPolicyL2VPNSessionCreate(d *schema.ResourceData, m interface{})
 		}
 	}
 	if err != nil {
-		return handleCreateError("L2VPNSession", id, err)
+		return getOperationDiagnostics(m, handleCreateError("L2VPNSession", id, err))
 	}
 
 	d.SetId(id)
 	d.Set("nsx_id", id)
 
-	return resourceNsxtPolicyL2VPNSessionRead(d, m)
+	return resourceNsxtPolicyL2VPNSessionRead(ctx, d, m)
 }
 
 func parseL2VPNServicePolicyPath(path string) (bool, string, string, string, error) {
@@ -199,16 +201,16 @@ func parseL2VPNServicePolicyPath(path string) (bool, string, string, string, err
 	return isT0, gwID, localeServiceID, serviceID, nil
 }
 
-func resourceNsxtPolicyL2VPNSessionRead(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyL2VPNSessionRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 	servicePath := d.Get("service_path").(string)
 	isT0, gwID, localeServiceID, serviceID, err := parseL2VPNServicePolicyPath(servicePath)
 	if err != nil {
-		return err
+		return getOperationDiagnostics(m, err)
 	}
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining L2VPNSession ID")
+		return diag.Errorf("Error obtaining L2VPNSession ID")
 	}
 
 	var obj model.L2VPNSession
@@ -230,7 +232,7 @@ func resourceNsxtPolicyL2VPNSessionRead(d *schema.ResourceData, m interface{}) e
 		}
 	}
 	if err != nil {
-		return handleReadError(d, "L2VPNSession", id, err)
+		return getOperationDiagnostics(m, handleReadError(d, "L2VPNSession", id, err))
 	}
 
 	d.Set("display_name", obj.DisplayName)
@@ -296,18 +298,18 @@ func resourceNsxtPolicyL2VpnSessionExists(isT0 bool, gwID string, localeServiceI
 	return false, logAPIError("Error retrieving resource", err)
 }
 
-func resourceNsxtPolicyL2VPNSessionUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyL2VPNSessionUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 	servicePath := d.Get("service_path").(string)
 	isT0, gwID, localeServiceID, serviceID, err := parseL2VPNServicePolicyPath(servicePath)
 	if err != nil {
-		return err
+		return getOperationDiagnostics(m, err)
 	}
 	transportTunnel := getStringListFromSchemaList(d, "transport_tunnels")
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining L2VPNSession ID")
+		return diag.Errorf("Error obtaining L2VPNSession ID")
 	}
 
 	// Read the rest of the configured parameters
@@ -367,23 +369,23 @@ func resourceNsxtPolicyL2VPNSessionUpdate(d *schema.ResourceData, m interface{})
 	}
 
 	if err != nil {
-		return handleUpdateError("L2VPNSession", id, err)
+		return getOperationDiagnostics(m, handleUpdateError("L2VPNSession", id, err))
 	}
 
-	return resourceNsxtPolicyL2VPNSessionRead(d, m)
+	return resourceNsxtPolicyL2VPNSessionRead(ctx, d, m)
 
 }
 
-func resourceNsxtPolicyL2VPNSessionDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyL2VPNSessionDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 
 	servicePath := d.Get("service_path").(string)
 	isT0, gwID, localeServiceID, serviceID, err := parseL2VPNServicePolicyPath(servicePath)
 	if err != nil {
-		return err
+		return getOperationDiagnostics(m, err)
 	}
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining L2VPNSession ID")
+		return diag.Errorf("Error obtaining L2VPNSession ID")
 	}
 
 	connector := getPolicyConnector(m)
@@ -406,7 +408,7 @@ func resourceNsxtPolicyL2VPNSessionDelete(d *schema.ResourceData, m interface{})
 	}
 
 	if err != nil {
-		return handleDeleteError("L2VPNSession", id, err)
+		return getOperationDiagnostics(m, handleDeleteError("L2VPNSession", id, err))
 	}
 
 	return nil
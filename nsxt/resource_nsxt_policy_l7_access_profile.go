@@ -0,0 +1,365 @@
+/* Copyright © 2023 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+var l7AccessProfileActionValues = []string{
+	model.L7AccessProfile_DEFAULT_ACTION_ALLOW,
+	model.L7AccessProfile_DEFAULT_ACTION_REJECT,
+	model.L7AccessProfile_DEFAULT_ACTION_REJECT_WITH_RESPONSE,
+}
+
+var l7AccessEntryAttributeKeyMap = map[string]string{
+	"app_id":       model.L7AccessAttributes_KEY_APP_ID,
+	"custom_url":   model.L7AccessAttributes_KEY_CUSTOM_URL,
+	"url_category": model.L7AccessAttributes_KEY_URL_CATEGORY,
+}
+
+var l7AccessEntryAttributeReverseKeyMap = map[string]string{
+	model.L7AccessAttributes_KEY_APP_ID:       "app_id",
+	model.L7AccessAttributes_KEY_CUSTOM_URL:   "custom_url",
+	model.L7AccessAttributes_KEY_URL_CATEGORY: "url_category",
+}
+
+func resourceNsxtPolicyL7AccessProfile() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceNsxtPolicyL7AccessProfileCreate,
+		ReadContext:   resourceNsxtPolicyL7AccessProfileRead,
+		UpdateContext: resourceNsxtPolicyL7AccessProfileUpdate,
+		DeleteContext: resourceNsxtPolicyL7AccessProfileDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"nsx_id":       getNsxIDSchema(),
+			"path":         getPathSchema(),
+			"display_name": getDisplayNameSchema(),
+			"description":  getDescriptionSchema(),
+			"revision":     getRevisionSchema(),
+			"tag":          getTagsSchema(),
+			"default_action": {
+				Type:         schema.TypeString,
+				Description:  "Action to apply to traffic that does not match any entry",
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(l7AccessProfileActionValues, false),
+			},
+			"default_action_logged": {
+				Type:        schema.TypeBool,
+				Description: "Flag to enable packet logging for default action",
+				Optional:    true,
+				Default:     false,
+			},
+			"l7_access_entry": getPolicyL7AccessEntrySchema(),
+		},
+	}
+}
+
+func getPolicyL7AccessEntrySchema() *schema.Schema {
+	appIDSchema := getContextProfilePolicyAppIDAttributesSchema()
+	appIDSchema.MaxItems = 1
+
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Description: "List of L7 access entries, evaluated in order",
+		Optional:    true,
+		MaxItems:    1000,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"nsx_id":       getFlexNsxIDSchema(true),
+				"display_name": getOptionalDisplayNameSchema(true),
+				"description":  getDescriptionSchema(),
+				"action": {
+					Type:         schema.TypeString,
+					Description:  "Action to apply to traffic matching this entry",
+					Required:     true,
+					ValidateFunc: validation.StringInSlice(l7AccessProfileActionValues, false),
+				},
+				"disabled": {
+					Type:        schema.TypeBool,
+					Description: "Flag to disable the entry",
+					Optional:    true,
+					Default:     false,
+				},
+				"logged": {
+					Type:        schema.TypeBool,
+					Description: "Flag to enable packet logging",
+					Optional:    true,
+					Default:     false,
+				},
+				"sequence_number": {
+					Type:        schema.TypeInt,
+					Description: "Sequence number of this entry, defined by order of entries in the list",
+					Computed:    true,
+				},
+				"app_id":       appIDSchema,
+				"custom_url":   getContextProfilePolicyCustomURLAttributesSchema(),
+				"url_category": getContextProfilePolicyOtherAttributesSchema(),
+			},
+		},
+	}
+}
+
+func resourceNsxtPolicyL7AccessProfileExists(id string, connector client.Connector, isGlobalManager bool) (bool, error) {
+	client := infra.NewL7AccessProfilesClient(connector)
+	_, err := client.Get(id)
+	if err == nil {
+		return true, nil
+	}
+
+	if isNotFoundError(err) {
+		return false, nil
+	}
+
+	return false, logAPIError("Error retrieving L7 Access Profile", err)
+}
+
+func getPolicyL7AccessEntriesFromSchema(d *schema.ResourceData) ([]model.L7AccessEntry, error) {
+	entries := d.Get("l7_access_entry").([]interface{})
+	var entryList []model.L7AccessEntry
+	resourceType := "L7AccessEntry"
+	for i, entry := range entries {
+		data := entry.(map[string]interface{})
+		displayName := data["display_name"].(string)
+		description := data["description"].(string)
+		action := data["action"].(string)
+		disabled := data["disabled"].(bool)
+		logged := data["logged"].(bool)
+		sequenceNumber := int64(i + 1)
+
+		id := newUUID()
+		nsxID := data["nsx_id"].(string)
+		if nsxID != "" {
+			id = nsxID
+		}
+		if displayName == "" {
+			displayName = id
+		}
+
+		var attributes []model.L7AccessAttributes
+		for key, attrKey := range l7AccessEntryAttributeKeyMap {
+			for _, rawAttribute := range data[key].(*schema.Set).List() {
+				attributeMap := rawAttribute.(map[string]interface{})
+				attribute, err := getPolicyL7AccessAttributeFromMap(attributeMap, key, attrKey)
+				if err != nil {
+					return nil, err
+				}
+				attributes = append(attributes, attribute)
+			}
+		}
+		if len(attributes) != 1 {
+			return nil, newAttributeError("l7_access_entry", fmt.Errorf("Exactly one of app_id, custom_url or url_category should be set for L7 access entry %d", i))
+		}
+
+		entryList = append(entryList, model.L7AccessEntry{
+			ResourceType:   &resourceType,
+			Id:             &id,
+			DisplayName:    &displayName,
+			Description:    &description,
+			Action:         &action,
+			Disabled:       &disabled,
+			Logged:         &logged,
+			SequenceNumber: &sequenceNumber,
+			Attributes:     attributes,
+		})
+	}
+
+	return entryList, nil
+}
+
+func getPolicyL7AccessAttributeFromMap(attributeMap map[string]interface{}, key string, attrKey string) (model.L7AccessAttributes, error) {
+	dataType := model.L7AccessAttributes_DATATYPE_STRING
+	description := attributeMap["description"].(string)
+	attribute := model.L7AccessAttributes{
+		Datatype:    &dataType,
+		Description: &description,
+		Key:         &attrKey,
+		Value:       interface2StringList(attributeMap["value"].(*schema.Set).List()),
+	}
+
+	if key == "app_id" {
+		if err := validateSubAttributes([]interface{}{attributeMap}); err != nil {
+			return attribute, err
+		}
+		subAttributes, err := constructSubAttributeModelList(attributeMap["sub_attribute"].(*schema.Set).List())
+		if err != nil {
+			return attribute, err
+		}
+		attribute.SubAttributes = subAttributes
+	}
+
+	if key == "custom_url" {
+		partialMatch := attributeMap["custom_url_partial_match"].(bool)
+		attribute.CustomUrlPartialMatch = &partialMatch
+	}
+
+	return attribute, nil
+}
+
+func setPolicyL7AccessEntriesInSchema(d *schema.ResourceData, entries []model.L7AccessEntry) error {
+	sort.Slice(entries, func(i, j int) bool {
+		if entries[i].SequenceNumber == nil || entries[j].SequenceNumber == nil {
+			return false
+		}
+		return *entries[i].SequenceNumber < *entries[j].SequenceNumber
+	})
+
+	var entryList []map[string]interface{}
+	for _, entry := range entries {
+		elem := make(map[string]interface{})
+		elem["nsx_id"] = entry.Id
+		elem["display_name"] = entry.DisplayName
+		elem["description"] = entry.Description
+		elem["action"] = entry.Action
+		elem["disabled"] = entry.Disabled
+		elem["logged"] = entry.Logged
+		elem["sequence_number"] = entry.SequenceNumber
+
+		for _, attribute := range entry.Attributes {
+			if attribute.Key == nil {
+				continue
+			}
+			key, ok := l7AccessEntryAttributeReverseKeyMap[*attribute.Key]
+			if !ok {
+				log.Printf("[WARNING] Unsupported attribute key %s in L7 access entry %s", *attribute.Key, *entry.Id)
+				continue
+			}
+			attrElem := make(map[string]interface{})
+			attrElem["description"] = attribute.Description
+			attrElem["value"] = attribute.Value
+			if key == "app_id" {
+				if len(attribute.SubAttributes) > 0 {
+					attrElem["sub_attribute"] = fillSubAttributesInSchema(attribute.SubAttributes)
+				}
+				attrElem["is_alg_type"] = attribute.IsALGType
+			} else if key == "custom_url" {
+				attrElem["custom_url_partial_match"] = attribute.CustomUrlPartialMatch
+			}
+			elem[key] = []interface{}{attrElem}
+		}
+
+		entryList = append(entryList, elem)
+	}
+
+	return d.Set("l7_access_entry", entryList)
+}
+
+func policyL7AccessProfilePatch(d *schema.ResourceData, m interface{}, id string) error {
+	connector := getPolicyConnector(m)
+
+	displayName := d.Get("display_name").(string)
+	description := d.Get("description").(string)
+	tags := getPolicyTagsFromSchema(d)
+	defaultAction := d.Get("default_action").(string)
+	defaultActionLogged := d.Get("default_action_logged").(bool)
+	entries, err := getPolicyL7AccessEntriesFromSchema(d)
+	if err != nil {
+		return err
+	}
+
+	obj := model.L7AccessProfile{
+		DisplayName:         &displayName,
+		Description:         &description,
+		Tags:                tags,
+		DefaultAction:       &defaultAction,
+		DefaultActionLogged: &defaultActionLogged,
+		L7AccessEntries:     entries,
+	}
+
+	client := infra.NewL7AccessProfilesClient(connector)
+	_, err = client.Patch(id, obj, nil)
+	return err
+}
+
+func resourceNsxtPolicyL7AccessProfileCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if isPolicyGlobalManager(m) {
+		return getErrorDiagnostics(localManagerOnlyError())
+	}
+
+	// Initialize resource Id and verify this ID is not yet used
+	id, err := getOrGenerateID(d, m, resourceNsxtPolicyL7AccessProfileExists)
+	if err != nil {
+		return getOperationDiagnostics(m, err)
+	}
+
+	log.Printf("[INFO] Creating L7 Access Profile with ID %s", id)
+	err = policyL7AccessProfilePatch(d, m, id)
+	if err != nil {
+		return getOperationDiagnostics(m, handleCreateError("L7 Access Profile", id, err))
+	}
+
+	d.SetId(id)
+	d.Set("nsx_id", id)
+
+	return resourceNsxtPolicyL7AccessProfileRead(ctx, d, m)
+}
+
+func resourceNsxtPolicyL7AccessProfileRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	connector := getPolicyConnector(m)
+	id := d.Id()
+	if id == "" {
+		return diag.Errorf("Error obtaining L7 Access Profile ID")
+	}
+
+	client := infra.NewL7AccessProfilesClient(connector)
+	obj, err := client.Get(id)
+	if err != nil {
+		return getOperationDiagnostics(m, handleReadError(d, "L7 Access Profile", id, err))
+	}
+
+	d.Set("display_name", obj.DisplayName)
+	d.Set("description", obj.Description)
+	setPolicyTagsInSchema(d, obj.Tags)
+	d.Set("nsx_id", id)
+	d.Set("path", obj.Path)
+	d.Set("revision", obj.Revision)
+	d.Set("default_action", obj.DefaultAction)
+	d.Set("default_action_logged", obj.DefaultActionLogged)
+
+	return getErrorDiagnostics(setPolicyL7AccessEntriesInSchema(d, obj.L7AccessEntries))
+}
+
+func resourceNsxtPolicyL7AccessProfileUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	id := d.Id()
+	if id == "" {
+		return diag.Errorf("Error obtaining L7 Access Profile ID")
+	}
+
+	log.Printf("[INFO] Updating L7 Access Profile with ID %s", id)
+	err := policyL7AccessProfilePatch(d, m, id)
+	if err != nil {
+		return getOperationDiagnostics(m, handleUpdateError("L7 Access Profile", id, err))
+	}
+
+	return resourceNsxtPolicyL7AccessProfileRead(ctx, d, m)
+}
+
+func resourceNsxtPolicyL7AccessProfileDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	id := d.Id()
+	if id == "" {
+		return diag.Errorf("Error obtaining L7 Access Profile ID")
+	}
+
+	connector := getPolicyConnector(m)
+	client := infra.NewL7AccessProfilesClient(connector)
+	err := client.Delete(id, nil)
+	if err != nil {
+		return getOperationDiagnostics(m, handleDeleteError("L7 Access Profile", id, err))
+	}
+
+	return nil
+}
@@ -0,0 +1,151 @@
+/* Copyright © 2023 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccResourceNsxtPolicyL7AccessProfile_basic(t *testing.T) {
+	testResourceName := "nsxt_policy_l7_access_profile.test"
+	displayName := getAccTestResourceName()
+	updatedName := getAccTestResourceName()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccOnlyLocalManager(t)
+			testAccNSXVersion(t, "4.0.0")
+		},
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyResourceCheckDestroy(state, updatedName, "nsxt_policy_l7_access_profile", resourceNsxtPolicyL7AccessProfileExists)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyL7AccessProfileCreateTemplate(displayName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyResourceExists(testResourceName, resourceNsxtPolicyL7AccessProfileExists),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", displayName),
+					resource.TestCheckResourceAttr(testResourceName, "description", "terraform created"),
+					resource.TestCheckResourceAttr(testResourceName, "default_action", "ALLOW"),
+					resource.TestCheckResourceAttr(testResourceName, "default_action_logged", "false"),
+					resource.TestCheckResourceAttr(testResourceName, "l7_access_entry.#", "2"),
+					resource.TestCheckResourceAttr(testResourceName, "l7_access_entry.0.action", "REJECT"),
+					resource.TestCheckResourceAttr(testResourceName, "l7_access_entry.0.url_category.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "l7_access_entry.0.sequence_number", "1"),
+					resource.TestCheckResourceAttrSet(testResourceName, "l7_access_entry.0.nsx_id"),
+					resource.TestCheckResourceAttr(testResourceName, "l7_access_entry.1.action", "ALLOW"),
+					resource.TestCheckResourceAttr(testResourceName, "l7_access_entry.1.custom_url.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "l7_access_entry.1.logged", "true"),
+					resource.TestCheckResourceAttr(testResourceName, "l7_access_entry.1.sequence_number", "2"),
+					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "1"),
+				),
+			},
+			{
+				Config: testAccNsxtPolicyL7AccessProfileUpdateTemplate(updatedName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyResourceExists(testResourceName, resourceNsxtPolicyL7AccessProfileExists),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", updatedName),
+					resource.TestCheckResourceAttr(testResourceName, "description", "terraform updated"),
+					resource.TestCheckResourceAttr(testResourceName, "default_action", "REJECT"),
+					resource.TestCheckResourceAttr(testResourceName, "default_action_logged", "true"),
+					resource.TestCheckResourceAttr(testResourceName, "l7_access_entry.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "l7_access_entry.0.action", "ALLOW"),
+					resource.TestCheckResourceAttr(testResourceName, "l7_access_entry.0.app_id.#", "1"),
+					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNsxtPolicyL7AccessProfile_importBasic(t *testing.T) {
+	name := getAccTestResourceName()
+	testResourceName := "nsxt_policy_l7_access_profile.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccOnlyLocalManager(t)
+			testAccNSXVersion(t, "4.0.0")
+		},
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyResourceCheckDestroy(state, name, "nsxt_policy_l7_access_profile", resourceNsxtPolicyL7AccessProfileExists)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyL7AccessProfileCreateTemplate(name),
+			},
+			{
+				ResourceName:      testResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicyL7AccessProfileCreateTemplate(displayName string) string {
+	return fmt.Sprintf(`
+resource "nsxt_policy_l7_access_profile" "test" {
+  display_name   = "%s"
+  description    = "terraform created"
+  default_action = "ALLOW"
+
+  l7_access_entry {
+    display_name = "block-gambling"
+    action       = "REJECT"
+
+    url_category {
+      value = ["Gambling"]
+    }
+  }
+
+  l7_access_entry {
+    display_name = "allow-corp"
+    action       = "ALLOW"
+    logged       = true
+
+    custom_url {
+      value                    = ["*.example.com"]
+      custom_url_partial_match = true
+    }
+  }
+
+  tag {
+    scope = "scope1"
+    tag   = "tag1"
+  }
+}`, displayName)
+}
+
+func testAccNsxtPolicyL7AccessProfileUpdateTemplate(displayName string) string {
+	return fmt.Sprintf(`
+resource "nsxt_policy_l7_access_profile" "test" {
+  display_name          = "%s"
+  description           = "terraform updated"
+  default_action        = "REJECT"
+  default_action_logged = true
+
+  l7_access_entry {
+    action = "ALLOW"
+
+    app_id {
+      value = ["SSL"]
+    }
+  }
+}`, displayName)
+}
@@ -4,11 +4,13 @@
 package nsxt
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strconv"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/vmware/vsphere-automation-sdk-go/runtime/bindings"
@@ -32,12 +34,12 @@ var lbPoolSnatTypeValues = []string{
 
 func resourceNsxtPolicyLBPool() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtPolicyLBPoolCreate,
-		Read:   resourceNsxtPolicyLBPoolRead,
-		Update: resourceNsxtPolicyLBPoolUpdate,
-		Delete: resourceNsxtPolicyLBPoolDelete,
+		CreateContext: resourceNsxtPolicyLBPoolCreate,
+		ReadContext:   resourceNsxtPolicyLBPoolRead,
+		UpdateContext: resourceNsxtPolicyLBPoolUpdate,
+		DeleteContext: resourceNsxtPolicyLBPoolDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -415,18 +417,18 @@ func resourceNsxtPolicyLBPoolExists(id string, connector client.Connector, isGlo
 	return false, logAPIError("Error retrieving resource", err)
 }
 
-func resourceNsxtPolicyLBPoolCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyLBPoolCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 	client := infra.NewLbPoolsClient(connector)
 
 	if client == nil {
-		return policyResourceNotSupportedError()
+		return getErrorDiagnostics(policyResourceNotSupportedError())
 	}
 
 	// Initialize resource Id and verify this ID is not yet used
 	id, err := getOrGenerateID(d, m, resourceNsxtPolicyLBPoolExists)
 	if err != nil {
-		return err
+		return getOperationDiagnostics(m, err)
 	}
 
 	displayName := d.Get("display_name").(string)
@@ -441,7 +443,7 @@ func resourceNsxtPolicyLBPoolCreate(d *schema.ResourceData, m interface{}) error
 	passiveMonitorPath := d.Get("passive_monitor_path").(string)
 	snatTranslation, err := getPolicyPoolSnatFromSchema(d)
 	if err != nil {
-		return err
+		return getOperationDiagnostics(m, err)
 	}
 	tcpMultiplexingEnabled := d.Get("tcp_multiplexing_enabled").(bool)
 	tcpMultiplexingNumber := int64(d.Get("tcp_multiplexing_number").(int))
@@ -464,31 +466,31 @@ func resourceNsxtPolicyLBPoolCreate(d *schema.ResourceData, m interface{}) error
 	log.Printf("[INFO] Creating LBPool with ID %s", id)
 	err = client.Patch(id, obj)
 	if err != nil {
-		return handleCreateError("LBPool", id, err)
+		return getOperationDiagnostics(m, handleCreateError("LBPool", id, err))
 	}
 
 	d.SetId(id)
 	d.Set("nsx_id", id)
 
-	return resourceNsxtPolicyLBPoolRead(d, m)
+	return resourceNsxtPolicyLBPoolRead(ctx, d, m)
 }
 
-func resourceNsxtPolicyLBPoolRead(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyLBPoolRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 	client := infra.NewLbPoolsClient(connector)
 
 	if client == nil {
-		return policyResourceNotSupportedError()
+		return getErrorDiagnostics(policyResourceNotSupportedError())
 	}
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining LBPool ID")
+		return diag.Errorf("Error obtaining LBPool ID")
 	}
 
 	obj, err := client.Get(id)
 	if err != nil {
-		return handleReadError(d, "LBPool", id, err)
+		return getOperationDiagnostics(m, handleReadError(d, "LBPool", id, err))
 	}
 
 	d.Set("display_name", obj.DisplayName)
@@ -505,20 +507,20 @@ func resourceNsxtPolicyLBPoolRead(d *schema.ResourceData, m interface{}) error {
 	d.Set("algorithm", obj.Algorithm)
 	err = setPolicyPoolMemberGroupInSchema(d, obj.MemberGroup)
 	if err != nil {
-		return err
+		return getOperationDiagnostics(m, err)
 	}
 	err = setPolicyPoolMembersInSchema(d, obj.Members)
 	if err != nil {
-		return err
+		return getOperationDiagnostics(m, err)
 	}
 	err = setPolicyPoolMemberGroupInSchema(d, obj.MemberGroup)
 	if err != nil {
-		return err
+		return getOperationDiagnostics(m, err)
 	}
 
 	err = setPolicyPoolSnatInSchema(d, obj.SnatTranslation)
 	if err != nil {
-		return err
+		return getOperationDiagnostics(m, err)
 	}
 
 	if obj.MinActiveMembers != nil {
@@ -533,16 +535,16 @@ func resourceNsxtPolicyLBPoolRead(d *schema.ResourceData, m interface{}) error {
 	return nil
 }
 
-func resourceNsxtPolicyLBPoolUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyLBPoolUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 	client := infra.NewLbPoolsClient(connector)
 	if client == nil {
-		return policyResourceNotSupportedError()
+		return getErrorDiagnostics(policyResourceNotSupportedError())
 	}
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining LBPool ID")
+		return diag.Errorf("Error obtaining LBPool ID")
 	}
 
 	// Read the rest of the configured parameters
@@ -558,7 +560,7 @@ func resourceNsxtPolicyLBPoolUpdate(d *schema.ResourceData, m interface{}) error
 	passiveMonitorPath := d.Get("passive_monitor_path").(string)
 	snatTranslation, err := getPolicyPoolSnatFromSchema(d)
 	if err != nil {
-		return err
+		return getOperationDiagnostics(m, err)
 	}
 	tcpMultiplexingEnabled := d.Get("tcp_multiplexing_enabled").(bool)
 	tcpMultiplexingNumber := int64(d.Get("tcp_multiplexing_number").(int))
@@ -583,28 +585,28 @@ func resourceNsxtPolicyLBPoolUpdate(d *schema.ResourceData, m interface{}) error
 
 	_, err = client.Update(id, obj)
 	if err != nil {
-		return handleUpdateError("LBPool", id, err)
+		return getOperationDiagnostics(m, handleUpdateError("LBPool", id, err))
 	}
 
-	return resourceNsxtPolicyLBPoolRead(d, m)
+	return resourceNsxtPolicyLBPoolRead(ctx, d, m)
 }
 
-func resourceNsxtPolicyLBPoolDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyLBPoolDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining LBPool ID")
+		return diag.Errorf("Error obtaining LBPool ID")
 	}
 
 	connector := getPolicyConnector(m)
 	client := infra.NewLbPoolsClient(connector)
 	if client == nil {
-		return policyResourceNotSupportedError()
+		return getErrorDiagnostics(policyResourceNotSupportedError())
 	}
 
 	force := true
 	err := client.Delete(id, &force)
 	if err != nil {
-		return handleDeleteError("LBPool", id, err)
+		return getOperationDiagnostics(m, handleDeleteError("LBPool", id, err))
 	}
 
 	return nil
@@ -4,9 +4,10 @@
 package nsxt
 
 import (
-	"fmt"
+	"context"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/vmware/vsphere-automation-sdk-go/runtime/bindings"
@@ -135,12 +136,12 @@ var lbSslModeSelectionActionValues = []string{
 
 func resourceNsxtPolicyLBVirtualServer() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtPolicyLBVirtualServerCreate,
-		Read:   resourceNsxtPolicyLBVirtualServerRead,
-		Update: resourceNsxtPolicyLBVirtualServerUpdate,
-		Delete: resourceNsxtPolicyLBVirtualServerDelete,
+		CreateContext: resourceNsxtPolicyLBVirtualServerCreate,
+		ReadContext:   resourceNsxtPolicyLBVirtualServerRead,
+		UpdateContext: resourceNsxtPolicyLBVirtualServerUpdate,
+		DeleteContext: resourceNsxtPolicyLBVirtualServerDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -1575,18 +1576,18 @@ func resourceNsxtPolicyLBVirtualServerExists(id string, connector client.Connect
 	return false, logAPIError("Error retrieving resource", err)
 }
 
-func resourceNsxtPolicyLBVirtualServerCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyLBVirtualServerCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 	client := infra.NewLbVirtualServersClient(connector)
 
 	if client == nil {
-		return policyResourceNotSupportedError()
+		return getErrorDiagnostics(policyResourceNotSupportedError())
 	}
 
 	// Initialize resource Id and verify this ID is not yet used
 	id, err := getOrGenerateID(d, m, resourceNsxtPolicyLBVirtualServerExists)
 	if err != nil {
-		return err
+		return getOperationDiagnostics(m, err)
 	}
 
 	displayName := d.Get("display_name").(string)
@@ -1641,31 +1642,31 @@ func resourceNsxtPolicyLBVirtualServerCreate(d *schema.ResourceData, m interface
 	log.Printf("[INFO] Creating LBVirtualServer with ID %s", id)
 	err = client.Patch(id, obj)
 	if err != nil {
-		return handleCreateError("LBVirtualServer", id, err)
+		return getOperationDiagnostics(m, handleCreateError("LBVirtualServer", id, err))
 	}
 
 	d.SetId(id)
 	d.Set("nsx_id", id)
 
-	return resourceNsxtPolicyLBVirtualServerRead(d, m)
+	return resourceNsxtPolicyLBVirtualServerRead(ctx, d, m)
 }
 
-func resourceNsxtPolicyLBVirtualServerRead(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyLBVirtualServerRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 	client := infra.NewLbVirtualServersClient(connector)
 
 	if client == nil {
-		return policyResourceNotSupportedError()
+		return getErrorDiagnostics(policyResourceNotSupportedError())
 	}
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining LBVirtualServer ID")
+		return diag.Errorf("Error obtaining LBVirtualServer ID")
 	}
 
 	obj, err := client.Get(id)
 	if err != nil {
-		return handleReadError(d, "LBVirtualServer", id, err)
+		return getOperationDiagnostics(m, handleReadError(d, "LBVirtualServer", id, err))
 	}
 
 	d.Set("display_name", obj.DisplayName)
@@ -1711,16 +1712,16 @@ func resourceNsxtPolicyLBVirtualServerRead(d *schema.ResourceData, m interface{}
 	return nil
 }
 
-func resourceNsxtPolicyLBVirtualServerUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyLBVirtualServerUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 	client := infra.NewLbVirtualServersClient(connector)
 	if client == nil {
-		return policyResourceNotSupportedError()
+		return getErrorDiagnostics(policyResourceNotSupportedError())
 	}
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining LBVirtualServer ID")
+		return diag.Errorf("Error obtaining LBVirtualServer ID")
 	}
 
 	// Read the rest of the configured parameters
@@ -1784,7 +1785,7 @@ func resourceNsxtPolicyLBVirtualServerUpdate(d *schema.ResourceData, m interface
 		log.Printf("[INFO] No changes detected in rule section")
 		existingObj, err := client.Get(id)
 		if err != nil {
-			return handleUpdateError("LBVirtualServer", id, err)
+			return getOperationDiagnostics(m, handleUpdateError("LBVirtualServer", id, err))
 		}
 
 		if len(existingObj.Rules) > 0 {
@@ -1805,28 +1806,28 @@ func resourceNsxtPolicyLBVirtualServerUpdate(d *schema.ResourceData, m interface
 	// Update the resource using PATCH
 	err := client.Patch(id, obj)
 	if err != nil {
-		return handleUpdateError("LBVirtualServer", id, err)
+		return getOperationDiagnostics(m, handleUpdateError("LBVirtualServer", id, err))
 	}
 
-	return resourceNsxtPolicyLBVirtualServerRead(d, m)
+	return resourceNsxtPolicyLBVirtualServerRead(ctx, d, m)
 }
 
-func resourceNsxtPolicyLBVirtualServerDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyLBVirtualServerDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining LBVirtualServer ID")
+		return diag.Errorf("Error obtaining LBVirtualServer ID")
 	}
 
 	connector := getPolicyConnector(m)
 	client := infra.NewLbVirtualServersClient(connector)
 	if client == nil {
-		return policyResourceNotSupportedError()
+		return getErrorDiagnostics(policyResourceNotSupportedError())
 	}
 
 	force := true
 	err := client.Delete(id, &force)
 	if err != nil {
-		return handleDeleteError("LBVirtualServer", id, err)
+		return getOperationDiagnostics(m, handleDeleteError("LBVirtualServer", id, err))
 	}
 
 	return nil
@@ -0,0 +1,119 @@
+/* Copyright © 2023 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+func resourceNsxtPolicyLldpHostSwitchProfile() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceNsxtPolicyLldpHostSwitchProfileCreate,
+		ReadContext:   resourceNsxtPolicyLldpHostSwitchProfileRead,
+		UpdateContext: resourceNsxtPolicyLldpHostSwitchProfileUpdate,
+		DeleteContext: resourceNsxtPolicyHostSwitchProfileDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"nsx_id":       getNsxIDSchema(),
+			"path":         getPathSchema(),
+			"display_name": getDisplayNameSchema(),
+			"description":  getDescriptionSchema(),
+			"revision":     getRevisionSchema(),
+			"tag":          getTagsSchema(),
+			"send_enabled": {
+				Type:        schema.TypeBool,
+				Description: "Enabled or disabled sending LLDP packets",
+				Optional:    true,
+				Default:     true,
+			},
+		},
+	}
+}
+
+func policyLldpHostSwitchProfilePatch(d *schema.ResourceData, m interface{}, id string) error {
+	displayName := d.Get("display_name").(string)
+	description := d.Get("description").(string)
+	tags := getPolicyTagsFromSchema(d)
+	sendEnabled := d.Get("send_enabled").(bool)
+
+	obj := model.PolicyLldpHostSwitchProfile{
+		DisplayName:  &displayName,
+		Description:  &description,
+		Tags:         tags,
+		ResourceType: model.PolicyBaseHostSwitchProfile_RESOURCE_TYPE_POLICYLLDPHOSTSWITCHPROFILE,
+		SendEnabled:  &sendEnabled,
+	}
+
+	return policyHostSwitchProfilePatch(getPolicyConnector(m), id, obj, model.PolicyLldpHostSwitchProfileBindingType())
+}
+
+func resourceNsxtPolicyLldpHostSwitchProfileCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if isPolicyGlobalManager(m) {
+		return getErrorDiagnostics(localManagerOnlyError())
+	}
+
+	// Initialize resource Id and verify this ID is not yet used
+	id, err := getOrGenerateID(d, m, resourceNsxtPolicyHostSwitchProfileExists)
+	if err != nil {
+		return getOperationDiagnostics(m, err)
+	}
+
+	log.Printf("[INFO] Creating LLDP Host Switch Profile with ID %s", id)
+	err = policyLldpHostSwitchProfilePatch(d, m, id)
+	if err != nil {
+		return getOperationDiagnostics(m, handleCreateError("LLDP Host Switch Profile", id, err))
+	}
+
+	d.SetId(id)
+	d.Set("nsx_id", id)
+
+	return resourceNsxtPolicyLldpHostSwitchProfileRead(ctx, d, m)
+}
+
+func resourceNsxtPolicyLldpHostSwitchProfileRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	connector := getPolicyConnector(m)
+	id := d.Id()
+	if id == "" {
+		return diag.Errorf("Error obtaining LLDP Host Switch Profile ID")
+	}
+
+	profile, err := policyHostSwitchProfileGet(connector, id, model.PolicyLldpHostSwitchProfileBindingType())
+	if err != nil {
+		return getOperationDiagnostics(m, handleReadError(d, "LLDP Host Switch Profile", id, err))
+	}
+	obj := profile.(model.PolicyLldpHostSwitchProfile)
+
+	d.Set("display_name", obj.DisplayName)
+	d.Set("description", obj.Description)
+	setPolicyTagsInSchema(d, obj.Tags)
+	d.Set("nsx_id", id)
+	d.Set("path", obj.Path)
+	d.Set("revision", obj.Revision)
+	d.Set("send_enabled", obj.SendEnabled)
+
+	return nil
+}
+
+func resourceNsxtPolicyLldpHostSwitchProfileUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	id := d.Id()
+	if id == "" {
+		return diag.Errorf("Error obtaining LLDP Host Switch Profile ID")
+	}
+
+	log.Printf("[INFO] Updating LLDP Host Switch Profile with ID %s", id)
+	err := policyLldpHostSwitchProfilePatch(d, m, id)
+	if err != nil {
+		return getOperationDiagnostics(m, handleUpdateError("LLDP Host Switch Profile", id, err))
+	}
+
+	return resourceNsxtPolicyLldpHostSwitchProfileRead(ctx, d, m)
+}
@@ -4,9 +4,10 @@
 package nsxt
 
 import (
-	"fmt"
+	"context"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
@@ -23,12 +24,12 @@ var macDiscoveryProfileMacLimitPolicyValues = []string{
 
 func resourceNsxtPolicyMacDiscoveryProfile() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtPolicyMacDiscoveryProfileCreate,
-		Read:   resourceNsxtPolicyMacDiscoveryProfileRead,
-		Update: resourceNsxtPolicyMacDiscoveryProfileUpdate,
-		Delete: resourceNsxtPolicyMacDiscoveryProfileDelete,
+		CreateContext: resourceNsxtPolicyMacDiscoveryProfileCreate,
+		ReadContext:   resourceNsxtPolicyMacDiscoveryProfileRead,
+		UpdateContext: resourceNsxtPolicyMacDiscoveryProfileUpdate,
+		DeleteContext: resourceNsxtPolicyMacDiscoveryProfileDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -93,13 +94,13 @@ func resourceNsxtPolicyMacDiscoveryProfileExists(id string, connector client.Con
 	return false, logAPIError("Error retrieving resource", err)
 }
 
-func resourceNsxtPolicyMacDiscoveryProfileCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyMacDiscoveryProfileCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 
 	// Initialize resource Id and verify this ID is not yet used
 	id, err := getOrGenerateID(d, m, resourceNsxtPolicyMacDiscoveryProfileExists)
 	if err != nil {
-		return err
+		return getOperationDiagnostics(m, err)
 	}
 
 	displayName := d.Get("display_name").(string)
@@ -130,7 +131,7 @@ func resourceNsxtPolicyMacDiscoveryProfileCreate(d *schema.ResourceData, m inter
 	if isPolicyGlobalManager(m) {
 		gmObj, convErr := convertModelBindingType(obj, model.MacDiscoveryProfileBindingType(), gm_model.MacDiscoveryProfileBindingType())
 		if convErr != nil {
-			return convErr
+			return getErrorDiagnostics(convErr)
 		}
 		client := gm_infra.NewMacDiscoveryProfilesClient(connector)
 		err = client.Patch(id, gmObj.(gm_model.MacDiscoveryProfile), &boolFalse)
@@ -139,21 +140,21 @@ func resourceNsxtPolicyMacDiscoveryProfileCreate(d *schema.ResourceData, m inter
 		err = client.Patch(id, obj, &boolFalse)
 	}
 	if err != nil {
-		return handleCreateError("MacDiscoveryProfile", id, err)
+		return getOperationDiagnostics(m, handleCreateError("MacDiscoveryProfile", id, err))
 	}
 
 	d.SetId(id)
 	d.Set("nsx_id", id)
 
-	return resourceNsxtPolicyMacDiscoveryProfileRead(d, m)
+	return resourceNsxtPolicyMacDiscoveryProfileRead(ctx, d, m)
 }
 
-func resourceNsxtPolicyMacDiscoveryProfileRead(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyMacDiscoveryProfileRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining MacDiscoveryProfile ID")
+		return diag.Errorf("Error obtaining MacDiscoveryProfile ID")
 	}
 
 	var obj model.MacDiscoveryProfile
@@ -161,12 +162,12 @@ func resourceNsxtPolicyMacDiscoveryProfileRead(d *schema.ResourceData, m interfa
 		client := gm_infra.NewMacDiscoveryProfilesClient(connector)
 		gmObj, err := client.Get(id)
 		if err != nil {
-			return handleReadError(d, "MacDiscoveryProfile", id, err)
+			return getOperationDiagnostics(m, handleReadError(d, "MacDiscoveryProfile", id, err))
 		}
 
 		lmObj, err := convertModelBindingType(gmObj, gm_model.MacDiscoveryProfileBindingType(), model.MacDiscoveryProfileBindingType())
 		if err != nil {
-			return err
+			return getOperationDiagnostics(m, err)
 		}
 		obj = lmObj.(model.MacDiscoveryProfile)
 	} else {
@@ -174,7 +175,7 @@ func resourceNsxtPolicyMacDiscoveryProfileRead(d *schema.ResourceData, m interfa
 		var err error
 		obj, err = client.Get(id)
 		if err != nil {
-			return handleReadError(d, "MacDiscoveryProfile", id, err)
+			return getOperationDiagnostics(m, handleReadError(d, "MacDiscoveryProfile", id, err))
 		}
 	}
 
@@ -195,12 +196,12 @@ func resourceNsxtPolicyMacDiscoveryProfileRead(d *schema.ResourceData, m interfa
 	return nil
 }
 
-func resourceNsxtPolicyMacDiscoveryProfileUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyMacDiscoveryProfileUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining MacDiscoveryProfile ID")
+		return diag.Errorf("Error obtaining MacDiscoveryProfile ID")
 	}
 
 	// Read the rest of the configured parameters
@@ -235,7 +236,7 @@ func resourceNsxtPolicyMacDiscoveryProfileUpdate(d *schema.ResourceData, m inter
 	if isPolicyGlobalManager(m) {
 		gmObj, convErr := convertModelBindingType(obj, model.MacDiscoveryProfileBindingType(), gm_model.MacDiscoveryProfileBindingType())
 		if convErr != nil {
-			return convErr
+			return getErrorDiagnostics(convErr)
 		}
 		client := gm_infra.NewMacDiscoveryProfilesClient(connector)
 		_, err = client.Update(id, gmObj.(gm_model.MacDiscoveryProfile), &boolFalse)
@@ -244,16 +245,16 @@ func resourceNsxtPolicyMacDiscoveryProfileUpdate(d *schema.ResourceData, m inter
 		_, err = client.Update(id, obj, &boolFalse)
 	}
 	if err != nil {
-		return handleUpdateError("MacDiscoveryProfile", id, err)
+		return getOperationDiagnostics(m, handleUpdateError("MacDiscoveryProfile", id, err))
 	}
 
-	return resourceNsxtPolicyMacDiscoveryProfileRead(d, m)
+	return resourceNsxtPolicyMacDiscoveryProfileRead(ctx, d, m)
 }
 
-func resourceNsxtPolicyMacDiscoveryProfileDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyMacDiscoveryProfileDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining MacDiscoveryProfile ID")
+		return diag.Errorf("Error obtaining MacDiscoveryProfile ID")
 	}
 
 	connector := getPolicyConnector(m)
@@ -268,7 +269,7 @@ func resourceNsxtPolicyMacDiscoveryProfileDelete(d *schema.ResourceData, m inter
 	}
 
 	if err != nil {
-		return handleDeleteError("MacDiscoveryProfile", id, err)
+		return getOperationDiagnostics(m, handleDeleteError("MacDiscoveryProfile", id, err))
 	}
 
 	return nil
@@ -4,10 +4,12 @@
 package nsxt
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
@@ -19,6 +21,7 @@ import (
 	t0nat "github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/tier_0s/nat"
 	t1nat "github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/tier_1s/nat"
 	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+	project_t1nat "github.com/vmware/vsphere-automation-sdk-go/services/nsxt/orgs/projects/infra/tier_1s/nat"
 )
 
 var policyNATRuleActionTypeValues = []string{
@@ -37,12 +40,12 @@ var policyNATRuleFirewallMatchTypeValues = []string{
 
 func resourceNsxtPolicyNATRule() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtPolicyNATRuleCreate,
-		Read:   resourceNsxtPolicyNATRuleRead,
-		Update: resourceNsxtPolicyNATRuleUpdate,
-		Delete: resourceNsxtPolicyNATRuleDelete,
+		CreateContext: resourceNsxtPolicyNATRuleCreate,
+		ReadContext:   resourceNsxtPolicyNATRuleRead,
+		UpdateContext: resourceNsxtPolicyNATRuleUpdate,
+		DeleteContext: resourceNsxtPolicyNATRuleDelete,
 		Importer: &schema.ResourceImporter{
-			State: resourceNsxtPolicyNATRuleImport,
+			StateContext: resourceNsxtPolicyNATRuleImport,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -52,6 +55,7 @@ func resourceNsxtPolicyNATRule() *schema.Resource {
 			"description":  getDescriptionSchema(),
 			"revision":     getRevisionSchema(),
 			"tag":          getTagsSchema(),
+			"context":      getPolicyContextSchema(),
 			"gateway_path": getPolicyGatewayPathSchema(),
 			"action": {
 				Type:         schema.TypeString,
@@ -135,7 +139,14 @@ func resourceNsxtPolicyNATRule() *schema.Resource {
 	}
 }
 
-func deleteNsxtPolicyNATRule(connector client.Connector, gwID string, isT0 bool, natType string, ruleID string, isGlobalManager bool) error {
+func deleteNsxtPolicyNATRule(connector client.Connector, projectID string, gwID string, isT0 bool, natType string, ruleID string, isGlobalManager bool) error {
+	if projectID != "" {
+		if isT0 {
+			return projectTier0NATRuleError()
+		}
+		client := project_t1nat.NewNatRulesClient(connector)
+		return client.Delete(defaultOrgID, projectID, gwID, natType, ruleID)
+	}
 	if isGlobalManager {
 		if isT0 {
 			client := gm_t0nat.NewNatRulesClient(connector)
@@ -152,29 +163,40 @@ func deleteNsxtPolicyNATRule(connector client.Connector, gwID string, isT0 bool,
 	return client.Delete(gwID, natType, ruleID)
 }
 
-func resourceNsxtPolicyNATRuleDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyNATRuleDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining NAT Rule ID")
+		return diag.Errorf("Error obtaining NAT Rule ID")
 	}
 
 	gwPolicyPath := d.Get("gateway_path").(string)
 	isT0, gwID := parseGatewayPolicyPath(gwPolicyPath)
 	if gwID == "" {
-		return fmt.Errorf("gateway_path is not valid")
+		return getErrorDiagnostics(newAttributeError("gateway_path", fmt.Errorf("gateway_path is not valid")))
 	}
 
 	action := d.Get("action").(string)
 	natType := getNatTypeByAction(action)
-	err := deleteNsxtPolicyNATRule(getPolicyConnector(m), gwID, isT0, natType, id, isPolicyGlobalManager(m))
+	err := deleteNsxtPolicyNATRule(getPolicyConnector(m), getPolicyProjectID(d), gwID, isT0, natType, id, isPolicyGlobalManager(m))
 	if err != nil {
-		return handleDeleteError("NAT Rule", id, err)
+		return getOperationDiagnostics(m, handleDeleteError("NAT Rule", id, err))
 	}
 
 	return nil
 }
 
-func getNsxtPolicyNATRuleByID(connector client.Connector, gwID string, isT0 bool, natType string, ruleID string, isGlobalManager bool) (model.PolicyNatRule, error) {
+func projectTier0NATRuleError() error {
+	return fmt.Errorf("NAT Rules on Tier0 gateways are not supported in project context")
+}
+
+func getNsxtPolicyNATRuleByID(connector client.Connector, projectID string, gwID string, isT0 bool, natType string, ruleID string, isGlobalManager bool) (model.PolicyNatRule, error) {
+	if projectID != "" {
+		if isT0 {
+			return model.PolicyNatRule{}, projectTier0NATRuleError()
+		}
+		client := project_t1nat.NewNatRulesClient(connector)
+		return client.Get(defaultOrgID, projectID, gwID, natType, ruleID)
+	}
 	if isGlobalManager {
 		var obj model.PolicyNatRule
 		var gmObj gm_model.PolicyNatRule
@@ -204,12 +226,19 @@ func getNsxtPolicyNATRuleByID(connector client.Connector, gwID string, isT0 bool
 	return client.Get(gwID, natType, ruleID)
 }
 
-func patchNsxtPolicyNATRule(connector client.Connector, gwID string, rule model.PolicyNatRule, isT0 bool, isGlobalManager bool) error {
+func patchNsxtPolicyNATRule(connector client.Connector, projectID string, gwID string, rule model.PolicyNatRule, isT0 bool, isGlobalManager bool) error {
 	natType := getNatTypeByAction(*rule.Action)
 	_, err := getTranslatedNetworks(rule)
 	if err != nil {
 		return err
 	}
+	if projectID != "" {
+		if isT0 {
+			return projectTier0NATRuleError()
+		}
+		client := project_t1nat.NewNatRulesClient(connector)
+		return client.Patch(defaultOrgID, projectID, gwID, natType, *rule.Id, rule)
+	}
 	if isGlobalManager {
 		rawObj, err := convertModelBindingType(rule, model.PolicyNatRuleBindingType(), gm_model.PolicyNatRuleBindingType())
 		if err != nil {
@@ -251,25 +280,25 @@ func getTranslatedNetworks(rule model.PolicyNatRule) (*string, error) {
 	return tNets, nil
 }
 
-func resourceNsxtPolicyNATRuleRead(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyNATRuleRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining NAT Rule ID")
+		return diag.Errorf("Error obtaining NAT Rule ID")
 	}
 
 	gwPolicyPath := d.Get("gateway_path").(string)
 	isT0, gwID := parseGatewayPolicyPath(gwPolicyPath)
 	if gwID == "" {
-		return fmt.Errorf("gateway_path is not valid")
+		return getErrorDiagnostics(newAttributeError("gateway_path", fmt.Errorf("gateway_path is not valid")))
 	}
 
 	action := d.Get("action").(string)
 	natType := getNatTypeByAction(action)
-	obj, err := getNsxtPolicyNATRuleByID(connector, gwID, isT0, natType, id, isPolicyGlobalManager(m))
+	obj, err := getNsxtPolicyNATRuleByID(connector, getPolicyProjectID(d), gwID, isT0, natType, id, isPolicyGlobalManager(m))
 	if err != nil {
-		return handleReadError(d, "NAT Rule", id, err)
+		return getOperationDiagnostics(m, handleReadError(d, "NAT Rule", id, err))
 	}
 
 	d.Set("display_name", obj.DisplayName)
@@ -277,6 +306,9 @@ func resourceNsxtPolicyNATRuleRead(d *schema.ResourceData, m interface{}) error
 	setPolicyTagsInSchema(d, obj.Tags)
 	d.Set("nsx_id", id)
 	d.Set("path", obj.Path)
+	if obj.Path != nil {
+		setPolicyProjectIDInSchema(d, getPolicyProjectIDFromPath(*obj.Path))
+	}
 	d.Set("revision", obj.Revision)
 	d.Set("action", obj.Action)
 	if obj.DestinationNetwork != nil {
@@ -301,7 +333,7 @@ func resourceNsxtPolicyNATRuleRead(d *schema.ResourceData, m interface{}) error
 	return nil
 }
 
-func resourceNsxtPolicyNATRuleCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyNATRuleCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 
 	gwPolicyPath := d.Get("gateway_path").(string)
@@ -309,19 +341,28 @@ func resourceNsxtPolicyNATRuleCreate(d *schema.ResourceData, m interface{}) erro
 	natType := getNatTypeByAction(action)
 	isT0, gwID := parseGatewayPolicyPath(gwPolicyPath)
 	if gwID == "" {
-		return fmt.Errorf("gateway_path is not valid")
+		return getErrorDiagnostics(newAttributeError("gateway_path", fmt.Errorf("gateway_path is not valid")))
 	}
 	isGlobalManager := isPolicyGlobalManager(m)
+	projectID := getPolicyProjectID(d)
+	err := validatePolicyProjectContext(projectID, m)
+	if err != nil {
+		return getOperationDiagnostics(m, err)
+	}
+	err = validatePolicyPathInContext("gateway_path", gwPolicyPath, projectID)
+	if err != nil {
+		return getOperationDiagnostics(m, err)
+	}
 
 	id := d.Get("nsx_id").(string)
 	if id == "" {
 		id = newUUID()
 	} else {
-		_, err := getNsxtPolicyNATRuleByID(connector, gwID, isT0, natType, id, isGlobalManager)
+		_, err := getNsxtPolicyNATRuleByID(connector, projectID, gwID, isT0, natType, id, isGlobalManager)
 		if err == nil {
-			return fmt.Errorf("NAT Rule with nsx_id '%s' already exists", id)
+			return getErrorDiagnostics(newAttributeError("nsx_id", fmt.Errorf("NAT Rule with nsx_id '%s' already exists", id)))
 		} else if !isNotFoundError(err) {
-			return err
+			return getOperationDiagnostics(m, err)
 		}
 	}
 
@@ -365,29 +406,29 @@ func resourceNsxtPolicyNATRuleCreate(d *schema.ResourceData, m interface{}) erro
 
 	log.Printf("[INFO] Creating NAT Rule with ID %s", id)
 
-	err := patchNsxtPolicyNATRule(connector, gwID, ruleStruct, isT0, isGlobalManager)
+	err = patchNsxtPolicyNATRule(connector, projectID, gwID, ruleStruct, isT0, isGlobalManager)
 	if err != nil {
-		return handleCreateError("NAT Rule", id, err)
+		return getOperationDiagnostics(m, handleCreateError("NAT Rule", id, err))
 	}
 
 	d.SetId(id)
 	d.Set("nsx_id", id)
 
-	return resourceNsxtPolicyNATRuleRead(d, m)
+	return resourceNsxtPolicyNATRuleRead(ctx, d, m)
 }
 
-func resourceNsxtPolicyNATRuleUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyNATRuleUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining NAT Rule ID")
+		return diag.Errorf("Error obtaining NAT Rule ID")
 	}
 
 	gwPolicyPath := d.Get("gateway_path").(string)
 	isT0, gwID := parseGatewayPolicyPath(gwPolicyPath)
 	if gwID == "" {
-		return fmt.Errorf("gateway_path is not valid")
+		return getErrorDiagnostics(newAttributeError("gateway_path", fmt.Errorf("gateway_path is not valid")))
 	}
 
 	displayName := d.Get("display_name").(string)
@@ -430,19 +471,35 @@ func resourceNsxtPolicyNATRuleUpdate(d *schema.ResourceData, m interface{}) erro
 	}
 
 	log.Printf("[INFO] Updating NAT Rule with ID %s", id)
-	err := patchNsxtPolicyNATRule(connector, gwID, ruleStruct, isT0, isPolicyGlobalManager(m))
+	err := patchNsxtPolicyNATRule(connector, getPolicyProjectID(d), gwID, ruleStruct, isT0, isPolicyGlobalManager(m))
 	if err != nil {
-		return handleUpdateError("NAT Rule", id, err)
+		return getOperationDiagnostics(m, handleUpdateError("NAT Rule", id, err))
 	}
 
 	d.SetId(id)
 	d.Set("nsx_id", id)
 
-	return resourceNsxtPolicyNATRuleRead(d, m)
+	return resourceNsxtPolicyNATRuleRead(ctx, d, m)
 }
 
-func resourceNsxtPolicyNATRuleImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+func resourceNsxtPolicyNATRuleImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	importID := d.Id()
+	if projectID := getPolicyProjectIDFromPath(importID); projectID != "" {
+		// Project-scoped rules are imported by full policy path
+		// sample path looks like "/orgs/default/projects/p1/infra/tier-1s/gw1/nat/USER/nat-rules/rule1"
+		natIndex := strings.Index(importID, "/nat/")
+		if natIndex < 0 {
+			return nil, fmt.Errorf("Please provide full policy path of the NAT Rule as an input")
+		}
+		if getResourceIDFromResourcePath(importID, "nat") == model.PolicyNat_NAT_TYPE_NAT64 {
+			d.Set("action", model.PolicyNatRule_ACTION_NAT64)
+		}
+		setPolicyProjectIDInSchema(d, projectID)
+		d.Set("gateway_path", importID[:natIndex])
+		d.SetId(getPolicyIDFromPath(importID))
+		return []*schema.ResourceData{d}, nil
+	}
+
 	s := strings.Split(importID, "/")
 	if len(s) < 2 || len(s) > 3 {
 		return nil, fmt.Errorf("Please provide <gateway-id>/<nat-rule-id>/[nat-type] as an input")
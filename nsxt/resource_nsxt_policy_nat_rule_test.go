@@ -17,6 +17,23 @@ var testAccResourcePolicyNATRuleSourceNet = "14.1.1.3"
 var testAccResourcePolicyNATRuleDestNet = "15.1.1.3"
 var testAccResourcePolicyNATRuleTransNet = "16.1.1.3"
 
+func TestUnitResourceNsxtPolicyNATRule_project(t *testing.T) {
+	fake := newFakeNsxServer(t)
+	meta := testUnitConfigureProvider(t, fake)
+
+	// Gateway outside of rule project is rejected before calling project API
+	for _, gatewayPath := range []string{"/infra/tier-1s/gw1", "/orgs/default/projects/project2/infra/tier-1s/gw1"} {
+		testUnitCheckApplyAttributeError(t, meta, "nsxt_policy_nat_rule", map[string]interface{}{
+			"display_name":         "rule1",
+			"gateway_path":         gatewayPath,
+			"action":               model.PolicyNatRule_ACTION_DNAT,
+			"destination_networks": []interface{}{"15.1.1.3"},
+			"translated_networks":  []interface{}{"16.1.1.3"},
+			"context":              []interface{}{map[string]interface{}{"project_id": "project1"}},
+		}, "gateway_path")
+	}
+}
+
 func TestAccResourceNsxtPolicyNATRule_minimalT0(t *testing.T) {
 	name := getAccTestResourceName()
 	action := model.PolicyNatRule_ACTION_REFLEXIVE
@@ -360,7 +377,7 @@ func testAccNsxtPolicyNATRuleExists(resourceName string, isNat bool) resource.Te
 			natType = model.PolicyNat_NAT_TYPE_NAT64
 		}
 		isT0, gwID := parseGatewayPolicyPath(gwPath)
-		_, err := getNsxtPolicyNATRuleByID(connector, gwID, isT0, natType, resourceID, testAccIsGlobalManager())
+		_, err := getNsxtPolicyNATRuleByID(connector, "", gwID, isT0, natType, resourceID, testAccIsGlobalManager())
 		if err != nil {
 			return fmt.Errorf("Error while retrieving policy NAT Rule ID %s. Error: %v", resourceID, err)
 		}
@@ -384,7 +401,7 @@ func testAccNsxtPolicyNATRuleCheckDestroy(state *terraform.State, displayName st
 		if isNat {
 			natType = model.PolicyNat_NAT_TYPE_NAT64
 		}
-		_, err := getNsxtPolicyNATRuleByID(connector, gwID, isT0, natType, resourceID, testAccIsGlobalManager())
+		_, err := getNsxtPolicyNATRuleByID(connector, "", gwID, isT0, natType, resourceID, testAccIsGlobalManager())
 		if err == nil {
 			return fmt.Errorf("Policy NAT Rule %s still exists", displayName)
 		}
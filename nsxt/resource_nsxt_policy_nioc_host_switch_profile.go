@@ -0,0 +1,206 @@
+/* Copyright © 2023 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+var niocTrafficNameValues = []string{
+	model.PolicyHostInfraTrafficType_TRAFFIC_NAME_FAULT_TOLERANCE,
+	model.PolicyHostInfraTrafficType_TRAFFIC_NAME_HBR,
+	model.PolicyHostInfraTrafficType_TRAFFIC_NAME_ISCSI,
+	model.PolicyHostInfraTrafficType_TRAFFIC_NAME_MANAGEMENT,
+	model.PolicyHostInfraTrafficType_TRAFFIC_NAME_NFS,
+	model.PolicyHostInfraTrafficType_TRAFFIC_NAME_VDP,
+	model.PolicyHostInfraTrafficType_TRAFFIC_NAME_VIRTUAL_MACHINE,
+	model.PolicyHostInfraTrafficType_TRAFFIC_NAME_VMOTION,
+	model.PolicyHostInfraTrafficType_TRAFFIC_NAME_VSAN,
+}
+
+func resourceNsxtPolicyNiocHostSwitchProfile() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceNsxtPolicyNiocHostSwitchProfileCreate,
+		ReadContext:   resourceNsxtPolicyNiocHostSwitchProfileRead,
+		UpdateContext: resourceNsxtPolicyNiocHostSwitchProfileUpdate,
+		DeleteContext: resourceNsxtPolicyHostSwitchProfileDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"nsx_id":       getNsxIDSchema(),
+			"path":         getPathSchema(),
+			"display_name": getDisplayNameSchema(),
+			"description":  getDescriptionSchema(),
+			"revision":     getRevisionSchema(),
+			"tag":          getTagsSchema(),
+			"enabled": {
+				Type:        schema.TypeBool,
+				Description: "Enabled status of host infra traffic resource allocation",
+				Optional:    true,
+				Default:     true,
+			},
+			"host_infra_traffic_res": {
+				Type:        schema.TypeList,
+				Description: "Resource allocation associated with NIOC profile",
+				Optional:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"traffic_name": {
+							Type:         schema.TypeString,
+							Description:  "Traffic type to which the resource allocation applies",
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(niocTrafficNameValues, false),
+						},
+						"limit": {
+							Type:         schema.TypeFloat,
+							Description:  "Maximum bandwidth percentage, -1 for unlimited",
+							Optional:     true,
+							Default:      -1,
+							ValidateFunc: validation.FloatBetween(-1, 100),
+						},
+						"reservation": {
+							Type:         schema.TypeFloat,
+							Description:  "Minimum guaranteed bandwidth percentage",
+							Optional:     true,
+							Default:      0,
+							ValidateFunc: validation.FloatBetween(0, 100),
+						},
+						"shares": {
+							Type:         schema.TypeInt,
+							Description:  "Shares value",
+							Optional:     true,
+							Default:      50,
+							ValidateFunc: validation.IntBetween(1, 100),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func getPolicyNiocTrafficResFromSchema(d *schema.ResourceData) []model.PolicyPolicyResourceAllocation {
+	var result []model.PolicyPolicyResourceAllocation
+	for _, r := range d.Get("host_infra_traffic_res").([]interface{}) {
+		res := r.(map[string]interface{})
+		trafficName := res["traffic_name"].(string)
+		limit := res["limit"].(float64)
+		reservation := res["reservation"].(float64)
+		shares := int64(res["shares"].(int))
+		result = append(result, model.PolicyPolicyResourceAllocation{
+			TrafficType: &model.PolicyHostInfraTrafficType{
+				TrafficName: &trafficName,
+			},
+			Limit:       &limit,
+			Reservation: &reservation,
+			Shares:      &shares,
+		})
+	}
+
+	return result
+}
+
+func setPolicyNiocTrafficResInSchema(d *schema.ResourceData, resources []model.PolicyPolicyResourceAllocation) {
+	var resList []interface{}
+	for _, res := range resources {
+		elem := make(map[string]interface{})
+		if res.TrafficType != nil {
+			elem["traffic_name"] = res.TrafficType.TrafficName
+		}
+		elem["limit"] = res.Limit
+		elem["reservation"] = res.Reservation
+		elem["shares"] = res.Shares
+		resList = append(resList, elem)
+	}
+	d.Set("host_infra_traffic_res", resList)
+}
+
+func policyNiocHostSwitchProfilePatch(d *schema.ResourceData, m interface{}, id string) error {
+	displayName := d.Get("display_name").(string)
+	description := d.Get("description").(string)
+	tags := getPolicyTagsFromSchema(d)
+	enabled := d.Get("enabled").(bool)
+
+	obj := model.PolicyNiocProfile{
+		DisplayName:         &displayName,
+		Description:         &description,
+		Tags:                tags,
+		ResourceType:        model.PolicyBaseHostSwitchProfile_RESOURCE_TYPE_POLICYNIOCPROFILE,
+		Enabled:             &enabled,
+		HostInfraTrafficRes: getPolicyNiocTrafficResFromSchema(d),
+	}
+
+	return policyHostSwitchProfilePatch(getPolicyConnector(m), id, obj, model.PolicyNiocProfileBindingType())
+}
+
+func resourceNsxtPolicyNiocHostSwitchProfileCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if isPolicyGlobalManager(m) {
+		return getErrorDiagnostics(localManagerOnlyError())
+	}
+
+	// Initialize resource Id and verify this ID is not yet used
+	id, err := getOrGenerateID(d, m, resourceNsxtPolicyHostSwitchProfileExists)
+	if err != nil {
+		return getOperationDiagnostics(m, err)
+	}
+
+	log.Printf("[INFO] Creating NIOC Host Switch Profile with ID %s", id)
+	err = policyNiocHostSwitchProfilePatch(d, m, id)
+	if err != nil {
+		return getOperationDiagnostics(m, handleCreateError("NIOC Host Switch Profile", id, err))
+	}
+
+	d.SetId(id)
+	d.Set("nsx_id", id)
+
+	return resourceNsxtPolicyNiocHostSwitchProfileRead(ctx, d, m)
+}
+
+func resourceNsxtPolicyNiocHostSwitchProfileRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	connector := getPolicyConnector(m)
+	id := d.Id()
+	if id == "" {
+		return diag.Errorf("Error obtaining NIOC Host Switch Profile ID")
+	}
+
+	profile, err := policyHostSwitchProfileGet(connector, id, model.PolicyNiocProfileBindingType())
+	if err != nil {
+		return getOperationDiagnostics(m, handleReadError(d, "NIOC Host Switch Profile", id, err))
+	}
+	obj := profile.(model.PolicyNiocProfile)
+
+	d.Set("display_name", obj.DisplayName)
+	d.Set("description", obj.Description)
+	setPolicyTagsInSchema(d, obj.Tags)
+	d.Set("nsx_id", id)
+	d.Set("path", obj.Path)
+	d.Set("revision", obj.Revision)
+	d.Set("enabled", obj.Enabled)
+	setPolicyNiocTrafficResInSchema(d, obj.HostInfraTrafficRes)
+
+	return nil
+}
+
+func resourceNsxtPolicyNiocHostSwitchProfileUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	id := d.Id()
+	if id == "" {
+		return diag.Errorf("Error obtaining NIOC Host Switch Profile ID")
+	}
+
+	log.Printf("[INFO] Updating NIOC Host Switch Profile with ID %s", id)
+	err := policyNiocHostSwitchProfilePatch(d, m, id)
+	if err != nil {
+		return getOperationDiagnostics(m, handleUpdateError("NIOC Host Switch Profile", id, err))
+	}
+
+	return resourceNsxtPolicyNiocHostSwitchProfileRead(ctx, d, m)
+}
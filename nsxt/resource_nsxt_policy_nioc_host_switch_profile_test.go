@@ -0,0 +1,111 @@
+/* Copyright © 2023 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccResourceNsxtPolicyNiocHostSwitchProfile_basic(t *testing.T) {
+	testResourceName := "nsxt_policy_nioc_host_switch_profile.test"
+	name := getAccTestResourceName()
+	updatedName := getAccTestResourceName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccOnlyLocalManager(t)
+			testAccNSXVersion(t, "4.0.0")
+		},
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyResourceCheckDestroy(state, updatedName, "nsxt_policy_nioc_host_switch_profile", resourceNsxtPolicyHostSwitchProfileExists)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyNiocHostSwitchProfileTemplate(name, 50),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyResourceExists(testResourceName, resourceNsxtPolicyHostSwitchProfileExists),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", name),
+					resource.TestCheckResourceAttr(testResourceName, "description", "terraform created"),
+					resource.TestCheckResourceAttr(testResourceName, "enabled", "true"),
+					resource.TestCheckResourceAttr(testResourceName, "host_infra_traffic_res.#", "2"),
+					resource.TestCheckResourceAttr(testResourceName, "host_infra_traffic_res.0.traffic_name", "VIRTUAL_MACHINE"),
+					resource.TestCheckResourceAttr(testResourceName, "host_infra_traffic_res.0.shares", "50"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "1"),
+					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+				),
+			},
+			{
+				Config: testAccNsxtPolicyNiocHostSwitchProfileTemplate(updatedName, 100),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyResourceExists(testResourceName, resourceNsxtPolicyHostSwitchProfileExists),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", updatedName),
+					resource.TestCheckResourceAttr(testResourceName, "host_infra_traffic_res.#", "2"),
+					resource.TestCheckResourceAttr(testResourceName, "host_infra_traffic_res.0.shares", "100"),
+					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNsxtPolicyNiocHostSwitchProfile_importBasic(t *testing.T) {
+	name := getAccTestResourceName()
+	testResourceName := "nsxt_policy_nioc_host_switch_profile.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccOnlyLocalManager(t)
+			testAccNSXVersion(t, "4.0.0")
+		},
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyResourceCheckDestroy(state, name, "nsxt_policy_nioc_host_switch_profile", resourceNsxtPolicyHostSwitchProfileExists)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyNiocHostSwitchProfileTemplate(name, 50),
+			},
+			{
+				ResourceName:      testResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicyNiocHostSwitchProfileTemplate(name string, shares int) string {
+	return fmt.Sprintf(`
+resource "nsxt_policy_nioc_host_switch_profile" "test" {
+  display_name = "%s"
+  description  = "terraform created"
+
+  host_infra_traffic_res {
+    traffic_name = "VIRTUAL_MACHINE"
+    shares       = %d
+  }
+
+  host_infra_traffic_res {
+    traffic_name = "VMOTION"
+    limit        = 50
+    reservation  = 10
+  }
+
+  tag {
+    scope = "scope1"
+    tag   = "tag1"
+  }
+}`, name, shares)
+}
@@ -4,9 +4,11 @@
 package nsxt
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
@@ -27,12 +29,12 @@ var ospfAreaAuthModeValues = []string{
 
 func resourceNsxtPolicyOspfArea() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtPolicyOspfAreaCreate,
-		Read:   resourceNsxtPolicyOspfAreaRead,
-		Update: resourceNsxtPolicyOspfAreaUpdate,
-		Delete: resourceNsxtPolicyOspfAreaDelete,
+		CreateContext: resourceNsxtPolicyOspfAreaCreate,
+		ReadContext:   resourceNsxtPolicyOspfAreaRead,
+		UpdateContext: resourceNsxtPolicyOspfAreaUpdate,
+		DeleteContext: resourceNsxtPolicyOspfAreaDelete,
 		Importer: &schema.ResourceImporter{
-			State: resourceNsxtPolicyOspfAreaImport,
+			StateContext: resourceNsxtPolicyOspfAreaImport,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -149,7 +151,7 @@ func policyOspfAreaPatch(d *schema.ResourceData, m interface{}, id string) error
 	return err
 }
 
-func resourceNsxtPolicyOspfAreaCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyOspfAreaCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 
 	id := d.Get("nsx_id").(string)
 	if id == "" {
@@ -161,33 +163,33 @@ func resourceNsxtPolicyOspfAreaCreate(d *schema.ResourceData, m interface{}) err
 
 	err := policyOspfAreaPatch(d, m, id)
 	if err != nil {
-		return handleCreateError("Ospf Area", id, err)
+		return getOperationDiagnostics(m, handleCreateError("Ospf Area", id, err))
 	}
 
 	d.SetId(id)
 	d.Set("nsx_id", id)
 
-	return resourceNsxtPolicyOspfAreaRead(d, m)
+	return resourceNsxtPolicyOspfAreaRead(ctx, d, m)
 }
 
-func resourceNsxtPolicyOspfAreaRead(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyOspfAreaRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining OspfArea ID")
+		return diag.Errorf("Error obtaining OspfArea ID")
 	}
 
 	ospfPath := d.Get("ospf_path").(string)
 	gwID, localeServiceID := parseOspfConfigPath(ospfPath)
 	if gwID == "" {
-		return fmt.Errorf("Expecting OSPF config path, got %s", ospfPath)
+		return diag.Errorf("Expecting OSPF config path, got %s", ospfPath)
 	}
 
 	client := ospf.NewAreasClient(connector)
 	obj, err := client.Get(gwID, localeServiceID, id)
 	if err != nil {
-		return handleReadError(d, "Ospf Area", id, err)
+		return getOperationDiagnostics(m, handleReadError(d, "Ospf Area", id, err))
 	}
 
 	d.Set("display_name", obj.DisplayName)
@@ -208,44 +210,44 @@ func resourceNsxtPolicyOspfAreaRead(d *schema.ResourceData, m interface{}) error
 	return nil
 }
 
-func resourceNsxtPolicyOspfAreaUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyOspfAreaUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining Ospf Area ID")
+		return diag.Errorf("Error obtaining Ospf Area ID")
 	}
 
 	err := policyOspfAreaPatch(d, m, id)
 	if err != nil {
-		return handleUpdateError("Ospf Area", id, err)
+		return getOperationDiagnostics(m, handleUpdateError("Ospf Area", id, err))
 	}
 
-	return resourceNsxtPolicyOspfAreaRead(d, m)
+	return resourceNsxtPolicyOspfAreaRead(ctx, d, m)
 }
 
-func resourceNsxtPolicyOspfAreaDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyOspfAreaDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining Ospf Area ID")
+		return diag.Errorf("Error obtaining Ospf Area ID")
 	}
 
 	connector := getPolicyConnector(m)
 	ospfPath := d.Get("ospf_path").(string)
 	gwID, localeServiceID := parseOspfConfigPath(ospfPath)
 	if gwID == "" {
-		return fmt.Errorf("Expecting OSPF config path, got %s", ospfPath)
+		return diag.Errorf("Expecting OSPF config path, got %s", ospfPath)
 	}
 
 	client := ospf.NewAreasClient(connector)
 	err := client.Delete(gwID, localeServiceID, id)
 	if err != nil {
-		return handleDeleteError("Ospf Area", id, err)
+		return getOperationDiagnostics(m, handleDeleteError("Ospf Area", id, err))
 	}
 
 	return nil
 }
 
-func resourceNsxtPolicyOspfAreaImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+func resourceNsxtPolicyOspfAreaImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	importID := d.Id()
 	s := strings.Split(importID, "/")
 	if len(s) != 3 {
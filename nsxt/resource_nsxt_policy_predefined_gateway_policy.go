@@ -4,9 +4,11 @@
 package nsxt
 
 import (
+	"context"
 	"fmt"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
@@ -21,12 +23,12 @@ import (
 
 func resourceNsxtPolicyPredefinedGatewayPolicy() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtPolicyPredefinedGatewayPolicyCreate,
-		Read:   resourceNsxtPolicyPredefinedGatewayPolicyRead,
-		Update: resourceNsxtPolicyPredefinedGatewayPolicyUpdate,
-		Delete: resourceNsxtPolicyPredefinedGatewayPolicyDelete,
+		CreateContext: resourceNsxtPolicyPredefinedGatewayPolicyCreate,
+		ReadContext:   resourceNsxtPolicyPredefinedGatewayPolicyRead,
+		UpdateContext: resourceNsxtPolicyPredefinedGatewayPolicyUpdate,
+		DeleteContext: resourceNsxtPolicyPredefinedGatewayPolicyDelete,
 		Importer: &schema.ResourceImporter{
-			State: nsxtPredefinedPolicyImporter,
+			StateContext: nsxtPredefinedPolicyImporter,
 		},
 
 		Schema: getPolicyPredefinedGatewayPolicySchema(),
@@ -38,7 +40,7 @@ func getPolicyPredefinedGatewayPolicySchema() map[string]*schema.Schema {
 		"path":         getPolicyPathSchema(true, true, "Path for this Gateway Policy"),
 		"description":  getComputedDescriptionSchema(),
 		"tag":          getTagsSchema(),
-		"rule":         getSecurityPolicyAndGatewayRulesSchema(true, false, false),
+		"rule":         getPolicyGatewayRulesSchema(false),
 		"default_rule": getGatewayPolicyDefaultRulesSchema(),
 		"revision":     getRevisionSchema(),
 	}
@@ -276,8 +278,9 @@ func gatewayPolicyInfraPatch(policy model.GatewayPolicy, domain string, m interf
 		ResourceType: &infraType,
 	}
 
-	return policyInfraPatch(infraObj, isPolicyGlobalManager(m), getPolicyConnector(m), false)
-
+	// Revision is only set in update flow
+	enforceRevision := getPolicyEnforceRevision(m) && policy.Revision != nil
+	return policyInfraPatch(infraObj, isPolicyGlobalManager(m), getPolicyConnector(m), enforceRevision)
 }
 
 func updatePolicyPredefinedGatewayPolicy(id string, d *schema.ResourceData, m interface{}) error {
@@ -382,30 +385,30 @@ func updatePolicyPredefinedGatewayPolicy(id string, d *schema.ResourceData, m in
 	return nil
 }
 
-func resourceNsxtPolicyPredefinedGatewayPolicyCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyPredefinedGatewayPolicyCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	path := d.Get("path").(string)
 	id := getPolicyIDFromPath(path)
 
 	if id == "" {
-		return fmt.Errorf("Failed to extract ID from Gateway Policy path %s", path)
+		return diag.Errorf("Failed to extract ID from Gateway Policy path %s", path)
 	}
 
 	err := updatePolicyPredefinedGatewayPolicy(id, d, m)
 	if err != nil {
-		return err
+		return getOperationDiagnostics(m, err)
 	}
 
 	d.SetId(id)
 
-	return resourceNsxtPolicyPredefinedGatewayPolicyRead(d, m)
+	return resourceNsxtPolicyPredefinedGatewayPolicyRead(ctx, d, m)
 }
 
-func resourceNsxtPolicyPredefinedGatewayPolicyRead(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyPredefinedGatewayPolicyRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining Gateway Policy ID")
+		return diag.Errorf("Error obtaining Gateway Policy ID")
 	}
 
 	path := d.Get("path").(string)
@@ -416,11 +419,11 @@ func resourceNsxtPolicyPredefinedGatewayPolicyRead(d *schema.ResourceData, m int
 		client := gm_domains.NewGatewayPoliciesClient(connector)
 		gmObj, err := client.Get(domain, id)
 		if err != nil {
-			return handleReadError(d, "Predefined Gateway Policy", id, err)
+			return getOperationDiagnostics(m, handleReadError(d, "Predefined Gateway Policy", id, err))
 		}
 		rawObj, err := convertModelBindingType(gmObj, gm_model.GatewayPolicyBindingType(), model.GatewayPolicyBindingType())
 		if err != nil {
-			return err
+			return getOperationDiagnostics(m, err)
 		}
 		obj = rawObj.(model.GatewayPolicy)
 	} else {
@@ -428,7 +431,7 @@ func resourceNsxtPolicyPredefinedGatewayPolicyRead(d *schema.ResourceData, m int
 		client := domains.NewGatewayPoliciesClient(connector)
 		obj, err = client.Get(domain, id)
 		if err != nil {
-			return handleReadError(d, "Predefined Gateway Policy", id, err)
+			return getOperationDiagnostics(m, handleReadError(d, "Predefined Gateway Policy", id, err))
 		}
 	}
 
@@ -448,31 +451,31 @@ func resourceNsxtPolicyPredefinedGatewayPolicyRead(d *schema.ResourceData, m int
 		}
 	}
 
-	err := setPolicyRulesInSchema(d, rules)
+	err := setPolicyRulesInSchema(d, rules, true)
 	if err != nil {
-		return err
+		return getOperationDiagnostics(m, err)
 	}
-	return setPolicyDefaultRulesInSchema(d, defaultRules)
+	return getErrorDiagnostics(setPolicyDefaultRulesInSchema(d, defaultRules))
 }
 
-func resourceNsxtPolicyPredefinedGatewayPolicyUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyPredefinedGatewayPolicyUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining Predefined Gateway Policy ID")
+		return diag.Errorf("Error obtaining Predefined Gateway Policy ID")
 	}
 	err := updatePolicyPredefinedGatewayPolicy(id, d, m)
 	if err != nil {
-		return err
+		return getOperationDiagnostics(m, err)
 	}
 
-	return resourceNsxtPolicyPredefinedGatewayPolicyRead(d, m)
+	return resourceNsxtPolicyPredefinedGatewayPolicyRead(ctx, d, m)
 }
 
-func resourceNsxtPolicyPredefinedGatewayPolicyDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyPredefinedGatewayPolicyDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	// Delete means revert back to default
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining Predefined Gateway Policy ID")
+		return diag.Errorf("Error obtaining Predefined Gateway Policy ID")
 	}
 
 	path := d.Get("path").(string)
@@ -480,22 +483,22 @@ func resourceNsxtPolicyPredefinedGatewayPolicyDelete(d *schema.ResourceData, m i
 
 	predefinedPolicy, err := getGatewayPolicyInDomain(id, domain, getPolicyConnector(m), isPolicyGlobalManager(m))
 	if err != nil {
-		return err
+		return getOperationDiagnostics(m, err)
 	}
 
 	revertedPolicy, err := revertPolicyPredefinedGatewayPolicy(predefinedPolicy, m)
 	if err != nil {
-		return fmt.Errorf("Failed to revert Predefined Gateway Policy %s: %s", id, err)
+		return diag.Errorf("Failed to revert Predefined Gateway Policy %s: %s", id, err)
 	}
 
 	err = gatewayPolicyInfraPatch(revertedPolicy, domain, m)
 	if err != nil {
-		return handleUpdateError("Predefined Gateway Policy", id, err)
+		return getOperationDiagnostics(m, handleUpdateError("Predefined Gateway Policy", id, err))
 	}
 	return nil
 }
 
-func nsxtPredefinedPolicyImporter(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+func nsxtPredefinedPolicyImporter(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	importPath := d.Id()
 	d.Set("path", importPath)
 	id := getPolicyIDFromPath(importPath)
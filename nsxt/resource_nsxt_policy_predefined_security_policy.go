@@ -4,9 +4,11 @@
 package nsxt
 
 import (
+	"context"
 	"fmt"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
@@ -20,12 +22,11 @@ import (
 
 func resourceNsxtPolicyPredefinedSecurityPolicy() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtPolicyPredefinedSecurityPolicyCreate,
-		Read:   resourceNsxtPolicyPredefinedSecurityPolicyRead,
-		Update: resourceNsxtPolicyPredefinedSecurityPolicyUpdate,
-		Delete: resourceNsxtPolicyPredefinedSecurityPolicyDelete,
-
-		Schema: getPolicyPredefinedSecurityPolicySchema(),
+		CreateContext: resourceNsxtPolicyPredefinedSecurityPolicyCreate,
+		ReadContext:   resourceNsxtPolicyPredefinedSecurityPolicyRead,
+		UpdateContext: resourceNsxtPolicyPredefinedSecurityPolicyUpdate,
+		DeleteContext: resourceNsxtPolicyPredefinedSecurityPolicyDelete,
+		Schema:        getPolicyPredefinedSecurityPolicySchema(),
 	}
 }
 
@@ -304,30 +305,30 @@ func updatePolicyPredefinedSecurityPolicy(id string, d *schema.ResourceData, m i
 	return nil
 }
 
-func resourceNsxtPolicyPredefinedSecurityPolicyCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyPredefinedSecurityPolicyCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	path := d.Get("path").(string)
 	id := getPolicyIDFromPath(path)
 
 	if id == "" {
-		return fmt.Errorf("Failed to extract ID from Security Policy path %s", path)
+		return diag.Errorf("Failed to extract ID from Security Policy path %s", path)
 	}
 
 	err := updatePolicyPredefinedSecurityPolicy(id, d, m)
 	if err != nil {
-		return err
+		return getOperationDiagnostics(m, err)
 	}
 
 	d.SetId(id)
 
-	return resourceNsxtPolicyPredefinedSecurityPolicyRead(d, m)
+	return resourceNsxtPolicyPredefinedSecurityPolicyRead(ctx, d, m)
 }
 
-func resourceNsxtPolicyPredefinedSecurityPolicyRead(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyPredefinedSecurityPolicyRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining Security Policy ID")
+		return diag.Errorf("Error obtaining Security Policy ID")
 	}
 
 	path := d.Get("path").(string)
@@ -338,11 +339,11 @@ func resourceNsxtPolicyPredefinedSecurityPolicyRead(d *schema.ResourceData, m in
 		client := gm_domains.NewSecurityPoliciesClient(connector)
 		gmObj, err := client.Get(domain, id)
 		if err != nil {
-			return handleReadError(d, "Predefined Security Policy", id, err)
+			return getOperationDiagnostics(m, handleReadError(d, "Predefined Security Policy", id, err))
 		}
 		rawObj, err := convertModelBindingType(gmObj, gm_model.SecurityPolicyBindingType(), model.SecurityPolicyBindingType())
 		if err != nil {
-			return err
+			return getOperationDiagnostics(m, err)
 		}
 		obj = rawObj.(model.SecurityPolicy)
 	} else {
@@ -350,7 +351,7 @@ func resourceNsxtPolicyPredefinedSecurityPolicyRead(d *schema.ResourceData, m in
 		client := domains.NewSecurityPoliciesClient(connector)
 		obj, err = client.Get(domain, id)
 		if err != nil {
-			return handleReadError(d, "Predefined Security Policy", id, err)
+			return getOperationDiagnostics(m, handleReadError(d, "Predefined Security Policy", id, err))
 		}
 	}
 
@@ -370,31 +371,31 @@ func resourceNsxtPolicyPredefinedSecurityPolicyRead(d *schema.ResourceData, m in
 		}
 	}
 
-	err := setPolicyRulesInSchema(d, rules)
+	err := setPolicyRulesInSchema(d, rules, false)
 	if err != nil {
-		return err
+		return getOperationDiagnostics(m, err)
 	}
-	return setPolicyDefaultRulesInSchema(d, defaultRules)
+	return getErrorDiagnostics(setPolicyDefaultRulesInSchema(d, defaultRules))
 }
 
-func resourceNsxtPolicyPredefinedSecurityPolicyUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyPredefinedSecurityPolicyUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining Predefined Security Policy ID")
+		return diag.Errorf("Error obtaining Predefined Security Policy ID")
 	}
 	err := updatePolicyPredefinedSecurityPolicy(id, d, m)
 	if err != nil {
-		return err
+		return getOperationDiagnostics(m, err)
 	}
 
-	return resourceNsxtPolicyPredefinedSecurityPolicyRead(d, m)
+	return resourceNsxtPolicyPredefinedSecurityPolicyRead(ctx, d, m)
 }
 
-func resourceNsxtPolicyPredefinedSecurityPolicyDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyPredefinedSecurityPolicyDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	// Delete means revert back to default
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining Predefined Security Policy ID")
+		return diag.Errorf("Error obtaining Predefined Security Policy ID")
 	}
 
 	path := d.Get("path").(string)
@@ -402,22 +403,26 @@ func resourceNsxtPolicyPredefinedSecurityPolicyDelete(d *schema.ResourceData, m
 
 	predefinedPolicy, err := getSecurityPolicyInDomain(id, domain, getPolicyConnector(m), isPolicyGlobalManager(m))
 	if err != nil {
-		return err
+		return getOperationDiagnostics(m, err)
 	}
 
 	revertedPolicy, err := revertPolicyPredefinedSecurityPolicy(predefinedPolicy, m)
 	if err != nil {
-		return fmt.Errorf("Failed to revert Predefined Security Policy %s: %s", id, err)
+		return diag.Errorf("Failed to revert Predefined Security Policy %s: %s", id, err)
 	}
 
 	err = securityPolicyInfraPatch(revertedPolicy, domain, m)
 	if err != nil {
-		return handleUpdateError("Predefined Security Policy", id, err)
+		return getOperationDiagnostics(m, handleUpdateError("Predefined Security Policy", id, err))
 	}
 	return nil
 }
 
 func securityPolicyInfraPatch(policy model.SecurityPolicy, domain string, m interface{}) error {
+	return securityPolicyInfraPatchWithContext("", policy, domain, m)
+}
+
+func securityPolicyInfraPatchWithContext(projectID string, policy model.SecurityPolicy, domain string, m interface{}) error {
 	childDomain, err := createChildDomainWithSecurityPolicy(domain, *policy.Id, policy)
 	if err != nil {
 		return fmt.Errorf("Failed to create H-API for Predefined Security Policy: %s", err)
@@ -432,6 +437,7 @@ func securityPolicyInfraPatch(policy model.SecurityPolicy, domain string, m inte
 		ResourceType: &infraType,
 	}
 
-	return policyInfraPatch(infraObj, isPolicyGlobalManager(m), getPolicyConnector(m), false)
-
+	// Revision is only set in update flow
+	enforceRevision := getPolicyEnforceRevision(m) && policy.Revision != nil
+	return policyInfraPatchWithContext(projectID, infraObj, isPolicyGlobalManager(m), getPolicyConnector(m), enforceRevision)
 }
@@ -0,0 +1,220 @@
+/* Copyright © 2023 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/orgs"
+)
+
+func resourceNsxtPolicyProject() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceNsxtPolicyProjectCreate,
+		ReadContext:   resourceNsxtPolicyProjectRead,
+		UpdateContext: resourceNsxtPolicyProjectUpdate,
+		DeleteContext: resourceNsxtPolicyProjectDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"nsx_id":       getNsxIDSchema(),
+			"path":         getPathSchema(),
+			"display_name": getDisplayNameSchema(),
+			"description":  getDescriptionSchema(),
+			"revision":     getRevisionSchema(),
+			"tag":          getTagsSchema(),
+			"short_id": {
+				Type:        schema.TypeString,
+				Description: "Short identifier of the project, used as prefix for realized objects",
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+			},
+			"tier0_gateway_paths": {
+				Type:        schema.TypeList,
+				Description: "Policy paths of Tier0 gateways available to this project",
+				Optional:    true,
+				Elem:        getElemPolicyPathSchema(),
+			},
+			"site_info": {
+				Type:        schema.TypeList,
+				Description: "Edge clusters available to this project, per site",
+				Optional:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"edge_cluster_paths": {
+							Type:        schema.TypeList,
+							Description: "Policy paths of edge clusters available to this project",
+							Optional:    true,
+							Elem:        getElemPolicyPathSchema(),
+						},
+						"site_path": {
+							Type:         schema.TypeString,
+							Description:  "Policy path of the site",
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validatePolicyPath(),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceNsxtPolicyProjectExists(id string, connector client.Connector, isGlobalManager bool) (bool, error) {
+	client := orgs.NewProjectsClient(connector)
+	_, err := client.Get(defaultOrgID, id)
+	if err == nil {
+		return true, nil
+	}
+
+	if isNotFoundError(err) {
+		return false, nil
+	}
+
+	return false, logAPIError("Error retrieving Project", err)
+}
+
+func getPolicyProjectSiteInfosFromSchema(d *schema.ResourceData) []model.SiteInfo {
+	var siteInfos []model.SiteInfo
+	for _, s := range d.Get("site_info").([]interface{}) {
+		siteInfo := s.(map[string]interface{})
+		elem := model.SiteInfo{
+			EdgeClusterPaths: interface2StringList(siteInfo["edge_cluster_paths"].([]interface{})),
+		}
+		sitePath := siteInfo["site_path"].(string)
+		if sitePath != "" {
+			elem.SitePath = &sitePath
+		}
+		siteInfos = append(siteInfos, elem)
+	}
+
+	return siteInfos
+}
+
+func setPolicyProjectSiteInfosInSchema(d *schema.ResourceData, siteInfos []model.SiteInfo) {
+	var siteInfoList []interface{}
+	for _, siteInfo := range siteInfos {
+		elem := make(map[string]interface{})
+		elem["edge_cluster_paths"] = siteInfo.EdgeClusterPaths
+		elem["site_path"] = siteInfo.SitePath
+		siteInfoList = append(siteInfoList, elem)
+	}
+	d.Set("site_info", siteInfoList)
+}
+
+func policyProjectPatch(d *schema.ResourceData, m interface{}, id string) error {
+	connector := getPolicyConnector(m)
+
+	displayName := d.Get("display_name").(string)
+	description := d.Get("description").(string)
+	tags := getPolicyTagsFromSchema(d)
+
+	obj := model.Project{
+		DisplayName: &displayName,
+		Description: &description,
+		Tags:        tags,
+		Tier0s:      interface2StringList(d.Get("tier0_gateway_paths").([]interface{})),
+		SiteInfos:   getPolicyProjectSiteInfosFromSchema(d),
+	}
+
+	shortID := d.Get("short_id").(string)
+	if shortID != "" {
+		obj.ShortId = &shortID
+	}
+
+	client := orgs.NewProjectsClient(connector)
+	return client.Patch(defaultOrgID, id, obj)
+}
+
+func resourceNsxtPolicyProjectCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if isPolicyGlobalManager(m) {
+		return getErrorDiagnostics(localManagerOnlyError())
+	}
+	if !nsxVersionHigherOrEqual(policyMultitenancyMinVersion) {
+		return diag.Errorf("Project resource requires NSX version %s or higher", policyMultitenancyMinVersion)
+	}
+
+	// Initialize resource Id and verify this ID is not yet used
+	id, err := getOrGenerateID(d, m, resourceNsxtPolicyProjectExists)
+	if err != nil {
+		return getOperationDiagnostics(m, err)
+	}
+
+	log.Printf("[INFO] Creating Project with ID %s", id)
+	err = policyProjectPatch(d, m, id)
+	if err != nil {
+		return getOperationDiagnostics(m, handleCreateError("Project", id, err))
+	}
+
+	d.SetId(id)
+	d.Set("nsx_id", id)
+
+	return resourceNsxtPolicyProjectRead(ctx, d, m)
+}
+
+func resourceNsxtPolicyProjectRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	connector := getPolicyConnector(m)
+	id := d.Id()
+	if id == "" {
+		return diag.Errorf("Error obtaining Project ID")
+	}
+
+	client := orgs.NewProjectsClient(connector)
+	obj, err := client.Get(defaultOrgID, id)
+	if err != nil {
+		return getOperationDiagnostics(m, handleReadError(d, "Project", id, err))
+	}
+
+	d.Set("display_name", obj.DisplayName)
+	d.Set("description", obj.Description)
+	setPolicyTagsInSchema(d, obj.Tags)
+	d.Set("nsx_id", id)
+	d.Set("path", obj.Path)
+	d.Set("revision", obj.Revision)
+	d.Set("short_id", obj.ShortId)
+	d.Set("tier0_gateway_paths", obj.Tier0s)
+	setPolicyProjectSiteInfosInSchema(d, obj.SiteInfos)
+
+	return nil
+}
+
+func resourceNsxtPolicyProjectUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	id := d.Id()
+	if id == "" {
+		return diag.Errorf("Error obtaining Project ID")
+	}
+
+	log.Printf("[INFO] Updating Project with ID %s", id)
+	err := policyProjectPatch(d, m, id)
+	if err != nil {
+		return getOperationDiagnostics(m, handleUpdateError("Project", id, err))
+	}
+
+	return resourceNsxtPolicyProjectRead(ctx, d, m)
+}
+
+func resourceNsxtPolicyProjectDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	id := d.Id()
+	if id == "" {
+		return diag.Errorf("Error obtaining Project ID")
+	}
+
+	connector := getPolicyConnector(m)
+	client := orgs.NewProjectsClient(connector)
+	err := client.Delete(defaultOrgID, id)
+	if err != nil {
+		return getOperationDiagnostics(m, handleDeleteError("Project", id, err))
+	}
+
+	return nil
+}
@@ -0,0 +1,233 @@
+/* Copyright © 2023 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccResourceNsxtPolicyProject_basic(t *testing.T) {
+	testResourceName := "nsxt_policy_project.test"
+	name := getAccTestResourceName()
+	updatedName := getAccTestResourceName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccOnlyLocalManager(t)
+			testAccNSXVersion(t, "4.1.0")
+		},
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyResourceCheckDestroy(state, updatedName, "nsxt_policy_project", resourceNsxtPolicyProjectExists)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyProjectTemplate(name, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyResourceExists(testResourceName, resourceNsxtPolicyProjectExists),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", name),
+					resource.TestCheckResourceAttr(testResourceName, "description", "terraform created"),
+					resource.TestCheckResourceAttr(testResourceName, "tier0_gateway_paths.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "site_info.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "site_info.0.edge_cluster_paths.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "1"),
+					resource.TestCheckResourceAttrSet(testResourceName, "short_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+				),
+			},
+			{
+				Config: testAccNsxtPolicyProjectTemplate(updatedName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyResourceExists(testResourceName, resourceNsxtPolicyProjectExists),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", updatedName),
+					resource.TestCheckResourceAttr(testResourceName, "tier0_gateway_paths.#", "0"),
+					resource.TestCheckResourceAttr(testResourceName, "site_info.#", "1"),
+					resource.TestCheckResourceAttrSet(testResourceName, "short_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNsxtPolicyProject_importBasic(t *testing.T) {
+	name := getAccTestResourceName()
+	testResourceName := "nsxt_policy_project.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccOnlyLocalManager(t)
+			testAccNSXVersion(t, "4.1.0")
+		},
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyResourceCheckDestroy(state, name, "nsxt_policy_project", resourceNsxtPolicyProjectExists)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyProjectTemplate(name, false),
+			},
+			{
+				ResourceName:      testResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccResourceNsxtPolicyProject_context(t *testing.T) {
+	name := getAccTestResourceName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccOnlyLocalManager(t)
+			testAccNSXVersion(t, "4.1.0")
+		},
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyResourceCheckDestroy(state, name, "nsxt_policy_project", resourceNsxtPolicyProjectExists)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyProjectContextTemplate(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyResourceExists("nsxt_policy_project.test", resourceNsxtPolicyProjectExists),
+					resource.TestCheckResourceAttr("nsxt_policy_tier1_gateway.test", "context.#", "1"),
+					resource.TestMatchResourceAttr("nsxt_policy_tier1_gateway.test", "path", projectPathRegexp),
+					resource.TestMatchResourceAttr("nsxt_policy_segment.test", "path", projectPathRegexp),
+					resource.TestMatchResourceAttr("nsxt_policy_group.test", "path", projectPathRegexp),
+					resource.TestMatchResourceAttr("nsxt_policy_security_policy.test", "path", projectPathRegexp),
+					resource.TestCheckResourceAttr("nsxt_policy_security_policy.test", "rule.#", "1"),
+					resource.TestMatchResourceAttr("nsxt_policy_nat_rule.test", "path", projectPathRegexp),
+				),
+			},
+			{
+				ResourceName:      "nsxt_policy_group.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccNsxtPolicyProjectResourcePathImporterGetID("nsxt_policy_group.test"),
+			},
+			{
+				ResourceName:      "nsxt_policy_tier1_gateway.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccNsxtPolicyProjectResourcePathImporterGetID("nsxt_policy_tier1_gateway.test"),
+				// Edge cluster is not configured for this gateway
+				ImportStateVerifyIgnore: []string{"edge_cluster_path"},
+			},
+		},
+	})
+}
+
+var projectPathRegexp = regexp.MustCompile("^/orgs/default/projects/.*/infra/")
+
+func testAccNsxtPolicyProjectResourcePathImporterGetID(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("NSX Policy resource %s not found in resources", resourceName)
+		}
+		path := rs.Primary.Attributes["path"]
+		if path == "" {
+			return "", fmt.Errorf("NSX Policy resource path not set in resources")
+		}
+		return path, nil
+	}
+}
+
+func testAccNsxtPolicyProjectTemplate(name string, removeTier0 bool) string {
+	tier0Paths := "[data.nsxt_policy_tier0_gateway.test.path]"
+	if removeTier0 {
+		tier0Paths = "[]"
+	}
+	return testAccNsxtPolicyTier0GatewayReadTemplate(getTier0RouterName()) +
+		testAccNsxtPolicyEdgeClusterReadTemplate(getEdgeClusterName()) + fmt.Sprintf(`
+resource "nsxt_policy_project" "test" {
+  display_name        = "%s"
+  description         = "terraform created"
+  tier0_gateway_paths = %s
+
+  site_info {
+    edge_cluster_paths = [data.nsxt_policy_edge_cluster.test.path]
+  }
+
+  tag {
+    scope = "scope1"
+    tag   = "tag1"
+  }
+}`, name, tier0Paths)
+}
+
+func testAccNsxtPolicyProjectContextTemplate(name string) string {
+	return testAccNsxtPolicyProjectTemplate(name, false) + fmt.Sprintf(`
+resource "nsxt_policy_tier1_gateway" "test" {
+  context {
+    project_id = nsxt_policy_project.test.id
+  }
+  display_name = "%s"
+  tier0_path   = data.nsxt_policy_tier0_gateway.test.path
+}
+
+resource "nsxt_policy_segment" "test" {
+  context {
+    project_id = nsxt_policy_project.test.id
+  }
+  display_name      = "%s"
+  connectivity_path = nsxt_policy_tier1_gateway.test.path
+
+  subnet {
+    cidr = "12.12.2.1/24"
+  }
+}
+
+resource "nsxt_policy_group" "test" {
+  context {
+    project_id = nsxt_policy_project.test.id
+  }
+  display_name = "%s"
+
+  criteria {
+    ipaddress_expression {
+      ip_addresses = ["12.12.2.10"]
+    }
+  }
+}
+
+resource "nsxt_policy_security_policy" "test" {
+  context {
+    project_id = nsxt_policy_project.test.id
+  }
+  display_name = "%s"
+  category     = "Application"
+
+  rule {
+    display_name  = "rule1"
+    source_groups = [nsxt_policy_group.test.path]
+    action        = "ALLOW"
+  }
+}
+
+resource "nsxt_policy_nat_rule" "test" {
+  context {
+    project_id = nsxt_policy_project.test.id
+  }
+  display_name        = "%s"
+  action              = "SNAT"
+  source_networks     = ["12.12.2.0/24"]
+  translated_networks = ["2.2.2.2"]
+  gateway_path        = nsxt_policy_tier1_gateway.test.path
+}`, name, name, name, name, name)
+}
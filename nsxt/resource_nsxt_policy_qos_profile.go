@@ -4,9 +4,11 @@
 package nsxt
 
 import (
+	"context"
 	"fmt"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/vmware/vsphere-automation-sdk-go/runtime/bindings"
@@ -22,12 +24,12 @@ var rateLimiterResourceTypes = []string{model.QosBaseRateLimiter_RESOURCE_TYPE_I
 
 func resourceNsxtPolicyQosProfile() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtPolicyQosProfileCreate,
-		Read:   resourceNsxtPolicyQosProfileRead,
-		Update: resourceNsxtPolicyQosProfileUpdate,
-		Delete: resourceNsxtPolicyQosProfileDelete,
+		CreateContext: resourceNsxtPolicyQosProfileCreate,
+		ReadContext:   resourceNsxtPolicyQosProfileRead,
+		UpdateContext: resourceNsxtPolicyQosProfileUpdate,
+		DeleteContext: resourceNsxtPolicyQosProfileDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -136,13 +138,13 @@ func setPolicyQosRateShaperInSchema(d *schema.ResourceData, shaperConf []*data.S
 	}
 }
 
-func resourceNsxtPolicyQosProfileCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyQosProfileCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 
 	// Initialize resource Id and verify this ID is not yet used
 	id, err := getOrGenerateID(d, m, resourceNsxtPolicyQosProfileExists)
 	if err != nil {
-		return err
+		return getOperationDiagnostics(m, err)
 	}
 
 	displayName := d.Get("display_name").(string)
@@ -182,7 +184,7 @@ func resourceNsxtPolicyQosProfileCreate(d *schema.ResourceData, m interface{}) e
 	if isPolicyGlobalManager(m) {
 		gmObj, err1 := convertModelBindingType(obj, model.QosProfileBindingType(), gm_model.QosProfileBindingType())
 		if err1 != nil {
-			return err1
+			return getErrorDiagnostics(err1)
 		}
 
 		client := gm_infra.NewQosProfilesClient(connector)
@@ -192,21 +194,21 @@ func resourceNsxtPolicyQosProfileCreate(d *schema.ResourceData, m interface{}) e
 		err = client.Patch(id, obj, &boolFalse)
 	}
 	if err != nil {
-		return handleCreateError("QosProfile", id, err)
+		return getOperationDiagnostics(m, handleCreateError("QosProfile", id, err))
 	}
 
 	d.SetId(id)
 	d.Set("nsx_id", id)
 
-	return resourceNsxtPolicyQosProfileRead(d, m)
+	return resourceNsxtPolicyQosProfileRead(ctx, d, m)
 }
 
-func resourceNsxtPolicyQosProfileRead(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyQosProfileRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining QosProfile ID")
+		return diag.Errorf("Error obtaining QosProfile ID")
 	}
 
 	var obj model.QosProfile
@@ -214,11 +216,11 @@ func resourceNsxtPolicyQosProfileRead(d *schema.ResourceData, m interface{}) err
 		client := gm_infra.NewQosProfilesClient(connector)
 		gmObj, err := client.Get(id)
 		if err != nil {
-			return handleReadError(d, "QosProfile", id, err)
+			return getOperationDiagnostics(m, handleReadError(d, "QosProfile", id, err))
 		}
 		rawObj, err := convertModelBindingType(gmObj, gm_model.QosProfileBindingType(), model.QosProfileBindingType())
 		if err != nil {
-			return err
+			return getOperationDiagnostics(m, err)
 		}
 		obj = rawObj.(model.QosProfile)
 
@@ -227,7 +229,7 @@ func resourceNsxtPolicyQosProfileRead(d *schema.ResourceData, m interface{}) err
 		client := infra.NewQosProfilesClient(connector)
 		obj, err = client.Get(id)
 		if err != nil {
-			return handleReadError(d, "QosProfile", id, err)
+			return getOperationDiagnostics(m, handleReadError(d, "QosProfile", id, err))
 		}
 	}
 
@@ -251,16 +253,16 @@ func resourceNsxtPolicyQosProfileRead(d *schema.ResourceData, m interface{}) err
 	return nil
 }
 
-func resourceNsxtPolicyQosProfileUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyQosProfileUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 	client := infra.NewQosProfilesClient(connector)
 	if client == nil {
-		return policyResourceNotSupportedError()
+		return getErrorDiagnostics(policyResourceNotSupportedError())
 	}
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining QosProfile ID")
+		return diag.Errorf("Error obtaining QosProfile ID")
 	}
 
 	// Read the rest of the configured parameters
@@ -302,7 +304,7 @@ func resourceNsxtPolicyQosProfileUpdate(d *schema.ResourceData, m interface{}) e
 	if isPolicyGlobalManager(m) {
 		gmObj, err1 := convertModelBindingType(obj, model.QosProfileBindingType(), gm_model.QosProfileBindingType())
 		if err1 != nil {
-			return err1
+			return getErrorDiagnostics(err1)
 		}
 
 		client := gm_infra.NewQosProfilesClient(connector)
@@ -312,16 +314,16 @@ func resourceNsxtPolicyQosProfileUpdate(d *schema.ResourceData, m interface{}) e
 		err = client.Patch(id, obj, &boolFalse)
 	}
 	if err != nil {
-		return handleUpdateError("QosProfile", id, err)
+		return getOperationDiagnostics(m, handleUpdateError("QosProfile", id, err))
 	}
 
-	return resourceNsxtPolicyQosProfileRead(d, m)
+	return resourceNsxtPolicyQosProfileRead(ctx, d, m)
 }
 
-func resourceNsxtPolicyQosProfileDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyQosProfileDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining QosProfile ID")
+		return diag.Errorf("Error obtaining QosProfile ID")
 	}
 
 	var err error
@@ -336,7 +338,7 @@ func resourceNsxtPolicyQosProfileDelete(d *schema.ResourceData, m interface{}) e
 	}
 
 	if err != nil {
-		return handleDeleteError("QosProfile", id, err)
+		return getOperationDiagnostics(m, handleDeleteError("QosProfile", id, err))
 	}
 
 	return nil
@@ -0,0 +1,158 @@
+/* Copyright © 2023 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	gm_infra "github.com/vmware/vsphere-automation-sdk-go/services/nsxt-gm/global_infra"
+	gm_model "github.com/vmware/vsphere-automation-sdk-go/services/nsxt-gm/model"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+// Region is a global domain that groups sites. Sites are added to the region with
+// nsxt_policy_span resource, which allows region to be referenced before its span
+// is defined. This resource is supported only for Policy Global Manager.
+func resourceNsxtPolicyRegion() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceNsxtPolicyRegionCreate,
+		ReadContext:   resourceNsxtPolicyRegionRead,
+		UpdateContext: resourceNsxtPolicyRegionUpdate,
+		DeleteContext: resourceNsxtPolicyRegionDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"nsx_id":       getNsxIDSchema(),
+			"path":         getPathSchema(),
+			"display_name": getDisplayNameSchema(),
+			"description":  getDescriptionSchema(),
+			"revision":     getRevisionSchema(),
+			"tag":          getTagsSchema(),
+			"site_paths": {
+				Type:        schema.TypeList,
+				Description: "Paths of sites this region spans",
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func policyRegionPatch(d *schema.ResourceData, m interface{}, id string) error {
+	connector := getPolicyConnector(m)
+
+	displayName := d.Get("display_name").(string)
+	description := d.Get("description").(string)
+	tags := getPolicyTagsFromSchema(d)
+
+	obj := model.Domain{
+		DisplayName: &displayName,
+		Description: &description,
+		Tags:        tags,
+	}
+
+	gmObj, err := convertModelBindingType(obj, model.DomainBindingType(), gm_model.DomainBindingType())
+	if err != nil {
+		return err
+	}
+
+	client := gm_infra.NewDomainsClient(connector)
+	return client.Patch(id, gmObj.(gm_model.Domain))
+}
+
+func resourceNsxtPolicyRegionCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if !isPolicyGlobalManager(m) {
+		return getErrorDiagnostics(globalManagerOnlyError())
+	}
+
+	// Initialize resource Id and verify this ID is not yet used
+	id, err := getOrGenerateID(d, m, resourceNsxtPolicyDomainExists)
+	if err != nil {
+		return getOperationDiagnostics(m, err)
+	}
+
+	log.Printf("[INFO] Creating Region with ID %s", id)
+	err = policyRegionPatch(d, m, id)
+	if err != nil {
+		return getOperationDiagnostics(m, handleCreateError("Region", id, err))
+	}
+
+	d.SetId(id)
+	d.Set("nsx_id", id)
+
+	return resourceNsxtPolicyRegionRead(ctx, d, m)
+}
+
+func resourceNsxtPolicyRegionRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	connector := getPolicyConnector(m)
+	id := d.Id()
+	if id == "" {
+		return diag.Errorf("Error obtaining Region ID")
+	}
+
+	client := gm_infra.NewDomainsClient(connector)
+	gmObj, err := client.Get(id)
+	if err != nil {
+		return getOperationDiagnostics(m, handleReadError(d, "Region", id, err))
+	}
+
+	lmObj, err := convertModelBindingType(gmObj, gm_model.DomainBindingType(), model.DomainBindingType())
+	if err != nil {
+		return getOperationDiagnostics(m, err)
+	}
+	obj := lmObj.(model.Domain)
+
+	d.Set("display_name", obj.DisplayName)
+	d.Set("description", obj.Description)
+	setPolicyTagsInSchema(d, obj.Tags)
+	d.Set("nsx_id", id)
+	d.Set("path", obj.Path)
+	d.Set("revision", obj.Revision)
+
+	sitePaths, err := listPolicyDomainSpanSitePaths(connector, id)
+	if err != nil {
+		return getOperationDiagnostics(m, handleListError("Region Span", err))
+	}
+	d.Set("site_paths", sitePaths)
+
+	return nil
+}
+
+func resourceNsxtPolicyRegionUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	id := d.Id()
+	if id == "" {
+		return diag.Errorf("Error obtaining Region ID")
+	}
+
+	log.Printf("[INFO] Updating Region with ID %s", id)
+	err := policyRegionPatch(d, m, id)
+	if err != nil {
+		return getOperationDiagnostics(m, handleUpdateError("Region", id, err))
+	}
+
+	return resourceNsxtPolicyRegionRead(ctx, d, m)
+}
+
+func resourceNsxtPolicyRegionDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	id := d.Id()
+	if id == "" {
+		return diag.Errorf("Error obtaining Region ID")
+	}
+
+	connector := getPolicyConnector(m)
+	client := gm_infra.NewDomainsClient(connector)
+	err := client.Delete(id)
+	if err != nil {
+		return getOperationDiagnostics(m, handleDeleteError("Region", id, err))
+	}
+
+	return nil
+}
@@ -0,0 +1,119 @@
+/* Copyright © 2023 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+func TestAccResourceNsxtPolicyRegion_basic(t *testing.T) {
+	testResourceName := "nsxt_policy_region.test"
+	name := getAccTestResourceName()
+	updatedName := getAccTestResourceName()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccOnlyGlobalManager(t)
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyResourceCheckDestroy(state, updatedName, "nsxt_policy_region", resourceNsxtPolicyDomainExists)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyRegionTemplate(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyResourceExists(testResourceName, resourceNsxtPolicyDomainExists),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", name),
+					resource.TestCheckResourceAttr(testResourceName, "description", "terraform created"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "site_paths.#", "0"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+				),
+			},
+			{
+				Config: testAccNsxtPolicyRegionTemplate(updatedName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyResourceExists(testResourceName, resourceNsxtPolicyDomainExists),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", updatedName),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNsxtPolicyRegion_importBasic(t *testing.T) {
+	name := getAccTestResourceName()
+	testResourceName := "nsxt_policy_region.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccOnlyGlobalManager(t)
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyResourceCheckDestroy(state, name, "nsxt_policy_region", resourceNsxtPolicyDomainExists)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyRegionTemplate(name),
+			},
+			{
+				ResourceName:      testResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicyRegionTemplate(name string) string {
+	return fmt.Sprintf(`
+resource "nsxt_policy_region" "test" {
+  display_name = "%s"
+  description  = "terraform created"
+
+  tag {
+    scope = "scope1"
+    tag   = "tag1"
+  }
+}`, name)
+}
+
+func TestUnitResourceNsxtPolicyRegion_basic(t *testing.T) {
+	fake := newFakeNsxServer(t)
+	meta := testUnitConfigureProviderWithOptions(t, fake, map[string]interface{}{"global_manager": true})
+
+	state := testUnitApplyResource(t, meta, "nsxt_policy_region", nil, map[string]interface{}{
+		"nsx_id":       "region1",
+		"display_name": "region1",
+		"description":  "terraform created",
+	})
+	testUnitCheckAttr(t, state, "path", "/global-infra/domains/region1")
+	testUnitCheckAttr(t, state, "site_paths.#", "0")
+
+	// Span of the region is reported once sites are added to it
+	epPath := "/global-infra/sites/site1/enforcement-points/default"
+	fake.putPolicyObject("/global-infra/domains/region1/domain-deployment-maps/region1-site1", model.DomainDeploymentMap{
+		EnforcementPointPath: &epPath,
+	})
+	state = testUnitRefreshResource(t, meta, "nsxt_policy_region", state)
+	testUnitCheckAttr(t, state, "site_paths.#", "1")
+	testUnitCheckAttr(t, state, "site_paths.0", "/global-infra/sites/site1")
+
+	testUnitDestroyResource(t, meta, "nsxt_policy_region", state)
+	if fake.getObject("/global-infra/domains/region1") != nil {
+		t.Errorf("Expected region to be deleted")
+	}
+}
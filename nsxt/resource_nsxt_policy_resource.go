@@ -0,0 +1,277 @@
+/* Copyright © 2023 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"reflect"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+)
+
+// Properties populated by NSX, not included in body of imported object
+var policyGenericComputedProperties = []string{
+	"id",
+	"path",
+	"parent_path",
+	"relative_path",
+	"remote_path",
+	"unique_id",
+	"realization_id",
+	"owner_id",
+	"origin_site_id",
+	"marked_for_delete",
+	"overridden",
+	"children",
+	"resource_type",
+}
+
+func resourceNsxtPolicyResource() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceNsxtPolicyResourceCreate,
+		ReadContext:   resourceNsxtPolicyResourceRead,
+		UpdateContext: resourceNsxtPolicyResourceUpdate,
+		DeleteContext: resourceNsxtPolicyResourceDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"path": {
+				Type:         schema.TypeString,
+				Description:  "Policy path of the object",
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validatePolicyGenericPath(),
+			},
+			"resource_type": {
+				Type:         schema.TypeString,
+				Description:  "NSX resource type of the object",
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			"body": {
+				Type:             schema.TypeString,
+				Description:      "JSON body of the object. Only properties specified in body are compared against NSX",
+				Required:         true,
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: isPolicyGenericBodyEqual,
+			},
+			"result": {
+				Type:        schema.TypeString,
+				Description: "JSON of the object as returned by NSX",
+				Computed:    true,
+			},
+			"revision": getRevisionSchema(),
+		},
+	}
+}
+
+func validatePolicyGenericPath() schema.SchemaValidateFunc {
+	return func(i interface{}, k string) ([]string, []error) {
+		v, ok := i.(string)
+		if !ok {
+			return nil, []error{fmt.Errorf("Expected type of %s to be string", k)}
+		}
+
+		if !(strings.HasPrefix(v, "/infra/") || strings.HasPrefix(v, "/global-infra/")) || !isPolicyPath(v) {
+			return nil, []error{fmt.Errorf("Expected %s to be policy path under /infra or /global-infra, got %s", k, v)}
+		}
+
+		return nil, nil
+	}
+}
+
+func isPolicyGenericBodyEqual(k, old, new string, d *schema.ResourceData) bool {
+	var oldBody, newBody interface{}
+	if json.Unmarshal([]byte(old), &oldBody) != nil || json.Unmarshal([]byte(new), &newBody) != nil {
+		return false
+	}
+	return reflect.DeepEqual(oldBody, newBody)
+}
+
+// Returns part of object on NSX that corresponds to properties specified in
+// configured body, so that properties populated by NSX do not cause diff
+func getPolicyGenericConfiguredSubset(configured interface{}, actual interface{}) interface{} {
+	switch configuredValue := configured.(type) {
+	case map[string]interface{}:
+		actualMap, ok := actual.(map[string]interface{})
+		if !ok {
+			return actual
+		}
+		subset := make(map[string]interface{})
+		for key, value := range configuredValue {
+			if actualValue, ok := actualMap[key]; ok {
+				subset[key] = getPolicyGenericConfiguredSubset(value, actualValue)
+			}
+		}
+		return subset
+	case []interface{}:
+		actualList, ok := actual.([]interface{})
+		if !ok || len(actualList) != len(configuredValue) {
+			return actual
+		}
+		subset := make([]interface{}, len(actualList))
+		for i := range actualList {
+			subset[i] = getPolicyGenericConfiguredSubset(configuredValue[i], actualList[i])
+		}
+		return subset
+	}
+	return actual
+}
+
+// Returns properties of object on NSX that can be specified by user
+func getPolicyGenericUserProperties(obj map[string]interface{}) map[string]interface{} {
+	properties := make(map[string]interface{})
+	for key, value := range obj {
+		if strings.HasPrefix(key, "_") || stringInList(key, policyGenericComputedProperties) {
+			continue
+		}
+		properties[key] = value
+	}
+	return properties
+}
+
+func getPolicyGenericBodyFromSchema(d *schema.ResourceData) (map[string]interface{}, error) {
+	body := make(map[string]interface{})
+	err := json.Unmarshal([]byte(d.Get("body").(string)), &body)
+	if err != nil {
+		return nil, newAttributeError("body", fmt.Errorf("Failed to parse body: %v", err))
+	}
+	body["resource_type"] = d.Get("resource_type").(string)
+	return body, nil
+}
+
+func resourceNsxtPolicyResourceExists(path string, connector client.Connector, isGlobalManager bool) (bool, error) {
+	_, err := policyGenericGet(connector, isGlobalManager, path)
+	if err == nil {
+		return true, nil
+	}
+
+	if isNotFoundError(err) {
+		return false, nil
+	}
+
+	return false, logAPIError("Error retrieving Policy Resource", err)
+}
+
+func resourceNsxtPolicyResourceRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	connector := getPolicyConnector(m)
+	path := d.Id()
+	if path == "" {
+		return diag.Errorf("Error obtaining Policy Resource path")
+	}
+
+	obj, err := policyGenericGet(connector, isPolicyGlobalManager(m), path)
+	if err != nil {
+		if isNotFoundError(err) {
+			d.SetId("")
+			log.Printf("[DEBUG] Policy Resource %s not found", path)
+			return nil
+		}
+		return getOperationDiagnostics(m, handleReadError(d, "Policy Resource", path, err))
+	}
+
+	var body interface{}
+	if configured := d.Get("body").(string); configured != "" {
+		var configuredBody interface{}
+		err = json.Unmarshal([]byte(configured), &configuredBody)
+		if err != nil {
+			return diag.Errorf("Failed to parse body: %v", err)
+		}
+		body = getPolicyGenericConfiguredSubset(configuredBody, obj)
+	} else {
+		// Imported object
+		body = getPolicyGenericUserProperties(obj)
+	}
+
+	encodedBody, err := json.Marshal(body)
+	if err != nil {
+		return getOperationDiagnostics(m, err)
+	}
+	result, err := json.Marshal(obj)
+	if err != nil {
+		return getOperationDiagnostics(m, err)
+	}
+
+	d.Set("path", path)
+	d.Set("resource_type", obj["resource_type"])
+	d.Set("body", string(encodedBody))
+	d.Set("result", string(result))
+	d.Set("revision", obj["_revision"])
+
+	return nil
+}
+
+func resourceNsxtPolicyResourceCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	connector := getPolicyConnector(m)
+	path := d.Get("path").(string)
+
+	exists, err := resourceNsxtPolicyResourceExists(path, connector, isPolicyGlobalManager(m))
+	if err != nil {
+		return getOperationDiagnostics(m, err)
+	}
+	if exists {
+		return getErrorDiagnostics(newAttributeError("path", fmt.Errorf("Policy Resource %s already exists", path)))
+	}
+
+	body, err := getPolicyGenericBodyFromSchema(d)
+	if err != nil {
+		return getOperationDiagnostics(m, err)
+	}
+
+	log.Printf("[INFO] Creating Policy Resource %s", path)
+	err = policyGenericPatch(connector, isPolicyGlobalManager(m), path, body)
+	if err != nil {
+		return getOperationDiagnostics(m, handleCreateError("Policy Resource", path, err))
+	}
+
+	d.SetId(path)
+	return resourceNsxtPolicyResourceRead(ctx, d, m)
+}
+
+func resourceNsxtPolicyResourceUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	connector := getPolicyConnector(m)
+	path := d.Id()
+	if path == "" {
+		return diag.Errorf("Error obtaining Policy Resource path")
+	}
+
+	body, err := getPolicyGenericBodyFromSchema(d)
+	if err != nil {
+		return getOperationDiagnostics(m, err)
+	}
+
+	log.Printf("[INFO] Updating Policy Resource %s", path)
+	err = policyGenericPatch(connector, isPolicyGlobalManager(m), path, body)
+	if err != nil {
+		return getOperationDiagnostics(m, handleUpdateError("Policy Resource", path, err))
+	}
+
+	return resourceNsxtPolicyResourceRead(ctx, d, m)
+}
+
+func resourceNsxtPolicyResourceDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	connector := getPolicyConnector(m)
+	path := d.Id()
+	if path == "" {
+		return diag.Errorf("Error obtaining Policy Resource path")
+	}
+
+	log.Printf("[INFO] Deleting Policy Resource %s", path)
+	err := policyGenericDelete(connector, isPolicyGlobalManager(m), path)
+	if err != nil {
+		return getOperationDiagnostics(m, handleDeleteError("Policy Resource", path, err))
+	}
+
+	return nil
+}
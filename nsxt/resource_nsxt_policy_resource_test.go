@@ -0,0 +1,159 @@
+/* Copyright © 2023 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccResourceNsxtPolicyResource_basic(t *testing.T) {
+	name := getAccTestResourceName()
+	updateName := getAccTestResourceName()
+	testResourceName := "nsxt_policy_resource.test"
+	path := fmt.Sprintf("/infra/domains/default/groups/%s", name)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccOnlyLocalManager(t)
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyResourceCheckDestroy(state, name, "nsxt_policy_resource", resourceNsxtPolicyResourceExists)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyResourceTemplate(path, name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyResourceExists(testResourceName, resourceNsxtPolicyResourceExists),
+					resource.TestCheckResourceAttr(testResourceName, "path", path),
+					resource.TestCheckResourceAttr(testResourceName, "resource_type", "Group"),
+					resource.TestCheckResourceAttrSet(testResourceName, "result"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+				),
+			},
+			{
+				Config: testAccNsxtPolicyResourceTemplate(path, updateName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyResourceExists(testResourceName, resourceNsxtPolicyResourceExists),
+					resource.TestCheckResourceAttr(testResourceName, "path", path),
+					resource.TestCheckResourceAttr("data.nsxt_policy_resource.test", "display_name", updateName),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNsxtPolicyResource_importBasic(t *testing.T) {
+	name := getAccTestResourceName()
+	testResourceName := "nsxt_policy_resource.test"
+	path := fmt.Sprintf("/infra/domains/default/groups/%s", name)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccOnlyLocalManager(t)
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyResourceCheckDestroy(state, name, "nsxt_policy_resource", resourceNsxtPolicyResourceExists)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyResourceTemplate(path, name),
+			},
+			{
+				ResourceName:            testResourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"body"},
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicyResourceTemplate(path string, name string) string {
+	return fmt.Sprintf(`
+resource "nsxt_policy_resource" "test" {
+  path          = "%s"
+  resource_type = "Group"
+  body = jsonencode({
+    display_name = "%s"
+    description  = "Acceptance Test"
+  })
+}
+
+data "nsxt_policy_resource" "test" {
+  path = nsxt_policy_resource.test.path
+}`, path, name)
+}
+
+func TestUnitResourceNsxtPolicyResource_basic(t *testing.T) {
+	fake := newFakeNsxServer(t)
+	meta := testUnitConfigureProvider(t, fake)
+	resourceName := "nsxt_policy_resource"
+	path := "/infra/domains/default/groups/group1"
+
+	state := testUnitApplyResource(t, meta, resourceName, nil, map[string]interface{}{
+		"path":          path,
+		"resource_type": "Group",
+		"body":          `{"display_name": "group1", "description": "created"}`,
+	})
+	testUnitCheckAttr(t, state, "id", path)
+	// Properties populated by NSX are not part of the body
+	testUnitCheckAttr(t, state, "body", `{"description":"created","display_name":"group1"}`)
+	if !strings.Contains(state.Attributes["result"], `"_revision":0`) {
+		t.Errorf("Expected result to contain object revision, got %s", state.Attributes["result"])
+	}
+	if obj := fake.getObject(path); obj["resource_type"] != "Group" {
+		t.Fatalf("Group %s was not created on NSX", path)
+	}
+
+	state = testUnitApplyResource(t, meta, resourceName, state, map[string]interface{}{
+		"path":          path,
+		"resource_type": "Group",
+		"body":          `{"display_name": "group1", "description": "updated"}`,
+	})
+	testUnitCheckAttr(t, state, "revision", "1")
+	if description := fake.getObject(path)["description"]; description != "updated" {
+		t.Errorf("Expected description on NSX to be updated, got %v", description)
+	}
+
+	dataState := testUnitReadDataSource(t, meta, resourceName, map[string]interface{}{
+		"path": path,
+	})
+	testUnitCheckAttr(t, dataState, "display_name", "group1")
+	testUnitCheckAttr(t, dataState, "resource_type", "Group")
+
+	testUnitDestroyResource(t, meta, resourceName, state)
+	if fake.getObject(path) != nil {
+		t.Fatalf("Group %s was not deleted on NSX", path)
+	}
+}
+
+func TestGetPolicyGenericConfiguredSubset(t *testing.T) {
+	configured := map[string]interface{}{
+		"display_name": "test",
+		"tags":         []interface{}{map[string]interface{}{"tag": "a"}},
+		"missing":      "value",
+	}
+	actual := map[string]interface{}{
+		"display_name": "test",
+		"tags":         []interface{}{map[string]interface{}{"tag": "a", "scope": ""}},
+		"_revision":    2,
+	}
+	subset := getPolicyGenericConfiguredSubset(configured, actual)
+	expected := map[string]interface{}{
+		"display_name": "test",
+		"tags":         []interface{}{map[string]interface{}{"tag": "a"}},
+	}
+	if fmt.Sprint(subset) != fmt.Sprint(expected) {
+		t.Errorf("Expected %v, got %v", expected, subset)
+	}
+}
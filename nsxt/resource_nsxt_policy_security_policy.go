@@ -4,30 +4,38 @@
 package nsxt
 
 import (
-	"fmt"
+	"context"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
 	gm_domains "github.com/vmware/vsphere-automation-sdk-go/services/nsxt-gm/global_infra/domains"
 	gm_model "github.com/vmware/vsphere-automation-sdk-go/services/nsxt-gm/model"
 	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/domains"
 	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+	project_domains "github.com/vmware/vsphere-automation-sdk-go/services/nsxt/orgs/projects/infra/domains"
 )
 
 func resourceNsxtPolicySecurityPolicy() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtPolicySecurityPolicyCreate,
-		Read:   resourceNsxtPolicySecurityPolicyRead,
-		Update: resourceNsxtPolicySecurityPolicyUpdate,
-		Delete: resourceNsxtPolicySecurityPolicyDelete,
+		CreateContext: resourceNsxtPolicySecurityPolicyCreate,
+		ReadContext:   resourceNsxtPolicySecurityPolicyRead,
+		UpdateContext: resourceNsxtPolicySecurityPolicyUpdate,
+		DeleteContext: resourceNsxtPolicySecurityPolicyDelete,
 		Importer: &schema.ResourceImporter{
-			State: nsxtDomainResourceImporter,
+			StateContext: nsxtDomainResourceImporterWithContext,
 		},
-		Schema: getPolicySecurityPolicySchema(false),
+		Schema: getPolicySecurityPolicyResourceSchema(),
 	}
 }
 
+func getPolicySecurityPolicyResourceSchema() map[string]*schema.Schema {
+	secPolicy := getPolicySecurityPolicySchema(false)
+	secPolicy["context"] = getPolicyContextSchema()
+	return secPolicy
+}
+
 func getSecurityPolicyInDomain(id string, domainName string, connector client.Connector, isGlobalManager bool) (model.SecurityPolicy, error) {
 	if isGlobalManager {
 		client := gm_domains.NewSecurityPoliciesClient(connector)
@@ -47,10 +55,17 @@ func getSecurityPolicyInDomain(id string, domainName string, connector client.Co
 }
 
 func resourceNsxtPolicySecurityPolicyExistsInDomain(id string, domainName string, connector client.Connector, isGlobalManager bool) (bool, error) {
+	return resourceNsxtPolicySecurityPolicyExistsInProjectDomain(id, "", domainName, connector, isGlobalManager)
+}
+
+func resourceNsxtPolicySecurityPolicyExistsInProjectDomain(id string, projectID string, domainName string, connector client.Connector, isGlobalManager bool) (bool, error) {
 	var err error
 	if isGlobalManager {
 		client := gm_domains.NewSecurityPoliciesClient(connector)
 		_, err = client.Get(domainName, id)
+	} else if projectID != "" {
+		client := project_domains.NewSecurityPoliciesClient(connector)
+		_, err = client.Get(defaultOrgID, projectID, domainName, id)
 	} else {
 		client := domains.NewSecurityPoliciesClient(connector)
 		_, err = client.Get(domainName, id)
@@ -67,9 +82,9 @@ func resourceNsxtPolicySecurityPolicyExistsInDomain(id string, domainName string
 	return false, logAPIError("Error retrieving Security Policy", err)
 }
 
-func resourceNsxtPolicySecurityPolicyExistsPartial(domainName string) func(id string, connector client.Connector, isGlobalManager bool) (bool, error) {
+func resourceNsxtPolicySecurityPolicyExistsPartial(projectID string, domainName string) func(id string, connector client.Connector, isGlobalManager bool) (bool, error) {
 	return func(id string, connector client.Connector, isGlobalManager bool) (bool, error) {
-		return resourceNsxtPolicySecurityPolicyExistsInDomain(id, domainName, connector, isGlobalManager)
+		return resourceNsxtPolicySecurityPolicyExistsInProjectDomain(id, projectID, domainName, connector, isGlobalManager)
 	}
 }
 
@@ -105,6 +120,12 @@ func policySecurityPolicyBuildAndPatch(d *schema.ResourceData, m interface{}, co
 	}
 	log.Printf("[INFO] Creating Security Policy with ID %s", id)
 
+	// Empty path is sent explicitly to detach the scheduler, since the object is patched
+	schedulerPath := d.Get("scheduler_path").(string)
+	if schedulerPath != "" || d.HasChange("scheduler_path") {
+		obj.SchedulerPath = &schedulerPath
+	}
+
 	if len(d.Id()) > 0 {
 		// This is update flow
 		obj.Revision = &revision
@@ -118,55 +139,68 @@ func policySecurityPolicyBuildAndPatch(d *schema.ResourceData, m interface{}, co
 		obj.Children = policyChildren
 	}
 
-	return securityPolicyInfraPatch(obj, domain, m)
+	return securityPolicyInfraPatchWithContext(getPolicyProjectID(d), obj, domain, m)
 }
 
-func resourceNsxtPolicySecurityPolicyCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicySecurityPolicyCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
+	projectID := getPolicyProjectID(d)
+	err := validatePolicyProjectContext(projectID, m)
+	if err != nil {
+		return getOperationDiagnostics(m, err)
+	}
 
 	// Initialize resource Id and verify this ID is not yet used
-	id, err := getOrGenerateID(d, m, resourceNsxtPolicySecurityPolicyExistsPartial(d.Get("domain").(string)))
+	id, err := getOrGenerateID(d, m, resourceNsxtPolicySecurityPolicyExistsPartial(projectID, d.Get("domain").(string)))
 	if err != nil {
-		return err
+		return getOperationDiagnostics(m, err)
 	}
 
 	err = policySecurityPolicyBuildAndPatch(d, m, connector, isPolicyGlobalManager(m), id)
 
 	if err != nil {
-		return handleCreateError("Security Policy", id, err)
+		return getOperationDiagnostics(m, handleCreateError("Security Policy", id, err))
 	}
 
 	d.SetId(id)
 	d.Set("nsx_id", id)
 
-	return resourceNsxtPolicySecurityPolicyRead(d, m)
+	return resourceNsxtPolicySecurityPolicyRead(ctx, d, m)
 }
 
-func resourceNsxtPolicySecurityPolicyRead(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicySecurityPolicyRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 	id := d.Id()
 	domainName := d.Get("domain").(string)
+	projectID := getPolicyProjectID(d)
 	if id == "" {
-		return fmt.Errorf("Error obtaining Security Policy id")
+		return diag.Errorf("Error obtaining Security Policy id")
 	}
 	var obj model.SecurityPolicy
 	if isPolicyGlobalManager(m) {
 		client := gm_domains.NewSecurityPoliciesClient(connector)
 		gmObj, err := client.Get(domainName, id)
 		if err != nil {
-			return handleReadError(d, "SecurityPolicy", id, err)
+			return getOperationDiagnostics(m, handleReadError(d, "SecurityPolicy", id, err))
 		}
 		rawObj, err := convertModelBindingType(gmObj, gm_model.SecurityPolicyBindingType(), model.SecurityPolicyBindingType())
 		if err != nil {
-			return err
+			return getOperationDiagnostics(m, err)
 		}
 		obj = rawObj.(model.SecurityPolicy)
+	} else if projectID != "" {
+		var err error
+		client := project_domains.NewSecurityPoliciesClient(connector)
+		obj, err = client.Get(defaultOrgID, projectID, domainName, id)
+		if err != nil {
+			return getOperationDiagnostics(m, handleReadError(d, "SecurityPolicy", id, err))
+		}
 	} else {
 		var err error
 		client := domains.NewSecurityPoliciesClient(connector)
 		obj, err = client.Get(domainName, id)
 		if err != nil {
-			return handleReadError(d, "SecurityPolicy", id, err)
+			return getOperationDiagnostics(m, handleReadError(d, "SecurityPolicy", id, err))
 		}
 	}
 	d.Set("display_name", obj.DisplayName)
@@ -175,6 +209,7 @@ func resourceNsxtPolicySecurityPolicyRead(d *schema.ResourceData, m interface{})
 	d.Set("nsx_id", id)
 	d.Set("path", obj.Path)
 	d.Set("domain", getDomainFromResourcePath(*obj.Path))
+	setPolicyProjectIDInSchema(d, getPolicyProjectIDFromPath(*obj.Path))
 	d.Set("category", obj.Category)
 	d.Set("comments", obj.Comments)
 	d.Set("locked", obj.Locked)
@@ -186,29 +221,30 @@ func resourceNsxtPolicySecurityPolicyRead(d *schema.ResourceData, m interface{})
 	d.Set("sequence_number", obj.SequenceNumber)
 	d.Set("stateful", obj.Stateful)
 	d.Set("tcp_strict", obj.TcpStrict)
+	d.Set("scheduler_path", obj.SchedulerPath)
 	d.Set("revision", obj.Revision)
-	return setPolicyRulesInSchema(d, obj.Rules)
+	return getErrorDiagnostics(setPolicyRulesInSchema(d, obj.Rules, false))
 }
 
-func resourceNsxtPolicySecurityPolicyUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicySecurityPolicyUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining Security Policy id")
+		return diag.Errorf("Error obtaining Security Policy id")
 	}
 	err := policySecurityPolicyBuildAndPatch(d, m, connector, isPolicyGlobalManager(m), id)
 	if err != nil {
-		return handleUpdateError("Security Policy", id, err)
+		return getOperationDiagnostics(m, handleUpdateError("Security Policy", id, err))
 	}
 
-	return resourceNsxtPolicySecurityPolicyRead(d, m)
+	return resourceNsxtPolicySecurityPolicyRead(ctx, d, m)
 }
 
-func resourceNsxtPolicySecurityPolicyDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicySecurityPolicyDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining Security Policy id")
+		return diag.Errorf("Error obtaining Security Policy id")
 	}
 
 	connector := getPolicyConnector(m)
@@ -217,13 +253,16 @@ func resourceNsxtPolicySecurityPolicyDelete(d *schema.ResourceData, m interface{
 	if isPolicyGlobalManager(m) {
 		client := gm_domains.NewSecurityPoliciesClient(connector)
 		err = client.Delete(d.Get("domain").(string), id)
+	} else if projectID := getPolicyProjectID(d); projectID != "" {
+		client := project_domains.NewSecurityPoliciesClient(connector)
+		err = client.Delete(defaultOrgID, projectID, d.Get("domain").(string), id)
 	} else {
 		client := domains.NewSecurityPoliciesClient(connector)
 		err = client.Delete(d.Get("domain").(string), id)
 	}
 
 	if err != nil {
-		return handleDeleteError("Security Policy", id, err)
+		return getOperationDiagnostics(m, handleDeleteError("Security Policy", id, err))
 	}
 
 	return nil
@@ -566,6 +566,84 @@ func TestAccResourceNsxtGlobalPolicySecurityPolicy_withSite(t *testing.T) {
 	})
 }
 
+func TestAccResourceNsxtPolicySecurityPolicy_withScheduler(t *testing.T) {
+	name := getAccTestResourceName()
+	testResourceName := "nsxt_policy_security_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t); testAccOnlyLocalManager(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicySecurityPolicyCheckDestroy(state, name, defaultDomain)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicySecurityPolicyWithScheduler(name, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicySecurityPolicyExists(testResourceName, defaultDomain),
+					resource.TestCheckResourceAttrPair(testResourceName, "scheduler_path", "nsxt_policy_firewall_scheduler.test", "path"),
+				),
+			},
+			{
+				Config: testAccNsxtPolicySecurityPolicyWithScheduler(name, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicySecurityPolicyExists(testResourceName, defaultDomain),
+					resource.TestCheckResourceAttr(testResourceName, "scheduler_path", ""),
+				),
+			},
+		},
+	})
+}
+
+func TestUnitResourceNsxtPolicySecurityPolicy_basic(t *testing.T) {
+	fake := newFakeNsxServer(t)
+	meta := testUnitConfigureProvider(t, fake)
+	resourceName := "nsxt_policy_security_policy"
+	schedulerPath := "/infra/firewall-schedulers/scheduler1"
+
+	state := testUnitApplyResource(t, meta, resourceName, nil, map[string]interface{}{
+		"display_name":   "policy1",
+		"category":       "Application",
+		"scheduler_path": schedulerPath,
+		"rule": []interface{}{
+			map[string]interface{}{"display_name": "rule1", "action": "ALLOW"},
+			map[string]interface{}{"display_name": "rule2", "action": "DROP"},
+		},
+	})
+	testUnitCheckAttr(t, state, "display_name", "policy1")
+	testUnitCheckAttr(t, state, "scheduler_path", schedulerPath)
+	testUnitCheckAttr(t, state, "rule.#", "2")
+	testUnitCheckAttr(t, state, "rule.1.action", "DROP")
+	path := state.Attributes["path"]
+	if fake.getObject(path) == nil {
+		t.Fatalf("Security Policy %s was not created on NSX", path)
+	}
+	ruleID := state.Attributes["rule.1.nsx_id"]
+	if fake.getObject(path+"/rules/"+ruleID) == nil {
+		t.Fatalf("Rule %s was not created on NSX", ruleID)
+	}
+
+	// Removed rule is deleted, and scheduler is detached
+	state = testUnitApplyResource(t, meta, resourceName, state, map[string]interface{}{
+		"display_name": "policy1",
+		"category":     "Application",
+		"rule": []interface{}{
+			map[string]interface{}{"display_name": "rule1", "action": "REJECT"},
+		},
+	})
+	testUnitCheckAttr(t, state, "rule.#", "1")
+	testUnitCheckAttr(t, state, "rule.0.action", "REJECT")
+	testUnitCheckAttr(t, state, "scheduler_path", "")
+	if fake.getObject(path+"/rules/"+ruleID) != nil {
+		t.Errorf("Rule %s was not deleted on NSX", ruleID)
+	}
+
+	testUnitDestroyResource(t, meta, resourceName, state)
+	if fake.getObject(path) != nil {
+		t.Fatalf("Security Policy %s was not deleted on NSX", path)
+	}
+}
+
 func testAccNsxtPolicySecurityPolicyExists(resourceName string, domainName string) resource.TestCheckFunc {
 	return func(state *terraform.State) error {
 
@@ -941,3 +1019,24 @@ profiles = [nsxt_policy_context_profile.test.path]
 `
 	return testAccNsxtPolicyContextProfileTemplate("security-policy-test-profile", testAccNsxtPolicyContextProfileAttributeDomainNameTemplate(testSystemDomainName)) + testAccNsxtPolicySecurityPolicyWithRule(name, direction, protocol, ruleTag, domainName, profiles)
 }
+
+func testAccNsxtPolicySecurityPolicyWithScheduler(name string, withScheduler bool) string {
+	schedulerPath := ""
+	if withScheduler {
+		schedulerPath = "scheduler_path = nsxt_policy_firewall_scheduler.test.path"
+	}
+	return fmt.Sprintf(`
+resource "nsxt_policy_firewall_scheduler" "test" {
+  display_name = "%s"
+  days         = ["SATURDAY", "SUNDAY"]
+  start_time   = "00:00"
+  end_time     = "23:30"
+  start_date   = "01/01/2030"
+}
+
+resource "nsxt_policy_security_policy" "test" {
+  display_name = "%s"
+  category     = "Application"
+  %s
+}`, name, name, schedulerPath)
+}
@@ -4,9 +4,10 @@
 package nsxt
 
 import (
-	"fmt"
+	"context"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
@@ -18,12 +19,12 @@ import (
 
 func resourceNsxtPolicySegmentSecurityProfile() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtPolicySegmentSecurityProfileCreate,
-		Read:   resourceNsxtPolicySegmentSecurityProfileRead,
-		Update: resourceNsxtPolicySegmentSecurityProfileUpdate,
-		Delete: resourceNsxtPolicySegmentSecurityProfileDelete,
+		CreateContext: resourceNsxtPolicySegmentSecurityProfileCreate,
+		ReadContext:   resourceNsxtPolicySegmentSecurityProfileRead,
+		UpdateContext: resourceNsxtPolicySegmentSecurityProfileUpdate,
+		DeleteContext: resourceNsxtPolicySegmentSecurityProfileDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -197,31 +198,31 @@ func resourceNsxtPolicySegmentSecurityProfilePatch(d *schema.ResourceData, m int
 	return client.Patch(id, obj, nil)
 }
 
-func resourceNsxtPolicySegmentSecurityProfileCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicySegmentSecurityProfileCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 
 	// Initialize resource Id and verify this ID is not yet used
 	id, err := getOrGenerateID(d, m, resourceNsxtPolicySegmentSecurityProfileExists)
 	if err != nil {
-		return err
+		return getOperationDiagnostics(m, err)
 	}
 
 	err = resourceNsxtPolicySegmentSecurityProfilePatch(d, m, id)
 	if err != nil {
-		return handleCreateError("SegmentSecurityProfile", id, err)
+		return getOperationDiagnostics(m, handleCreateError("SegmentSecurityProfile", id, err))
 	}
 
 	d.SetId(id)
 	d.Set("nsx_id", id)
 
-	return resourceNsxtPolicySegmentSecurityProfileRead(d, m)
+	return resourceNsxtPolicySegmentSecurityProfileRead(ctx, d, m)
 }
 
-func resourceNsxtPolicySegmentSecurityProfileRead(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicySegmentSecurityProfileRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining SegmentSecurityProfile ID")
+		return diag.Errorf("Error obtaining SegmentSecurityProfile ID")
 	}
 
 	var obj model.SegmentSecurityProfile
@@ -229,12 +230,12 @@ func resourceNsxtPolicySegmentSecurityProfileRead(d *schema.ResourceData, m inte
 		client := gm_infra.NewSegmentSecurityProfilesClient(connector)
 		gmObj, err := client.Get(id)
 		if err != nil {
-			return handleReadError(d, "SegmentSecurityProfile", id, err)
+			return getOperationDiagnostics(m, handleReadError(d, "SegmentSecurityProfile", id, err))
 		}
 
 		lmObj, err := convertModelBindingType(gmObj, gm_model.SegmentSecurityProfileBindingType(), model.SegmentSecurityProfileBindingType())
 		if err != nil {
-			return err
+			return getOperationDiagnostics(m, err)
 		}
 		obj = lmObj.(model.SegmentSecurityProfile)
 	} else {
@@ -242,7 +243,7 @@ func resourceNsxtPolicySegmentSecurityProfileRead(d *schema.ResourceData, m inte
 		var err error
 		obj, err = client.Get(id)
 		if err != nil {
-			return handleReadError(d, "SegmentSecurityProfile", id, err)
+			return getOperationDiagnostics(m, handleReadError(d, "SegmentSecurityProfile", id, err))
 		}
 	}
 
@@ -278,25 +279,25 @@ func resourceNsxtPolicySegmentSecurityProfileRead(d *schema.ResourceData, m inte
 	return nil
 }
 
-func resourceNsxtPolicySegmentSecurityProfileUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicySegmentSecurityProfileUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining SegmentSecurityProfile ID")
+		return diag.Errorf("Error obtaining SegmentSecurityProfile ID")
 	}
 
 	err := resourceNsxtPolicySegmentSecurityProfilePatch(d, m, id)
 	if err != nil {
-		return handleUpdateError("SegmentSecurityProfile", id, err)
+		return getOperationDiagnostics(m, handleUpdateError("SegmentSecurityProfile", id, err))
 	}
 
-	return resourceNsxtPolicySegmentSecurityProfileRead(d, m)
+	return resourceNsxtPolicySegmentSecurityProfileRead(ctx, d, m)
 }
 
-func resourceNsxtPolicySegmentSecurityProfileDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicySegmentSecurityProfileDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining SegmentSecurityProfile ID")
+		return diag.Errorf("Error obtaining SegmentSecurityProfile ID")
 	}
 
 	connector := getPolicyConnector(m)
@@ -310,7 +311,7 @@ func resourceNsxtPolicySegmentSecurityProfileDelete(d *schema.ResourceData, m in
 	}
 
 	if err != nil {
-		return handleDeleteError("SegmentSecurityProfile", id, err)
+		return getOperationDiagnostics(m, handleDeleteError("SegmentSecurityProfile", id, err))
 	}
 
 	return nil
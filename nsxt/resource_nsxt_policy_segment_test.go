@@ -11,6 +11,30 @@ import (
 var testPolicySegmentHelper1Name = getAccTestResourceName()
 var testPolicySegmentHelper2Name = getAccTestResourceName()
 
+func TestUnitResourceNsxtPolicySegment_project(t *testing.T) {
+	fake := newFakeNsxServer(t)
+	meta := testUnitConfigureProvider(t, fake)
+
+	for _, tc := range []struct {
+		connectivityPath string
+		context          []interface{}
+	}{
+		{"/infra/tier-1s/gw1", []interface{}{map[string]interface{}{"project_id": "project1"}}},
+		{"/orgs/default/projects/project2/infra/tier-1s/gw1", []interface{}{map[string]interface{}{"project_id": "project1"}}},
+		// Project gateway can not be used in default context
+		{"/orgs/default/projects/project1/infra/tier-1s/gw1", nil},
+	} {
+		config := map[string]interface{}{
+			"display_name":      "segment1",
+			"connectivity_path": tc.connectivityPath,
+		}
+		if tc.context != nil {
+			config["context"] = tc.context
+		}
+		testUnitCheckApplyAttributeError(t, meta, "nsxt_policy_segment", config, "connectivity_path")
+	}
+}
+
 func TestAccResourceNsxtPolicySegment_basicImport(t *testing.T) {
 	name := getAccTestResourceName()
 	testResourceName := "nsxt_policy_segment.test"
@@ -4,10 +4,11 @@
 package nsxt
 
 import (
-	"fmt"
+	"context"
 	"log"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/vmware/vsphere-automation-sdk-go/runtime/bindings"
@@ -21,12 +22,12 @@ import (
 
 func resourceNsxtPolicyService() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtPolicyServiceCreate,
-		Read:   resourceNsxtPolicyServiceRead,
-		Update: resourceNsxtPolicyServiceUpdate,
-		Delete: resourceNsxtPolicyServiceDelete,
+		CreateContext: resourceNsxtPolicyServiceCreate,
+		ReadContext:   resourceNsxtPolicyServiceRead,
+		UpdateContext: resourceNsxtPolicyServiceUpdate,
+		DeleteContext: resourceNsxtPolicyServiceDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -448,13 +449,13 @@ func filterServiceEntryDisplayName(entryDisplayName string, entryID string) stri
 	return entryDisplayName
 }
 
-func resourceNsxtPolicyServiceCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyServiceCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 
 	// Initialize resource Id and verify this ID is not yet used
 	id, err := getOrGenerateID(d, m, resourceNsxtPolicyServiceExists)
 	if err != nil {
-		return err
+		return getOperationDiagnostics(m, err)
 	}
 
 	displayName := d.Get("display_name").(string)
@@ -462,7 +463,7 @@ func resourceNsxtPolicyServiceCreate(d *schema.ResourceData, m interface{}) erro
 	tags := getPolicyTagsFromSchema(d)
 	serviceEntries, errc := resourceNsxtPolicyServiceGetEntriesFromSchema(d)
 	if errc != nil {
-		return fmt.Errorf("Error during Service entries conversion: %v", errc)
+		return diag.Errorf("Error during Service entries conversion: %v", errc)
 	}
 
 	obj := model.Service{
@@ -478,7 +479,7 @@ func resourceNsxtPolicyServiceCreate(d *schema.ResourceData, m interface{}) erro
 	if isPolicyGlobalManager(m) {
 		gmObj, convErr := convertModelBindingType(obj, model.ServiceBindingType(), gm_model.ServiceBindingType())
 		if convErr != nil {
-			return convErr
+			return getErrorDiagnostics(convErr)
 		}
 		client := gm_infra.NewServicesClient(connector)
 		err = client.Patch(id, gmObj.(gm_model.Service))
@@ -487,20 +488,20 @@ func resourceNsxtPolicyServiceCreate(d *schema.ResourceData, m interface{}) erro
 		err = client.Patch(id, obj)
 	}
 	if err != nil {
-		return handleCreateError("Service", id, err)
+		return getOperationDiagnostics(m, handleCreateError("Service", id, err))
 	}
 
 	d.SetId(id)
 	d.Set("nsx_id", id)
-	return resourceNsxtPolicyServiceRead(d, m)
+	return resourceNsxtPolicyServiceRead(ctx, d, m)
 }
 
-func resourceNsxtPolicyServiceRead(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyServiceRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining service id")
+		return diag.Errorf("Error obtaining service id")
 	}
 
 	var obj model.Service
@@ -508,11 +509,11 @@ func resourceNsxtPolicyServiceRead(d *schema.ResourceData, m interface{}) error
 		client := gm_infra.NewServicesClient(connector)
 		gmObj, err := client.Get(id)
 		if err != nil {
-			return handleReadError(d, "Service", id, err)
+			return getOperationDiagnostics(m, handleReadError(d, "Service", id, err))
 		}
 		lmObj, err := convertModelBindingType(gmObj, gm_model.ServiceBindingType(), model.ServiceBindingType())
 		if err != nil {
-			return err
+			return getOperationDiagnostics(m, err)
 		}
 		obj = lmObj.(model.Service)
 	} else {
@@ -520,7 +521,7 @@ func resourceNsxtPolicyServiceRead(d *schema.ResourceData, m interface{}) error
 		var err error
 		obj, err = client.Get(id)
 		if err != nil {
-			return handleReadError(d, "Service", id, err)
+			return getOperationDiagnostics(m, handleReadError(d, "Service", id, err))
 		}
 	}
 
@@ -546,13 +547,13 @@ func resourceNsxtPolicyServiceRead(d *schema.ResourceData, m interface{}) error
 		base, errs := converter.ConvertToGolang(entry, model.ServiceEntryBindingType())
 		resourceType := base.(model.ServiceEntry).ResourceType
 		if errs != nil {
-			return errs[0]
+			return getErrorDiagnostics(errs[0])
 		}
 
 		if resourceType == model.ServiceEntry_RESOURCE_TYPE_ICMPTYPESERVICEENTRY {
 			icmpEntry, errs := converter.ConvertToGolang(entry, model.ICMPTypeServiceEntryBindingType())
 			if errs != nil {
-				return errs[0]
+				return getErrorDiagnostics(errs[0])
 			}
 
 			serviceEntry := icmpEntry.(model.ICMPTypeServiceEntry)
@@ -573,7 +574,7 @@ func resourceNsxtPolicyServiceRead(d *schema.ResourceData, m interface{}) error
 		} else if resourceType == model.ServiceEntry_RESOURCE_TYPE_L4PORTSETSERVICEENTRY {
 			l4Entry, errs := converter.ConvertToGolang(entry, model.L4PortSetServiceEntryBindingType())
 			if errs != nil {
-				return errs[0]
+				return getErrorDiagnostics(errs[0])
 			}
 
 			serviceEntry := l4Entry.(model.L4PortSetServiceEntry)
@@ -586,7 +587,7 @@ func resourceNsxtPolicyServiceRead(d *schema.ResourceData, m interface{}) error
 		} else if resourceType == model.ServiceEntry_RESOURCE_TYPE_ETHERTYPESERVICEENTRY {
 			etherEntry, errs := converter.ConvertToGolang(entry, model.EtherTypeServiceEntryBindingType())
 			if errs != nil {
-				return errs[0]
+				return getErrorDiagnostics(errs[0])
 			}
 
 			serviceEntry := etherEntry.(model.EtherTypeServiceEntry)
@@ -597,7 +598,7 @@ func resourceNsxtPolicyServiceRead(d *schema.ResourceData, m interface{}) error
 		} else if resourceType == model.ServiceEntry_RESOURCE_TYPE_IPPROTOCOLSERVICEENTRY {
 			ipProtEntry, errs := converter.ConvertToGolang(entry, model.IPProtocolServiceEntryBindingType())
 			if errs != nil {
-				return errs[0]
+				return getErrorDiagnostics(errs[0])
 			}
 
 			serviceEntry := ipProtEntry.(model.IPProtocolServiceEntry)
@@ -608,7 +609,7 @@ func resourceNsxtPolicyServiceRead(d *schema.ResourceData, m interface{}) error
 		} else if resourceType == model.ServiceEntry_RESOURCE_TYPE_ALGTYPESERVICEENTRY {
 			algEntry, errs := converter.ConvertToGolang(entry, model.ALGTypeServiceEntryBindingType())
 			if errs != nil {
-				return errs[0]
+				return getErrorDiagnostics(errs[0])
 			}
 
 			serviceEntry := algEntry.(model.ALGTypeServiceEntry)
@@ -621,7 +622,7 @@ func resourceNsxtPolicyServiceRead(d *schema.ResourceData, m interface{}) error
 		} else if resourceType == model.ServiceEntry_RESOURCE_TYPE_IGMPTYPESERVICEENTRY {
 			igmpEntry, errs := converter.ConvertToGolang(entry, model.IGMPTypeServiceEntryBindingType())
 			if errs != nil {
-				return errs[0]
+				return getErrorDiagnostics(errs[0])
 			}
 
 			serviceEntry := igmpEntry.(model.IGMPTypeServiceEntry)
@@ -631,7 +632,7 @@ func resourceNsxtPolicyServiceRead(d *schema.ResourceData, m interface{}) error
 		} else if resourceType == model.ServiceEntry_RESOURCE_TYPE_NESTEDSERVICESERVICEENTRY {
 			nestedEntry, errs := converter.ConvertToGolang(entry, model.NestedServiceServiceEntryBindingType())
 			if errs != nil {
-				return errs[0]
+				return getErrorDiagnostics(errs[0])
 			}
 
 			serviceEntry := nestedEntry.(model.NestedServiceServiceEntry)
@@ -641,54 +642,54 @@ func resourceNsxtPolicyServiceRead(d *schema.ResourceData, m interface{}) error
 			nestedServiceEntriesList = append(nestedServiceEntriesList, elem)
 
 		} else {
-			return fmt.Errorf("Unrecognized Service Entry Type %s", resourceType)
+			return diag.Errorf("Unrecognized Service Entry Type %s", resourceType)
 		}
 	}
 
 	err := d.Set("icmp_entry", icmpEntriesList)
 	if err != nil {
-		return err
+		return getOperationDiagnostics(m, err)
 	}
 
 	err = d.Set("l4_port_set_entry", l4EntriesList)
 	if err != nil {
-		return err
+		return getOperationDiagnostics(m, err)
 	}
 
 	err = d.Set("igmp_entry", igmpEntriesList)
 	if err != nil {
-		return err
+		return getOperationDiagnostics(m, err)
 	}
 
 	err = d.Set("ether_type_entry", etherEntriesList)
 	if err != nil {
-		return err
+		return getOperationDiagnostics(m, err)
 	}
 
 	err = d.Set("ip_protocol_entry", ipProtEntriesList)
 	if err != nil {
-		return err
+		return getOperationDiagnostics(m, err)
 	}
 
 	err = d.Set("algorithm_entry", algEntriesList)
 	if err != nil {
-		return err
+		return getOperationDiagnostics(m, err)
 	}
 
 	err = d.Set("nested_service_entry", nestedServiceEntriesList)
 	if err != nil {
-		return err
+		return getOperationDiagnostics(m, err)
 	}
 
 	return nil
 }
 
-func resourceNsxtPolicyServiceUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyServiceUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining service id")
+		return diag.Errorf("Error obtaining service id")
 	}
 
 	// Read the rest of the configured parameters
@@ -698,7 +699,7 @@ func resourceNsxtPolicyServiceUpdate(d *schema.ResourceData, m interface{}) erro
 	tags := getPolicyTagsFromSchema(d)
 	serviceEntries, errc := resourceNsxtPolicyServiceGetEntriesFromSchema(d)
 	if errc != nil {
-		return fmt.Errorf("Error during Service entries conversion: %v", errc)
+		return diag.Errorf("Error during Service entries conversion: %v", errc)
 	}
 	obj := model.Service{
 		DisplayName:    &displayName,
@@ -714,7 +715,7 @@ func resourceNsxtPolicyServiceUpdate(d *schema.ResourceData, m interface{}) erro
 
 		gmObj, convErr := convertModelBindingType(obj, model.ServiceBindingType(), gm_model.ServiceBindingType())
 		if convErr != nil {
-			return convErr
+			return getErrorDiagnostics(convErr)
 		}
 		client := gm_infra.NewServicesClient(connector)
 		_, err = client.Update(id, gmObj.(gm_model.Service))
@@ -724,15 +725,15 @@ func resourceNsxtPolicyServiceUpdate(d *schema.ResourceData, m interface{}) erro
 	}
 
 	if err != nil {
-		return handleUpdateError("Service", id, err)
+		return getOperationDiagnostics(m, handleUpdateError("Service", id, err))
 	}
-	return resourceNsxtPolicyServiceRead(d, m)
+	return resourceNsxtPolicyServiceRead(ctx, d, m)
 }
 
-func resourceNsxtPolicyServiceDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyServiceDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining service id")
+		return diag.Errorf("Error obtaining service id")
 	}
 
 	connector := getPolicyConnector(m)
@@ -749,7 +750,7 @@ func resourceNsxtPolicyServiceDelete(d *schema.ResourceData, m interface{}) erro
 	err := doDelete()
 
 	if err != nil {
-		return handleDeleteError("Service", id, err)
+		return getOperationDiagnostics(m, handleDeleteError("Service", id, err))
 	}
 
 	return nil
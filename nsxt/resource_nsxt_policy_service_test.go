@@ -1114,3 +1114,42 @@ func testAccNsxtPolicyNestedServiceMixedTemplate(serviceName string, nestedServi
   }
 }`, serviceName, nestedServiceEntryName, testAccAdjustPolicyInfraConfig("/infra/services/"+nestedServiceEntryName))
 }
+
+func TestUnitResourceNsxtPolicyService_l4PortSet(t *testing.T) {
+	fake := newFakeNsxServer(t)
+	meta := testUnitConfigureProvider(t, fake)
+	resourceName := "nsxt_policy_service"
+
+	state := testUnitApplyResource(t, meta, resourceName, nil, map[string]interface{}{
+		"display_name": "service1",
+		"l4_port_set_entry": []interface{}{
+			map[string]interface{}{
+				"display_name":      "http",
+				"protocol":          "TCP",
+				"destination_ports": []interface{}{"80"},
+			},
+		},
+	})
+	testUnitCheckAttr(t, state, "l4_port_set_entry.#", "1")
+
+	// Service entries are stored on NSX as part of the service
+	obj := fake.getObject(state.Attributes["path"])
+	if entries, _ := obj["service_entries"].([]interface{}); len(entries) != 1 {
+		t.Fatalf("Expected single service entry on NSX, got %v", obj["service_entries"])
+	}
+
+	state = testUnitApplyResource(t, meta, resourceName, state, map[string]interface{}{
+		"display_name": "service1",
+		"l4_port_set_entry": []interface{}{
+			map[string]interface{}{
+				"display_name":      "https",
+				"protocol":          "TCP",
+				"destination_ports": []interface{}{"443"},
+			},
+		},
+	})
+	state = testUnitRefreshResource(t, meta, resourceName, state)
+	testUnitCheckAttr(t, state, "l4_port_set_entry.#", "1")
+
+	testUnitDestroyResource(t, meta, resourceName, state)
+}
@@ -0,0 +1,156 @@
+/* Copyright © 2023 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+func resourceNsxtPolicyShare() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceNsxtPolicyShareCreate,
+		ReadContext:   resourceNsxtPolicyShareRead,
+		UpdateContext: resourceNsxtPolicyShareUpdate,
+		DeleteContext: resourceNsxtPolicyShareDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"nsx_id":       getNsxIDSchema(),
+			"path":         getPathSchema(),
+			"display_name": getDisplayNameSchema(),
+			"description":  getDescriptionSchema(),
+			"revision":     getRevisionSchema(),
+			"tag":          getTagsSchema(),
+			"shared_with": {
+				Type:        schema.TypeList,
+				Description: "Paths of the Orgs, Projects or Domains to share with",
+				Required:    true,
+				Elem:        getElemPolicyPathSchema(),
+			},
+		},
+	}
+}
+
+func resourceNsxtPolicyShareExists(id string, connector client.Connector, isGlobalManager bool) (bool, error) {
+	client := infra.NewSharesClient(connector)
+	_, err := client.Get(id)
+	if err == nil {
+		return true, nil
+	}
+
+	if isNotFoundError(err) {
+		return false, nil
+	}
+
+	return false, logAPIError("Error retrieving Share", err)
+}
+
+func policySharePatch(d *schema.ResourceData, m interface{}, id string) error {
+	connector := getPolicyConnector(m)
+
+	displayName := d.Get("display_name").(string)
+	description := d.Get("description").(string)
+	tags := getPolicyTagsFromSchema(d)
+	sharedWith := interface2StringList(d.Get("shared_with").([]interface{}))
+
+	obj := model.Share{
+		DisplayName: &displayName,
+		Description: &description,
+		Tags:        tags,
+		SharedWith:  sharedWith,
+	}
+
+	client := infra.NewSharesClient(connector)
+	return client.Patch(id, obj)
+}
+
+func resourceNsxtPolicyShareCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if isPolicyGlobalManager(m) {
+		return getErrorDiagnostics(localManagerOnlyError())
+	}
+	if !nsxVersionHigherOrEqual(policyMultitenancyMinVersion) {
+		return diag.Errorf("Share resource requires NSX version %s or higher", policyMultitenancyMinVersion)
+	}
+
+	// Initialize resource Id and verify this ID is not yet used
+	id, err := getOrGenerateID(d, m, resourceNsxtPolicyShareExists)
+	if err != nil {
+		return getOperationDiagnostics(m, err)
+	}
+
+	log.Printf("[INFO] Creating Share with ID %s", id)
+	err = policySharePatch(d, m, id)
+	if err != nil {
+		return getOperationDiagnostics(m, handleCreateError("Share", id, err))
+	}
+
+	d.SetId(id)
+	d.Set("nsx_id", id)
+
+	return resourceNsxtPolicyShareRead(ctx, d, m)
+}
+
+func resourceNsxtPolicyShareRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	connector := getPolicyConnector(m)
+	id := d.Id()
+	if id == "" {
+		return diag.Errorf("Error obtaining Share ID")
+	}
+
+	client := infra.NewSharesClient(connector)
+	obj, err := client.Get(id)
+	if err != nil {
+		return getOperationDiagnostics(m, handleReadError(d, "Share", id, err))
+	}
+
+	d.Set("display_name", obj.DisplayName)
+	d.Set("description", obj.Description)
+	setPolicyTagsInSchema(d, obj.Tags)
+	d.Set("nsx_id", id)
+	d.Set("path", obj.Path)
+	d.Set("revision", obj.Revision)
+	d.Set("shared_with", obj.SharedWith)
+
+	return nil
+}
+
+func resourceNsxtPolicyShareUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	id := d.Id()
+	if id == "" {
+		return diag.Errorf("Error obtaining Share ID")
+	}
+
+	log.Printf("[INFO] Updating Share with ID %s", id)
+	err := policySharePatch(d, m, id)
+	if err != nil {
+		return getOperationDiagnostics(m, handleUpdateError("Share", id, err))
+	}
+
+	return resourceNsxtPolicyShareRead(ctx, d, m)
+}
+
+func resourceNsxtPolicyShareDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	id := d.Id()
+	if id == "" {
+		return diag.Errorf("Error obtaining Share ID")
+	}
+
+	connector := getPolicyConnector(m)
+	client := infra.NewSharesClient(connector)
+	err := client.Delete(id)
+	if err != nil {
+		return getOperationDiagnostics(m, handleDeleteError("Share", id, err))
+	}
+
+	return nil
+}
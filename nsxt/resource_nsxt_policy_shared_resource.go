@@ -0,0 +1,258 @@
+/* Copyright © 2023 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/shares"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+func resourceNsxtPolicySharedResource() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceNsxtPolicySharedResourceCreate,
+		ReadContext:   resourceNsxtPolicySharedResourceRead,
+		UpdateContext: resourceNsxtPolicySharedResourceUpdate,
+		DeleteContext: resourceNsxtPolicySharedResourceDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceNsxtPolicySharedResourceImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"nsx_id":       getNsxIDSchema(),
+			"path":         getPathSchema(),
+			"display_name": getDisplayNameSchema(),
+			"description":  getDescriptionSchema(),
+			"revision":     getRevisionSchema(),
+			"tag":          getTagsSchema(),
+			"share_path":   getPolicyPathSchema(true, true, "Path of the Share this resource belongs to"),
+			"resource_object": {
+				Type:        schema.TypeList,
+				Description: "Resources to share",
+				Required:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"resource_path": getPolicyPathSchema(true, false, "Path of the resource to share"),
+						"include_children": {
+							Type:        schema.TypeBool,
+							Description: "Whether children of the resource are shared as well",
+							Optional:    true,
+							Default:     false,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceNsxtPolicySharedResourceExists(shareID string, id string, connector client.Connector) (bool, error) {
+	client := shares.NewResourcesClient(connector)
+	_, err := client.Get(shareID, id)
+	if err == nil {
+		return true, nil
+	}
+
+	if isNotFoundError(err) {
+		return false, nil
+	}
+
+	return false, logAPIError("Error retrieving Shared Resource", err)
+}
+
+func resourceNsxtPolicySharedResourceExistsPartial(shareID string) func(id string, connector client.Connector, isGlobalManager bool) (bool, error) {
+	return func(id string, connector client.Connector, isGlobalManager bool) (bool, error) {
+		return resourceNsxtPolicySharedResourceExists(shareID, id, connector)
+	}
+}
+
+func getPolicySharedResourceObjectsFromSchema(d *schema.ResourceData) []model.ResourceObject {
+	var objects []model.ResourceObject
+	for _, o := range d.Get("resource_object").([]interface{}) {
+		object := o.(map[string]interface{})
+		resourcePath := object["resource_path"].(string)
+		includeChildren := object["include_children"].(bool)
+		objects = append(objects, model.ResourceObject{
+			ResourcePath:    &resourcePath,
+			IncludeChildren: &includeChildren,
+		})
+	}
+
+	return objects
+}
+
+func setPolicySharedResourceObjectsInSchema(d *schema.ResourceData, objects []model.ResourceObject) {
+	var objectList []interface{}
+	for _, object := range objects {
+		elem := make(map[string]interface{})
+		elem["resource_path"] = object.ResourcePath
+		elem["include_children"] = object.IncludeChildren
+		objectList = append(objectList, elem)
+	}
+	d.Set("resource_object", objectList)
+}
+
+// Verify none of the resource paths is already shared by another resource within the same share
+func validatePolicySharedResourceConflicts(connector client.Connector, shareID string, id string, objects []model.ResourceObject) error {
+	client := shares.NewResourcesClient(connector)
+	existing, err := client.List(shareID, nil)
+	if err != nil {
+		return logAPIError("Error listing Shared Resources", err)
+	}
+
+	sharedPaths := make(map[string]string)
+	for _, sharedResource := range existing.Results {
+		if sharedResource.Id == nil || *sharedResource.Id == id {
+			continue
+		}
+		for _, object := range sharedResource.ResourceObjects {
+			if object.ResourcePath != nil {
+				sharedPaths[*object.ResourcePath] = *sharedResource.Id
+			}
+		}
+	}
+
+	var conflicts []string
+	for _, object := range objects {
+		if otherID, ok := sharedPaths[*object.ResourcePath]; ok {
+			conflicts = append(conflicts, fmt.Sprintf("%s (shared by %s)", *object.ResourcePath, otherID))
+		}
+	}
+
+	if len(conflicts) > 0 {
+		return newAttributeError("resource_object", fmt.Errorf("Resources are already shared within share %s: %s", shareID, strings.Join(conflicts, ", ")))
+	}
+
+	return nil
+}
+
+func policySharedResourcePatch(d *schema.ResourceData, m interface{}, shareID string, id string) error {
+	connector := getPolicyConnector(m)
+
+	displayName := d.Get("display_name").(string)
+	description := d.Get("description").(string)
+	tags := getPolicyTagsFromSchema(d)
+	objects := getPolicySharedResourceObjectsFromSchema(d)
+
+	err := validatePolicySharedResourceConflicts(connector, shareID, id, objects)
+	if err != nil {
+		return err
+	}
+
+	obj := model.SharedResource{
+		DisplayName:     &displayName,
+		Description:     &description,
+		Tags:            tags,
+		ResourceObjects: objects,
+	}
+
+	client := shares.NewResourcesClient(connector)
+	return client.Patch(shareID, id, obj)
+}
+
+func resourceNsxtPolicySharedResourceCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if isPolicyGlobalManager(m) {
+		return getErrorDiagnostics(localManagerOnlyError())
+	}
+	if !nsxVersionHigherOrEqual(policyMultitenancyMinVersion) {
+		return diag.Errorf("Shared Resource resource requires NSX version %s or higher", policyMultitenancyMinVersion)
+	}
+
+	shareID := getPolicyIDFromPath(d.Get("share_path").(string))
+
+	// Initialize resource Id and verify this ID is not yet used
+	id, err := getOrGenerateID(d, m, resourceNsxtPolicySharedResourceExistsPartial(shareID))
+	if err != nil {
+		return getOperationDiagnostics(m, err)
+	}
+
+	log.Printf("[INFO] Creating Shared Resource with ID %s", id)
+	err = policySharedResourcePatch(d, m, shareID, id)
+	if err != nil {
+		return getOperationDiagnostics(m, handleCreateError("Shared Resource", id, err))
+	}
+
+	d.SetId(id)
+	d.Set("nsx_id", id)
+
+	return resourceNsxtPolicySharedResourceRead(ctx, d, m)
+}
+
+func resourceNsxtPolicySharedResourceRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	connector := getPolicyConnector(m)
+	id := d.Id()
+	if id == "" {
+		return diag.Errorf("Error obtaining Shared Resource ID")
+	}
+
+	shareID := getPolicyIDFromPath(d.Get("share_path").(string))
+	client := shares.NewResourcesClient(connector)
+	obj, err := client.Get(shareID, id)
+	if err != nil {
+		return getOperationDiagnostics(m, handleReadError(d, "Shared Resource", id, err))
+	}
+
+	d.Set("display_name", obj.DisplayName)
+	d.Set("description", obj.Description)
+	setPolicyTagsInSchema(d, obj.Tags)
+	d.Set("nsx_id", id)
+	d.Set("path", obj.Path)
+	d.Set("revision", obj.Revision)
+	setPolicySharedResourceObjectsInSchema(d, obj.ResourceObjects)
+
+	return nil
+}
+
+func resourceNsxtPolicySharedResourceUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	id := d.Id()
+	if id == "" {
+		return diag.Errorf("Error obtaining Shared Resource ID")
+	}
+
+	shareID := getPolicyIDFromPath(d.Get("share_path").(string))
+	log.Printf("[INFO] Updating Shared Resource with ID %s", id)
+	err := policySharedResourcePatch(d, m, shareID, id)
+	if err != nil {
+		return getOperationDiagnostics(m, handleUpdateError("Shared Resource", id, err))
+	}
+
+	return resourceNsxtPolicySharedResourceRead(ctx, d, m)
+}
+
+func resourceNsxtPolicySharedResourceDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	id := d.Id()
+	if id == "" {
+		return diag.Errorf("Error obtaining Shared Resource ID")
+	}
+
+	shareID := getPolicyIDFromPath(d.Get("share_path").(string))
+	connector := getPolicyConnector(m)
+	client := shares.NewResourcesClient(connector)
+	err := client.Delete(shareID, id)
+	if err != nil {
+		return getOperationDiagnostics(m, handleDeleteError("Shared Resource", id, err))
+	}
+
+	return nil
+}
+
+func resourceNsxtPolicySharedResourceImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	importID := d.Id()
+	s := strings.Split(importID, "/")
+	if len(s) != 2 {
+		return nil, fmt.Errorf("Please provide <share-id>/<shared-resource-id> as an input")
+	}
+
+	d.Set("share_path", fmt.Sprintf("/infra/shares/%s", s[0]))
+	d.SetId(s[1])
+
+	return []*schema.ResourceData{d}, nil
+}
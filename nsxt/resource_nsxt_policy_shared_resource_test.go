@@ -0,0 +1,270 @@
+/* Copyright © 2023 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccResourceNsxtPolicySharedResource_basic(t *testing.T) {
+	testResourceName := "nsxt_policy_shared_resource.test"
+	name := getAccTestResourceName()
+	updatedName := getAccTestResourceName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccOnlyLocalManager(t)
+			testAccNSXVersion(t, "4.1.0")
+		},
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicySharedResourceCheckDestroy(state, updatedName)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicySharedResourceTemplate(name, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicySharedResourceExists(testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", name),
+					resource.TestCheckResourceAttr(testResourceName, "description", "terraform created"),
+					resource.TestCheckResourceAttr(testResourceName, "resource_object.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "resource_object.0.include_children", "false"),
+					resource.TestCheckResourceAttrSet(testResourceName, "share_path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+				),
+			},
+			{
+				Config: testAccNsxtPolicySharedResourceTemplate(updatedName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicySharedResourceExists(testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", updatedName),
+					resource.TestCheckResourceAttr(testResourceName, "resource_object.#", "2"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNsxtPolicySharedResource_importBasic(t *testing.T) {
+	name := getAccTestResourceName()
+	testResourceName := "nsxt_policy_shared_resource.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccOnlyLocalManager(t)
+			testAccNSXVersion(t, "4.1.0")
+		},
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicySharedResourceCheckDestroy(state, name)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicySharedResourceTemplate(name, false),
+			},
+			{
+				ResourceName:      testResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccNsxtPolicySharedResourceImporterGetID,
+			},
+		},
+	})
+}
+
+func TestAccResourceNsxtPolicySharedResource_conflict(t *testing.T) {
+	name := getAccTestResourceName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccOnlyLocalManager(t)
+			testAccNSXVersion(t, "4.1.0")
+		},
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicySharedResourceCheckDestroy(state, name)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicySharedResourceTemplate(name, false),
+			},
+			{
+				Config:      testAccNsxtPolicySharedResourceConflictTemplate(name),
+				ExpectError: regexp.MustCompile("already shared"),
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicySharedResourceExists(resourceName string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		connector := getPolicyConnector(testAccProvider.Meta().(nsxtClients))
+
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Policy Shared Resource resource %s not found in resources", resourceName)
+		}
+
+		resourceID := rs.Primary.ID
+		if resourceID == "" {
+			return fmt.Errorf("Policy Shared Resource resource ID not set in resources")
+		}
+
+		shareID := getPolicyIDFromPath(rs.Primary.Attributes["share_path"])
+		exists, err := resourceNsxtPolicySharedResourceExists(shareID, resourceID, connector)
+		if err != nil {
+			return err
+		}
+		if !exists {
+			return fmt.Errorf("Policy Shared Resource %s does not exist", resourceID)
+		}
+
+		return nil
+	}
+}
+
+func testAccNsxtPolicySharedResourceCheckDestroy(state *terraform.State, displayName string) error {
+	connector := getPolicyConnector(testAccProvider.Meta().(nsxtClients))
+	for _, rs := range state.RootModule().Resources {
+
+		if rs.Type != "nsxt_policy_shared_resource" {
+			continue
+		}
+
+		resourceID := rs.Primary.Attributes["id"]
+		shareID := getPolicyIDFromPath(rs.Primary.Attributes["share_path"])
+		exists, err := resourceNsxtPolicySharedResourceExists(shareID, resourceID, connector)
+		if err == nil {
+			return err
+		}
+
+		if exists {
+			return fmt.Errorf("Policy Shared Resource %s still exists", displayName)
+		}
+	}
+	return nil
+}
+
+func testAccNsxtPolicySharedResourceImporterGetID(s *terraform.State) (string, error) {
+	rs, ok := s.RootModule().Resources["nsxt_policy_shared_resource.test"]
+	if !ok {
+		return "", fmt.Errorf("NSX Policy Shared Resource resource not found in resources")
+	}
+	resourceID := rs.Primary.ID
+	if resourceID == "" {
+		return "", fmt.Errorf("NSX Policy Shared Resource resource ID not set in resources")
+	}
+	sharePath := rs.Primary.Attributes["share_path"]
+	if sharePath == "" {
+		return "", fmt.Errorf("NSX Policy Shared Resource share_path not set in resources")
+	}
+	return fmt.Sprintf("%s/%s", getPolicyIDFromPath(sharePath), resourceID), nil
+}
+
+func testAccNsxtPolicySharedResourcePrerequisites(name string) string {
+	return testAccNsxtPolicyShareTemplate(name) + fmt.Sprintf(`
+resource "nsxt_policy_group" "test1" {
+  display_name = "%s-1"
+}
+
+resource "nsxt_policy_group" "test2" {
+  display_name = "%s-2"
+}`, name, name)
+}
+
+func testAccNsxtPolicySharedResourceTemplate(name string, shareBoth bool) string {
+	extraObject := ""
+	if shareBoth {
+		extraObject = `
+  resource_object {
+    resource_path    = nsxt_policy_group.test2.path
+    include_children = true
+  }`
+	}
+	return testAccNsxtPolicySharedResourcePrerequisites(name) + fmt.Sprintf(`
+resource "nsxt_policy_shared_resource" "test" {
+  display_name = "%s"
+  description  = "terraform created"
+  share_path   = nsxt_policy_share.test.path
+
+  resource_object {
+    resource_path = nsxt_policy_group.test1.path
+  }
+%s
+}`, name, extraObject)
+}
+
+func testAccNsxtPolicySharedResourceConflictTemplate(name string) string {
+	return testAccNsxtPolicySharedResourceTemplate(name, false) + fmt.Sprintf(`
+resource "nsxt_policy_shared_resource" "conflict" {
+  display_name = "%s-conflict"
+  share_path   = nsxt_policy_share.test.path
+
+  resource_object {
+    resource_path = nsxt_policy_group.test1.path
+  }
+
+  depends_on = [nsxt_policy_shared_resource.test]
+}`, name)
+}
+
+func TestUnitResourceNsxtPolicySharedResource_conflict(t *testing.T) {
+	fake := newFakeNsxServer(t)
+	meta := testUnitConfigureProvider(t, fake)
+	resourceName := "nsxt_policy_shared_resource"
+
+	share := testUnitApplyResource(t, meta, "nsxt_policy_share", nil, map[string]interface{}{
+		"display_name": "share1",
+		"shared_with":  []interface{}{"/orgs/default/projects/project1"},
+	})
+	service := testUnitApplyResource(t, meta, "nsxt_policy_service", nil, map[string]interface{}{
+		"display_name": "service1",
+		"l4_port_set_entry": []interface{}{
+			map[string]interface{}{
+				"protocol":          "TCP",
+				"destination_ports": []interface{}{"80"},
+			},
+		},
+	})
+	config := map[string]interface{}{
+		"display_name": "shared1",
+		"share_path":   share.Attributes["path"],
+		"resource_object": []interface{}{
+			map[string]interface{}{"resource_path": service.Attributes["path"]},
+		},
+	}
+
+	state := testUnitApplyResource(t, meta, resourceName, nil, config)
+	testUnitCheckAttr(t, state, "resource_object.#", "1")
+	testUnitCheckAttr(t, state, "resource_object.0.resource_path", service.Attributes["path"])
+
+	// Same object can not be shared twice within the share
+	r := testUnitGetResource(t, resourceName)
+	ctx := context.Background()
+	config["display_name"] = "shared2"
+	diff, err := r.Diff(ctx, nil, terraform.NewResourceConfigRaw(config), meta)
+	if err != nil {
+		t.Fatalf("Failed to plan %s: %v", resourceName, err)
+	}
+	_, diags := r.Apply(ctx, nil, diff, meta)
+	if !diags.HasError() || !strings.Contains(diags[0].Summary, "already shared") {
+		t.Fatalf("Expected conflict error, got %v", diags)
+	}
+
+	testUnitDestroyResource(t, meta, resourceName, state)
+}
@@ -0,0 +1,333 @@
+/* Copyright © 2023 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	gm_infra "github.com/vmware/vsphere-automation-sdk-go/services/nsxt-gm/global_infra"
+	gm_model "github.com/vmware/vsphere-automation-sdk-go/services/nsxt-gm/model"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+var policySiteTypeValues = []string{
+	model.Site_SITE_TYPE_ONPREM_LM,
+	model.Site_SITE_TYPE_SDDC_LM,
+}
+
+// This resource is supported only for Policy Global Manager
+func resourceNsxtPolicySite() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceNsxtPolicySiteCreate,
+		ReadContext:   resourceNsxtPolicySiteRead,
+		UpdateContext: resourceNsxtPolicySiteUpdate,
+		DeleteContext: resourceNsxtPolicySiteDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"nsx_id":       getNsxIDSchema(),
+			"path":         getPathSchema(),
+			"display_name": getDisplayNameSchema(),
+			"description":  getDescriptionSchema(),
+			"revision":     getRevisionSchema(),
+			"tag":          getTagsSchema(),
+			"fail_if_rtep_misconfigured": {
+				Type:        schema.TypeBool,
+				Description: "Fail onboarding if RTEPs misconfigured",
+				Optional:    true,
+				Default:     true,
+			},
+			"fail_if_rtt_exceeded": {
+				Type:        schema.TypeBool,
+				Description: "Fail onboarding if maximum RTT exceeded",
+				Optional:    true,
+				Default:     true,
+			},
+			"maximum_rtt": {
+				Type:         schema.TypeInt,
+				Description:  "Maximum acceptable packet round trip time (RTT) in milliseconds",
+				Optional:     true,
+				Default:      250,
+				ValidateFunc: validation.IntBetween(0, 1000),
+			},
+			"site_type": {
+				Type:         schema.TypeString,
+				Description:  "Type of the site",
+				Optional:     true,
+				Default:      model.Site_SITE_TYPE_ONPREM_LM,
+				ValidateFunc: validation.StringInSlice(policySiteTypeValues, false),
+			},
+			"transit_subnet": {
+				Type:         schema.TypeString,
+				Description:  "IP subnet for transit segment allocation when gateways are stretched",
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateCidr(),
+			},
+			"site_connection_info": {
+				Type:        schema.TypeList,
+				Description: "Connection information for the Local Manager of this site",
+				Optional:    true,
+				MaxItems:    3,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"fqdn": {
+							Type:        schema.TypeString,
+							Description: "Fully qualified domain name or IP of the Local Manager",
+							Required:    true,
+						},
+						"username": {
+							Type:        schema.TypeString,
+							Description: "Username for the Local Manager",
+							Required:    true,
+						},
+						"password": {
+							Type:        schema.TypeString,
+							Description: "Password for the Local Manager",
+							Required:    true,
+							Sensitive:   true,
+						},
+						"thumbprint": {
+							Type:        schema.TypeString,
+							Description: "Thumbprint of the Local Manager certificate",
+							Optional:    true,
+							Computed:    true,
+						},
+						"site_uuid": {
+							Type:        schema.TypeString,
+							Description: "ID of the site as reported by the Local Manager",
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"site_index": {
+				Type:        schema.TypeInt,
+				Description: "Unique site index allocated by the Global Manager",
+				Computed:    true,
+			},
+			"rtep_ips": {
+				Type:        schema.TypeList,
+				Description: "Remote tunnel endpoint IP addresses of the site",
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func resourceNsxtPolicySiteExists(id string, connector client.Connector, isGlobalManager bool) (bool, error) {
+	if !isGlobalManager {
+		return false, fmt.Errorf("Site resource is not supported for local manager")
+	}
+
+	client := gm_infra.NewSitesClient(connector)
+	_, err := client.Get(id)
+	if err == nil {
+		return true, nil
+	}
+
+	if isNotFoundError(err) {
+		return false, nil
+	}
+
+	return false, logAPIError("Error retrieving Site", err)
+}
+
+func getPolicySiteConnectionInfosFromSchema(d *schema.ResourceData) []model.SiteNodeConnectionInfo {
+	var connectionInfos []model.SiteNodeConnectionInfo
+	for _, c := range d.Get("site_connection_info").([]interface{}) {
+		connectionInfo := c.(map[string]interface{})
+		fqdn := connectionInfo["fqdn"].(string)
+		username := connectionInfo["username"].(string)
+		password := connectionInfo["password"].(string)
+		elem := model.SiteNodeConnectionInfo{
+			Fqdn:     &fqdn,
+			Username: &username,
+			Password: &password,
+		}
+		thumbprint := connectionInfo["thumbprint"].(string)
+		if thumbprint != "" {
+			elem.Thumbprint = &thumbprint
+		}
+		connectionInfos = append(connectionInfos, elem)
+	}
+
+	return connectionInfos
+}
+
+func setPolicySiteConnectionInfosInSchema(d *schema.ResourceData, connectionInfos []model.SiteNodeConnectionInfo) {
+	// Password is not returned by NSX, hence it is preserved from intent
+	passwords := make(map[string]string)
+	for _, c := range d.Get("site_connection_info").([]interface{}) {
+		connectionInfo := c.(map[string]interface{})
+		passwords[connectionInfo["fqdn"].(string)] = connectionInfo["password"].(string)
+	}
+
+	var connectionInfoList []interface{}
+	for _, connectionInfo := range connectionInfos {
+		elem := make(map[string]interface{})
+		elem["fqdn"] = connectionInfo.Fqdn
+		elem["username"] = connectionInfo.Username
+		elem["thumbprint"] = connectionInfo.Thumbprint
+		elem["site_uuid"] = connectionInfo.SiteUiid
+		if connectionInfo.Fqdn != nil {
+			elem["password"] = passwords[*connectionInfo.Fqdn]
+		}
+		connectionInfoList = append(connectionInfoList, elem)
+	}
+	d.Set("site_connection_info", connectionInfoList)
+}
+
+func policySitePatch(d *schema.ResourceData, m interface{}, id string) error {
+	connector := getPolicyConnector(m)
+
+	displayName := d.Get("display_name").(string)
+	description := d.Get("description").(string)
+	tags := getPolicyTagsFromSchema(d)
+	failIfRtepMisconfigured := d.Get("fail_if_rtep_misconfigured").(bool)
+	failIfRttExceeded := d.Get("fail_if_rtt_exceeded").(bool)
+	maximumRtt := int64(d.Get("maximum_rtt").(int))
+	siteType := d.Get("site_type").(string)
+
+	obj := model.Site{
+		DisplayName:             &displayName,
+		Description:             &description,
+		Tags:                    tags,
+		FailIfRtepMisconfigured: &failIfRtepMisconfigured,
+		FailIfRttExceeded:       &failIfRttExceeded,
+		MaximumRtt:              &maximumRtt,
+		SiteType:                &siteType,
+		SiteConnectionInfo:      getPolicySiteConnectionInfosFromSchema(d),
+	}
+
+	transitSubnet := d.Get("transit_subnet").(string)
+	if transitSubnet != "" {
+		obj.FederationConfig = &model.GmFederationSiteConfig{
+			TransitSubnet: &transitSubnet,
+		}
+	}
+
+	gmObj, err := convertModelBindingType(obj, model.SiteBindingType(), gm_model.SiteBindingType())
+	if err != nil {
+		return err
+	}
+
+	client := gm_infra.NewSitesClient(connector)
+	return client.Patch(id, gmObj.(gm_model.Site))
+}
+
+func resourceNsxtPolicySiteCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if !isPolicyGlobalManager(m) {
+		return getErrorDiagnostics(globalManagerOnlyError())
+	}
+
+	// Initialize resource Id and verify this ID is not yet used
+	id, err := getOrGenerateID(d, m, resourceNsxtPolicySiteExists)
+	if err != nil {
+		return getOperationDiagnostics(m, err)
+	}
+
+	log.Printf("[INFO] Creating Site with ID %s", id)
+	err = policySitePatch(d, m, id)
+	if err != nil {
+		return getOperationDiagnostics(m, handleCreateError("Site", id, err))
+	}
+
+	d.SetId(id)
+	d.Set("nsx_id", id)
+
+	return resourceNsxtPolicySiteRead(ctx, d, m)
+}
+
+func resourceNsxtPolicySiteRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	connector := getPolicyConnector(m)
+	id := d.Id()
+	if id == "" {
+		return diag.Errorf("Error obtaining Site ID")
+	}
+
+	client := gm_infra.NewSitesClient(connector)
+	gmObj, err := client.Get(id)
+	if err != nil {
+		return getOperationDiagnostics(m, handleReadError(d, "Site", id, err))
+	}
+
+	lmObj, err := convertModelBindingType(gmObj, gm_model.SiteBindingType(), model.SiteBindingType())
+	if err != nil {
+		return getOperationDiagnostics(m, err)
+	}
+	obj := lmObj.(model.Site)
+
+	d.Set("display_name", obj.DisplayName)
+	d.Set("description", obj.Description)
+	setPolicyTagsInSchema(d, obj.Tags)
+	d.Set("nsx_id", id)
+	d.Set("path", obj.Path)
+	d.Set("revision", obj.Revision)
+	d.Set("fail_if_rtep_misconfigured", obj.FailIfRtepMisconfigured)
+	d.Set("fail_if_rtt_exceeded", obj.FailIfRttExceeded)
+	d.Set("maximum_rtt", obj.MaximumRtt)
+	d.Set("site_type", obj.SiteType)
+	if obj.FederationConfig != nil {
+		d.Set("transit_subnet", obj.FederationConfig.TransitSubnet)
+	}
+	setPolicySiteConnectionInfosInSchema(d, obj.SiteConnectionInfo)
+
+	// Site index and RTEPs are allocated by Global Manager as part of federation config
+	federationConfig, err := gm_infra.NewFederationConfigClient(connector).Get()
+	if err != nil {
+		return getOperationDiagnostics(m, logAPIError("Error retrieving Federation Config", err))
+	}
+	for _, siteConfig := range federationConfig.SiteConfig {
+		if siteConfig.SitePath != nil && obj.Path != nil && *siteConfig.SitePath == *obj.Path {
+			d.Set("site_index", siteConfig.SiteIndex)
+			d.Set("rtep_ips", siteConfig.RtepIps)
+			break
+		}
+	}
+
+	return nil
+}
+
+func resourceNsxtPolicySiteUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	id := d.Id()
+	if id == "" {
+		return diag.Errorf("Error obtaining Site ID")
+	}
+
+	log.Printf("[INFO] Updating Site with ID %s", id)
+	err := policySitePatch(d, m, id)
+	if err != nil {
+		return getOperationDiagnostics(m, handleUpdateError("Site", id, err))
+	}
+
+	return resourceNsxtPolicySiteRead(ctx, d, m)
+}
+
+func resourceNsxtPolicySiteDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	id := d.Id()
+	if id == "" {
+		return diag.Errorf("Error obtaining Site ID")
+	}
+
+	connector := getPolicyConnector(m)
+	client := gm_infra.NewSitesClient(connector)
+	err := client.Delete(id, nil)
+	if err != nil {
+		return getOperationDiagnostics(m, handleDeleteError("Site", id, err))
+	}
+
+	return nil
+}
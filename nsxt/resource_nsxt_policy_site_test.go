@@ -0,0 +1,86 @@
+/* Copyright © 2023 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccResourceNsxtPolicySite_basic(t *testing.T) {
+	testResourceName := "nsxt_policy_site.test"
+	name := getAccTestResourceName()
+	updatedName := getAccTestResourceName()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccOnlyGlobalManager(t)
+			testAccEnvDefined(t, "NSXT_TEST_ONBOARD_SITE_FQDN")
+			testAccEnvDefined(t, "NSXT_TEST_ONBOARD_SITE_USERNAME")
+			testAccEnvDefined(t, "NSXT_TEST_ONBOARD_SITE_PASSWORD")
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyResourceCheckDestroy(state, updatedName, "nsxt_policy_site", resourceNsxtPolicySiteExists)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicySiteTemplate(name, 250),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyResourceExists(testResourceName, resourceNsxtPolicySiteExists),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", name),
+					resource.TestCheckResourceAttr(testResourceName, "description", "terraform created"),
+					resource.TestCheckResourceAttr(testResourceName, "maximum_rtt", "250"),
+					resource.TestCheckResourceAttr(testResourceName, "site_type", "ONPREM_LM"),
+					resource.TestCheckResourceAttr(testResourceName, "site_connection_info.#", "1"),
+					resource.TestCheckResourceAttrSet(testResourceName, "site_connection_info.0.thumbprint"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "1"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+					resource.TestCheckResourceAttr("data.nsxt_policy_gm_operational_state.test", "consolidated_progress", "COMPLETED"),
+				),
+			},
+			{
+				Config: testAccNsxtPolicySiteTemplate(updatedName, 500),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyResourceExists(testResourceName, resourceNsxtPolicySiteExists),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", updatedName),
+					resource.TestCheckResourceAttr(testResourceName, "maximum_rtt", "500"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicySiteTemplate(name string, maxRtt int) string {
+	return fmt.Sprintf(`
+resource "nsxt_policy_site" "test" {
+  display_name = "%s"
+  description  = "terraform created"
+  maximum_rtt  = %d
+
+  site_connection_info {
+    fqdn     = "%s"
+    username = "%s"
+    password = "%s"
+  }
+
+  tag {
+    scope = "scope1"
+    tag   = "tag1"
+  }
+}
+
+data "nsxt_policy_gm_operational_state" "test" {
+  delay      = 10
+  depends_on = [nsxt_policy_site.test]
+}`, name, maxRtt, os.Getenv("NSXT_TEST_ONBOARD_SITE_FQDN"), os.Getenv("NSXT_TEST_ONBOARD_SITE_USERNAME"), os.Getenv("NSXT_TEST_ONBOARD_SITE_PASSWORD"))
+}
@@ -0,0 +1,192 @@
+/* Copyright © 2023 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	gm_domains "github.com/vmware/vsphere-automation-sdk-go/services/nsxt-gm/global_infra/domains"
+	gm_model "github.com/vmware/vsphere-automation-sdk-go/services/nsxt-gm/model"
+)
+
+const policyGlobalDomainPathPrefix = "/global-infra/domains/"
+
+// Span of a global domain is defined by its deployment maps, one per site. Objects
+// within the domain, such as groups and security policies, inherit its span. This
+// resource is supported only for Policy Global Manager.
+func resourceNsxtPolicySpan() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceNsxtPolicySpanCreate,
+		ReadContext:   resourceNsxtPolicySpanRead,
+		UpdateContext: resourceNsxtPolicySpanUpdate,
+		DeleteContext: resourceNsxtPolicySpanDelete,
+
+		Schema: map[string]*schema.Schema{
+			"domain_path": getPolicyPathSchema(true, true, "Path of the domain or region to set span for"),
+			"site_paths": {
+				Type:        schema.TypeSet,
+				Description: "Paths of sites the domain spans",
+				Required:    true,
+				MinItems:    1,
+				Elem:        getElemPolicyPathSchema(),
+			},
+		},
+	}
+}
+
+func getPolicyDomainDeploymentMapID(domainID string, sitePath string) string {
+	// Same convention as nsxt_policy_domain, so that both resources manage
+	// the same deployment maps
+	return domainID + "-" + getPolicyIDFromPath(sitePath)
+}
+
+func getPolicySitePathFromEnforcementPointPath(epPath string) string {
+	return strings.Split(epPath, "/enforcement-points/")[0]
+}
+
+func listPolicyDomainDeploymentMaps(connector client.Connector, domainID string) ([]gm_model.DomainDeploymentMap, error) {
+	client := gm_domains.NewDomainDeploymentMapsClient(connector)
+	var results []gm_model.DomainDeploymentMap
+	var cursor *string
+	for {
+		objList, err := client.List(domainID, cursor, nil, nil, nil, nil, nil)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, objList.Results...)
+		cursor = objList.Cursor
+		if cursor == nil || len(objList.Results) == 0 {
+			return results, nil
+		}
+	}
+}
+
+func listPolicyDomainSpanSitePaths(connector client.Connector, domainID string) ([]string, error) {
+	deploymentMaps, err := listPolicyDomainDeploymentMaps(connector, domainID)
+	if err != nil {
+		return nil, err
+	}
+
+	var sitePaths []string
+	for _, deploymentMap := range deploymentMaps {
+		if deploymentMap.EnforcementPointPath != nil {
+			sitePaths = append(sitePaths, getPolicySitePathFromEnforcementPointPath(*deploymentMap.EnforcementPointPath))
+		}
+	}
+	return sitePaths, nil
+}
+
+func policySpanPatchSites(m interface{}, domainID string, sitePaths []string) error {
+	client := gm_domains.NewDomainDeploymentMapsClient(getPolicyConnector(m))
+	for _, sitePath := range sitePaths {
+		mapID := getPolicyDomainDeploymentMapID(domainID, sitePath)
+		siteID := getPolicyIDFromPath(sitePath)
+		epPath := sitePath + "/enforcement-points/" + getPolicyEnforcementPoint(m)
+		obj := gm_model.DomainDeploymentMap{
+			DisplayName:          &siteID,
+			EnforcementPointPath: &epPath,
+		}
+
+		log.Printf("[INFO] Adding site %s to span of domain %s", sitePath, domainID)
+		err := client.Patch(domainID, mapID, obj)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func policySpanDeleteSites(m interface{}, domainID string, sitePaths []string) error {
+	client := gm_domains.NewDomainDeploymentMapsClient(getPolicyConnector(m))
+	for _, sitePath := range sitePaths {
+		log.Printf("[INFO] Removing site %s from span of domain %s", sitePath, domainID)
+		err := client.Delete(domainID, getPolicyDomainDeploymentMapID(domainID, sitePath))
+		if err != nil && !isNotFoundError(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+func resourceNsxtPolicySpanCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if !isPolicyGlobalManager(m) {
+		return getErrorDiagnostics(globalManagerOnlyError())
+	}
+
+	domainPath := d.Get("domain_path").(string)
+	if !strings.HasPrefix(domainPath, policyGlobalDomainPathPrefix) {
+		return getErrorDiagnostics(newAttributeError("domain_path", fmt.Errorf("Span can only be set for global domain, got %s", domainPath)))
+	}
+	domainID := getPolicyIDFromPath(domainPath)
+
+	sitePaths := getStringListFromSchemaSet(d, "site_paths")
+	err := policySpanPatchSites(m, domainID, sitePaths)
+	if err != nil {
+		return getOperationDiagnostics(m, handleCreateError("Span", domainID, err))
+	}
+
+	d.SetId(domainID)
+
+	return resourceNsxtPolicySpanRead(ctx, d, m)
+}
+
+func resourceNsxtPolicySpanRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	connector := getPolicyConnector(m)
+	id := d.Id()
+	if id == "" {
+		return diag.Errorf("Error obtaining Span ID")
+	}
+
+	sitePaths, err := listPolicyDomainSpanSitePaths(connector, id)
+	if err != nil {
+		return getOperationDiagnostics(m, handleReadError(d, "Span", id, err))
+	}
+
+	d.Set("domain_path", policyGlobalDomainPathPrefix+id)
+	d.Set("site_paths", sitePaths)
+
+	return nil
+}
+
+func resourceNsxtPolicySpanUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	id := d.Id()
+	if id == "" {
+		return diag.Errorf("Error obtaining Span ID")
+	}
+
+	oldSites, newSites := d.GetChange("site_paths")
+	removedSites := interface2StringList(oldSites.(*schema.Set).Difference(newSites.(*schema.Set)).List())
+	addedSites := interface2StringList(newSites.(*schema.Set).Difference(oldSites.(*schema.Set)).List())
+
+	// Add new sites first, so that domain never remains without span
+	err := policySpanPatchSites(m, id, addedSites)
+	if err == nil {
+		err = policySpanDeleteSites(m, id, removedSites)
+	}
+	if err != nil {
+		return getOperationDiagnostics(m, handleUpdateError("Span", id, err))
+	}
+
+	return resourceNsxtPolicySpanRead(ctx, d, m)
+}
+
+func resourceNsxtPolicySpanDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	id := d.Id()
+	if id == "" {
+		return diag.Errorf("Error obtaining Span ID")
+	}
+
+	err := policySpanDeleteSites(m, id, getStringListFromSchemaSet(d, "site_paths"))
+	if err != nil {
+		return getOperationDiagnostics(m, handleDeleteError("Span", id, err))
+	}
+
+	return nil
+}
@@ -0,0 +1,124 @@
+/* Copyright © 2023 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccResourceNsxtPolicySpan_basic(t *testing.T) {
+	testResourceName := "nsxt_policy_span.test"
+	name := getAccTestResourceName()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccOnlyGlobalManager(t)
+			testAccEnvDefined(t, "NSXT_TEST_SITE_NAME")
+			testAccEnvDefined(t, "NSXT_TEST_ANOTHER_SITE_NAME")
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyResourceCheckDestroy(state, name, "nsxt_policy_region", resourceNsxtPolicyDomainExists)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicySpanTemplate(name, "[data.nsxt_policy_site.site1.path]"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(testResourceName, "domain_path", "nsxt_policy_region.test", "path"),
+					resource.TestCheckResourceAttr(testResourceName, "site_paths.#", "1"),
+					resource.TestCheckResourceAttr("data.nsxt_policy_span.test", "site_paths.#", "1"),
+				),
+			},
+			{
+				Config: testAccNsxtPolicySpanTemplate(name, "[data.nsxt_policy_site.site1.path, data.nsxt_policy_site.site2.path]"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(testResourceName, "site_paths.#", "2"),
+					resource.TestCheckResourceAttr("nsxt_policy_region.test", "site_paths.#", "2"),
+					resource.TestCheckResourceAttr("data.nsxt_policy_span.test", "site_paths.#", "2"),
+				),
+			},
+			{
+				Config: testAccNsxtPolicySpanTemplate(name, "[data.nsxt_policy_site.site2.path]"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(testResourceName, "site_paths.#", "1"),
+					resource.TestCheckResourceAttrPair(testResourceName, "site_paths.0", "data.nsxt_policy_site.site2", "path"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicySpanTemplate(name string, sitePaths string) string {
+	return fmt.Sprintf(`
+data "nsxt_policy_site" "site1" {
+  display_name = "%s"
+}
+
+data "nsxt_policy_site" "site2" {
+  display_name = "%s"
+}
+
+resource "nsxt_policy_region" "test" {
+  display_name = "%s"
+}
+
+resource "nsxt_policy_span" "test" {
+  domain_path = nsxt_policy_region.test.path
+  site_paths  = %s
+}
+
+data "nsxt_policy_span" "test" {
+  path       = nsxt_policy_region.test.path
+  depends_on = [nsxt_policy_span.test]
+}`, getTestSiteName(), getTestAnotherSiteName(), name, sitePaths)
+}
+
+func TestUnitResourceNsxtPolicySpan_basic(t *testing.T) {
+	fake := newFakeNsxServer(t)
+	meta := testUnitConfigureProviderWithOptions(t, fake, map[string]interface{}{"global_manager": true})
+	mapPath := "/global-infra/domains/region1/domain-deployment-maps/region1-"
+
+	testUnitApplyResource(t, meta, "nsxt_policy_region", nil, map[string]interface{}{
+		"nsx_id":       "region1",
+		"display_name": "region1",
+	})
+	state := testUnitApplyResource(t, meta, "nsxt_policy_span", nil, map[string]interface{}{
+		"domain_path": "/global-infra/domains/region1",
+		"site_paths":  []interface{}{"/global-infra/sites/site1"},
+	})
+	testUnitCheckAttr(t, state, "id", "region1")
+	testUnitCheckAttr(t, state, "site_paths.#", "1")
+	if obj := fake.getObject(mapPath + "site1"); obj == nil || obj["enforcement_point_path"] != "/global-infra/sites/site1/enforcement-points/default" {
+		t.Errorf("Expected deployment map for site1, got %v", obj)
+	}
+
+	// Moving span to another site adds new deployment map and removes the old one
+	state = testUnitApplyResource(t, meta, "nsxt_policy_span", state, map[string]interface{}{
+		"domain_path": "/global-infra/domains/region1",
+		"site_paths":  []interface{}{"/global-infra/sites/site2"},
+	})
+	testUnitCheckAttr(t, state, "site_paths.#", "1")
+	if fake.getObject(mapPath+"site1") != nil || fake.getObject(mapPath+"site2") == nil {
+		t.Errorf("Expected span of region1 to move from site1 to site2")
+	}
+
+	testUnitDestroyResource(t, meta, "nsxt_policy_span", state)
+	if fake.getObject(mapPath+"site2") != nil {
+		t.Errorf("Expected deployment map for site2 to be deleted")
+	}
+	if fake.getObject("/global-infra/domains/region1") == nil {
+		t.Errorf("Expected region to remain after span is destroyed")
+	}
+
+	// Span can not be set for non-global objects
+	testUnitCheckApplyAttributeError(t, meta, "nsxt_policy_span", map[string]interface{}{
+		"domain_path": "/infra/domains/default",
+		"site_paths":  []interface{}{"/global-infra/sites/site1"},
+	}, "domain_path")
+}
@@ -4,9 +4,10 @@
 package nsxt
 
 import (
-	"fmt"
+	"context"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
 	gm_infra "github.com/vmware/vsphere-automation-sdk-go/services/nsxt-gm/global_infra"
@@ -17,12 +18,12 @@ import (
 
 func resourceNsxtPolicySpoofGuardProfile() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtPolicySpoofGuardProfileCreate,
-		Read:   resourceNsxtPolicySpoofGuardProfileRead,
-		Update: resourceNsxtPolicySpoofGuardProfileUpdate,
-		Delete: resourceNsxtPolicySpoofGuardProfileDelete,
+		CreateContext: resourceNsxtPolicySpoofGuardProfileCreate,
+		ReadContext:   resourceNsxtPolicySpoofGuardProfileRead,
+		UpdateContext: resourceNsxtPolicySpoofGuardProfileUpdate,
+		DeleteContext: resourceNsxtPolicySpoofGuardProfileDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -89,31 +90,31 @@ func resourceNsxtPolicySpoofGuardProfilePatch(d *schema.ResourceData, m interfac
 	return client.Patch(id, obj, nil)
 }
 
-func resourceNsxtPolicySpoofGuardProfileCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicySpoofGuardProfileCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 
 	// Initialize resource Id and verify this ID is not yet used
 	id, err := getOrGenerateID(d, m, resourceNsxtPolicySpoofGuardProfileExists)
 	if err != nil {
-		return err
+		return getOperationDiagnostics(m, err)
 	}
 
 	err = resourceNsxtPolicySpoofGuardProfilePatch(d, m, id)
 	if err != nil {
-		return handleCreateError("SpoofGuardProfile", id, err)
+		return getOperationDiagnostics(m, handleCreateError("SpoofGuardProfile", id, err))
 	}
 
 	d.SetId(id)
 	d.Set("nsx_id", id)
 
-	return resourceNsxtPolicySpoofGuardProfileRead(d, m)
+	return resourceNsxtPolicySpoofGuardProfileRead(ctx, d, m)
 }
 
-func resourceNsxtPolicySpoofGuardProfileRead(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicySpoofGuardProfileRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining SpoofGuardProfile ID")
+		return diag.Errorf("Error obtaining SpoofGuardProfile ID")
 	}
 
 	var obj model.SpoofGuardProfile
@@ -121,12 +122,12 @@ func resourceNsxtPolicySpoofGuardProfileRead(d *schema.ResourceData, m interface
 		client := gm_infra.NewSpoofguardProfilesClient(connector)
 		gmObj, err := client.Get(id)
 		if err != nil {
-			return handleReadError(d, "SpoofGuardProfile", id, err)
+			return getOperationDiagnostics(m, handleReadError(d, "SpoofGuardProfile", id, err))
 		}
 
 		lmObj, err := convertModelBindingType(gmObj, gm_model.SpoofGuardProfileBindingType(), model.SpoofGuardProfileBindingType())
 		if err != nil {
-			return err
+			return getOperationDiagnostics(m, err)
 		}
 		obj = lmObj.(model.SpoofGuardProfile)
 	} else {
@@ -134,7 +135,7 @@ func resourceNsxtPolicySpoofGuardProfileRead(d *schema.ResourceData, m interface
 		var err error
 		obj, err = client.Get(id)
 		if err != nil {
-			return handleReadError(d, "SpoofGuardProfile", id, err)
+			return getOperationDiagnostics(m, handleReadError(d, "SpoofGuardProfile", id, err))
 		}
 	}
 
@@ -150,25 +151,25 @@ func resourceNsxtPolicySpoofGuardProfileRead(d *schema.ResourceData, m interface
 	return nil
 }
 
-func resourceNsxtPolicySpoofGuardProfileUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicySpoofGuardProfileUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining SpoofGuardProfile ID")
+		return diag.Errorf("Error obtaining SpoofGuardProfile ID")
 	}
 
 	err := resourceNsxtPolicySpoofGuardProfilePatch(d, m, id)
 	if err != nil {
-		return handleUpdateError("SpoofGuardProfile", id, err)
+		return getOperationDiagnostics(m, handleUpdateError("SpoofGuardProfile", id, err))
 	}
 
-	return resourceNsxtPolicySpoofGuardProfileRead(d, m)
+	return resourceNsxtPolicySpoofGuardProfileRead(ctx, d, m)
 }
 
-func resourceNsxtPolicySpoofGuardProfileDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicySpoofGuardProfileDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining SpoofGuardProfile ID")
+		return diag.Errorf("Error obtaining SpoofGuardProfile ID")
 	}
 
 	connector := getPolicyConnector(m)
@@ -182,7 +183,7 @@ func resourceNsxtPolicySpoofGuardProfileDelete(d *schema.ResourceData, m interfa
 	}
 
 	if err != nil {
-		return handleDeleteError("SpoofGuardProfile", id, err)
+		return getOperationDiagnostics(m, handleDeleteError("SpoofGuardProfile", id, err))
 	}
 
 	return nil
@@ -4,10 +4,12 @@
 package nsxt
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
@@ -19,12 +21,12 @@ import (
 
 func resourceNsxtPolicyStaticRoute() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtPolicyStaticRouteCreate,
-		Read:   resourceNsxtPolicyStaticRouteRead,
-		Update: resourceNsxtPolicyStaticRouteUpdate,
-		Delete: resourceNsxtPolicyStaticRouteDelete,
+		CreateContext: resourceNsxtPolicyStaticRouteCreate,
+		ReadContext:   resourceNsxtPolicyStaticRouteRead,
+		UpdateContext: resourceNsxtPolicyStaticRouteUpdate,
+		DeleteContext: resourceNsxtPolicyStaticRouteDelete,
 		Importer: &schema.ResourceImporter{
-			State: resourceNsxtPolicyStaticRouteImport,
+			StateContext: resourceNsxtPolicyStaticRouteImport,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -102,13 +104,13 @@ func getNsxtPolicyStaticRouteByID(connector client.Connector, gwID string, isT0
 	return routeClient.Get(gwID, routeID)
 }
 
-func resourceNsxtPolicyStaticRouteCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyStaticRouteCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 
 	gwPolicyPath := d.Get("gateway_path").(string)
 	isT0, gwID := parseGatewayPolicyPath(gwPolicyPath)
 	if gwID == "" {
-		return fmt.Errorf("gateway_path is not a valid")
+		return getErrorDiagnostics(newAttributeError("gateway_path", fmt.Errorf("gateway_path is not a valid")))
 	}
 
 	id := d.Get("nsx_id").(string)
@@ -117,9 +119,9 @@ func resourceNsxtPolicyStaticRouteCreate(d *schema.ResourceData, m interface{})
 	} else {
 		_, err := getNsxtPolicyStaticRouteByID(connector, gwID, isT0, id)
 		if err == nil {
-			return fmt.Errorf("Static Route with nsx_id '%s' already exists", id)
+			return getErrorDiagnostics(newAttributeError("nsx_id", fmt.Errorf("Static Route with nsx_id '%s' already exists", id)))
 		} else if !isNotFoundError(err) {
-			return err
+			return getOperationDiagnostics(m, err)
 		}
 	}
 
@@ -165,32 +167,32 @@ func resourceNsxtPolicyStaticRouteCreate(d *schema.ResourceData, m interface{})
 	log.Printf("[INFO] Creating Static Route with ID %s", id)
 	err := patchNsxtPolicyStaticRoute(connector, gwID, routeStruct, isT0)
 	if err != nil {
-		return handleCreateError("Static Route", id, err)
+		return getOperationDiagnostics(m, handleCreateError("Static Route", id, err))
 	}
 
 	d.SetId(id)
 	d.Set("nsx_id", id)
 
-	return resourceNsxtPolicyStaticRouteRead(d, m)
+	return resourceNsxtPolicyStaticRouteRead(ctx, d, m)
 }
 
-func resourceNsxtPolicyStaticRouteRead(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyStaticRouteRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining Static Route ID")
+		return diag.Errorf("Error obtaining Static Route ID")
 	}
 
 	gwPolicyPath := d.Get("gateway_path").(string)
 	isT0, gwID := parseGatewayPolicyPath(gwPolicyPath)
 	if gwID == "" {
-		return fmt.Errorf("gateway_path is not a valid")
+		return getErrorDiagnostics(newAttributeError("gateway_path", fmt.Errorf("gateway_path is not a valid")))
 	}
 
 	obj, err := getNsxtPolicyStaticRouteByID(connector, gwID, isT0, id)
 	if err != nil {
-		return handleReadError(d, "Static Route", id, err)
+		return getOperationDiagnostics(m, handleReadError(d, "Static Route", id, err))
 	}
 
 	d.Set("display_name", obj.DisplayName)
@@ -228,18 +230,18 @@ func resourceNsxtPolicyStaticRouteRead(d *schema.ResourceData, m interface{}) er
 	return nil
 }
 
-func resourceNsxtPolicyStaticRouteUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyStaticRouteUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining Static Route ID")
+		return diag.Errorf("Error obtaining Static Route ID")
 	}
 
 	gwPolicyPath := d.Get("gateway_path").(string)
 	isT0, gwID := parseGatewayPolicyPath(gwPolicyPath)
 	if gwID == "" {
-		return fmt.Errorf("gateway_path is not valid")
+		return getErrorDiagnostics(newAttributeError("gateway_path", fmt.Errorf("gateway_path is not valid")))
 	}
 
 	displayName := d.Get("display_name").(string)
@@ -283,36 +285,36 @@ func resourceNsxtPolicyStaticRouteUpdate(d *schema.ResourceData, m interface{})
 	log.Printf("[INFO] Updating Static Route with ID %s", id)
 	err := patchNsxtPolicyStaticRoute(connector, gwID, routeStruct, isT0)
 	if err != nil {
-		return handleUpdateError("Static Route", id, err)
+		return getOperationDiagnostics(m, handleUpdateError("Static Route", id, err))
 	}
 
 	d.SetId(id)
 	d.Set("nsx_id", id)
 
-	return resourceNsxtPolicyStaticRouteRead(d, m)
+	return resourceNsxtPolicyStaticRouteRead(ctx, d, m)
 }
 
-func resourceNsxtPolicyStaticRouteDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyStaticRouteDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining Static Route ID")
+		return diag.Errorf("Error obtaining Static Route ID")
 	}
 
 	gwPolicyPath := d.Get("gateway_path").(string)
 	isT0, gwID := parseGatewayPolicyPath(gwPolicyPath)
 	if gwID == "" {
-		return fmt.Errorf("gateway_path is not valid")
+		return getErrorDiagnostics(newAttributeError("gateway_path", fmt.Errorf("gateway_path is not valid")))
 	}
 
 	err := deleteNsxtPolicyStaticRoute(getPolicyConnector(m), gwID, isT0, id)
 	if err != nil {
-		return handleDeleteError("Static Route", id, err)
+		return getOperationDiagnostics(m, handleDeleteError("Static Route", id, err))
 	}
 
 	return nil
 }
 
-func resourceNsxtPolicyStaticRouteImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+func resourceNsxtPolicyStaticRouteImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	importID := d.Id()
 	s := strings.Split(importID, "/")
 	if len(s) != 2 {
@@ -4,9 +4,11 @@
 package nsxt
 
 import (
+	"context"
 	"fmt"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/vmware/vsphere-automation-sdk-go/runtime/bindings"
@@ -41,12 +43,12 @@ var policyBGPGracefulRestartStaleRouteTimerDefault = 600
 func resourceNsxtPolicyTier0Gateway() *schema.Resource {
 
 	return &schema.Resource{
-		Create: resourceNsxtPolicyTier0GatewayCreate,
-		Read:   resourceNsxtPolicyTier0GatewayRead,
-		Update: resourceNsxtPolicyTier0GatewayUpdate,
-		Delete: resourceNsxtPolicyTier0GatewayDelete,
+		CreateContext: resourceNsxtPolicyTier0GatewayCreate,
+		ReadContext:   resourceNsxtPolicyTier0GatewayRead,
+		UpdateContext: resourceNsxtPolicyTier0GatewayUpdate,
+		DeleteContext: resourceNsxtPolicyTier0GatewayDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -676,11 +678,11 @@ func verifyPolicyTier0GatewayConfig(d *schema.ResourceData, isGlobalManager bool
 	if isGlobalManager {
 		_, isSet := d.GetOk("edge_cluster_path")
 		if isSet {
-			return fmt.Errorf("edge_cluster_path setting is not supported with NSX Global Manager, please use locale_service instead")
+			return newAttributeError("edge_cluster_path", fmt.Errorf("edge_cluster_path setting is not supported with NSX Global Manager, please use locale_service instead"))
 		}
 
 		if !isSetLocaleService {
-			return fmt.Errorf("locale_service setting is mandatory with NSX Global Manager")
+			return newAttributeError("locale_service", fmt.Errorf("locale_service setting is mandatory with NSX Global Manager"))
 		}
 		return nil
 	}
@@ -797,7 +799,7 @@ func policyTier0GatewayResourceToInfraStruct(d *schema.ResourceData, connector c
 				if bgpMap["enabled"].(bool) {
 					// BGP requires edge cluster
 					// TODO: validate at plan time once multi-attribute validation is supported
-					return infraStruct, fmt.Errorf("A valid edge_cluster_path is required when BGP is enabled")
+					return infraStruct, newAttributeError("edge_cluster_path", fmt.Errorf("A valid edge_cluster_path is required when BGP is enabled"))
 				}
 			}
 
@@ -830,46 +832,46 @@ func policyTier0GatewayResourceToInfraStruct(d *schema.ResourceData, connector c
 	return infraStruct, nil
 }
 
-func resourceNsxtPolicyTier0GatewayCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyTier0GatewayCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 
 	connector := getPolicyConnector(m)
 	isGlobalManager := isPolicyGlobalManager(m)
 
 	err := verifyPolicyTier0GatewayConfig(d, isGlobalManager)
 	if err != nil {
-		return err
+		return getOperationDiagnostics(m, err)
 	}
 
 	// Initialize resource Id and verify this ID is not yet used
 	id, err := getOrGenerateID(d, m, resourceNsxtPolicyTier0GatewayExists)
 	if err != nil {
-		return err
+		return getOperationDiagnostics(m, err)
 	}
 
 	obj, err := policyTier0GatewayResourceToInfraStruct(d, connector, isGlobalManager, id)
 	if err != nil {
-		return err
+		return getOperationDiagnostics(m, err)
 	}
 
 	log.Printf("[INFO] Using H-API to create Tier0 with ID %s", id)
 
 	err = policyInfraPatch(obj, isGlobalManager, getPolicyConnector(m), false)
 	if err != nil {
-		return handleCreateError("Tier0", id, err)
+		return getOperationDiagnostics(m, handleCreateError("Tier0", id, err))
 	}
 
 	d.SetId(id)
 	d.Set("nsx_id", id)
 
-	return resourceNsxtPolicyTier0GatewayRead(d, m)
+	return resourceNsxtPolicyTier0GatewayRead(ctx, d, m)
 }
 
-func resourceNsxtPolicyTier0GatewayRead(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyTier0GatewayRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining Tier0 ID")
+		return diag.Errorf("Error obtaining Tier0 ID")
 	}
 
 	var obj model.Tier0
@@ -878,12 +880,12 @@ func resourceNsxtPolicyTier0GatewayRead(d *schema.ResourceData, m interface{}) e
 		client := gm_infra.NewTier0sClient(connector)
 		gmObj, err := client.Get(id)
 		if err != nil {
-			return handleReadError(d, "Tier0", id, err)
+			return getOperationDiagnostics(m, handleReadError(d, "Tier0", id, err))
 		}
 
 		convertedObj, err := convertModelBindingType(gmObj, model.Tier0BindingType(), model.Tier0BindingType())
 		if err != nil {
-			return err
+			return getOperationDiagnostics(m, err)
 		}
 
 		obj = convertedObj.(model.Tier0)
@@ -893,7 +895,7 @@ func resourceNsxtPolicyTier0GatewayRead(d *schema.ResourceData, m interface{}) e
 		client := infra.NewTier0sClient(connector)
 		obj, err = client.Get(id)
 		if err != nil {
-			return handleReadError(d, "Tier0", id, err)
+			return getOperationDiagnostics(m, handleReadError(d, "Tier0", id, err))
 		}
 	}
 
@@ -908,14 +910,14 @@ func resourceNsxtPolicyTier0GatewayRead(d *schema.ResourceData, m interface{}) e
 	d.Set("ha_mode", obj.HaMode)
 	d.Set("force_whitelisting", obj.ForceWhitelisting)
 	d.Set("internal_transit_subnets", obj.InternalTransitSubnets)
-	d.Set("transit_subnets", obj.TransitSubnets)
+	d.Set("transit_subnets", getStringListOrderedByState(d.Get("transit_subnets").([]interface{}), obj.TransitSubnets))
 	d.Set("revision", obj.Revision)
 	if nsxVersionHigherOrEqual("3.0.0") {
 		d.Set("rd_admin_address", obj.RdAdminField)
 	}
 	vrfErr := setPolicyVRFConfigInSchema(d, obj.VrfConfig)
 	if vrfErr != nil {
-		return vrfErr
+		return getOperationDiagnostics(m, vrfErr)
 	}
 
 	dhcpPaths := obj.DhcpConfigPaths
@@ -925,7 +927,7 @@ func resourceNsxtPolicyTier0GatewayRead(d *schema.ResourceData, m interface{}) e
 	// Get the edge cluster Id or locale services
 	localeServices, err := listPolicyTier0GatewayLocaleServices(connector, id, isGlobalManager)
 	if err != nil {
-		return handleReadError(d, "Locale Service for T0", id, err)
+		return getOperationDiagnostics(m, handleReadError(d, "Locale Service for T0", id, err))
 	}
 	var services []map[string]interface{}
 	_, shouldSetLS := d.GetOk("locale_service")
@@ -958,7 +960,7 @@ func resourceNsxtPolicyTier0GatewayRead(d *schema.ResourceData, m interface{}) e
 					d.Set("edge_cluster_path", service.EdgeClusterPath)
 					err = resourceNsxtPolicyTier0GatewayReadBGPConfig(d, connector, service)
 					if err != nil {
-						return handleReadError(d, "BGP Configuration for T0", id, err)
+						return getOperationDiagnostics(m, handleReadError(d, "BGP Configuration for T0", id, err))
 					}
 
 					redistributionConfigs := getLocaleServiceRedistributionConfig(&localeServices[i])
@@ -984,54 +986,54 @@ func resourceNsxtPolicyTier0GatewayRead(d *schema.ResourceData, m interface{}) e
 
 	err = setIpv6ProfilePathsInSchema(d, obj.Ipv6ProfilePaths)
 	if err != nil {
-		return fmt.Errorf("Failed to get Tier0 %s ipv6 profiles: %v", *obj.Id, err)
+		return diag.Errorf("Failed to get Tier0 %s ipv6 profiles: %v", *obj.Id, err)
 	}
 
 	if isGlobalManager {
 		err = setPolicyGatewayIntersiteConfigInSchema(d, obj.IntersiteConfig)
 		if err != nil {
-			return fmt.Errorf("Failed to get Tier1 %s interset config: %v", *obj.Id, err)
+			return diag.Errorf("Failed to get Tier1 %s interset config: %v", *obj.Id, err)
 		}
 	}
 
 	return nil
 }
 
-func resourceNsxtPolicyTier0GatewayUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyTier0GatewayUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 	isGlobalManager := isPolicyGlobalManager(m)
 	err := verifyPolicyTier0GatewayConfig(d, isGlobalManager)
 	if err != nil {
-		return err
+		return getOperationDiagnostics(m, err)
 	}
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining Tier0 ID")
+		return diag.Errorf("Error obtaining Tier0 ID")
 	}
 
 	obj, err := policyTier0GatewayResourceToInfraStruct(d, connector, isGlobalManager, id)
 	if err != nil {
-		return handleUpdateError("Tier0", id, err)
+		return getOperationDiagnostics(m, handleUpdateError("Tier0", id, err))
 	}
 
 	log.Printf("[INFO] Using H-API to update Tier0 with ID %s", id)
 
 	err = policyInfraPatch(obj, isGlobalManager, connector, true)
 	if err != nil {
-		return handleUpdateError("Tier0", id, err)
+		return getOperationDiagnostics(m, handleUpdateError("Tier0", id, err))
 	}
 
-	return resourceNsxtPolicyTier0GatewayRead(d, m)
+	return resourceNsxtPolicyTier0GatewayRead(ctx, d, m)
 }
 
-func resourceNsxtPolicyTier0GatewayDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyTier0GatewayDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	var infraChildren []*data.StructValue
 	converter := bindings.NewTypeConverter()
 	boolTrue := true
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining Tier0 ID")
+		return diag.Errorf("Error obtaining Tier0 ID")
 	}
 
 	t0Type := "Tier0"
@@ -1047,7 +1049,7 @@ func resourceNsxtPolicyTier0GatewayDelete(d *schema.ResourceData, m interface{})
 	}
 	dataValue, errors := converter.ConvertToVapi(childT0, model.ChildTier0BindingType())
 	if errors != nil {
-		return fmt.Errorf("Error converting Child Tier0: %v", errors[0])
+		return diag.Errorf("Error converting Child Tier0: %v", errors[0])
 	}
 	infraChildren = append(infraChildren, dataValue.(*data.StructValue))
 
@@ -1060,7 +1062,7 @@ func resourceNsxtPolicyTier0GatewayDelete(d *schema.ResourceData, m interface{})
 	log.Printf("[DEBUG] Using H-API to delete Tier0 with ID %s", id)
 	err := policyInfraPatch(obj, isPolicyGlobalManager(m), getPolicyConnector(m), false)
 	if err != nil {
-		return handleDeleteError("Tier0", id, err)
+		return getOperationDiagnostics(m, handleDeleteError("Tier0", id, err))
 	}
 	log.Printf("[DEBUG] Success deleting Tier0 with ID %s", id)
 
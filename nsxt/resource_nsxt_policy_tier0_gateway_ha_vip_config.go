@@ -4,10 +4,12 @@
 package nsxt
 
 import (
+	"context"
 	"fmt"
 	"strconv"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	gm_tier0s "github.com/vmware/vsphere-automation-sdk-go/services/nsxt-gm/global_infra/tier_0s"
 	gm_model "github.com/vmware/vsphere-automation-sdk-go/services/nsxt-gm/model"
@@ -21,12 +23,12 @@ var interfaceLocaleSrvLocation = 5
 
 func resourceNsxtPolicyTier0GatewayHAVipConfig() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtPolicyTier0GatewayHAVipConfigCreate,
-		Read:   resourceNsxtPolicyTier0GatewayHAVipConfigRead,
-		Update: resourceNsxtPolicyTier0GatewayHAVipConfigUpdate,
-		Delete: resourceNsxtPolicyTier0GatewayHAVipConfigDelete,
+		CreateContext: resourceNsxtPolicyTier0GatewayHAVipConfigCreate,
+		ReadContext:   resourceNsxtPolicyTier0GatewayHAVipConfigRead,
+		UpdateContext: resourceNsxtPolicyTier0GatewayHAVipConfigUpdate,
+		DeleteContext: resourceNsxtPolicyTier0GatewayHAVipConfigDelete,
 		Importer: &schema.ResourceImporter{
-			State: resourceNsxtPolicyTier0GatewayHAVipConfigImport,
+			StateContext: resourceNsxtPolicyTier0GatewayHAVipConfigImport,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -100,7 +102,7 @@ func getHaVipInterfaceSubnetList(configData map[string]interface{}) []model.Inte
 	return interfaceSubnetList
 }
 
-func resourceNsxtPolicyTier0GatewayHAVipConfigCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyTier0GatewayHAVipConfigCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 
 	// Get the tier0 id and locale-service id from the interfaces and make sure
@@ -116,17 +118,17 @@ func resourceNsxtPolicyTier0GatewayHAVipConfigCreate(d *schema.ResourceData, m i
 		for _, intf := range interfaces {
 			splitPath := strings.Split(intf.(string), "/")
 			if len(splitPath) != interfacePathLen {
-				return fmt.Errorf("Error obtaining Tier0 id or Locale Service id from interface %s", intf)
+				return diag.Errorf("Error obtaining Tier0 id or Locale Service id from interface %s", intf)
 			}
 			if tier0ID == "" {
 				tier0ID = splitPath[interfaceTier0Location]
 			} else if tier0ID != splitPath[interfaceTier0Location] {
-				return fmt.Errorf("Interface %s has a different tier0 instead of %s", intf, tier0ID)
+				return diag.Errorf("Interface %s has a different tier0 instead of %s", intf, tier0ID)
 			}
 			if localeServiceID == "" {
 				localeServiceID = splitPath[interfaceLocaleSrvLocation]
 			} else if localeServiceID != splitPath[interfaceLocaleSrvLocation] {
-				return fmt.Errorf("Interface %s has a different locale-service instead of %s", intf, localeServiceID)
+				return diag.Errorf("Interface %s has a different locale-service instead of %s", intf, localeServiceID)
 			}
 			externalInterfacePaths = append(externalInterfacePaths, intf.(string))
 		}
@@ -155,7 +157,7 @@ func resourceNsxtPolicyTier0GatewayHAVipConfigCreate(d *schema.ResourceData, m i
 		// Use patch to only update the relevant fields
 		rawObj, err1 := convertModelBindingType(serviceStruct, model.LocaleServicesBindingType(), gm_model.LocaleServicesBindingType())
 		if err1 != nil {
-			return err1
+			return getErrorDiagnostics(err1)
 		}
 		client := gm_tier0s.NewLocaleServicesClient(connector)
 		err = client.Patch(tier0ID, localeServiceID, rawObj.(gm_model.LocaleServices))
@@ -165,24 +167,24 @@ func resourceNsxtPolicyTier0GatewayHAVipConfigCreate(d *schema.ResourceData, m i
 		err = client.Patch(tier0ID, localeServiceID, serviceStruct)
 	}
 	if err != nil {
-		return handleCreateError("Tier0 HA Vip config", id, err)
+		return getOperationDiagnostics(m, handleCreateError("Tier0 HA Vip config", id, err))
 	}
 
 	d.SetId(id)
 	d.Set("tier0_id", tier0ID)
 	d.Set("locale_service_id", localeServiceID)
 
-	return resourceNsxtPolicyTier0GatewayHAVipConfigRead(d, m)
+	return resourceNsxtPolicyTier0GatewayHAVipConfigRead(ctx, d, m)
 }
 
-func resourceNsxtPolicyTier0GatewayHAVipConfigRead(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyTier0GatewayHAVipConfigRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 
 	id := d.Id()
 	tier0ID := d.Get("tier0_id").(string)
 	localeServiceID := d.Get("locale_service_id").(string)
 	if id == "" || tier0ID == "" || localeServiceID == "" {
-		return fmt.Errorf("Error obtaining Tier0 id or Locale Service id")
+		return diag.Errorf("Error obtaining Tier0 id or Locale Service id")
 	}
 
 	var obj model.LocaleServices
@@ -190,11 +192,11 @@ func resourceNsxtPolicyTier0GatewayHAVipConfigRead(d *schema.ResourceData, m int
 		client := gm_tier0s.NewLocaleServicesClient(connector)
 		gmObj, err1 := client.Get(tier0ID, localeServiceID)
 		if err1 != nil {
-			return handleReadError(d, "Tier0 HA Vip config", id, err1)
+			return getOperationDiagnostics(m, handleReadError(d, "Tier0 HA Vip config", id, err1))
 		}
 		lmObj, err2 := convertModelBindingType(gmObj, model.LocaleServicesBindingType(), model.LocaleServicesBindingType())
 		if err2 != nil {
-			return err2
+			return getOperationDiagnostics(m, err2)
 		}
 		obj = lmObj.(model.LocaleServices)
 	} else {
@@ -202,7 +204,7 @@ func resourceNsxtPolicyTier0GatewayHAVipConfigRead(d *schema.ResourceData, m int
 		client := tier_0s.NewLocaleServicesClient(connector)
 		obj, err = client.Get(tier0ID, localeServiceID)
 		if err != nil {
-			return handleReadError(d, "Tier0 HA Vip config", id, err)
+			return getOperationDiagnostics(m, handleReadError(d, "Tier0 HA Vip config", id, err))
 		}
 	}
 
@@ -227,14 +229,14 @@ func resourceNsxtPolicyTier0GatewayHAVipConfigRead(d *schema.ResourceData, m int
 	return nil
 }
 
-func resourceNsxtPolicyTier0GatewayHAVipConfigUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyTier0GatewayHAVipConfigUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 
 	id := d.Id()
 	tier0ID := d.Get("tier0_id").(string)
 	localeServiceID := d.Get("locale_service_id").(string)
 	if id == "" || tier0ID == "" || localeServiceID == "" {
-		return fmt.Errorf("Error obtaining Tier0 id or Locale Service id")
+		return diag.Errorf("Error obtaining Tier0 id or Locale Service id")
 	}
 
 	// Make sure all the interfaces belong to the same locale service
@@ -247,13 +249,13 @@ func resourceNsxtPolicyTier0GatewayHAVipConfigUpdate(d *schema.ResourceData, m i
 		for _, intf := range interfaces {
 			splitPath := strings.Split(intf.(string), "/")
 			if len(splitPath) != interfacePathLen {
-				return fmt.Errorf("Error obtaining Tier0 id or Locale Service id from interface %s", intf)
+				return diag.Errorf("Error obtaining Tier0 id or Locale Service id from interface %s", intf)
 			}
 			if tier0ID != splitPath[interfaceTier0Location] {
-				return fmt.Errorf("Interface %s has a different tier0 instead of %s", intf, tier0ID)
+				return diag.Errorf("Interface %s has a different tier0 instead of %s", intf, tier0ID)
 			}
 			if localeServiceID != splitPath[interfaceLocaleSrvLocation] {
-				return fmt.Errorf("Interface %s has a different locale-service instead of %s", intf, localeServiceID)
+				return diag.Errorf("Interface %s has a different locale-service instead of %s", intf, localeServiceID)
 			}
 			externalInterfacePaths = append(externalInterfacePaths, intf.(string))
 		}
@@ -280,7 +282,7 @@ func resourceNsxtPolicyTier0GatewayHAVipConfigUpdate(d *schema.ResourceData, m i
 		// Use patch to only update the relevant fields
 		rawObj, err1 := convertModelBindingType(serviceStruct, model.LocaleServicesBindingType(), gm_model.LocaleServicesBindingType())
 		if err1 != nil {
-			return err1
+			return getErrorDiagnostics(err1)
 		}
 		client := gm_tier0s.NewLocaleServicesClient(connector)
 		err = client.Patch(tier0ID, localeServiceID, rawObj.(gm_model.LocaleServices))
@@ -290,20 +292,20 @@ func resourceNsxtPolicyTier0GatewayHAVipConfigUpdate(d *schema.ResourceData, m i
 		err = client.Patch(tier0ID, localeServiceID, serviceStruct)
 	}
 	if err != nil {
-		return handleUpdateError("Tier0 HA Vip config", id, err)
+		return getOperationDiagnostics(m, handleUpdateError("Tier0 HA Vip config", id, err))
 	}
 
-	return resourceNsxtPolicyTier0GatewayHAVipConfigRead(d, m)
+	return resourceNsxtPolicyTier0GatewayHAVipConfigRead(ctx, d, m)
 }
 
-func resourceNsxtPolicyTier0GatewayHAVipConfigDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyTier0GatewayHAVipConfigDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 
 	id := d.Id()
 	tier0ID := d.Get("tier0_id").(string)
 	localeServiceID := d.Get("locale_service_id").(string)
 	if id == "" || tier0ID == "" || localeServiceID == "" {
-		return fmt.Errorf("Error obtaining Tier0 id or Locale Service id")
+		return diag.Errorf("Error obtaining Tier0 id or Locale Service id")
 	}
 
 	// Update the locale service with empty HaVipConfigs using get/post
@@ -331,13 +333,13 @@ func resourceNsxtPolicyTier0GatewayHAVipConfigDelete(d *schema.ResourceData, m i
 	commonProviderConfig := getCommonProviderConfig(m)
 	err := retryUponPreconditionFailed(doUpdate, commonProviderConfig.MaxRetries)
 	if err != nil {
-		return handleDeleteError("Tier0 HA Vip config", id, err)
+		return getOperationDiagnostics(m, handleDeleteError("Tier0 HA Vip config", id, err))
 	}
 
 	return nil
 }
 
-func resourceNsxtPolicyTier0GatewayHAVipConfigImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+func resourceNsxtPolicyTier0GatewayHAVipConfigImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	importID := d.Id()
 	s := strings.Split(importID, "/")
 	if len(s) != 2 {
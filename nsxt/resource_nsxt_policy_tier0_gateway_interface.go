@@ -4,10 +4,12 @@
 package nsxt
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	gm_infra "github.com/vmware/vsphere-automation-sdk-go/services/nsxt-gm/global_infra"
@@ -32,12 +34,12 @@ var gatewayInterfaceOspfNetworkTypeValues = []string{
 
 func resourceNsxtPolicyTier0GatewayInterface() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtPolicyTier0GatewayInterfaceCreate,
-		Read:   resourceNsxtPolicyTier0GatewayInterfaceRead,
-		Update: resourceNsxtPolicyTier0GatewayInterfaceUpdate,
-		Delete: resourceNsxtPolicyTier0GatewayInterfaceDelete,
+		CreateContext: resourceNsxtPolicyTier0GatewayInterfaceCreate,
+		ReadContext:   resourceNsxtPolicyTier0GatewayInterfaceRead,
+		UpdateContext: resourceNsxtPolicyTier0GatewayInterfaceUpdate,
+		DeleteContext: resourceNsxtPolicyTier0GatewayInterfaceDelete,
 		Importer: &schema.ResourceImporter{
-			State: resourceNsxtPolicyTier0GatewayInterfaceImport,
+			StateContext: resourceNsxtPolicyTier0GatewayInterfaceImport,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -230,7 +232,7 @@ func policyTier0GatewayInterfaceOspfSetInSchema(d *schema.ResourceData, obj *mod
 	return d.Set("ospf", ospfConfigs)
 }
 
-func resourceNsxtPolicyTier0GatewayInterfaceCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyTier0GatewayInterfaceCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 
 	id := d.Get("nsx_id").(string)
@@ -242,36 +244,36 @@ func resourceNsxtPolicyTier0GatewayInterfaceCreate(d *schema.ResourceData, m int
 	ifType := d.Get("type").(string)
 	if len(segmentPath) == 0 && ifType != model.Tier0Interface_TYPE_LOOPBACK {
 		// segment_path in required for all interfaces other than loopback
-		return fmt.Errorf("segment_path is mandatory for interface of type %s", ifType)
+		return getErrorDiagnostics(newAttributeError("segment_path", fmt.Errorf("segment_path is mandatory for interface of type %s", ifType)))
 	}
 
 	localeServiceID := ""
 	if isPolicyGlobalManager(m) {
 		enablePIM := d.Get("enable_pim").(bool)
 		if enablePIM {
-			return fmt.Errorf("enable_pim configuration is only supported with NSX Local Manager")
+			return getErrorDiagnostics(newAttributeError("enable_pim", fmt.Errorf("enable_pim configuration is only supported with NSX Local Manager")))
 		}
 		if objSitePath == "" {
-			return attributeRequiredGlobalManagerError("site_path", "nsxt_policy_tier0_gateway_interface")
+			return getErrorDiagnostics(attributeRequiredGlobalManagerError("site_path", "nsxt_policy_tier0_gateway_interface"))
 		}
 		localeServices, err := listPolicyTier0GatewayLocaleServices(connector, tier0ID, true)
 		if err != nil {
-			return err
+			return getOperationDiagnostics(m, err)
 		}
 		localeServiceID, err = getGlobalPolicyGatewayLocaleServiceIDWithSite(localeServices, objSitePath, tier0ID)
 		if err != nil {
-			return err
+			return getOperationDiagnostics(m, err)
 		}
 	} else {
 		if objSitePath != "" {
-			return globalManagerOnlyError()
+			return getErrorDiagnostics(globalManagerOnlyError())
 		}
 		localeService, err := getPolicyTier0GatewayLocaleServiceWithEdgeCluster(tier0ID, connector)
 		if err != nil {
-			return err
+			return getOperationDiagnostics(m, err)
 		}
 		if localeService == nil {
-			return fmt.Errorf("Edge cluster is mandatory on gateway %s in order to create interfaces", tier0ID)
+			return diag.Errorf("Edge cluster is mandatory on gateway %s in order to create interfaces", tier0ID)
 		}
 		localeServiceID = *localeService.Id
 	}
@@ -289,9 +291,9 @@ func resourceNsxtPolicyTier0GatewayInterfaceCreate(d *schema.ResourceData, m int
 			_, err = client.Get(tier0ID, localeServiceID, id)
 		}
 		if err == nil {
-			return fmt.Errorf("Interface with ID '%s' already exists on Tier0 Gateway %s", id, tier0ID)
+			return diag.Errorf("Interface with ID '%s' already exists on Tier0 Gateway %s", id, tier0ID)
 		} else if !isNotFoundError(err) {
-			return err
+			return getOperationDiagnostics(m, err)
 		}
 	}
 
@@ -329,7 +331,7 @@ func resourceNsxtPolicyTier0GatewayInterfaceCreate(d *schema.ResourceData, m int
 
 	err := gatewayInterfaceVersionDepenantSet(d, m, &obj)
 	if err != nil {
-		return handleCreateError("Tier0 Interface", id, err)
+		return getOperationDiagnostics(m, handleCreateError("Tier0 Interface", id, err))
 	}
 
 	// Create the resource using PATCH
@@ -337,7 +339,7 @@ func resourceNsxtPolicyTier0GatewayInterfaceCreate(d *schema.ResourceData, m int
 	if isPolicyGlobalManager(m) {
 		gmObj, err1 := convertModelBindingType(obj, model.Tier0InterfaceBindingType(), gm_model.Tier0InterfaceBindingType())
 		if err1 != nil {
-			return err1
+			return getErrorDiagnostics(err1)
 		}
 		client := gm_locale_services.NewInterfacesClient(connector)
 		err = client.Patch(tier0ID, localeServiceID, id, gmObj.(gm_model.Tier0Interface), nil)
@@ -347,17 +349,17 @@ func resourceNsxtPolicyTier0GatewayInterfaceCreate(d *schema.ResourceData, m int
 	}
 
 	if err != nil {
-		return handleCreateError("Tier0 Interface", id, err)
+		return getOperationDiagnostics(m, handleCreateError("Tier0 Interface", id, err))
 	}
 
 	d.SetId(id)
 	d.Set("nsx_id", id)
 	d.Set("locale_service_id", localeServiceID)
 
-	return resourceNsxtPolicyTier0GatewayInterfaceRead(d, m)
+	return resourceNsxtPolicyTier0GatewayInterfaceRead(ctx, d, m)
 }
 
-func resourceNsxtPolicyTier0GatewayInterfaceRead(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyTier0GatewayInterfaceRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 
 	id := d.Id()
@@ -365,7 +367,7 @@ func resourceNsxtPolicyTier0GatewayInterfaceRead(d *schema.ResourceData, m inter
 	tier0ID := getPolicyIDFromPath(tier0Path)
 	localeServiceID := d.Get("locale_service_id").(string)
 	if id == "" || tier0ID == "" {
-		return fmt.Errorf("Error obtaining Tier0 Interface id")
+		return diag.Errorf("Error obtaining Tier0 Interface id")
 	}
 
 	var obj model.Tier0Interface
@@ -374,17 +376,17 @@ func resourceNsxtPolicyTier0GatewayInterfaceRead(d *schema.ResourceData, m inter
 		client := gm_locale_services.NewInterfacesClient(connector)
 		gmObj, err1 := client.Get(tier0ID, localeServiceID, id)
 		if err1 != nil {
-			return handleReadError(d, "Tier0 Interface", id, err1)
+			return getOperationDiagnostics(m, handleReadError(d, "Tier0 Interface", id, err1))
 		}
 		lmObj, err2 := convertModelBindingType(gmObj, model.Tier0InterfaceBindingType(), model.Tier0InterfaceBindingType())
 		if err2 != nil {
-			return err2
+			return getOperationDiagnostics(m, err2)
 		}
 		obj = lmObj.(model.Tier0Interface)
 		tier0Client := gm_tier0s.NewLocaleServicesClient(connector)
 		localeService, err := tier0Client.Get(tier0ID, localeServiceID)
 		if err != nil {
-			return err
+			return getOperationDiagnostics(m, err)
 		}
 		sitePath := getSitePathFromEdgePath(*localeService.EdgeClusterPath)
 		d.Set("site_path", sitePath)
@@ -393,7 +395,7 @@ func resourceNsxtPolicyTier0GatewayInterfaceRead(d *schema.ResourceData, m inter
 		obj, err = client.Get(tier0ID, localeServiceID, id)
 	}
 	if err != nil {
-		return handleReadError(d, "Tier0 Interface", id, err)
+		return getOperationDiagnostics(m, handleReadError(d, "Tier0 Interface", id, err))
 	}
 
 	d.Set("display_name", obj.DisplayName)
@@ -442,10 +444,10 @@ func resourceNsxtPolicyTier0GatewayInterfaceRead(d *schema.ResourceData, m inter
 		d.Set("urpf_mode", model.Tier0Interface_URPF_MODE_STRICT)
 	}
 
-	return policyTier0GatewayInterfaceOspfSetInSchema(d, &obj)
+	return getOperationDiagnostics(m, policyTier0GatewayInterfaceOspfSetInSchema(d, &obj))
 }
 
-func resourceNsxtPolicyTier0GatewayInterfaceUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyTier0GatewayInterfaceUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 
 	id := d.Id()
@@ -453,13 +455,13 @@ func resourceNsxtPolicyTier0GatewayInterfaceUpdate(d *schema.ResourceData, m int
 	localeServiceID := d.Get("locale_service_id").(string)
 	tier0ID := getPolicyIDFromPath(tier0Path)
 	if id == "" || tier0ID == "" || localeServiceID == "" {
-		return fmt.Errorf("Error obtaining Tier0 id or Locale Service id")
+		return diag.Errorf("Error obtaining Tier0 id or Locale Service id")
 	}
 
 	if isPolicyGlobalManager(m) {
 		enablePIM := d.Get("enable_pim").(bool)
 		if enablePIM {
-			return fmt.Errorf("enable_pim configuration is only supported with NSX Local Manager")
+			return getErrorDiagnostics(newAttributeError("enable_pim", fmt.Errorf("enable_pim configuration is only supported with NSX Local Manager")))
 		}
 	}
 
@@ -500,13 +502,13 @@ func resourceNsxtPolicyTier0GatewayInterfaceUpdate(d *schema.ResourceData, m int
 
 	err := gatewayInterfaceVersionDepenantSet(d, m, &obj)
 	if err != nil {
-		return handleUpdateError("Tier0 Interface", id, err)
+		return getOperationDiagnostics(m, handleUpdateError("Tier0 Interface", id, err))
 	}
 
 	if isPolicyGlobalManager(m) {
 		gmObj, err1 := convertModelBindingType(obj, model.Tier0InterfaceBindingType(), gm_model.Tier0InterfaceBindingType())
 		if err1 != nil {
-			return err1
+			return getErrorDiagnostics(err1)
 		}
 		client := gm_locale_services.NewInterfacesClient(connector)
 		_, err = client.Update(tier0ID, localeServiceID, id, gmObj.(gm_model.Tier0Interface), nil)
@@ -515,13 +517,13 @@ func resourceNsxtPolicyTier0GatewayInterfaceUpdate(d *schema.ResourceData, m int
 		_, err = client.Update(tier0ID, localeServiceID, id, obj, nil)
 	}
 	if err != nil {
-		return handleUpdateError("Tier0 Interface", id, err)
+		return getOperationDiagnostics(m, handleUpdateError("Tier0 Interface", id, err))
 	}
 
-	return resourceNsxtPolicyTier0GatewayInterfaceRead(d, m)
+	return resourceNsxtPolicyTier0GatewayInterfaceRead(ctx, d, m)
 }
 
-func resourceNsxtPolicyTier0GatewayInterfaceDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyTier0GatewayInterfaceDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 
 	id := d.Id()
@@ -529,7 +531,7 @@ func resourceNsxtPolicyTier0GatewayInterfaceDelete(d *schema.ResourceData, m int
 	tier0ID := getPolicyIDFromPath(tier0Path)
 	localeServiceID := d.Get("locale_service_id").(string)
 	if id == "" || tier0ID == "" || localeServiceID == "" {
-		return fmt.Errorf("Error obtaining Tier0 id or Locale Service id")
+		return diag.Errorf("Error obtaining Tier0 id or Locale Service id")
 	}
 	var err error
 	if isPolicyGlobalManager(m) {
@@ -540,13 +542,13 @@ func resourceNsxtPolicyTier0GatewayInterfaceDelete(d *schema.ResourceData, m int
 		err = client.Delete(tier0ID, localeServiceID, id, nil)
 	}
 	if err != nil {
-		return handleDeleteError("Tier0 Interface", id, err)
+		return getOperationDiagnostics(m, handleDeleteError("Tier0 Interface", id, err))
 	}
 
 	return nil
 }
 
-func resourceNsxtPolicyTier0GatewayInterfaceImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+func resourceNsxtPolicyTier0GatewayInterfaceImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	importID := d.Id()
 	s := strings.Split(importID, "/")
 	if len(s) != 3 {
@@ -0,0 +1,432 @@
+/* Copyright © 2023 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/tier_0s"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+var interVrfRoutingAddressFamilyValues = []string{
+	model.BgpRouteLeaking_ADDRESS_FAMILY_IPV4,
+	model.BgpRouteLeaking_ADDRESS_FAMILY_IPV6,
+}
+
+var interVrfAdvertisementTypeValues = []string{
+	model.PolicyRouteAdvertisementRule_ROUTE_ADVERTISEMENT_TYPES_TIER0_STATIC,
+	model.PolicyRouteAdvertisementRule_ROUTE_ADVERTISEMENT_TYPES_TIER0_CONNECTED,
+	model.PolicyRouteAdvertisementRule_ROUTE_ADVERTISEMENT_TYPES_TIER0_NAT,
+	model.PolicyRouteAdvertisementRule_ROUTE_ADVERTISEMENT_TYPES_TIER0_DNS_FORWARDER_IP,
+	model.PolicyRouteAdvertisementRule_ROUTE_ADVERTISEMENT_TYPES_TIER0_IPSEC_LOCAL_ENDPOINT,
+	model.PolicyRouteAdvertisementRule_ROUTE_ADVERTISEMENT_TYPES_TIER1_STATIC,
+	model.PolicyRouteAdvertisementRule_ROUTE_ADVERTISEMENT_TYPES_TIER1_CONNECTED,
+	model.PolicyRouteAdvertisementRule_ROUTE_ADVERTISEMENT_TYPES_TIER1_LB_SNAT,
+	model.PolicyRouteAdvertisementRule_ROUTE_ADVERTISEMENT_TYPES_TIER1_LB_VIP,
+	model.PolicyRouteAdvertisementRule_ROUTE_ADVERTISEMENT_TYPES_TIER1_NAT,
+	model.PolicyRouteAdvertisementRule_ROUTE_ADVERTISEMENT_TYPES_TIER1_DNS_FORWARDER_IP,
+	model.PolicyRouteAdvertisementRule_ROUTE_ADVERTISEMENT_TYPES_TIER1_IPSEC_LOCAL_ENDPOINT,
+}
+
+func resourceNsxtPolicyTier0InterVRFRouting() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceNsxtPolicyTier0InterVRFRoutingCreate,
+		ReadContext:   resourceNsxtPolicyTier0InterVRFRoutingRead,
+		UpdateContext: resourceNsxtPolicyTier0InterVRFRoutingUpdate,
+		DeleteContext: resourceNsxtPolicyTier0InterVRFRoutingDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceNsxtPolicyTier0GatewayImporter,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"nsx_id":       getNsxIDSchema(),
+			"path":         getPathSchema(),
+			"display_name": getDisplayNameSchema(),
+			"description":  getDescriptionSchema(),
+			"revision":     getRevisionSchema(),
+			"tag":          getTagsSchema(),
+			"gateway_path": getPolicyPathSchema(true, true, "Policy path for Tier0 gateway"),
+			"target_path":  getPolicyPathSchema(true, true, "Policy path to Tier0 or VRF gateway sharing the same parent Tier0"),
+			"bgp_route_leaking": {
+				Type:        schema.TypeList,
+				Description: "Import / export BGP routes",
+				Optional:    true,
+				MaxItems:    2,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"address_family": {
+							Type:         schema.TypeString,
+							Description:  "Address family type",
+							Optional:     true,
+							Default:      model.BgpRouteLeaking_ADDRESS_FAMILY_IPV4,
+							ValidateFunc: validation.StringInSlice(interVrfRoutingAddressFamilyValues, false),
+						},
+						"in_filter": {
+							Type:        schema.TypeList,
+							Description: "Policy path of route map to filter routes for IN direction",
+							Optional:    true,
+							MaxItems:    1,
+							Elem:        getElemPolicyPathSchema(),
+						},
+						"out_filter": {
+							Type:        schema.TypeList,
+							Description: "Policy path of route map to filter routes for OUT direction",
+							Optional:    true,
+							MaxItems:    1,
+							Elem:        getElemPolicyPathSchema(),
+						},
+					},
+				},
+			},
+			"static_route_advertisement": {
+				Type:        schema.TypeList,
+				Description: "Advertise subnets to target peers as static routes",
+				Optional:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"advertisement_rule": {
+							Type:        schema.TypeList,
+							Description: "Route advertisement rules",
+							Optional:    true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:        schema.TypeString,
+										Description: "Display name for rule",
+										Optional:    true,
+									},
+									"action": {
+										Type:         schema.TypeString,
+										Description:  "Action to advertise filtered routes to the target gateway",
+										Optional:     true,
+										Default:      model.PolicyRouteAdvertisementRule_ACTION_PERMIT,
+										ValidateFunc: validation.StringInSlice(advertismentRuleActionValues, false),
+									},
+									"prefix_operator": {
+										Type:         schema.TypeString,
+										Description:  "Prefix operator to filter subnets",
+										Optional:     true,
+										Default:      model.PolicyRouteAdvertisementRule_PREFIX_OPERATOR_GE,
+										ValidateFunc: validation.StringInSlice(advertismentRuleOperatorValues, false),
+									},
+									"route_advertisement_types": {
+										Type:        schema.TypeSet,
+										Description: "Enable different types of route advertisements",
+										Optional:    true,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: validation.StringInSlice(interVrfAdvertisementTypeValues, false),
+										},
+									},
+									"subnets": {
+										Type:        schema.TypeSet,
+										Description: "Network CIDRs to be routed",
+										Optional:    true,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: validateCidr(),
+										},
+									},
+								},
+							},
+						},
+						"in_filter_prefix_list": {
+							Type:        schema.TypeList,
+							Description: "Paths of ordered Prefix lists",
+							Optional:    true,
+							Elem:        getElemPolicyPathSchema(),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceNsxtPolicyTier0InterVRFRoutingExists(gwID string, id string, connector client.Connector) (bool, error) {
+	client := tier_0s.NewInterVrfRoutingClient(connector)
+	_, err := client.Get(gwID, id)
+	if err == nil {
+		return true, nil
+	}
+
+	if isNotFoundError(err) {
+		return false, nil
+	}
+
+	return false, logAPIError("Error retrieving resource", err)
+}
+
+// Parent of a VRF gateway is the Tier0 its VRF config points to,
+// while a regular Tier0 gateway is considered a parent of itself
+func getPolicyTier0GatewayParentPath(connector client.Connector, gwPath string) (string, error) {
+	isT0, gwID := parseGatewayPolicyPath(gwPath)
+	if !isT0 {
+		return "", fmt.Errorf("Tier0 Gateway path expected, got %s", gwPath)
+	}
+
+	client := infra.NewTier0sClient(connector)
+	obj, err := client.Get(gwID)
+	if err != nil {
+		return "", logAPIError(fmt.Sprintf("Error retrieving Tier0 Gateway %s", gwID), err)
+	}
+
+	if obj.VrfConfig != nil && obj.VrfConfig.Tier0Path != nil {
+		return *obj.VrfConfig.Tier0Path, nil
+	}
+
+	return *obj.Path, nil
+}
+
+func validatePolicyTier0InterVRFRoutingPeers(connector client.Connector, gwPath string, targetPath string) error {
+	if gwPath == targetPath {
+		return newAttributeError("target_path", fmt.Errorf("gateway_path and target_path should refer to different gateways"))
+	}
+
+	gwParent, err := getPolicyTier0GatewayParentPath(connector, gwPath)
+	if err != nil {
+		return err
+	}
+
+	targetParent, err := getPolicyTier0GatewayParentPath(connector, targetPath)
+	if err != nil {
+		return err
+	}
+
+	if gwParent != targetParent {
+		return newAttributeError("target_path", fmt.Errorf("Gateway %s and target %s do not share the same parent Tier0 (%s vs %s)", gwPath, targetPath, gwParent, targetParent))
+	}
+
+	return nil
+}
+
+func getPolicyInterVRFBgpRouteLeakingFromSchema(d *schema.ResourceData) []model.BgpRouteLeaking {
+	var result []model.BgpRouteLeaking
+	for _, leaking := range d.Get("bgp_route_leaking").([]interface{}) {
+		data := leaking.(map[string]interface{})
+		addressFamily := data["address_family"].(string)
+		elem := model.BgpRouteLeaking{
+			AddressFamily: &addressFamily,
+			InFilter:      interface2StringList(data["in_filter"].([]interface{})),
+			OutFilter:     interface2StringList(data["out_filter"].([]interface{})),
+		}
+
+		result = append(result, elem)
+	}
+
+	return result
+}
+
+func setPolicyInterVRFBgpRouteLeakingInSchema(d *schema.ResourceData, leakingList []model.BgpRouteLeaking) error {
+	var result []map[string]interface{}
+	for _, leaking := range leakingList {
+		elem := make(map[string]interface{})
+		elem["address_family"] = leaking.AddressFamily
+		elem["in_filter"] = leaking.InFilter
+		elem["out_filter"] = leaking.OutFilter
+
+		result = append(result, elem)
+	}
+
+	return d.Set("bgp_route_leaking", result)
+}
+
+func getPolicyInterVRFStaticRouteAdvertisementFromSchema(d *schema.ResourceData) *model.PolicyStaticRouteAdvertisement {
+	advertisements := d.Get("static_route_advertisement").([]interface{})
+	if len(advertisements) == 0 || advertisements[0] == nil {
+		return nil
+	}
+
+	data := advertisements[0].(map[string]interface{})
+	var rules []model.PolicyRouteAdvertisementRule
+	for _, rule := range data["advertisement_rule"].([]interface{}) {
+		ruleData := rule.(map[string]interface{})
+		name := ruleData["name"].(string)
+		action := ruleData["action"].(string)
+		prefixOperator := ruleData["prefix_operator"].(string)
+		elem := model.PolicyRouteAdvertisementRule{
+			Action:                  &action,
+			PrefixOperator:          &prefixOperator,
+			RouteAdvertisementTypes: interface2StringList(ruleData["route_advertisement_types"].(*schema.Set).List()),
+			Subnets:                 interface2StringList(ruleData["subnets"].(*schema.Set).List()),
+		}
+		if len(name) > 0 {
+			elem.Name = &name
+		}
+
+		rules = append(rules, elem)
+	}
+
+	return &model.PolicyStaticRouteAdvertisement{
+		AdvertisementRules: rules,
+		InFilterPrefixList: interface2StringList(data["in_filter_prefix_list"].([]interface{})),
+	}
+}
+
+func setPolicyInterVRFStaticRouteAdvertisementInSchema(d *schema.ResourceData, advertisement *model.PolicyStaticRouteAdvertisement) error {
+	var result []map[string]interface{}
+	if advertisement != nil {
+		elem := make(map[string]interface{})
+		var rules []map[string]interface{}
+		for _, rule := range advertisement.AdvertisementRules {
+			ruleData := make(map[string]interface{})
+			ruleData["name"] = rule.Name
+			ruleData["action"] = rule.Action
+			ruleData["prefix_operator"] = rule.PrefixOperator
+			ruleData["route_advertisement_types"] = rule.RouteAdvertisementTypes
+			ruleData["subnets"] = rule.Subnets
+
+			rules = append(rules, ruleData)
+		}
+		elem["advertisement_rule"] = rules
+		elem["in_filter_prefix_list"] = advertisement.InFilterPrefixList
+
+		result = append(result, elem)
+	}
+
+	return d.Set("static_route_advertisement", result)
+}
+
+func resourceNsxtPolicyTier0InterVRFRoutingPatch(gwID string, id string, d *schema.ResourceData, connector client.Connector) error {
+	displayName := d.Get("display_name").(string)
+	description := d.Get("description").(string)
+	tags := getPolicyTagsFromSchema(d)
+	targetPath := d.Get("target_path").(string)
+
+	obj := model.PolicyInterVrfRoutingConfig{
+		DisplayName:              &displayName,
+		Description:              &description,
+		Tags:                     tags,
+		TargetPath:               &targetPath,
+		BgpRouteLeaking:          getPolicyInterVRFBgpRouteLeakingFromSchema(d),
+		StaticRouteAdvertisement: getPolicyInterVRFStaticRouteAdvertisementFromSchema(d),
+	}
+
+	client := tier_0s.NewInterVrfRoutingClient(connector)
+	return client.Patch(gwID, id, obj)
+}
+
+func resourceNsxtPolicyTier0InterVRFRoutingCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if isPolicyGlobalManager(m) {
+		return getErrorDiagnostics(policyResourceNotSupportedError())
+	}
+	connector := getPolicyConnector(m)
+
+	// Initialize resource Id and verify this ID is not yet used
+	id := d.Get("nsx_id").(string)
+	gwPath := d.Get("gateway_path").(string)
+	isT0, gwID := parseGatewayPolicyPath(gwPath)
+	if !isT0 {
+		return diag.Errorf("Tier0 Gateway path expected, got %s", gwPath)
+	}
+
+	err := validatePolicyTier0InterVRFRoutingPeers(connector, gwPath, d.Get("target_path").(string))
+	if err != nil {
+		return getOperationDiagnostics(m, err)
+	}
+
+	if id == "" {
+		id = newUUID()
+	} else {
+		exists, err := resourceNsxtPolicyTier0InterVRFRoutingExists(gwID, id, connector)
+		if err != nil {
+			return getOperationDiagnostics(m, err)
+		}
+		if exists {
+			return diag.Errorf("Inter VRF Routing with ID '%s' already exists on Tier0 Gateway %s", id, gwID)
+		}
+	}
+
+	log.Printf("[INFO] Creating Inter VRF Routing with ID %s", id)
+	err = resourceNsxtPolicyTier0InterVRFRoutingPatch(gwID, id, d, connector)
+	if err != nil {
+		return getOperationDiagnostics(m, handleCreateError("Inter VRF Routing", id, err))
+	}
+
+	d.SetId(id)
+	d.Set("nsx_id", id)
+
+	return resourceNsxtPolicyTier0InterVRFRoutingRead(ctx, d, m)
+}
+
+func resourceNsxtPolicyTier0InterVRFRoutingRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	connector := getPolicyConnector(m)
+
+	id := d.Id()
+	if id == "" {
+		return diag.Errorf("Error obtaining Inter VRF Routing ID")
+	}
+	gwPath := d.Get("gateway_path").(string)
+	isT0, gwID := parseGatewayPolicyPath(gwPath)
+	if !isT0 {
+		return diag.Errorf("Tier0 Gateway path expected, got %s", gwPath)
+	}
+
+	client := tier_0s.NewInterVrfRoutingClient(connector)
+	obj, err := client.Get(gwID, id)
+	if err != nil {
+		return getOperationDiagnostics(m, handleReadError(d, "Inter VRF Routing", id, err))
+	}
+
+	d.Set("display_name", obj.DisplayName)
+	d.Set("description", obj.Description)
+	setPolicyTagsInSchema(d, obj.Tags)
+	d.Set("nsx_id", id)
+	d.Set("path", obj.Path)
+	d.Set("revision", obj.Revision)
+	d.Set("target_path", obj.TargetPath)
+
+	err = setPolicyInterVRFBgpRouteLeakingInSchema(d, obj.BgpRouteLeaking)
+	if err != nil {
+		return getOperationDiagnostics(m, err)
+	}
+
+	return getErrorDiagnostics(setPolicyInterVRFStaticRouteAdvertisementInSchema(d, obj.StaticRouteAdvertisement))
+}
+
+func resourceNsxtPolicyTier0InterVRFRoutingUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	connector := getPolicyConnector(m)
+
+	id := d.Id()
+	if id == "" {
+		return diag.Errorf("Error obtaining Inter VRF Routing ID")
+	}
+	gwPath := d.Get("gateway_path").(string)
+	_, gwID := parseGatewayPolicyPath(gwPath)
+
+	log.Printf("[INFO] Updating Inter VRF Routing with ID %s", id)
+	err := resourceNsxtPolicyTier0InterVRFRoutingPatch(gwID, id, d, connector)
+	if err != nil {
+		return getOperationDiagnostics(m, handleUpdateError("Inter VRF Routing", id, err))
+	}
+
+	return resourceNsxtPolicyTier0InterVRFRoutingRead(ctx, d, m)
+}
+
+func resourceNsxtPolicyTier0InterVRFRoutingDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	id := d.Id()
+	if id == "" {
+		return diag.Errorf("Error obtaining Inter VRF Routing ID")
+	}
+	gwPath := d.Get("gateway_path").(string)
+	_, gwID := parseGatewayPolicyPath(gwPath)
+
+	connector := getPolicyConnector(m)
+	client := tier_0s.NewInterVrfRoutingClient(connector)
+	err := client.Delete(gwID, id)
+	if err != nil {
+		return getOperationDiagnostics(m, handleDeleteError("Inter VRF Routing", id, err))
+	}
+
+	return nil
+}
@@ -0,0 +1,222 @@
+/* Copyright © 2023 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccResourceNsxtPolicyTier0InterVRFRouting_basic(t *testing.T) {
+	testResourceName := "nsxt_policy_tier0_inter_vrf_routing.test"
+	displayName := getAccTestResourceName()
+	updatedName := getAccTestResourceName()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t); testAccOnlyLocalManager(t); testAccNSXVersion(t, "4.1.0") },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyTier0InterVRFRoutingCheckDestroy(state, displayName)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyTier0InterVRFRoutingTemplate(displayName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyTier0InterVRFRoutingExists(testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", displayName),
+					resource.TestCheckResourceAttr(testResourceName, "description", "terraform created"),
+					resource.TestCheckResourceAttrSet(testResourceName, "target_path"),
+					resource.TestCheckResourceAttr(testResourceName, "bgp_route_leaking.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "bgp_route_leaking.0.address_family", "IPV4"),
+					resource.TestCheckResourceAttr(testResourceName, "bgp_route_leaking.0.in_filter.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "bgp_route_leaking.0.out_filter.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "static_route_advertisement.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "static_route_advertisement.0.advertisement_rule.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "static_route_advertisement.0.advertisement_rule.0.subnets.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "static_route_advertisement.0.in_filter_prefix_list.#", "1"),
+					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "1"),
+				),
+			},
+			{
+				Config: testAccNsxtPolicyTier0InterVRFRoutingTemplate(updatedName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyTier0InterVRFRoutingExists(testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", updatedName),
+					resource.TestCheckResourceAttr(testResourceName, "description", "terraform created"),
+					resource.TestCheckResourceAttr(testResourceName, "bgp_route_leaking.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "bgp_route_leaking.0.in_filter.#", "0"),
+					resource.TestCheckResourceAttr(testResourceName, "bgp_route_leaking.0.out_filter.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "static_route_advertisement.#", "0"),
+					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNsxtPolicyTier0InterVRFRouting_importBasic(t *testing.T) {
+	name := getAccTestResourceName()
+	testResourceName := "nsxt_policy_tier0_inter_vrf_routing.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t); testAccOnlyLocalManager(t); testAccNSXVersion(t, "4.1.0") },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyTier0InterVRFRoutingCheckDestroy(state, name)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyTier0InterVRFRoutingTemplate(name, false),
+			},
+			{
+				ResourceName:      testResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccNSXPolicyGetGatewayImporterIDGenerator(testResourceName),
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicyTier0InterVRFRoutingExists(resourceName string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+
+		connector := getPolicyConnector(testAccProvider.Meta().(nsxtClients))
+
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Policy Inter VRF Routing resource %s not found in resources", resourceName)
+		}
+
+		resourceID := rs.Primary.ID
+		if resourceID == "" {
+			return fmt.Errorf("Policy Inter VRF Routing resource ID not set in resources")
+		}
+		gwPath := rs.Primary.Attributes["gateway_path"]
+		_, gwID := parseGatewayPolicyPath(gwPath)
+
+		exists, err := resourceNsxtPolicyTier0InterVRFRoutingExists(gwID, resourceID, connector)
+		if err != nil {
+			return err
+		}
+		if !exists {
+			return fmt.Errorf("Policy Inter VRF Routing %s does not exist", resourceID)
+		}
+
+		return nil
+	}
+}
+
+func testAccNsxtPolicyTier0InterVRFRoutingCheckDestroy(state *terraform.State, displayName string) error {
+	connector := getPolicyConnector(testAccProvider.Meta().(nsxtClients))
+	for _, rs := range state.RootModule().Resources {
+
+		if rs.Type != "nsxt_policy_tier0_inter_vrf_routing" {
+			continue
+		}
+
+		resourceID := rs.Primary.ID
+		gwPath := rs.Primary.Attributes["gateway_path"]
+		_, gwID := parseGatewayPolicyPath(gwPath)
+
+		exists, err := resourceNsxtPolicyTier0InterVRFRoutingExists(gwID, resourceID, connector)
+		if err == nil {
+			return err
+		}
+
+		if exists {
+			return fmt.Errorf("Policy Inter VRF Routing %s still exists", displayName)
+		}
+	}
+	return nil
+}
+
+func testAccNsxtPolicyTier0InterVRFRoutingTemplate(displayName string, full bool) string {
+	inFilter := ""
+	staticAdvertisement := ""
+	if full {
+		inFilter = "in_filter = [nsxt_policy_gateway_route_map.test.path]"
+		staticAdvertisement = `
+  static_route_advertisement {
+    advertisement_rule {
+      name                      = "rule1"
+      action                    = "PERMIT"
+      prefix_operator           = "GE"
+      route_advertisement_types = ["TIER0_CONNECTED"]
+      subnets                   = ["10.10.0.0/16"]
+    }
+    in_filter_prefix_list = [nsxt_policy_gateway_prefix_list.test.path]
+  }`
+	}
+
+	return testAccNsxtPolicyEdgeClusterReadTemplate(getEdgeClusterName()) + fmt.Sprintf(`
+resource "nsxt_policy_tier0_gateway" "parent" {
+  display_name      = "inter-vrf-parent"
+  edge_cluster_path = data.nsxt_policy_edge_cluster.test.path
+}
+
+resource "nsxt_policy_tier0_gateway" "vrf1" {
+  display_name      = "inter-vrf-1"
+  edge_cluster_path = data.nsxt_policy_edge_cluster.test.path
+  vrf_config {
+    gateway_path = nsxt_policy_tier0_gateway.parent.path
+  }
+}
+
+resource "nsxt_policy_tier0_gateway" "vrf2" {
+  display_name      = "inter-vrf-2"
+  edge_cluster_path = data.nsxt_policy_edge_cluster.test.path
+  vrf_config {
+    gateway_path = nsxt_policy_tier0_gateway.parent.path
+  }
+}
+
+resource "nsxt_policy_gateway_prefix_list" "test" {
+  display_name = "inter-vrf"
+  gateway_path = nsxt_policy_tier0_gateway.vrf1.path
+
+  prefix {
+    action  = "PERMIT"
+    network = "10.10.0.0/16"
+  }
+}
+
+resource "nsxt_policy_gateway_route_map" "test" {
+  display_name = "inter-vrf"
+  gateway_path = nsxt_policy_tier0_gateway.vrf1.path
+
+  entry {
+    action              = "PERMIT"
+    prefix_list_matches = [nsxt_policy_gateway_prefix_list.test.path]
+  }
+}
+
+resource "nsxt_policy_tier0_inter_vrf_routing" "test" {
+  display_name = "%s"
+  description  = "terraform created"
+  gateway_path = nsxt_policy_tier0_gateway.vrf1.path
+  target_path  = nsxt_policy_tier0_gateway.vrf2.path
+
+  bgp_route_leaking {
+    address_family = "IPV4"
+    %s
+    out_filter     = [nsxt_policy_gateway_route_map.test.path]
+  }
+  %s
+
+  tag {
+    scope = "scope1"
+    tag   = "tag1"
+  }
+}`, displayName, inFilter, staticAdvertisement)
+}
@@ -4,9 +4,11 @@
 package nsxt
 
 import (
+	"context"
 	"fmt"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/vmware/vsphere-automation-sdk-go/runtime/bindings"
@@ -18,6 +20,8 @@ import (
 	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra"
 	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/tier_1s"
 	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+	project_infra "github.com/vmware/vsphere-automation-sdk-go/services/nsxt/orgs/projects/infra"
+	project_tier_1s "github.com/vmware/vsphere-automation-sdk-go/services/nsxt/orgs/projects/infra/tier_1s"
 )
 
 var advertismentTypeValues = []string{
@@ -49,12 +53,12 @@ var t1HaModeValues = []string{
 
 func resourceNsxtPolicyTier1Gateway() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtPolicyTier1GatewayCreate,
-		Read:   resourceNsxtPolicyTier1GatewayRead,
-		Update: resourceNsxtPolicyTier1GatewayUpdate,
-		Delete: resourceNsxtPolicyTier1GatewayDelete,
+		CreateContext: resourceNsxtPolicyTier1GatewayCreate,
+		ReadContext:   resourceNsxtPolicyTier1GatewayRead,
+		UpdateContext: resourceNsxtPolicyTier1GatewayUpdate,
+		DeleteContext: resourceNsxtPolicyTier1GatewayDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: nsxtPolicyContextResourceImporter,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -64,6 +68,7 @@ func resourceNsxtPolicyTier1Gateway() *schema.Resource {
 			"description":       getDescriptionSchema(),
 			"revision":          getRevisionSchema(),
 			"tag":               getTagsSchema(),
+			"context":           getPolicyContextSchema(),
 			"edge_cluster_path": getPolicyEdgeClusterPathSchema(),
 			"locale_service":    getPolicyLocaleServiceSchema(true),
 			"failover_mode":     getFailoverModeSchema(failOverModeDefaultValue),
@@ -213,17 +218,42 @@ func listPolicyTier1GatewayLocaleServices(connector client.Connector, gwID strin
 	return listPolicyGatewayLocaleServices(connector, gwID, listLocalManagerTier1GatewayLocaleServices)
 }
 
-func getPolicyTier1GatewayLocaleServiceEntry(gwID string, connector client.Connector) (*model.LocaleServices, error) {
+func listProjectTier1GatewayLocaleServicesPartial(projectID string) func(client.Connector, string, *string) (model.LocaleServicesListResult, error) {
+	return func(connector client.Connector, gwID string, cursor *string) (model.LocaleServicesListResult, error) {
+		client := project_tier_1s.NewLocaleServicesClient(connector)
+		markForDelete := false
+		return client.List(defaultOrgID, projectID, gwID, cursor, &markForDelete, nil, nil, nil, nil)
+	}
+}
+
+func listPolicyTier1GatewayLocaleServicesPartial(projectID string) func(client.Connector, string, bool) ([]model.LocaleServices, error) {
+	if projectID == "" {
+		return listPolicyTier1GatewayLocaleServices
+	}
+
+	return func(connector client.Connector, gwID string, isGlobalManager bool) ([]model.LocaleServices, error) {
+		return listPolicyGatewayLocaleServices(connector, gwID, listProjectTier1GatewayLocaleServicesPartial(projectID))
+	}
+}
+
+func getPolicyTier1GatewayLocaleServiceEntry(gwID string, projectID string, connector client.Connector) (*model.LocaleServices, error) {
 	// Get the locale services of this Tier1 for the edge-cluster id
-	client := tier_1s.NewLocaleServicesClient(connector)
-	obj, err := client.Get(gwID, defaultPolicyLocaleServiceID)
+	var obj model.LocaleServices
+	var err error
+	if projectID != "" {
+		client := project_tier_1s.NewLocaleServicesClient(connector)
+		obj, err = client.Get(defaultOrgID, projectID, gwID, defaultPolicyLocaleServiceID)
+	} else {
+		client := tier_1s.NewLocaleServicesClient(connector)
+		obj, err = client.Get(gwID, defaultPolicyLocaleServiceID)
+	}
 	if err == nil {
 		return &obj, nil
 	}
 
 	// No locale-service with the default ID
 	// List all the locale services
-	objList, errList := listPolicyTier1GatewayLocaleServices(connector, gwID, false)
+	objList, errList := listPolicyTier1GatewayLocaleServicesPartial(projectID)(connector, gwID, false)
 	if errList != nil {
 		return nil, fmt.Errorf("Error while reading Tier1 %v locale-services: %v", gwID, err)
 	}
@@ -243,9 +273,9 @@ func getPolicyTier1GatewayLocaleServiceEntry(gwID string, connector client.Conne
 	return nil, nil
 }
 
-func resourceNsxtPolicyTier1GatewayReadEdgeCluster(d *schema.ResourceData, connector client.Connector) error {
+func resourceNsxtPolicyTier1GatewayReadEdgeCluster(d *schema.ResourceData, projectID string, connector client.Connector) error {
 	// Get the locale services of this Tier1 for the edge-cluster id
-	obj, err := getPolicyTier1GatewayLocaleServiceEntry(d.Id(), connector)
+	obj, err := getPolicyTier1GatewayLocaleServiceEntry(d.Id(), projectID, connector)
 	if err != nil || obj == nil {
 		// No locale-service found
 		return nil
@@ -279,6 +309,26 @@ func resourceNsxtPolicyTier1GatewayExists(id string, connector client.Connector,
 	return false, logAPIError("Error retrieving Tier1", err)
 }
 
+func resourceNsxtPolicyTier1GatewayExistsPartial(projectID string) func(id string, connector client.Connector, isGlobalManager bool) (bool, error) {
+	if projectID == "" {
+		return resourceNsxtPolicyTier1GatewayExists
+	}
+
+	return func(id string, connector client.Connector, isGlobalManager bool) (bool, error) {
+		client := project_infra.NewTier1sClient(connector)
+		_, err := client.Get(defaultOrgID, projectID, id)
+		if err == nil {
+			return true, nil
+		}
+
+		if isNotFoundError(err) {
+			return false, nil
+		}
+
+		return false, logAPIError("Error retrieving Tier1", err)
+	}
+}
+
 func setAdvRulesInSchema(d *schema.ResourceData, rules []model.RouteAdvertisementRule) error {
 	var rulesList []map[string]interface{}
 	for _, rule := range rules {
@@ -348,7 +398,7 @@ func initSingleTier1GatewayLocaleService(d *schema.ResourceData, connector clien
 	var err error
 	if len(d.Id()) > 0 {
 		// This is an update flow - fetch existing locale service to reuse if needed
-		serviceStruct, err = getPolicyTier1GatewayLocaleServiceEntry(d.Id(), connector)
+		serviceStruct, err = getPolicyTier1GatewayLocaleServiceEntry(d.Id(), getPolicyProjectID(d), connector)
 		if err != nil {
 			return nil, err
 		}
@@ -448,7 +498,7 @@ func policyTier1GatewayResourceToInfraStruct(d *schema.ResourceData, connector c
 
 	if d.HasChange("locale_service") {
 		// Update locale services only if configuration changed
-		localeServices, err := initGatewayLocaleServices(d, connector, isGlobalManager, listPolicyTier1GatewayLocaleServices)
+		localeServices, err := initGatewayLocaleServices(d, connector, isGlobalManager, listPolicyTier1GatewayLocaleServicesPartial(getPolicyProjectID(d)))
 		if err != nil {
 			return infraStruct, err
 		}
@@ -479,63 +529,72 @@ func policyTier1GatewayResourceToInfraStruct(d *schema.ResourceData, connector c
 	return infraStruct, nil
 }
 
-func resourceNsxtPolicyTier1GatewayCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyTier1GatewayCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
+	projectID := getPolicyProjectID(d)
+	err := validatePolicyProjectContext(projectID, m)
+	if err != nil {
+		return getOperationDiagnostics(m, err)
+	}
 
 	// Initialize resource Id and verify this ID is not yet used
-	id, err := getOrGenerateID(d, m, resourceNsxtPolicyTier1GatewayExists)
+	id, err := getOrGenerateID(d, m, resourceNsxtPolicyTier1GatewayExistsPartial(projectID))
 	if err != nil {
-		return err
+		return getOperationDiagnostics(m, err)
 	}
 
 	obj, err := policyTier1GatewayResourceToInfraStruct(d, connector, id, isPolicyGlobalManager(m))
 	if err != nil {
-		return err
+		return getOperationDiagnostics(m, err)
 	}
 
 	// Create the resource using PATCH
 	log.Printf("[INFO] Using H-API to create Tier1 with ID %s", id)
-	err = policyInfraPatch(obj, isPolicyGlobalManager(m), connector, false)
+	err = policyInfraPatchWithContext(projectID, obj, isPolicyGlobalManager(m), connector, false)
 	if err != nil {
-		return handleCreateError("Tier1", id, err)
+		return getOperationDiagnostics(m, handleCreateError("Tier1", id, err))
 	}
 
 	d.SetId(id)
 	d.Set("nsx_id", id)
 
-	return resourceNsxtPolicyTier1GatewayRead(d, m)
+	return resourceNsxtPolicyTier1GatewayRead(ctx, d, m)
 }
 
-func resourceNsxtPolicyTier1GatewayRead(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyTier1GatewayRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 	var obj model.Tier1
 	var err error
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining Tier1 id")
+		return diag.Errorf("Error obtaining Tier1 id")
 	}
 
+	projectID := getPolicyProjectID(d)
 	isGlobalManager := isPolicyGlobalManager(m)
 	if isGlobalManager {
 		client := gm_infra.NewTier1sClient(connector)
 		gmObj, getErr := client.Get(id)
 		if getErr != nil {
-			return handleReadError(d, "Tier0", id, getErr)
+			return getOperationDiagnostics(m, handleReadError(d, "Tier0", id, getErr))
 		}
 
 		convertedObj, convErr := convertModelBindingType(gmObj, model.Tier1BindingType(), model.Tier1BindingType())
 		if convErr != nil {
-			return convErr
+			return getErrorDiagnostics(convErr)
 		}
 		obj = convertedObj.(model.Tier1)
+	} else if projectID != "" {
+		client := project_infra.NewTier1sClient(connector)
+		obj, err = client.Get(defaultOrgID, projectID, id)
 	} else {
 		client := infra.NewTier1sClient(connector)
 		obj, err = client.Get(id)
 	}
 
 	if err != nil {
-		return handleReadError(d, "Tier1", id, err)
+		return getOperationDiagnostics(m, handleReadError(d, "Tier1", id, err))
 	}
 
 	d.Set("display_name", obj.DisplayName)
@@ -543,6 +602,9 @@ func resourceNsxtPolicyTier1GatewayRead(d *schema.ResourceData, m interface{}) e
 	setPolicyTagsInSchema(d, obj.Tags)
 	d.Set("nsx_id", id)
 	d.Set("path", obj.Path)
+	if obj.Path != nil {
+		setPolicyProjectIDInSchema(d, getPolicyProjectIDFromPath(*obj.Path))
+	}
 	d.Set("failover_mode", obj.FailoverMode)
 	d.Set("default_rule_logging", obj.DefaultRuleLogging)
 	d.Set("enable_firewall", !(*obj.DisableFirewall))
@@ -574,9 +636,9 @@ func resourceNsxtPolicyTier1GatewayRead(d *schema.ResourceData, m interface{}) e
 	}
 
 	// Get the edge cluster Id or locale services
-	localeServices, err := listPolicyTier1GatewayLocaleServices(connector, id, isGlobalManager)
+	localeServices, err := listPolicyTier1GatewayLocaleServicesPartial(projectID)(connector, id, isGlobalManager)
 	if err != nil {
-		return handleReadError(d, "Locale Service for T1", id, err)
+		return getOperationDiagnostics(m, handleReadError(d, "Locale Service for T1", id, err))
 	}
 	var services []map[string]interface{}
 	_, shouldSetLS := d.GetOk("locale_service")
@@ -612,50 +674,50 @@ func resourceNsxtPolicyTier1GatewayRead(d *schema.ResourceData, m interface{}) e
 
 	err = setAdvRulesInSchema(d, obj.RouteAdvertisementRules)
 	if err != nil {
-		return fmt.Errorf("Error during Tier1 advertisement rules set in schema: %v", err)
+		return diag.Errorf("Error during Tier1 advertisement rules set in schema: %v", err)
 	}
 
 	err = setIpv6ProfilePathsInSchema(d, obj.Ipv6ProfilePaths)
 	if err != nil {
-		return fmt.Errorf("Failed to get Tier1 %s ipv6 profiles: %v", *obj.Id, err)
+		return diag.Errorf("Failed to get Tier1 %s ipv6 profiles: %v", *obj.Id, err)
 	}
 
 	if isGlobalManager {
 		err = setPolicyGatewayIntersiteConfigInSchema(d, obj.IntersiteConfig)
 		if err != nil {
-			return fmt.Errorf("Failed to get Tier1 %s interset config: %v", *obj.Id, err)
+			return diag.Errorf("Failed to get Tier1 %s interset config: %v", *obj.Id, err)
 		}
 	}
 
 	return nil
 }
 
-func resourceNsxtPolicyTier1GatewayUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyTier1GatewayUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining Tier1 id")
+		return diag.Errorf("Error obtaining Tier1 id")
 	}
 
 	obj, err := policyTier1GatewayResourceToInfraStruct(d, connector, id, isPolicyGlobalManager(m))
 	if err != nil {
-		return err
+		return getOperationDiagnostics(m, err)
 	}
 
 	log.Printf("[INFO] Using H-API to update Tier1 with ID %s", id)
-	err = policyInfraPatch(obj, isPolicyGlobalManager(m), connector, true)
+	err = policyInfraPatchWithContext(getPolicyProjectID(d), obj, isPolicyGlobalManager(m), connector, true)
 	if err != nil {
-		return handleUpdateError("Tier1", id, err)
+		return getOperationDiagnostics(m, handleUpdateError("Tier1", id, err))
 	}
 
-	return resourceNsxtPolicyTier1GatewayRead(d, m)
+	return resourceNsxtPolicyTier1GatewayRead(ctx, d, m)
 }
 
-func resourceNsxtPolicyTier1GatewayDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyTier1GatewayDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining Tier1 id")
+		return diag.Errorf("Error obtaining Tier1 id")
 	}
 
 	var infraChildren []*data.StructValue
@@ -673,7 +735,7 @@ func resourceNsxtPolicyTier1GatewayDelete(d *schema.ResourceData, m interface{})
 	}
 	dataValue, errors := converter.ConvertToVapi(childT1, model.ChildTier1BindingType())
 	if errors != nil {
-		return fmt.Errorf("Error converting Child Tier1: %v", errors[0])
+		return diag.Errorf("Error converting Child Tier1: %v", errors[0])
 	}
 	infraChildren = append(infraChildren, dataValue.(*data.StructValue))
 	infraType := "Infra"
@@ -683,9 +745,9 @@ func resourceNsxtPolicyTier1GatewayDelete(d *schema.ResourceData, m interface{})
 	}
 
 	log.Printf("[DEBUG] Using H-API to delete Tier1 with ID %s", id)
-	err := policyInfraPatch(obj, isPolicyGlobalManager(m), getPolicyConnector(m), false)
+	err := policyInfraPatchWithContext(getPolicyProjectID(d), obj, isPolicyGlobalManager(m), getPolicyConnector(m), false)
 	if err != nil {
-		return handleDeleteError("Tier1", id, err)
+		return getOperationDiagnostics(m, handleDeleteError("Tier1", id, err))
 	}
 	log.Printf("[DEBUG] Success deleting Tier1 with ID %s", id)
 
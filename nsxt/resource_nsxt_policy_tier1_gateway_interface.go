@@ -4,11 +4,13 @@
 package nsxt
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strconv"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	gm_infra "github.com/vmware/vsphere-automation-sdk-go/services/nsxt-gm/global_infra"
 	gm_tier1s "github.com/vmware/vsphere-automation-sdk-go/services/nsxt-gm/global_infra/tier_1s"
@@ -21,12 +23,12 @@ import (
 
 func resourceNsxtPolicyTier1GatewayInterface() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtPolicyTier1GatewayInterfaceCreate,
-		Read:   resourceNsxtPolicyTier1GatewayInterfaceRead,
-		Update: resourceNsxtPolicyTier1GatewayInterfaceUpdate,
-		Delete: resourceNsxtPolicyTier1GatewayInterfaceDelete,
+		CreateContext: resourceNsxtPolicyTier1GatewayInterfaceCreate,
+		ReadContext:   resourceNsxtPolicyTier1GatewayInterfaceRead,
+		UpdateContext: resourceNsxtPolicyTier1GatewayInterfaceUpdate,
+		DeleteContext: resourceNsxtPolicyTier1GatewayInterfaceDelete,
 		Importer: &schema.ResourceImporter{
-			State: resourceNsxtPolicyTier1GatewayInterfaceImport,
+			StateContext: resourceNsxtPolicyTier1GatewayInterfaceImport,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -77,37 +79,40 @@ func getGatewayInterfaceSubnetList(d *schema.ResourceData) []model.InterfaceSubn
 	return interfaceSubnetList
 }
 
-func resourceNsxtPolicyTier1GatewayInterfaceCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyTier1GatewayInterfaceCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 
 	id := d.Get("nsx_id").(string)
 	tier1Path := d.Get("gateway_path").(string)
 	sitePath := d.Get("site_path").(string)
 	tier1ID := getPolicyIDFromPath(tier1Path)
+	if getPolicyProjectIDFromPath(tier1Path) != "" {
+		return getErrorDiagnostics(newAttributeError("gateway_path", fmt.Errorf("Interfaces on Tier1 gateways in project context are not supported")))
+	}
 	localeServiceID := ""
 	if isPolicyGlobalManager(m) {
 		if sitePath == "" {
-			return attributeRequiredGlobalManagerError("site_path", "nsxt_policy_tier1_gateway_interface")
+			return getErrorDiagnostics(attributeRequiredGlobalManagerError("site_path", "nsxt_policy_tier1_gateway_interface"))
 		}
 		localeServices, err := listPolicyTier1GatewayLocaleServices(connector, tier1ID, true)
 		if err != nil {
-			return err
+			return getOperationDiagnostics(m, err)
 		}
 		localeServiceID, err = getGlobalPolicyGatewayLocaleServiceIDWithSite(localeServices, sitePath, tier1ID)
 		if err != nil {
-			return err
+			return getOperationDiagnostics(m, err)
 		}
 
 	} else {
 		if sitePath != "" {
-			return globalManagerOnlyError()
+			return getErrorDiagnostics(globalManagerOnlyError())
 		}
-		localeService, err := getPolicyTier1GatewayLocaleServiceEntry(tier1ID, connector)
+		localeService, err := getPolicyTier1GatewayLocaleServiceEntry(tier1ID, "", connector)
 		if err != nil {
-			return err
+			return getOperationDiagnostics(m, err)
 		}
 		if localeService == nil {
-			return fmt.Errorf("Edge cluster is mandatory on gateway %s in order to create interfaces", tier1ID)
+			return diag.Errorf("Edge cluster is mandatory on gateway %s in order to create interfaces", tier1ID)
 		}
 		localeServiceID = *localeService.Id
 	}
@@ -125,9 +130,9 @@ func resourceNsxtPolicyTier1GatewayInterfaceCreate(d *schema.ResourceData, m int
 		}
 
 		if err == nil {
-			return fmt.Errorf("Interface with ID '%s' already exists on Tier1 Gateway %s", id, tier1ID)
+			return diag.Errorf("Interface with ID '%s' already exists on Tier1 Gateway %s", id, tier1ID)
 		} else if !isNotFoundError(err) {
-			return err
+			return getOperationDiagnostics(m, err)
 		}
 	}
 
@@ -166,7 +171,7 @@ func resourceNsxtPolicyTier1GatewayInterfaceCreate(d *schema.ResourceData, m int
 	if isPolicyGlobalManager(m) {
 		gmObj, convErr := convertModelBindingType(obj, model.Tier1InterfaceBindingType(), gm_model.Tier1InterfaceBindingType())
 		if convErr != nil {
-			return convErr
+			return getErrorDiagnostics(convErr)
 		}
 		client := gm_locale_services.NewInterfacesClient(connector)
 		err = client.Patch(tier1ID, localeServiceID, id, gmObj.(gm_model.Tier1Interface))
@@ -176,24 +181,24 @@ func resourceNsxtPolicyTier1GatewayInterfaceCreate(d *schema.ResourceData, m int
 	}
 
 	if err != nil {
-		return handleCreateError("Tier1 Interface", id, err)
+		return getOperationDiagnostics(m, handleCreateError("Tier1 Interface", id, err))
 	}
 
 	d.SetId(id)
 	d.Set("nsx_id", id)
 	d.Set("locale_service_id", localeServiceID)
 
-	return resourceNsxtPolicyTier1GatewayInterfaceRead(d, m)
+	return resourceNsxtPolicyTier1GatewayInterfaceRead(ctx, d, m)
 }
 
-func resourceNsxtPolicyTier1GatewayInterfaceRead(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyTier1GatewayInterfaceRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 	id := d.Id()
 	tier1Path := d.Get("gateway_path").(string)
 	localeServiceID := d.Get("locale_service_id").(string)
 	tier1ID := getPolicyIDFromPath(tier1Path)
 	if id == "" || tier1ID == "" {
-		return fmt.Errorf("Error obtaining Tier1 Interface id")
+		return diag.Errorf("Error obtaining Tier1 Interface id")
 	}
 
 	var obj model.Tier1Interface
@@ -201,17 +206,17 @@ func resourceNsxtPolicyTier1GatewayInterfaceRead(d *schema.ResourceData, m inter
 		client := gm_locale_services.NewInterfacesClient(connector)
 		gmObj, err := client.Get(tier1ID, localeServiceID, id)
 		if err != nil {
-			return handleReadError(d, "Tier1 Interface", id, err)
+			return getOperationDiagnostics(m, handleReadError(d, "Tier1 Interface", id, err))
 		}
 		lmObj, err1 := convertModelBindingType(gmObj, gm_model.Tier1InterfaceBindingType(), model.Tier1InterfaceBindingType())
 		if err1 != nil {
-			return err1
+			return getErrorDiagnostics(err1)
 		}
 		obj = lmObj.(model.Tier1Interface)
 		tier1Client := gm_tier1s.NewLocaleServicesClient(connector)
 		localeService, err := tier1Client.Get(tier1ID, localeServiceID)
 		if err != nil {
-			return err
+			return getOperationDiagnostics(m, err)
 		}
 		sitePath := getSitePathFromEdgePath(*localeService.EdgeClusterPath)
 		d.Set("site_path", sitePath)
@@ -220,7 +225,7 @@ func resourceNsxtPolicyTier1GatewayInterfaceRead(d *schema.ResourceData, m inter
 		client := locale_services.NewInterfacesClient(connector)
 		obj, err = client.Get(tier1ID, localeServiceID, id)
 		if err != nil {
-			return handleReadError(d, "Tier1 Interface", id, err)
+			return getOperationDiagnostics(m, handleReadError(d, "Tier1 Interface", id, err))
 		}
 	}
 
@@ -255,7 +260,7 @@ func resourceNsxtPolicyTier1GatewayInterfaceRead(d *schema.ResourceData, m inter
 	return nil
 }
 
-func resourceNsxtPolicyTier1GatewayInterfaceUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyTier1GatewayInterfaceUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 
 	id := d.Id()
@@ -263,7 +268,7 @@ func resourceNsxtPolicyTier1GatewayInterfaceUpdate(d *schema.ResourceData, m int
 	localeServiceID := d.Get("locale_service_id").(string)
 	tier1ID := getPolicyIDFromPath(tier1Path)
 	if id == "" || tier1ID == "" || localeServiceID == "" {
-		return fmt.Errorf("Error obtaining Tier1 id or Locale Service id")
+		return diag.Errorf("Error obtaining Tier1 id or Locale Service id")
 	}
 
 	displayName := d.Get("display_name").(string)
@@ -299,7 +304,7 @@ func resourceNsxtPolicyTier1GatewayInterfaceUpdate(d *schema.ResourceData, m int
 	if isPolicyGlobalManager(m) {
 		gmObj, err1 := convertModelBindingType(obj, model.Tier1InterfaceBindingType(), gm_model.Tier1InterfaceBindingType())
 		if err1 != nil {
-			return err1
+			return getErrorDiagnostics(err1)
 		}
 		client := gm_locale_services.NewInterfacesClient(connector)
 		_, err = client.Update(tier1ID, localeServiceID, id, gmObj.(gm_model.Tier1Interface))
@@ -308,13 +313,13 @@ func resourceNsxtPolicyTier1GatewayInterfaceUpdate(d *schema.ResourceData, m int
 		_, err = client.Update(tier1ID, localeServiceID, id, obj)
 	}
 	if err != nil {
-		return handleUpdateError("Tier1 Interface", id, err)
+		return getOperationDiagnostics(m, handleUpdateError("Tier1 Interface", id, err))
 	}
 
-	return resourceNsxtPolicyTier1GatewayInterfaceRead(d, m)
+	return resourceNsxtPolicyTier1GatewayInterfaceRead(ctx, d, m)
 }
 
-func resourceNsxtPolicyTier1GatewayInterfaceDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyTier1GatewayInterfaceDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 
 	id := d.Id()
@@ -322,7 +327,7 @@ func resourceNsxtPolicyTier1GatewayInterfaceDelete(d *schema.ResourceData, m int
 	tier1ID := getPolicyIDFromPath(tier1Path)
 	localeServiceID := d.Get("locale_service_id").(string)
 	if id == "" || tier1ID == "" || localeServiceID == "" {
-		return fmt.Errorf("Error obtaining Tier1 id or Locale Service id")
+		return diag.Errorf("Error obtaining Tier1 id or Locale Service id")
 	}
 
 	var err error
@@ -334,13 +339,13 @@ func resourceNsxtPolicyTier1GatewayInterfaceDelete(d *schema.ResourceData, m int
 		err = client.Delete(tier1ID, localeServiceID, id)
 	}
 	if err != nil {
-		return handleDeleteError("Tier1 Interface", id, err)
+		return getOperationDiagnostics(m, handleDeleteError("Tier1 Interface", id, err))
 	}
 
 	return nil
 }
 
-func resourceNsxtPolicyTier1GatewayInterfaceImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+func resourceNsxtPolicyTier1GatewayInterfaceImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	importID := d.Id()
 	s := strings.Split(importID, "/")
 	if len(s) != 3 {
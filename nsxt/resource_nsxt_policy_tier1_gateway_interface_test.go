@@ -4,10 +4,12 @@
 package nsxt
 
 import (
+	"context"
 	"fmt"
 	"strings"
 	"testing"
 
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	gm_locale_services "github.com/vmware/vsphere-automation-sdk-go/services/nsxt-gm/global_infra/tier_1s/locale_services"
@@ -16,6 +18,29 @@ import (
 
 var nsxtPolicyTier1GatewayName = "test"
 
+func TestUnitResourceNsxtPolicyTier1GatewayInterface_project(t *testing.T) {
+	fake := newFakeNsxServer(t)
+	meta := testUnitConfigureProvider(t, fake)
+
+	r := testUnitGetResource(t, "nsxt_policy_tier1_gateway_interface")
+	ctx := context.Background()
+	diff, err := r.Diff(ctx, nil, terraform.NewResourceConfigRaw(map[string]interface{}{
+		"display_name": "interface1",
+		"gateway_path": "/orgs/default/projects/project1/infra/tier-1s/gw1",
+		"segment_path": "/orgs/default/projects/project1/infra/segments/segment1",
+		"subnets":      []interface{}{"12.12.2.13/24"},
+	}), meta)
+	if err != nil {
+		t.Fatalf("Failed to plan: %v", err)
+	}
+
+	// Interface is not created under infra locale service of another gateway
+	_, diags := r.Apply(ctx, nil, diff, meta)
+	if !diags.HasError() || !diags[0].AttributePath.Equals(cty.GetAttrPath("gateway_path")) {
+		t.Errorf("Expected error on gateway_path for gateway in project context, got %v", diags)
+	}
+}
+
 func TestAccResourceNsxtPolicyTier1GatewayInterface_basic(t *testing.T) {
 	name := getAccTestResourceName()
 	updatedName := getAccTestResourceName()
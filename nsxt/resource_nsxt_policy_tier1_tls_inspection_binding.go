@@ -0,0 +1,203 @@
+/* Copyright © 2023 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/tier_1s"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+func resourceNsxtPolicyTier1TLSInspectionBinding() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceNsxtPolicyTier1TLSInspectionBindingCreate,
+		ReadContext:   resourceNsxtPolicyTier1TLSInspectionBindingRead,
+		UpdateContext: resourceNsxtPolicyTier1TLSInspectionBindingUpdate,
+		DeleteContext: resourceNsxtPolicyTier1TLSInspectionBindingDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceNsxtPolicyTier1TLSInspectionBindingImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"nsx_id":       getNsxIDSchema(),
+			"path":         getPathSchema(),
+			"display_name": getDisplayNameSchema(),
+			"description":  getDescriptionSchema(),
+			"revision":     getRevisionSchema(),
+			"tag":          getTagsSchema(),
+			"gateway_path": getPolicyPathSchema(true, true, "Policy path of Tier1 Gateway"),
+			"profile_path": getPolicyPathSchema(true, false, "Policy path of TLS Inspection Config Profile"),
+		},
+	}
+}
+
+func resourceNsxtPolicyTier1TLSInspectionBindingExists(gwID string, id string, connector client.Connector) (bool, error) {
+	client := tier_1s.NewTlsInspectionConfigProfileBindingsClient(connector)
+	_, err := client.Get(gwID, id)
+	if err == nil {
+		return true, nil
+	}
+
+	if isNotFoundError(err) {
+		return false, nil
+	}
+
+	return false, logAPIError("Error retrieving Tier1 TLS Inspection Binding", err)
+}
+
+func getPolicyTier1TLSInspectionBindingGatewayID(d *schema.ResourceData) (string, error) {
+	gwPath := d.Get("gateway_path").(string)
+	isT0, gwID := parseGatewayPolicyPath(gwPath)
+	if isT0 || gwID == "" {
+		return "", fmt.Errorf("Tier1 Gateway path expected, got %s", gwPath)
+	}
+
+	return gwID, nil
+}
+
+func resourceNsxtPolicyTier1TLSInspectionBindingPatch(d *schema.ResourceData, m interface{}, gwID string, id string) error {
+	connector := getPolicyConnector(m)
+
+	displayName := d.Get("display_name").(string)
+	description := d.Get("description").(string)
+	profilePath := d.Get("profile_path").(string)
+	tags := getPolicyTagsFromSchema(d)
+
+	obj := model.TlsConfigProfileBindingMap{
+		DisplayName: &displayName,
+		Description: &description,
+		Tags:        tags,
+		ProfilePath: &profilePath,
+	}
+
+	client := tier_1s.NewTlsInspectionConfigProfileBindingsClient(connector)
+	_, err := client.Patch(gwID, id, obj)
+	return err
+}
+
+func resourceNsxtPolicyTier1TLSInspectionBindingCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if isPolicyGlobalManager(m) {
+		return getErrorDiagnostics(policyResourceNotSupportedError())
+	}
+
+	connector := getPolicyConnector(m)
+	gwID, err := getPolicyTier1TLSInspectionBindingGatewayID(d)
+	if err != nil {
+		return getOperationDiagnostics(m, err)
+	}
+
+	id := d.Get("nsx_id").(string)
+	if id == "" {
+		id = newUUID()
+	} else {
+		exists, err := resourceNsxtPolicyTier1TLSInspectionBindingExists(gwID, id, connector)
+		if err != nil {
+			return getOperationDiagnostics(m, err)
+		}
+		if exists {
+			return diag.Errorf("TLS Inspection Binding with ID '%s' already exists on Tier1 Gateway %s", id, gwID)
+		}
+	}
+
+	log.Printf("[INFO] Creating TLS Inspection Binding with ID %s on Tier1 Gateway %s", id, gwID)
+	err = resourceNsxtPolicyTier1TLSInspectionBindingPatch(d, m, gwID, id)
+	if err != nil {
+		return getOperationDiagnostics(m, handleCreateError("Tier1 TLS Inspection Binding", id, err))
+	}
+
+	d.SetId(id)
+	d.Set("nsx_id", id)
+
+	return resourceNsxtPolicyTier1TLSInspectionBindingRead(ctx, d, m)
+}
+
+func resourceNsxtPolicyTier1TLSInspectionBindingRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	connector := getPolicyConnector(m)
+
+	id := d.Id()
+	gwID, err := getPolicyTier1TLSInspectionBindingGatewayID(d)
+	if err != nil {
+		return getOperationDiagnostics(m, err)
+	}
+	if id == "" {
+		return diag.Errorf("Error obtaining Tier1 TLS Inspection Binding ID")
+	}
+
+	client := tier_1s.NewTlsInspectionConfigProfileBindingsClient(connector)
+	obj, err := client.Get(gwID, id)
+	if err != nil {
+		return getOperationDiagnostics(m, handleReadError(d, "Tier1 TLS Inspection Binding", id, err))
+	}
+
+	d.Set("display_name", obj.DisplayName)
+	d.Set("description", obj.Description)
+	setPolicyTagsInSchema(d, obj.Tags)
+	d.Set("nsx_id", id)
+	d.Set("path", obj.Path)
+	d.Set("revision", obj.Revision)
+	d.Set("profile_path", obj.ProfilePath)
+
+	return nil
+}
+
+func resourceNsxtPolicyTier1TLSInspectionBindingUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	id := d.Id()
+	gwID, err := getPolicyTier1TLSInspectionBindingGatewayID(d)
+	if err != nil {
+		return getOperationDiagnostics(m, err)
+	}
+	if id == "" {
+		return diag.Errorf("Error obtaining Tier1 TLS Inspection Binding ID")
+	}
+
+	log.Printf("[INFO] Updating TLS Inspection Binding with ID %s on Tier1 Gateway %s", id, gwID)
+	err = resourceNsxtPolicyTier1TLSInspectionBindingPatch(d, m, gwID, id)
+	if err != nil {
+		return getOperationDiagnostics(m, handleUpdateError("Tier1 TLS Inspection Binding", id, err))
+	}
+
+	return resourceNsxtPolicyTier1TLSInspectionBindingRead(ctx, d, m)
+}
+
+func resourceNsxtPolicyTier1TLSInspectionBindingDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	connector := getPolicyConnector(m)
+
+	id := d.Id()
+	gwID, err := getPolicyTier1TLSInspectionBindingGatewayID(d)
+	if err != nil {
+		return getOperationDiagnostics(m, err)
+	}
+	if id == "" {
+		return diag.Errorf("Error obtaining Tier1 TLS Inspection Binding ID")
+	}
+
+	log.Printf("[INFO] Deleting TLS Inspection Binding with ID %s from Tier1 Gateway %s", id, gwID)
+	client := tier_1s.NewTlsInspectionConfigProfileBindingsClient(connector)
+	err = client.Delete(gwID, id)
+	if err != nil {
+		return getOperationDiagnostics(m, handleDeleteError("Tier1 TLS Inspection Binding", id, err))
+	}
+
+	return nil
+}
+
+func resourceNsxtPolicyTier1TLSInspectionBindingImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	importID := d.Id()
+	s := strings.Split(importID, "/")
+	if len(s) != 2 {
+		return nil, fmt.Errorf("Please provide <gateway-id>/<id> as an input")
+	}
+
+	d.SetId(s[1])
+	d.Set("gateway_path", fmt.Sprintf("/infra/tier-1s/%s", s[0]))
+
+	return []*schema.ResourceData{d}, nil
+}
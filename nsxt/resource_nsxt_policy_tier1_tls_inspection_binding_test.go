@@ -0,0 +1,162 @@
+/* Copyright © 2023 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func getTestTLSInspectionConfigProfilePath() string {
+	return os.Getenv("NSXT_TEST_TLS_CONFIG_PROFILE_PATH")
+}
+
+func TestAccResourceNsxtPolicyTier1TLSInspectionBinding_basic(t *testing.T) {
+	testResourceName := "nsxt_policy_tier1_tls_inspection_binding.test"
+	displayName := getAccTestResourceName()
+	updatedName := getAccTestResourceName()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccOnlyLocalManager(t)
+			testAccNSXVersion(t, "4.0.0")
+			testAccEnvDefined(t, "NSXT_TEST_TLS_CONFIG_PROFILE_PATH")
+		},
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyTier1TLSInspectionBindingCheckDestroy(state, updatedName)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyTier1TLSInspectionBindingTemplate(displayName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyTier1TLSInspectionBindingExists(testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", displayName),
+					resource.TestCheckResourceAttr(testResourceName, "description", "terraform created"),
+					resource.TestCheckResourceAttr(testResourceName, "profile_path", getTestTLSInspectionConfigProfilePath()),
+					resource.TestCheckResourceAttrSet(testResourceName, "gateway_path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "1"),
+				),
+			},
+			{
+				Config: testAccNsxtPolicyTier1TLSInspectionBindingTemplate(updatedName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyTier1TLSInspectionBindingExists(testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", updatedName),
+					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNsxtPolicyTier1TLSInspectionBinding_importBasic(t *testing.T) {
+	name := getAccTestResourceName()
+	testResourceName := "nsxt_policy_tier1_tls_inspection_binding.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccOnlyLocalManager(t)
+			testAccNSXVersion(t, "4.0.0")
+			testAccEnvDefined(t, "NSXT_TEST_TLS_CONFIG_PROFILE_PATH")
+		},
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyTier1TLSInspectionBindingCheckDestroy(state, name)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyTier1TLSInspectionBindingTemplate(name),
+			},
+			{
+				ResourceName:      testResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccNSXPolicyGetGatewayImporterIDGenerator(testResourceName),
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicyTier1TLSInspectionBindingExists(resourceName string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+
+		connector := getPolicyConnector(testAccProvider.Meta().(nsxtClients))
+
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Policy Tier1 TLS Inspection Binding resource %s not found in resources", resourceName)
+		}
+
+		resourceID := rs.Primary.ID
+		if resourceID == "" {
+			return fmt.Errorf("Policy Tier1 TLS Inspection Binding resource ID not set in resources")
+		}
+		_, gwID := parseGatewayPolicyPath(rs.Primary.Attributes["gateway_path"])
+
+		exists, err := resourceNsxtPolicyTier1TLSInspectionBindingExists(gwID, resourceID, connector)
+		if err != nil {
+			return err
+		}
+		if !exists {
+			return fmt.Errorf("Policy Tier1 TLS Inspection Binding %s does not exist", resourceID)
+		}
+
+		return nil
+	}
+}
+
+func testAccNsxtPolicyTier1TLSInspectionBindingCheckDestroy(state *terraform.State, displayName string) error {
+	connector := getPolicyConnector(testAccProvider.Meta().(nsxtClients))
+	for _, rs := range state.RootModule().Resources {
+
+		if rs.Type != "nsxt_policy_tier1_tls_inspection_binding" {
+			continue
+		}
+
+		resourceID := rs.Primary.ID
+		_, gwID := parseGatewayPolicyPath(rs.Primary.Attributes["gateway_path"])
+		exists, err := resourceNsxtPolicyTier1TLSInspectionBindingExists(gwID, resourceID, connector)
+		if err != nil {
+			return err
+		}
+
+		if exists {
+			return fmt.Errorf("Policy Tier1 TLS Inspection Binding %s still exists", displayName)
+		}
+	}
+	return nil
+}
+
+func testAccNsxtPolicyTier1TLSInspectionBindingTemplate(displayName string) string {
+	return testAccNsxtPolicyEdgeClusterReadTemplate(getEdgeClusterName()) + fmt.Sprintf(`
+resource "nsxt_policy_tier1_gateway" "test" {
+  display_name      = "tls-binding-test"
+  edge_cluster_path = data.nsxt_policy_edge_cluster.test.path
+}
+
+resource "nsxt_policy_tier1_tls_inspection_binding" "test" {
+  display_name = "%s"
+  description  = "terraform created"
+  gateway_path = nsxt_policy_tier1_gateway.test.path
+  profile_path = "%s"
+
+  tag {
+    scope = "scope1"
+    tag   = "tag1"
+  }
+}`, displayName, getTestTLSInspectionConfigProfilePath())
+}
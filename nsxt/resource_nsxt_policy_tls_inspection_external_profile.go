@@ -0,0 +1,41 @@
+/* Copyright © 2023 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceNsxtPolicyTLSInspectionExternalProfile() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceNsxtPolicyTLSInspectionExternalProfileCreate,
+		ReadContext:   resourceNsxtPolicyTLSInspectionExternalProfileRead,
+		UpdateContext: resourceNsxtPolicyTLSInspectionExternalProfileUpdate,
+		DeleteContext: resourceNsxtPolicyTLSInspectionExternalProfileDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: getPolicyTLSInspectionProfileSchema(true),
+	}
+}
+
+func resourceNsxtPolicyTLSInspectionExternalProfileCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	return getOperationDiagnostics(m, policyTLSInspectionProfileCreate(d, m, true))
+}
+
+func resourceNsxtPolicyTLSInspectionExternalProfileRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	return getOperationDiagnostics(m, policyTLSInspectionProfileRead(d, m, true))
+}
+
+func resourceNsxtPolicyTLSInspectionExternalProfileUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	return getOperationDiagnostics(m, policyTLSInspectionProfileUpdate(d, m, true))
+}
+
+func resourceNsxtPolicyTLSInspectionExternalProfileDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	return getOperationDiagnostics(m, policyTLSInspectionProfileDelete(d, m))
+}
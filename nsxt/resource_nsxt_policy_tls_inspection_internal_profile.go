@@ -0,0 +1,41 @@
+/* Copyright © 2023 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceNsxtPolicyTLSInspectionInternalProfile() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceNsxtPolicyTLSInspectionInternalProfileCreate,
+		ReadContext:   resourceNsxtPolicyTLSInspectionInternalProfileRead,
+		UpdateContext: resourceNsxtPolicyTLSInspectionInternalProfileUpdate,
+		DeleteContext: resourceNsxtPolicyTLSInspectionInternalProfileDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: getPolicyTLSInspectionProfileSchema(false),
+	}
+}
+
+func resourceNsxtPolicyTLSInspectionInternalProfileCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	return getOperationDiagnostics(m, policyTLSInspectionProfileCreate(d, m, false))
+}
+
+func resourceNsxtPolicyTLSInspectionInternalProfileRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	return getOperationDiagnostics(m, policyTLSInspectionProfileRead(d, m, false))
+}
+
+func resourceNsxtPolicyTLSInspectionInternalProfileUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	return getOperationDiagnostics(m, policyTLSInspectionProfileUpdate(d, m, false))
+}
+
+func resourceNsxtPolicyTLSInspectionInternalProfileDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	return getOperationDiagnostics(m, policyTLSInspectionProfileDelete(d, m))
+}
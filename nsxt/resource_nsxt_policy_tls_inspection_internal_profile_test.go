@@ -0,0 +1,103 @@
+/* Copyright © 2023 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccResourceNsxtPolicyTLSInspectionInternalProfile_basic(t *testing.T) {
+	testResourceName := "nsxt_policy_tls_inspection_internal_profile.test"
+	displayName := getAccTestResourceName()
+	updatedName := getAccTestResourceName()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccOnlyLocalManager(t)
+			testAccNSXVersion(t, "4.0.0")
+			testAccEnvDefined(t, "NSXT_TEST_CERTIFICATE_NAME")
+		},
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyResourceCheckDestroy(state, updatedName, "nsxt_policy_tls_inspection_internal_profile", resourceNsxtPolicyTLSInspectionProfileExists)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyTLSInspectionInternalProfileTemplate(displayName, "BLOCK"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyResourceExists(testResourceName, resourceNsxtPolicyTLSInspectionProfileExists),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", displayName),
+					resource.TestCheckResourceAttr(testResourceName, "description", "terraform created"),
+					resource.TestCheckResourceAttr(testResourceName, "tls_config_setting", "BALANCED"),
+					resource.TestCheckResourceAttr(testResourceName, "decryption_fail_action", "BLOCK"),
+					resource.TestCheckResourceAttr(testResourceName, "server_certs_key.#", "1"),
+					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "1"),
+				),
+			},
+			{
+				Config: testAccNsxtPolicyTLSInspectionInternalProfileTemplate(updatedName, "BYPASS"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyResourceExists(testResourceName, resourceNsxtPolicyTLSInspectionProfileExists),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", updatedName),
+					resource.TestCheckResourceAttr(testResourceName, "decryption_fail_action", "BYPASS"),
+					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNsxtPolicyTLSInspectionInternalProfile_importBasic(t *testing.T) {
+	name := getAccTestResourceName()
+	testResourceName := "nsxt_policy_tls_inspection_internal_profile.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccOnlyLocalManager(t)
+			testAccNSXVersion(t, "4.0.0")
+			testAccEnvDefined(t, "NSXT_TEST_CERTIFICATE_NAME")
+		},
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyResourceCheckDestroy(state, name, "nsxt_policy_tls_inspection_internal_profile", resourceNsxtPolicyTLSInspectionProfileExists)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyTLSInspectionInternalProfileTemplate(name, "BLOCK"),
+			},
+			{
+				ResourceName:      testResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicyTLSInspectionInternalProfileTemplate(displayName string, failAction string) string {
+	return testAccNsxtPolicyCertificateReadTemplate(getTestCertificateName(false)) + fmt.Sprintf(`
+resource "nsxt_policy_tls_inspection_internal_profile" "test" {
+  display_name           = "%s"
+  description            = "terraform created"
+  decryption_fail_action = "%s"
+  server_certs_key       = [data.nsxt_policy_certificate.test.path]
+
+  tag {
+    scope = "scope1"
+    tag   = "tag1"
+  }
+}`, displayName, failAction)
+}
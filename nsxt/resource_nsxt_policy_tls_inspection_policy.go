@@ -0,0 +1,384 @@
+/* Copyright © 2023 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/bindings"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/data"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+func resourceNsxtPolicyTLSInspectionPolicy() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceNsxtPolicyTLSInspectionPolicyCreate,
+		ReadContext:   resourceNsxtPolicyTLSInspectionPolicyRead,
+		UpdateContext: resourceNsxtPolicyTLSInspectionPolicyUpdate,
+		DeleteContext: resourceNsxtPolicyTLSInspectionPolicyDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: getPolicyTLSInspectionPolicySchema(),
+	}
+}
+
+func getPolicyTLSInspectionPolicySchema() map[string]*schema.Schema {
+	secPolicy := getPolicySecurityPolicySchema(false)
+	// TLS Inspection Policies reside directly under infra
+	delete(secPolicy, "domain")
+	delete(secPolicy, "category")
+	delete(secPolicy, "stateful")
+	delete(secPolicy, "tcp_strict")
+	delete(secPolicy, "scheduler_path")
+
+	ruleSchema := getSecurityPolicyAndGatewayRulesSchema(false, false, true)
+	ruleElemSchema := ruleSchema.Elem.(*schema.Resource).Schema
+	// TLS Inspection Rules specify action profile rather than action
+	delete(ruleElemSchema, "action")
+	ruleElemSchema["tls_profile"] = getPolicyPathSchema(true, false, "Policy path of TLS Inspection Action Profile")
+	secPolicy["rule"] = ruleSchema
+
+	return secPolicy
+}
+
+func resourceNsxtPolicyTLSInspectionPolicyExists(id string, connector client.Connector, isGlobalManager bool) (bool, error) {
+	client := infra.NewTlsInspectionPoliciesClient(connector)
+	_, err := client.Get(id)
+	if err == nil {
+		return true, nil
+	}
+
+	if isNotFoundError(err) {
+		return false, nil
+	}
+
+	return false, logAPIError("Error retrieving TLS Inspection Policy", err)
+}
+
+func setPolicyTLSRulesInSchema(d *schema.ResourceData, rules []model.TlsRule) error {
+	var rulesList []map[string]interface{}
+	for _, rule := range rules {
+		elem := make(map[string]interface{})
+		elem["display_name"] = rule.DisplayName
+		elem["description"] = rule.Description
+		elem["notes"] = rule.Notes
+		elem["logged"] = rule.Logged
+		elem["log_label"] = rule.Tag
+		elem["tls_profile"] = rule.TlsProfile
+		elem["destinations_excluded"] = rule.DestinationsExcluded
+		elem["sources_excluded"] = rule.SourcesExcluded
+		if rule.IpProtocol == nil {
+			elem["ip_version"] = "NONE"
+		} else {
+			elem["ip_version"] = rule.IpProtocol
+		}
+		elem["direction"] = rule.Direction
+		elem["disabled"] = rule.Disabled
+		elem["revision"] = rule.Revision
+		setPathListInMap(elem, "source_groups", rule.SourceGroups)
+		setPathListInMap(elem, "destination_groups", rule.DestinationGroups)
+		setPathListInMap(elem, "profiles", rule.Profiles)
+		setPathListInMap(elem, "services", rule.Services)
+		setPathListInMap(elem, "scope", rule.Scope)
+		elem["sequence_number"] = rule.SequenceNumber
+		elem["nsx_id"] = rule.Id
+		elem["rule_id"] = rule.RuleId
+
+		var tagList []map[string]string
+		for _, tag := range rule.Tags {
+			tags := make(map[string]string)
+			tags["scope"] = *tag.Scope
+			tags["tag"] = *tag.Tag
+			tagList = append(tagList, tags)
+		}
+		elem["tag"] = tagList
+
+		rulesList = append(rulesList, elem)
+	}
+
+	return d.Set("rule", rulesList)
+}
+
+func getPolicyTLSRulesFromSchema(d *schema.ResourceData) []model.TlsRule {
+	rules := d.Get("rule").([]interface{})
+	var ruleList []model.TlsRule
+	for _, rule := range rules {
+		data := rule.(map[string]interface{})
+		displayName := data["display_name"].(string)
+		description := data["description"].(string)
+		tlsProfile := data["tls_profile"].(string)
+		logged := data["logged"].(bool)
+		tag := data["log_label"].(string)
+		disabled := data["disabled"].(bool)
+		sourcesExcluded := data["sources_excluded"].(bool)
+		destinationsExcluded := data["destinations_excluded"].(bool)
+
+		var ipProtocol *string
+		ipp := data["ip_version"].(string)
+		if ipp != "NONE" {
+			ipProtocol = &ipp
+		}
+		direction := data["direction"].(string)
+		notes := data["notes"].(string)
+		sequenceNumber := int64(data["sequence_number"].(int))
+		tagStructs := getPolicyTagsFromSet(data["tag"].(*schema.Set))
+
+		id := newUUID()
+		nsxID := data["nsx_id"].(string)
+		if nsxID != "" {
+			id = nsxID
+		}
+
+		resourceType := "TlsRule"
+		elem := model.TlsRule{
+			ResourceType:         &resourceType,
+			Id:                   &id,
+			DisplayName:          &displayName,
+			Notes:                &notes,
+			Description:          &description,
+			TlsProfile:           &tlsProfile,
+			Logged:               &logged,
+			Tag:                  &tag,
+			Tags:                 tagStructs,
+			Disabled:             &disabled,
+			SourcesExcluded:      &sourcesExcluded,
+			DestinationsExcluded: &destinationsExcluded,
+			IpProtocol:           ipProtocol,
+			Direction:            &direction,
+			SourceGroups:         getPathListFromMap(data, "source_groups"),
+			DestinationGroups:    getPathListFromMap(data, "destination_groups"),
+			Services:             getPathListFromMap(data, "services"),
+			Scope:                getPathListFromMap(data, "scope"),
+			Profiles:             getPathListFromMap(data, "profiles"),
+		}
+
+		if sequenceNumber > 0 {
+			elem.SequenceNumber = &sequenceNumber
+		}
+
+		ruleList = append(ruleList, elem)
+	}
+
+	return ruleList
+}
+
+func createPolicyChildTLSRule(ruleID string, rule model.TlsRule, shouldDelete bool) (*data.StructValue, error) {
+	converter := bindings.NewTypeConverter()
+
+	childRule := model.ChildTlsRule{
+		ResourceType:    "ChildTlsRule",
+		Id:              &ruleID,
+		TlsRule:         &rule,
+		MarkedForDelete: &shouldDelete,
+	}
+
+	dataValue, errors := converter.ConvertToVapi(childRule, model.ChildTlsRuleBindingType())
+	if len(errors) > 0 {
+		return nil, errors[0]
+	}
+
+	return dataValue.(*data.StructValue), nil
+}
+
+func getUpdatedTLSRuleChildren(d *schema.ResourceData) ([]*data.StructValue, error) {
+	var policyChildren []*data.StructValue
+
+	if !d.HasChange("rule") {
+		return nil, nil
+	}
+
+	oldRules, newRules := d.GetChange("rule")
+	rules := getPolicyTLSRulesFromSchema(d)
+	newRulesCount := len(newRules.([]interface{}))
+	oldRulesCount := len(oldRules.([]interface{}))
+	for ruleNo := 0; ruleNo < newRulesCount; ruleNo++ {
+		ruleIndicator := fmt.Sprintf("rule.%d", ruleNo)
+		if d.HasChange(ruleIndicator) {
+			rule := rules[ruleNo]
+			log.Printf("[DEBUG]: Updating child rule with id %s", *rule.Id)
+			childRule, err := createPolicyChildTLSRule(*rule.Id, rule, false)
+			if err != nil {
+				return policyChildren, err
+			}
+			policyChildren = append(policyChildren, childRule)
+		}
+	}
+
+	resourceType := "TlsRule"
+	for ruleNo := newRulesCount; ruleNo < oldRulesCount; ruleNo++ {
+		ruleIndicator := fmt.Sprintf("rule.%d", ruleNo)
+		oldRule, _ := d.GetChange(ruleIndicator)
+		oldRuleMap := oldRule.(map[string]interface{})
+		oldRuleID := oldRuleMap["nsx_id"].(string)
+		rule := model.TlsRule{
+			Id:           &oldRuleID,
+			ResourceType: &resourceType,
+		}
+
+		childRule, err := createPolicyChildTLSRule(oldRuleID, rule, true)
+		if err != nil {
+			return policyChildren, err
+		}
+		log.Printf("[DEBUG]: Deleting child rule with id %s", oldRuleID)
+		policyChildren = append(policyChildren, childRule)
+	}
+
+	return policyChildren, nil
+}
+
+func tlsPolicyInfraPatch(policy model.TlsPolicy, m interface{}) error {
+	converter := bindings.NewTypeConverter()
+
+	childPolicy := model.ChildTlsPolicy{
+		Id:           policy.Id,
+		ResourceType: "ChildTlsPolicy",
+		TlsPolicy:    &policy,
+	}
+
+	dataValue, errors := converter.ConvertToVapi(childPolicy, model.ChildTlsPolicyBindingType())
+	if len(errors) > 0 {
+		return fmt.Errorf("Failed to create H-API for TLS Inspection Policy: %s", errors[0])
+	}
+
+	var infraChildren []*data.StructValue
+	infraChildren = append(infraChildren, dataValue.(*data.StructValue))
+
+	infraType := "Infra"
+	infraObj := model.Infra{
+		Children:     infraChildren,
+		ResourceType: &infraType,
+	}
+
+	return policyInfraPatch(infraObj, false, getPolicyConnector(m), false)
+}
+
+func policyTLSInspectionPolicyBuildAndPatch(d *schema.ResourceData, m interface{}, id string) error {
+	displayName := d.Get("display_name").(string)
+	description := d.Get("description").(string)
+	tags := getPolicyTagsFromSchema(d)
+	comments := d.Get("comments").(string)
+	locked := d.Get("locked").(bool)
+	scope := getStringListFromSchemaSet(d, "scope")
+	sequenceNumber := int64(d.Get("sequence_number").(int))
+	revision := int64(d.Get("revision").(int))
+	objType := "TlsPolicy"
+
+	obj := model.TlsPolicy{
+		Id:             &id,
+		DisplayName:    &displayName,
+		Description:    &description,
+		Tags:           tags,
+		Comments:       &comments,
+		Locked:         &locked,
+		Scope:          scope,
+		SequenceNumber: &sequenceNumber,
+		ResourceType:   &objType,
+	}
+
+	if len(d.Id()) > 0 {
+		// This is update flow
+		obj.Revision = &revision
+	}
+
+	policyChildren, err := getUpdatedTLSRuleChildren(d)
+	if err != nil {
+		return err
+	}
+	if len(policyChildren) > 0 {
+		obj.Children = policyChildren
+	}
+
+	return tlsPolicyInfraPatch(obj, m)
+}
+
+func resourceNsxtPolicyTLSInspectionPolicyCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if isPolicyGlobalManager(m) {
+		return getErrorDiagnostics(policyResourceNotSupportedError())
+	}
+
+	// Initialize resource Id and verify this ID is not yet used
+	id, err := getOrGenerateID(d, m, resourceNsxtPolicyTLSInspectionPolicyExists)
+	if err != nil {
+		return getOperationDiagnostics(m, err)
+	}
+
+	log.Printf("[INFO] Creating TLS Inspection Policy with ID %s", id)
+	err = policyTLSInspectionPolicyBuildAndPatch(d, m, id)
+	if err != nil {
+		return getOperationDiagnostics(m, handleCreateError("TLS Inspection Policy", id, err))
+	}
+
+	d.SetId(id)
+	d.Set("nsx_id", id)
+
+	return resourceNsxtPolicyTLSInspectionPolicyRead(ctx, d, m)
+}
+
+func resourceNsxtPolicyTLSInspectionPolicyRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	connector := getPolicyConnector(m)
+	id := d.Id()
+	if id == "" {
+		return diag.Errorf("Error obtaining TLS Inspection Policy id")
+	}
+
+	client := infra.NewTlsInspectionPoliciesClient(connector)
+	obj, err := client.Get(id)
+	if err != nil {
+		return getOperationDiagnostics(m, handleReadError(d, "TLS Inspection Policy", id, err))
+	}
+
+	d.Set("display_name", obj.DisplayName)
+	d.Set("description", obj.Description)
+	setPolicyTagsInSchema(d, obj.Tags)
+	d.Set("nsx_id", id)
+	d.Set("path", obj.Path)
+	d.Set("comments", obj.Comments)
+	d.Set("locked", obj.Locked)
+	if len(obj.Scope) == 1 && obj.Scope[0] == "ANY" {
+		d.Set("scope", nil)
+	} else {
+		d.Set("scope", obj.Scope)
+	}
+	d.Set("sequence_number", obj.SequenceNumber)
+	d.Set("revision", obj.Revision)
+	return getErrorDiagnostics(setPolicyTLSRulesInSchema(d, obj.Rules))
+}
+
+func resourceNsxtPolicyTLSInspectionPolicyUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	id := d.Id()
+	if id == "" {
+		return diag.Errorf("Error obtaining TLS Inspection Policy id")
+	}
+
+	log.Printf("[INFO] Updating TLS Inspection Policy with ID %s", id)
+	err := policyTLSInspectionPolicyBuildAndPatch(d, m, id)
+	if err != nil {
+		return getOperationDiagnostics(m, handleUpdateError("TLS Inspection Policy", id, err))
+	}
+
+	return resourceNsxtPolicyTLSInspectionPolicyRead(ctx, d, m)
+}
+
+func resourceNsxtPolicyTLSInspectionPolicyDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	id := d.Id()
+	if id == "" {
+		return diag.Errorf("Error obtaining TLS Inspection Policy id")
+	}
+
+	connector := getPolicyConnector(m)
+	client := infra.NewTlsInspectionPoliciesClient(connector)
+	err := client.Delete(id)
+	if err != nil {
+		return getOperationDiagnostics(m, handleDeleteError("TLS Inspection Policy", id, err))
+	}
+
+	return nil
+}
@@ -0,0 +1,131 @@
+/* Copyright © 2023 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccResourceNsxtPolicyTLSInspectionPolicy_basic(t *testing.T) {
+	testResourceName := "nsxt_policy_tls_inspection_policy.test"
+	displayName := getAccTestResourceName()
+	updatedName := getAccTestResourceName()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccOnlyLocalManager(t)
+			testAccNSXVersion(t, "4.0.0")
+			testAccEnvDefined(t, "NSXT_TEST_CERTIFICATE_NAME")
+		},
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyResourceCheckDestroy(state, updatedName, "nsxt_policy_tls_inspection_policy", resourceNsxtPolicyTLSInspectionPolicyExists)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyTLSInspectionPolicyTemplate(displayName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyResourceExists(testResourceName, resourceNsxtPolicyTLSInspectionPolicyExists),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", displayName),
+					resource.TestCheckResourceAttr(testResourceName, "description", "terraform created"),
+					resource.TestCheckResourceAttr(testResourceName, "rule.#", "2"),
+					resource.TestCheckResourceAttr(testResourceName, "rule.0.display_name", "rule1"),
+					resource.TestCheckResourceAttrSet(testResourceName, "rule.0.tls_profile"),
+					resource.TestCheckResourceAttr(testResourceName, "rule.0.destination_groups.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "rule.1.display_name", "rule2"),
+					resource.TestCheckResourceAttrSet(testResourceName, "rule.1.nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "1"),
+				),
+			},
+			{
+				Config: testAccNsxtPolicyTLSInspectionPolicyTemplate(updatedName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyResourceExists(testResourceName, resourceNsxtPolicyTLSInspectionPolicyExists),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", updatedName),
+					resource.TestCheckResourceAttr(testResourceName, "rule.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "rule.0.display_name", "rule1"),
+					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNsxtPolicyTLSInspectionPolicy_importBasic(t *testing.T) {
+	name := getAccTestResourceName()
+	testResourceName := "nsxt_policy_tls_inspection_policy.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccOnlyLocalManager(t)
+			testAccNSXVersion(t, "4.0.0")
+			testAccEnvDefined(t, "NSXT_TEST_CERTIFICATE_NAME")
+		},
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyResourceCheckDestroy(state, name, "nsxt_policy_tls_inspection_policy", resourceNsxtPolicyTLSInspectionPolicyExists)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyTLSInspectionPolicyTemplate(name, true),
+			},
+			{
+				ResourceName:      testResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicyTLSInspectionPolicyTemplate(displayName string, withSecondRule bool) string {
+	secondRule := ""
+	if withSecondRule {
+		secondRule = `
+  rule {
+    display_name = "rule2"
+    tls_profile  = nsxt_policy_tls_inspection_external_profile.test.path
+    logged       = true
+  }`
+	}
+
+	return testAccNsxtPolicyCertificateReadTemplate(getTestCertificateName(false)) + fmt.Sprintf(`
+resource "nsxt_policy_tls_inspection_external_profile" "test" {
+  display_name          = "tls-policy-test"
+  proxy_trusted_ca_cert = data.nsxt_policy_certificate.test.path
+}
+
+resource "nsxt_policy_group" "test" {
+  display_name = "tls-policy-test"
+}
+
+resource "nsxt_policy_tls_inspection_policy" "test" {
+  display_name = "%s"
+  description  = "terraform created"
+
+  rule {
+    display_name       = "rule1"
+    destination_groups = [nsxt_policy_group.test.path]
+    tls_profile        = nsxt_policy_tls_inspection_external_profile.test.path
+  }
+  %s
+
+  tag {
+    scope = "scope1"
+    tag   = "tag1"
+  }
+}`, displayName, secondRule)
+}
@@ -0,0 +1,207 @@
+/* Copyright © 2023 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/sites/enforcement_points"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+var policyTransportZoneResourceTransportTypes = []string{
+	model.PolicyTransportZone_TZ_TYPE_OVERLAY_STANDARD,
+	model.PolicyTransportZone_TZ_TYPE_OVERLAY_ENS,
+	model.PolicyTransportZone_TZ_TYPE_VLAN_BACKED,
+}
+
+func resourceNsxtPolicyTransportZone() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceNsxtPolicyTransportZoneCreate,
+		ReadContext:   resourceNsxtPolicyTransportZoneRead,
+		UpdateContext: resourceNsxtPolicyTransportZoneUpdate,
+		DeleteContext: resourceNsxtPolicyTransportZoneDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"nsx_id":       getNsxIDSchema(),
+			"path":         getPathSchema(),
+			"display_name": getDisplayNameSchema(),
+			"description":  getDescriptionSchema(),
+			"revision":     getRevisionSchema(),
+			"tag":          getTagsSchema(),
+			"transport_type": {
+				Type:         schema.TypeString,
+				Description:  "Type of Transport Zone",
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(policyTransportZoneResourceTransportTypes, false),
+			},
+			"is_default": {
+				Type:        schema.TypeBool,
+				Description: "Indicates whether the transport zone is default",
+				Optional:    true,
+				Default:     false,
+			},
+			"nested_nsx": {
+				Type:        schema.TypeBool,
+				Description: "Indicates whether the transport zone is used for nested NSX",
+				Optional:    true,
+				Default:     false,
+				ForceNew:    true,
+			},
+			"uplink_teaming_policy_names": {
+				Type:        schema.TypeList,
+				Description: "Names of the switching uplink teaming policies that are supported by this transport zone",
+				Optional:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"transport_zone_profile_paths": {
+				Type:        schema.TypeList,
+				Description: "Policy paths of transport zone profiles",
+				Optional:    true,
+				Elem:        getElemPolicyPathSchema(),
+			},
+		},
+	}
+}
+
+func resourceNsxtPolicyTransportZoneExistsInEnforcementPoint(id string, epID string, connector client.Connector) (bool, error) {
+	client := enforcement_points.NewTransportZonesClient(connector)
+	_, err := client.Get(defaultSite, epID, id)
+	if err == nil {
+		return true, nil
+	}
+
+	if isNotFoundError(err) {
+		return false, nil
+	}
+
+	return false, logAPIError("Error retrieving Transport Zone", err)
+}
+
+func resourceNsxtPolicyTransportZoneExistsPartial(epID string) func(id string, connector client.Connector, isGlobalManager bool) (bool, error) {
+	return func(id string, connector client.Connector, isGlobalManager bool) (bool, error) {
+		return resourceNsxtPolicyTransportZoneExistsInEnforcementPoint(id, epID, connector)
+	}
+}
+
+func policyTransportZonePatch(d *schema.ResourceData, m interface{}, id string) error {
+	connector := getPolicyConnector(m)
+
+	displayName := d.Get("display_name").(string)
+	description := d.Get("description").(string)
+	tags := getPolicyTagsFromSchema(d)
+	tzType := d.Get("transport_type").(string)
+	isDefault := d.Get("is_default").(bool)
+	nestedNsx := d.Get("nested_nsx").(bool)
+	teamingPolicyNames := interface2StringList(d.Get("uplink_teaming_policy_names").([]interface{}))
+	profilePaths := interface2StringList(d.Get("transport_zone_profile_paths").([]interface{}))
+
+	obj := model.PolicyTransportZone{
+		DisplayName:               &displayName,
+		Description:               &description,
+		Tags:                      tags,
+		TzType:                    &tzType,
+		IsDefault:                 &isDefault,
+		NestedNsx:                 &nestedNsx,
+		UplinkTeamingPolicyNames:  teamingPolicyNames,
+		TransportZoneProfilePaths: profilePaths,
+	}
+
+	client := enforcement_points.NewTransportZonesClient(connector)
+	_, err := client.Patch(defaultSite, getPolicyEnforcementPoint(m), id, obj)
+	return err
+}
+
+func resourceNsxtPolicyTransportZoneCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if isPolicyGlobalManager(m) {
+		return getErrorDiagnostics(localManagerOnlyError())
+	}
+
+	// Initialize resource Id and verify this ID is not yet used
+	id, err := getOrGenerateID(d, m, resourceNsxtPolicyTransportZoneExistsPartial(getPolicyEnforcementPoint(m)))
+	if err != nil {
+		return getOperationDiagnostics(m, err)
+	}
+
+	log.Printf("[INFO] Creating Transport Zone with ID %s", id)
+	err = policyTransportZonePatch(d, m, id)
+	if err != nil {
+		return getOperationDiagnostics(m, handleCreateError("Transport Zone", id, err))
+	}
+
+	d.SetId(id)
+	d.Set("nsx_id", id)
+
+	return resourceNsxtPolicyTransportZoneRead(ctx, d, m)
+}
+
+func resourceNsxtPolicyTransportZoneRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	connector := getPolicyConnector(m)
+	id := d.Id()
+	if id == "" {
+		return diag.Errorf("Error obtaining Transport Zone ID")
+	}
+
+	client := enforcement_points.NewTransportZonesClient(connector)
+	obj, err := client.Get(defaultSite, getPolicyEnforcementPoint(m), id)
+	if err != nil {
+		return getOperationDiagnostics(m, handleReadError(d, "Transport Zone", id, err))
+	}
+
+	d.Set("display_name", obj.DisplayName)
+	d.Set("description", obj.Description)
+	setPolicyTagsInSchema(d, obj.Tags)
+	d.Set("nsx_id", id)
+	d.Set("path", obj.Path)
+	d.Set("revision", obj.Revision)
+	d.Set("transport_type", obj.TzType)
+	d.Set("is_default", obj.IsDefault)
+	d.Set("nested_nsx", obj.NestedNsx)
+	d.Set("uplink_teaming_policy_names", obj.UplinkTeamingPolicyNames)
+	d.Set("transport_zone_profile_paths", obj.TransportZoneProfilePaths)
+
+	return nil
+}
+
+func resourceNsxtPolicyTransportZoneUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	id := d.Id()
+	if id == "" {
+		return diag.Errorf("Error obtaining Transport Zone ID")
+	}
+
+	log.Printf("[INFO] Updating Transport Zone with ID %s", id)
+	err := policyTransportZonePatch(d, m, id)
+	if err != nil {
+		return getOperationDiagnostics(m, handleUpdateError("Transport Zone", id, err))
+	}
+
+	return resourceNsxtPolicyTransportZoneRead(ctx, d, m)
+}
+
+func resourceNsxtPolicyTransportZoneDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	id := d.Id()
+	if id == "" {
+		return diag.Errorf("Error obtaining Transport Zone ID")
+	}
+
+	connector := getPolicyConnector(m)
+	client := enforcement_points.NewTransportZonesClient(connector)
+	err := client.Delete(defaultSite, getPolicyEnforcementPoint(m), id)
+	if err != nil {
+		return getOperationDiagnostics(m, handleDeleteError("Transport Zone", id, err))
+	}
+
+	return nil
+}
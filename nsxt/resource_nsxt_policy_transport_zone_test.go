@@ -0,0 +1,110 @@
+/* Copyright © 2023 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccResourceNsxtPolicyTransportZone_overlay(t *testing.T) {
+	testAccResourceNsxtPolicyTransportZoneBasic(t, "OVERLAY_STANDARD")
+}
+
+func TestAccResourceNsxtPolicyTransportZone_vlan(t *testing.T) {
+	testAccResourceNsxtPolicyTransportZoneBasic(t, "VLAN_BACKED")
+}
+
+func testAccResourceNsxtPolicyTransportZoneBasic(t *testing.T, transportType string) {
+	testResourceName := "nsxt_policy_transport_zone.test"
+	name := getAccTestResourceName()
+	updatedName := getAccTestResourceName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccOnlyLocalManager(t)
+			testAccNSXVersion(t, "4.0.0")
+		},
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyResourceCheckDestroy(state, updatedName, "nsxt_policy_transport_zone", resourceNsxtPolicyTransportZoneExistsPartial(defaultEnforcementPoint))
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyTransportZoneTemplate(name, transportType, "teaming1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyResourceExists(testResourceName, resourceNsxtPolicyTransportZoneExistsPartial(defaultEnforcementPoint)),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", name),
+					resource.TestCheckResourceAttr(testResourceName, "description", "terraform created"),
+					resource.TestCheckResourceAttr(testResourceName, "transport_type", transportType),
+					resource.TestCheckResourceAttr(testResourceName, "is_default", "false"),
+					resource.TestCheckResourceAttr(testResourceName, "uplink_teaming_policy_names.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "uplink_teaming_policy_names.0", "teaming1"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "1"),
+					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+				),
+			},
+			{
+				Config: testAccNsxtPolicyTransportZoneTemplate(updatedName, transportType, "teaming2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyResourceExists(testResourceName, resourceNsxtPolicyTransportZoneExistsPartial(defaultEnforcementPoint)),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", updatedName),
+					resource.TestCheckResourceAttr(testResourceName, "transport_type", transportType),
+					resource.TestCheckResourceAttr(testResourceName, "uplink_teaming_policy_names.0", "teaming2"),
+					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNsxtPolicyTransportZone_importBasic(t *testing.T) {
+	name := getAccTestResourceName()
+	testResourceName := "nsxt_policy_transport_zone.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccOnlyLocalManager(t)
+			testAccNSXVersion(t, "4.0.0")
+		},
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyResourceCheckDestroy(state, name, "nsxt_policy_transport_zone", resourceNsxtPolicyTransportZoneExistsPartial(defaultEnforcementPoint))
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyTransportZoneTemplate(name, "OVERLAY_STANDARD", "teaming1"),
+			},
+			{
+				ResourceName:      testResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicyTransportZoneTemplate(name string, transportType string, teamingName string) string {
+	return fmt.Sprintf(`
+resource "nsxt_policy_transport_zone" "test" {
+  display_name                = "%s"
+  description                 = "terraform created"
+  transport_type              = "%s"
+  uplink_teaming_policy_names = ["%s"]
+
+  tag {
+    scope = "scope1"
+    tag   = "tag1"
+  }
+}`, name, transportType, teamingName)
+}
@@ -0,0 +1,406 @@
+/* Copyright © 2023 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+var uplinkTeamingPolicyValues = []string{
+	model.TeamingPolicy_POLICY_FAILOVER_ORDER,
+	model.TeamingPolicy_POLICY_LOADBALANCE_SRCID,
+	model.TeamingPolicy_POLICY_LOADBALANCE_SRC_MAC,
+}
+
+var uplinkTypeValues = []string{
+	model.Uplink_UPLINK_TYPE_PNIC,
+	model.Uplink_UPLINK_TYPE_LAG,
+}
+
+var uplinkOverlayEncapValues = []string{
+	model.PolicyUplinkHostSwitchProfile_OVERLAY_ENCAP_GENEVE,
+	model.PolicyUplinkHostSwitchProfile_OVERLAY_ENCAP_VXLAN,
+}
+
+var lagLoadBalanceAlgorithmValues = []string{
+	model.Lag_LOAD_BALANCE_ALGORITHM_SRCMAC,
+	model.Lag_LOAD_BALANCE_ALGORITHM_DESTMAC,
+	model.Lag_LOAD_BALANCE_ALGORITHM_SRCDESTMAC,
+	model.Lag_LOAD_BALANCE_ALGORITHM_SRCDESTIPVLAN,
+	model.Lag_LOAD_BALANCE_ALGORITHM_SRCDESTMACIPPORT,
+}
+
+var lagModeValues = []string{
+	model.Lag_MODE_ACTIVE,
+	model.Lag_MODE_PASSIVE,
+}
+
+var lagTimeoutTypeValues = []string{
+	model.Lag_TIMEOUT_TYPE_SLOW,
+	model.Lag_TIMEOUT_TYPE_FAST,
+}
+
+func resourceNsxtPolicyUplinkHostSwitchProfile() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceNsxtPolicyUplinkHostSwitchProfileCreate,
+		ReadContext:   resourceNsxtPolicyUplinkHostSwitchProfileRead,
+		UpdateContext: resourceNsxtPolicyUplinkHostSwitchProfileUpdate,
+		DeleteContext: resourceNsxtPolicyHostSwitchProfileDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"nsx_id":       getNsxIDSchema(),
+			"path":         getPathSchema(),
+			"display_name": getDisplayNameSchema(),
+			"description":  getDescriptionSchema(),
+			"revision":     getRevisionSchema(),
+			"tag":          getTagsSchema(),
+			"mtu": {
+				Type:         schema.TypeInt,
+				Description:  "Maximum Transmission Unit used for uplinks",
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1280),
+			},
+			"transport_vlan": {
+				Type:         schema.TypeInt,
+				Description:  "VLAN used for tagging Overlay traffic of associated Host Switch",
+				Optional:     true,
+				Default:      0,
+				ValidateFunc: validation.IntBetween(0, 4094),
+			},
+			"overlay_encap": {
+				Type:         schema.TypeString,
+				Description:  "The protocol used to encapsulate overlay traffic",
+				Optional:     true,
+				Default:      model.PolicyUplinkHostSwitchProfile_OVERLAY_ENCAP_GENEVE,
+				ValidateFunc: validation.StringInSlice(uplinkOverlayEncapValues, false),
+			},
+			"teaming": {
+				Type:        schema.TypeList,
+				Description: "Default TeamingPolicy associated with this UplinkProfile",
+				Required:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: getPolicyUplinkTeamingSchema(false),
+				},
+			},
+			"named_teaming": {
+				Type:        schema.TypeList,
+				Description: "List of named uplink teaming policies that can be used by logical switches",
+				Optional:    true,
+				Elem: &schema.Resource{
+					Schema: getPolicyUplinkTeamingSchema(true),
+				},
+			},
+			"lag": {
+				Type:        schema.TypeList,
+				Description: "List of LACP group",
+				Optional:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Description: "Unique id",
+							Computed:    true,
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Description: "Lag name",
+							Required:    true,
+						},
+						"load_balance_algorithm": {
+							Type:         schema.TypeString,
+							Description:  "LACP load balance Algorithm",
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(lagLoadBalanceAlgorithmValues, false),
+						},
+						"mode": {
+							Type:         schema.TypeString,
+							Description:  "LACP group mode",
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(lagModeValues, false),
+						},
+						"number_of_uplinks": {
+							Type:         schema.TypeInt,
+							Description:  "Number of uplinks",
+							Required:     true,
+							ValidateFunc: validation.IntBetween(2, 32),
+						},
+						"timeout_type": {
+							Type:         schema.TypeString,
+							Description:  "LACP timeout type",
+							Optional:     true,
+							Default:      model.Lag_TIMEOUT_TYPE_SLOW,
+							ValidateFunc: validation.StringInSlice(lagTimeoutTypeValues, false),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func getPolicyUplinkSchema(description string, isRequired bool) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Description: description,
+		Required:    isRequired,
+		Optional:    !isRequired,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"uplink_name": {
+					Type:        schema.TypeString,
+					Description: "Name of this uplink",
+					Required:    true,
+				},
+				"uplink_type": {
+					Type:         schema.TypeString,
+					Description:  "Type of the uplink",
+					Required:     true,
+					ValidateFunc: validation.StringInSlice(uplinkTypeValues, false),
+				},
+			},
+		},
+	}
+}
+
+func getPolicyUplinkTeamingSchema(isNamed bool) map[string]*schema.Schema {
+	elemSchema := map[string]*schema.Schema{
+		"active":  getPolicyUplinkSchema("List of Uplinks used in active list", true),
+		"standby": getPolicyUplinkSchema("List of Uplinks used in standby list", false),
+		"policy": {
+			Type:         schema.TypeString,
+			Description:  "Teaming policy",
+			Required:     true,
+			ValidateFunc: validation.StringInSlice(uplinkTeamingPolicyValues, false),
+		},
+	}
+	if isNamed {
+		elemSchema["name"] = &schema.Schema{
+			Type:        schema.TypeString,
+			Description: "The name of the uplink teaming policy",
+			Required:    true,
+		}
+	}
+
+	return elemSchema
+}
+
+func getPolicyUplinksFromSchema(uplinks []interface{}) []model.Uplink {
+	var result []model.Uplink
+	for _, u := range uplinks {
+		uplink := u.(map[string]interface{})
+		uplinkName := uplink["uplink_name"].(string)
+		uplinkType := uplink["uplink_type"].(string)
+		result = append(result, model.Uplink{
+			UplinkName: &uplinkName,
+			UplinkType: &uplinkType,
+		})
+	}
+
+	return result
+}
+
+func setPolicyUplinksInSchema(uplinks []model.Uplink) []interface{} {
+	var result []interface{}
+	for _, uplink := range uplinks {
+		elem := make(map[string]interface{})
+		elem["uplink_name"] = uplink.UplinkName
+		elem["uplink_type"] = uplink.UplinkType
+		result = append(result, elem)
+	}
+
+	return result
+}
+
+func getPolicyUplinkTeamingFromSchema(d *schema.ResourceData) *model.TeamingPolicy {
+	teamings := d.Get("teaming").([]interface{})
+	if len(teamings) == 0 || teamings[0] == nil {
+		return nil
+	}
+
+	teaming := teamings[0].(map[string]interface{})
+	policy := teaming["policy"].(string)
+	return &model.TeamingPolicy{
+		ActiveList:  getPolicyUplinksFromSchema(teaming["active"].([]interface{})),
+		StandbyList: getPolicyUplinksFromSchema(teaming["standby"].([]interface{})),
+		Policy:      &policy,
+	}
+}
+
+func getPolicyUplinkNamedTeamingsFromSchema(d *schema.ResourceData) []model.NamedTeamingPolicy {
+	var result []model.NamedTeamingPolicy
+	for _, t := range d.Get("named_teaming").([]interface{}) {
+		teaming := t.(map[string]interface{})
+		name := teaming["name"].(string)
+		policy := teaming["policy"].(string)
+		result = append(result, model.NamedTeamingPolicy{
+			Name:        &name,
+			ActiveList:  getPolicyUplinksFromSchema(teaming["active"].([]interface{})),
+			StandbyList: getPolicyUplinksFromSchema(teaming["standby"].([]interface{})),
+			Policy:      &policy,
+		})
+	}
+
+	return result
+}
+
+func getPolicyUplinkLagsFromSchema(d *schema.ResourceData) []model.Lag {
+	var result []model.Lag
+	for _, l := range d.Get("lag").([]interface{}) {
+		lag := l.(map[string]interface{})
+		name := lag["name"].(string)
+		loadBalanceAlgorithm := lag["load_balance_algorithm"].(string)
+		mode := lag["mode"].(string)
+		numberOfUplinks := int64(lag["number_of_uplinks"].(int))
+		timeoutType := lag["timeout_type"].(string)
+		result = append(result, model.Lag{
+			Name:                 &name,
+			LoadBalanceAlgorithm: &loadBalanceAlgorithm,
+			Mode:                 &mode,
+			NumberOfUplinks:      &numberOfUplinks,
+			TimeoutType:          &timeoutType,
+		})
+	}
+
+	return result
+}
+
+func setPolicyUplinkTeamingsInSchema(d *schema.ResourceData, teaming *model.TeamingPolicy, namedTeamings []model.NamedTeamingPolicy) {
+	var teamingList []interface{}
+	if teaming != nil {
+		elem := make(map[string]interface{})
+		elem["active"] = setPolicyUplinksInSchema(teaming.ActiveList)
+		elem["standby"] = setPolicyUplinksInSchema(teaming.StandbyList)
+		elem["policy"] = teaming.Policy
+		teamingList = append(teamingList, elem)
+	}
+	d.Set("teaming", teamingList)
+
+	var namedTeamingList []interface{}
+	for _, namedTeaming := range namedTeamings {
+		elem := make(map[string]interface{})
+		elem["name"] = namedTeaming.Name
+		elem["active"] = setPolicyUplinksInSchema(namedTeaming.ActiveList)
+		elem["standby"] = setPolicyUplinksInSchema(namedTeaming.StandbyList)
+		elem["policy"] = namedTeaming.Policy
+		namedTeamingList = append(namedTeamingList, elem)
+	}
+	d.Set("named_teaming", namedTeamingList)
+}
+
+func setPolicyUplinkLagsInSchema(d *schema.ResourceData, lags []model.Lag) {
+	var lagList []interface{}
+	for _, lag := range lags {
+		elem := make(map[string]interface{})
+		elem["id"] = lag.Id
+		elem["name"] = lag.Name
+		elem["load_balance_algorithm"] = lag.LoadBalanceAlgorithm
+		elem["mode"] = lag.Mode
+		elem["number_of_uplinks"] = lag.NumberOfUplinks
+		elem["timeout_type"] = lag.TimeoutType
+		lagList = append(lagList, elem)
+	}
+	d.Set("lag", lagList)
+}
+
+func policyUplinkHostSwitchProfilePatch(d *schema.ResourceData, m interface{}, id string) error {
+	displayName := d.Get("display_name").(string)
+	description := d.Get("description").(string)
+	tags := getPolicyTagsFromSchema(d)
+	transportVlan := int64(d.Get("transport_vlan").(int))
+	overlayEncap := d.Get("overlay_encap").(string)
+
+	obj := model.PolicyUplinkHostSwitchProfile{
+		DisplayName:   &displayName,
+		Description:   &description,
+		Tags:          tags,
+		ResourceType:  model.PolicyBaseHostSwitchProfile_RESOURCE_TYPE_POLICYUPLINKHOSTSWITCHPROFILE,
+		TransportVlan: &transportVlan,
+		OverlayEncap:  &overlayEncap,
+		Teaming:       getPolicyUplinkTeamingFromSchema(d),
+		NamedTeamings: getPolicyUplinkNamedTeamingsFromSchema(d),
+		Lags:          getPolicyUplinkLagsFromSchema(d),
+	}
+	mtu := int64(d.Get("mtu").(int))
+	if mtu > 0 {
+		obj.Mtu = &mtu
+	}
+
+	return policyHostSwitchProfilePatch(getPolicyConnector(m), id, obj, model.PolicyUplinkHostSwitchProfileBindingType())
+}
+
+func resourceNsxtPolicyUplinkHostSwitchProfileCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if isPolicyGlobalManager(m) {
+		return getErrorDiagnostics(localManagerOnlyError())
+	}
+
+	// Initialize resource Id and verify this ID is not yet used
+	id, err := getOrGenerateID(d, m, resourceNsxtPolicyHostSwitchProfileExists)
+	if err != nil {
+		return getOperationDiagnostics(m, err)
+	}
+
+	log.Printf("[INFO] Creating Uplink Host Switch Profile with ID %s", id)
+	err = policyUplinkHostSwitchProfilePatch(d, m, id)
+	if err != nil {
+		return getOperationDiagnostics(m, handleCreateError("Uplink Host Switch Profile", id, err))
+	}
+
+	d.SetId(id)
+	d.Set("nsx_id", id)
+
+	return resourceNsxtPolicyUplinkHostSwitchProfileRead(ctx, d, m)
+}
+
+func resourceNsxtPolicyUplinkHostSwitchProfileRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	connector := getPolicyConnector(m)
+	id := d.Id()
+	if id == "" {
+		return diag.Errorf("Error obtaining Uplink Host Switch Profile ID")
+	}
+
+	profile, err := policyHostSwitchProfileGet(connector, id, model.PolicyUplinkHostSwitchProfileBindingType())
+	if err != nil {
+		return getOperationDiagnostics(m, handleReadError(d, "Uplink Host Switch Profile", id, err))
+	}
+	obj := profile.(model.PolicyUplinkHostSwitchProfile)
+
+	d.Set("display_name", obj.DisplayName)
+	d.Set("description", obj.Description)
+	setPolicyTagsInSchema(d, obj.Tags)
+	d.Set("nsx_id", id)
+	d.Set("path", obj.Path)
+	d.Set("revision", obj.Revision)
+	d.Set("mtu", obj.Mtu)
+	d.Set("transport_vlan", obj.TransportVlan)
+	d.Set("overlay_encap", obj.OverlayEncap)
+	setPolicyUplinkTeamingsInSchema(d, obj.Teaming, obj.NamedTeamings)
+	setPolicyUplinkLagsInSchema(d, obj.Lags)
+
+	return nil
+}
+
+func resourceNsxtPolicyUplinkHostSwitchProfileUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	id := d.Id()
+	if id == "" {
+		return diag.Errorf("Error obtaining Uplink Host Switch Profile ID")
+	}
+
+	log.Printf("[INFO] Updating Uplink Host Switch Profile with ID %s", id)
+	err := policyUplinkHostSwitchProfilePatch(d, m, id)
+	if err != nil {
+		return getOperationDiagnostics(m, handleUpdateError("Uplink Host Switch Profile", id, err))
+	}
+
+	return resourceNsxtPolicyUplinkHostSwitchProfileRead(ctx, d, m)
+}
@@ -0,0 +1,140 @@
+/* Copyright © 2023 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccResourceNsxtPolicyUplinkHostSwitchProfile_basic(t *testing.T) {
+	testResourceName := "nsxt_policy_uplink_host_switch_profile.test"
+	name := getAccTestResourceName()
+	updatedName := getAccTestResourceName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccOnlyLocalManager(t)
+			testAccNSXVersion(t, "4.0.0")
+		},
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyResourceCheckDestroy(state, updatedName, "nsxt_policy_uplink_host_switch_profile", resourceNsxtPolicyHostSwitchProfileExists)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyUplinkHostSwitchProfileTemplate(name, 1600, 10),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyResourceExists(testResourceName, resourceNsxtPolicyHostSwitchProfileExists),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", name),
+					resource.TestCheckResourceAttr(testResourceName, "description", "terraform created"),
+					resource.TestCheckResourceAttr(testResourceName, "mtu", "1600"),
+					resource.TestCheckResourceAttr(testResourceName, "transport_vlan", "10"),
+					resource.TestCheckResourceAttr(testResourceName, "overlay_encap", "GENEVE"),
+					resource.TestCheckResourceAttr(testResourceName, "teaming.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "teaming.0.policy", "FAILOVER_ORDER"),
+					resource.TestCheckResourceAttr(testResourceName, "teaming.0.active.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "teaming.0.standby.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "named_teaming.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "named_teaming.0.name", "named1"),
+					resource.TestCheckResourceAttr(testResourceName, "lag.#", "1"),
+					resource.TestCheckResourceAttrSet(testResourceName, "lag.0.id"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "1"),
+					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+				),
+			},
+			{
+				Config: testAccNsxtPolicyUplinkHostSwitchProfileTemplate(updatedName, 9000, 20),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyResourceExists(testResourceName, resourceNsxtPolicyHostSwitchProfileExists),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", updatedName),
+					resource.TestCheckResourceAttr(testResourceName, "mtu", "9000"),
+					resource.TestCheckResourceAttr(testResourceName, "transport_vlan", "20"),
+					resource.TestCheckResourceAttr(testResourceName, "teaming.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "named_teaming.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "lag.#", "1"),
+					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNsxtPolicyUplinkHostSwitchProfile_importBasic(t *testing.T) {
+	name := getAccTestResourceName()
+	testResourceName := "nsxt_policy_uplink_host_switch_profile.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccOnlyLocalManager(t)
+			testAccNSXVersion(t, "4.0.0")
+		},
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyResourceCheckDestroy(state, name, "nsxt_policy_uplink_host_switch_profile", resourceNsxtPolicyHostSwitchProfileExists)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyUplinkHostSwitchProfileTemplate(name, 1600, 10),
+			},
+			{
+				ResourceName:      testResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicyUplinkHostSwitchProfileTemplate(name string, mtu int, vlan int) string {
+	return fmt.Sprintf(`
+resource "nsxt_policy_uplink_host_switch_profile" "test" {
+  display_name   = "%s"
+  description    = "terraform created"
+  mtu            = %d
+  transport_vlan = %d
+
+  teaming {
+    policy = "FAILOVER_ORDER"
+    active {
+      uplink_name = "uplink1"
+      uplink_type = "PNIC"
+    }
+    standby {
+      uplink_name = "uplink2"
+      uplink_type = "PNIC"
+    }
+  }
+
+  named_teaming {
+    name   = "named1"
+    policy = "LOADBALANCE_SRCID"
+    active {
+      uplink_name = "uplink3"
+      uplink_type = "PNIC"
+    }
+  }
+
+  lag {
+    name                   = "lag1"
+    load_balance_algorithm = "SRCDESTIPVLAN"
+    mode                   = "ACTIVE"
+    number_of_uplinks      = 2
+  }
+
+  tag {
+    scope = "scope1"
+    tag   = "tag1"
+  }
+}`, name, mtu, vlan)
+}
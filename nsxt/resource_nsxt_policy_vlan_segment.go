@@ -4,6 +4,9 @@
 package nsxt
 
 import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -13,30 +16,30 @@ func resourceNsxtPolicyVlanSegment() *schema.Resource {
 	delete(segSchema, "connectivity_path")
 
 	return &schema.Resource{
-		Create: resourceNsxtPolicyVlanSegmentCreate,
-		Read:   resourceNsxtPolicyVlanSegmentRead,
-		Update: resourceNsxtPolicyVlanSegmentUpdate,
-		Delete: resourceNsxtPolicyVlanSegmentDelete,
+		CreateContext: resourceNsxtPolicyVlanSegmentCreate,
+		ReadContext:   resourceNsxtPolicyVlanSegmentRead,
+		UpdateContext: resourceNsxtPolicyVlanSegmentUpdate,
+		DeleteContext: resourceNsxtPolicyVlanSegmentDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: nsxtPolicyContextResourceImporter,
 		},
 
 		Schema: segSchema,
 	}
 }
 
-func resourceNsxtPolicyVlanSegmentCreate(d *schema.ResourceData, m interface{}) error {
-	return nsxtPolicySegmentCreate(d, m, true, false)
+func resourceNsxtPolicyVlanSegmentCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	return getOperationDiagnostics(m, nsxtPolicySegmentCreate(d, m, true, false))
 }
 
-func resourceNsxtPolicyVlanSegmentRead(d *schema.ResourceData, m interface{}) error {
-	return nsxtPolicySegmentRead(d, m, true, false)
+func resourceNsxtPolicyVlanSegmentRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	return getOperationDiagnostics(m, nsxtPolicySegmentRead(d, m, true, false))
 }
 
-func resourceNsxtPolicyVlanSegmentUpdate(d *schema.ResourceData, m interface{}) error {
-	return nsxtPolicySegmentUpdate(d, m, true, false)
+func resourceNsxtPolicyVlanSegmentUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	return getOperationDiagnostics(m, nsxtPolicySegmentUpdate(d, m, true, false))
 }
 
-func resourceNsxtPolicyVlanSegmentDelete(d *schema.ResourceData, m interface{}) error {
-	return nsxtPolicySegmentDelete(d, m, false)
+func resourceNsxtPolicyVlanSegmentDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	return getOperationDiagnostics(m, nsxtPolicySegmentDelete(d, m, false))
 }
@@ -4,11 +4,13 @@
 package nsxt
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strconv"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
 	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/realized_state"
@@ -24,12 +26,12 @@ var (
 
 func resourceNsxtPolicyVMTags() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtPolicyVMTagsCreate,
-		Read:   resourceNsxtPolicyVMTagsRead,
-		Update: resourceNsxtPolicyVMTagsUpdate,
-		Delete: resourceNsxtPolicyVMTagsDelete,
+		CreateContext: resourceNsxtPolicyVMTagsCreate,
+		ReadContext:   resourceNsxtPolicyVMTagsRead,
+		UpdateContext: resourceNsxtPolicyVMTagsUpdate,
+		DeleteContext: resourceNsxtPolicyVMTagsDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -295,12 +297,12 @@ func setPolicyVMPortTagsInSchema(d *schema.ResourceData, m interface{}, external
 	return nil
 }
 
-func resourceNsxtPolicyVMTagsRead(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyVMTagsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 
 	vmID := d.Id()
 	if vmID == "" {
-		return fmt.Errorf("Error obtaining Virtual Machine ID")
+		return diag.Errorf("Error obtaining Virtual Machine ID")
 	}
 
 	vm, err := findNsxtPolicyVMByID(connector, vmID, m)
@@ -317,10 +319,10 @@ func resourceNsxtPolicyVMTagsRead(d *schema.ResourceData, m interface{}) error {
 		d.Set("instance_id", vm.ExternalId)
 	}
 
-	return setPolicyVMPortTagsInSchema(d, m, *vm.ExternalId)
+	return getErrorDiagnostics(setPolicyVMPortTagsInSchema(d, m, *vm.ExternalId))
 }
 
-func resourceNsxtPolicyVMTagsCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyVMTagsCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 	instanceID := d.Get("instance_id").(string)
 
@@ -328,7 +330,7 @@ func resourceNsxtPolicyVMTagsCreate(d *schema.ResourceData, m interface{}) error
 	if err != nil {
 		if len(d.Id()) == 0 {
 			// This is create flow
-			return fmt.Errorf("Cannot find VM with ID %s", instanceID)
+			return diag.Errorf("Cannot find VM with ID %s", instanceID)
 		}
 		// Update flow - when VM is deleted, we don't want to error out,
 		// Customers prefer to swallow the error
@@ -343,26 +345,26 @@ func resourceNsxtPolicyVMTagsCreate(d *schema.ResourceData, m interface{}) error
 
 	err = updateNsxtPolicyVMTags(connector, *vm.ExternalId, tags, m)
 	if err != nil {
-		return handleCreateError("Virtual Machine Tag", *vm.ExternalId, err)
+		return getOperationDiagnostics(m, handleCreateError("Virtual Machine Tag", *vm.ExternalId, err))
 	}
 
 	portTags := d.Get("port").([]interface{})
 	err = updateNsxtPolicyVMPortTags(connector, *vm.ExternalId, portTags, m, false)
 	if err != nil {
-		return handleCreateError("Segment Port Tag", *vm.ExternalId, err)
+		return getOperationDiagnostics(m, handleCreateError("Segment Port Tag", *vm.ExternalId, err))
 	}
 
 	d.SetId(*vm.ExternalId)
 	d.Set("port", portTags)
 
-	return resourceNsxtPolicyVMTagsRead(d, m)
+	return resourceNsxtPolicyVMTagsRead(ctx, d, m)
 }
 
-func resourceNsxtPolicyVMTagsUpdate(d *schema.ResourceData, m interface{}) error {
-	return resourceNsxtPolicyVMTagsCreate(d, m)
+func resourceNsxtPolicyVMTagsUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	return resourceNsxtPolicyVMTagsCreate(ctx, d, m)
 }
 
-func resourceNsxtPolicyVMTagsDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyVMTagsDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	connector := getPolicyConnector(m)
 	instanceID := d.Get("instance_id").(string)
 
@@ -378,14 +380,14 @@ func resourceNsxtPolicyVMTagsDelete(d *schema.ResourceData, m interface{}) error
 	err = updateNsxtPolicyVMTags(connector, *vm.ExternalId, tags, m)
 
 	if err != nil {
-		return handleDeleteError("Virtual Machine Tag", *vm.ExternalId, err)
+		return getOperationDiagnostics(m, handleDeleteError("Virtual Machine Tag", *vm.ExternalId, err))
 	}
 
 	portTags := d.Get("port").([]interface{})
 	err = updateNsxtPolicyVMPortTags(connector, *vm.ExternalId, portTags, m, true)
 	if err != nil {
-		return handleDeleteError("Segment Port Tag", *vm.ExternalId, err)
+		return getOperationDiagnostics(m, handleDeleteError("Segment Port Tag", *vm.ExternalId, err))
 	}
 
-	return err
+	return getOperationDiagnostics(m, err)
 }
@@ -4,10 +4,12 @@
 package nsxt
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"net/http"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/vmware/go-vmware-nsxt/manager"
@@ -23,12 +25,12 @@ var rateShaperResourceTypes = []string{"IngressRateShaper", "IngressBroadcastRat
 
 func resourceNsxtQosSwitchingProfile() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtQosSwitchingProfileCreate,
-		Read:   resourceNsxtQosSwitchingProfileRead,
-		Update: resourceNsxtQosSwitchingProfileUpdate,
-		Delete: resourceNsxtQosSwitchingProfileDelete,
+		CreateContext: resourceNsxtQosSwitchingProfileCreate,
+		ReadContext:   resourceNsxtQosSwitchingProfileRead,
+		UpdateContext: resourceNsxtQosSwitchingProfileUpdate,
+		DeleteContext: resourceNsxtQosSwitchingProfileDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		DeprecationMessage: mpObjectResourceDeprecationMessage,
 		Schema: map[string]*schema.Schema{
@@ -173,10 +175,10 @@ func setQosRateShaperInSchema(d *schema.ResourceData, shaperConf []manager.QosBa
 
 }
 
-func resourceNsxtQosSwitchingProfileCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtQosSwitchingProfileCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return getErrorDiagnostics(resourceNotSupportedError())
 	}
 
 	description := d.Get("description").(string)
@@ -212,26 +214,26 @@ func resourceNsxtQosSwitchingProfileCreate(d *schema.ResourceData, m interface{}
 	qosSwitchingProfile, resp, err := nsxClient.LogicalSwitchingApi.CreateQosSwitchingProfile(nsxClient.Context, qosSwitchingProfile)
 
 	if err != nil {
-		return fmt.Errorf("Error during QosSwitchingProfile create: %v", err)
+		return diag.Errorf("Error during QosSwitchingProfile create: %v", err)
 	}
 
 	if resp.StatusCode != http.StatusCreated {
-		return fmt.Errorf("Unexpected status returned during QosSwitchingProfile create: %v", resp.StatusCode)
+		return diag.Errorf("Unexpected status returned during QosSwitchingProfile create: %v", resp.StatusCode)
 	}
 	d.SetId(qosSwitchingProfile.Id)
 
-	return resourceNsxtQosSwitchingProfileRead(d, m)
+	return resourceNsxtQosSwitchingProfileRead(ctx, d, m)
 }
 
-func resourceNsxtQosSwitchingProfileRead(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtQosSwitchingProfileRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return getErrorDiagnostics(resourceNotSupportedError())
 	}
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining logical object id")
+		return diag.Errorf("Error obtaining logical object id")
 	}
 
 	qosSwitchingProfile, resp, err := nsxClient.LogicalSwitchingApi.GetQosSwitchingProfile(nsxClient.Context, id)
@@ -241,7 +243,7 @@ func resourceNsxtQosSwitchingProfileRead(d *schema.ResourceData, m interface{})
 		return nil
 	}
 	if err != nil {
-		return fmt.Errorf("Error during QosSwitchingProfile read: %v", err)
+		return diag.Errorf("Error during QosSwitchingProfile read: %v", err)
 	}
 
 	d.Set("revision", qosSwitchingProfile.Revision)
@@ -262,15 +264,15 @@ func resourceNsxtQosSwitchingProfileRead(d *schema.ResourceData, m interface{})
 	return nil
 }
 
-func resourceNsxtQosSwitchingProfileUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtQosSwitchingProfileUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return getErrorDiagnostics(resourceNotSupportedError())
 	}
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining logical object id")
+		return diag.Errorf("Error obtaining logical object id")
 	}
 
 	description := d.Get("description").(string)
@@ -307,26 +309,26 @@ func resourceNsxtQosSwitchingProfileUpdate(d *schema.ResourceData, m interface{}
 	_, resp, err := nsxClient.LogicalSwitchingApi.UpdateQosSwitchingProfile(nsxClient.Context, id, qosSwitchingProfile)
 
 	if err != nil || resp.StatusCode == http.StatusNotFound {
-		return fmt.Errorf("Error during QosSwitchingProfile update: %v", err)
+		return diag.Errorf("Error during QosSwitchingProfile update: %v", err)
 	}
 
-	return resourceNsxtQosSwitchingProfileRead(d, m)
+	return resourceNsxtQosSwitchingProfileRead(ctx, d, m)
 }
 
-func resourceNsxtQosSwitchingProfileDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtQosSwitchingProfileDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return getErrorDiagnostics(resourceNotSupportedError())
 	}
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining logical object id")
+		return diag.Errorf("Error obtaining logical object id")
 	}
 
 	resp, err := nsxClient.LogicalSwitchingApi.DeleteSwitchingProfile(nsxClient.Context, id, nil)
 	if err != nil {
-		return fmt.Errorf("Error during QosSwitchingProfile delete: %v", err)
+		return diag.Errorf("Error during QosSwitchingProfile delete: %v", err)
 	}
 
 	if resp.StatusCode == http.StatusNotFound {
@@ -4,22 +4,23 @@
 package nsxt
 
 import (
-	"fmt"
+	"context"
 	"log"
 	"net/http"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/vmware/go-vmware-nsxt/manager"
 )
 
 func resourceNsxtSpoofGuardSwitchingProfile() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtSpoofGuardSwitchingProfileCreate,
-		Read:   resourceNsxtSpoofGuardSwitchingProfileRead,
-		Update: resourceNsxtSpoofGuardSwitchingProfileUpdate,
-		Delete: resourceNsxtSpoofGuardSwitchingProfileDelete,
+		CreateContext: resourceNsxtSpoofGuardSwitchingProfileCreate,
+		ReadContext:   resourceNsxtSpoofGuardSwitchingProfileRead,
+		UpdateContext: resourceNsxtSpoofGuardSwitchingProfileUpdate,
+		DeleteContext: resourceNsxtSpoofGuardSwitchingProfileDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		DeprecationMessage: mpObjectResourceDeprecationMessage,
 		Schema: map[string]*schema.Schema{
@@ -46,10 +47,10 @@ func resourceNsxtSpoofGuardSwitchingProfile() *schema.Resource {
 	}
 }
 
-func resourceNsxtSpoofGuardSwitchingProfileCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtSpoofGuardSwitchingProfileCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return getErrorDiagnostics(resourceNotSupportedError())
 	}
 
 	description := d.Get("description").(string)
@@ -70,26 +71,26 @@ func resourceNsxtSpoofGuardSwitchingProfileCreate(d *schema.ResourceData, m inte
 	sgSwitchingProfile, resp, err := nsxClient.LogicalSwitchingApi.CreateSpoofGuardSwitchingProfile(nsxClient.Context, sgSwitchingProfile)
 
 	if err != nil {
-		return fmt.Errorf("Error during SpoofGuardSwitchingProfile create: %v", err)
+		return diag.Errorf("Error during SpoofGuardSwitchingProfile create: %v", err)
 	}
 
 	if resp.StatusCode != http.StatusCreated {
-		return fmt.Errorf("Unexpected status returned during SpoofGuardSwitchingProfile create: %v", resp.StatusCode)
+		return diag.Errorf("Unexpected status returned during SpoofGuardSwitchingProfile create: %v", resp.StatusCode)
 	}
 	d.SetId(sgSwitchingProfile.Id)
 
-	return resourceNsxtSpoofGuardSwitchingProfileRead(d, m)
+	return resourceNsxtSpoofGuardSwitchingProfileRead(ctx, d, m)
 }
 
-func resourceNsxtSpoofGuardSwitchingProfileRead(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtSpoofGuardSwitchingProfileRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return getErrorDiagnostics(resourceNotSupportedError())
 	}
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining logical object id")
+		return diag.Errorf("Error obtaining logical object id")
 	}
 
 	sgSwitchingProfile, resp, err := nsxClient.LogicalSwitchingApi.GetSpoofGuardSwitchingProfile(nsxClient.Context, id)
@@ -99,7 +100,7 @@ func resourceNsxtSpoofGuardSwitchingProfileRead(d *schema.ResourceData, m interf
 		return nil
 	}
 	if err != nil {
-		return fmt.Errorf("Error during SpoofGuardSwitchingProfile read: %v", err)
+		return diag.Errorf("Error during SpoofGuardSwitchingProfile read: %v", err)
 	}
 
 	d.Set("revision", sgSwitchingProfile.Revision)
@@ -115,15 +116,15 @@ func resourceNsxtSpoofGuardSwitchingProfileRead(d *schema.ResourceData, m interf
 	return nil
 }
 
-func resourceNsxtSpoofGuardSwitchingProfileUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtSpoofGuardSwitchingProfileUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return getErrorDiagnostics(resourceNotSupportedError())
 	}
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining logical object id")
+		return diag.Errorf("Error obtaining logical object id")
 	}
 
 	description := d.Get("description").(string)
@@ -146,26 +147,26 @@ func resourceNsxtSpoofGuardSwitchingProfileUpdate(d *schema.ResourceData, m inte
 	_, resp, err := nsxClient.LogicalSwitchingApi.UpdateSpoofGuardSwitchingProfile(nsxClient.Context, id, sgSwitchingProfile)
 
 	if err != nil || resp.StatusCode == http.StatusNotFound {
-		return fmt.Errorf("Error during SpoofGuardSwitchingProfile update: %v", err)
+		return diag.Errorf("Error during SpoofGuardSwitchingProfile update: %v", err)
 	}
 
-	return resourceNsxtSpoofGuardSwitchingProfileRead(d, m)
+	return resourceNsxtSpoofGuardSwitchingProfileRead(ctx, d, m)
 }
 
-func resourceNsxtSpoofGuardSwitchingProfileDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtSpoofGuardSwitchingProfileDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return getErrorDiagnostics(resourceNotSupportedError())
 	}
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining logical object id")
+		return diag.Errorf("Error obtaining logical object id")
 	}
 
 	resp, err := nsxClient.LogicalSwitchingApi.DeleteSwitchingProfile(nsxClient.Context, id, nil)
 	if err != nil {
-		return fmt.Errorf("Error during SpoofGuardSwitchingProfile delete: %v", err)
+		return diag.Errorf("Error during SpoofGuardSwitchingProfile delete: %v", err)
 	}
 
 	if resp.StatusCode == http.StatusNotFound {
@@ -4,22 +4,23 @@
 package nsxt
 
 import (
-	"fmt"
+	"context"
 	"log"
 	"net/http"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/vmware/go-vmware-nsxt/manager"
 )
 
 func resourceNsxtVlanLogicalSwitch() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtVlanLogicalSwitchCreate,
-		Read:   resourceNsxtVlanLogicalSwitchRead,
-		Update: resourceNsxtVlanLogicalSwitchUpdate,
-		Delete: resourceNsxtVlanLogicalSwitchDelete,
+		CreateContext: resourceNsxtVlanLogicalSwitchCreate,
+		ReadContext:   resourceNsxtVlanLogicalSwitchRead,
+		UpdateContext: resourceNsxtVlanLogicalSwitchUpdate,
+		DeleteContext: resourceNsxtVlanLogicalSwitchDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		DeprecationMessage: mpObjectResourceDeprecationMessage,
 		Schema: map[string]*schema.Schema{
@@ -65,10 +66,10 @@ func resourceNsxtVlanLogicalSwitch() *schema.Resource {
 	}
 }
 
-func resourceNsxtVlanLogicalSwitchCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtVlanLogicalSwitchCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return getErrorDiagnostics(resourceNotSupportedError())
 	}
 
 	description := d.Get("description").(string)
@@ -98,34 +99,34 @@ func resourceNsxtVlanLogicalSwitchCreate(d *schema.ResourceData, m interface{})
 	logicalSwitch, resp, err := nsxClient.LogicalSwitchingApi.CreateLogicalSwitch(nsxClient.Context, logicalSwitch)
 
 	if err != nil {
-		return fmt.Errorf("Error during LogicalSwitch create: %v", err)
+		return diag.Errorf("Error during LogicalSwitch create: %v", err)
 	}
 
 	if resp.StatusCode != http.StatusCreated {
-		return fmt.Errorf("Unexpected status returned during LogicalSwitch create: %v", resp.StatusCode)
+		return diag.Errorf("Unexpected status returned during LogicalSwitch create: %v", resp.StatusCode)
 	}
 
 	toleratePartialSuccess := getCommonProviderConfig(m.(nsxtClients)).ToleratePartialSuccess
-	err = resourceNsxtLogicalSwitchVerifyRealization(d, nsxClient, &logicalSwitch, toleratePartialSuccess)
+	err = resourceNsxtLogicalSwitchVerifyRealization(ctx, d, nsxClient, &logicalSwitch, toleratePartialSuccess)
 
 	if err != nil {
-		return err
+		return getOperationDiagnostics(m, err)
 	}
 
 	d.SetId(logicalSwitch.Id)
 
-	return resourceNsxtVlanLogicalSwitchRead(d, m)
+	return resourceNsxtVlanLogicalSwitchRead(ctx, d, m)
 }
 
-func resourceNsxtVlanLogicalSwitchRead(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtVlanLogicalSwitchRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return getErrorDiagnostics(resourceNotSupportedError())
 	}
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining logical switch id")
+		return diag.Errorf("Error obtaining logical switch id")
 	}
 
 	logicalSwitch, resp, err := nsxClient.LogicalSwitchingApi.GetLogicalSwitch(nsxClient.Context, id)
@@ -135,7 +136,7 @@ func resourceNsxtVlanLogicalSwitchRead(d *schema.ResourceData, m interface{}) er
 		return nil
 	}
 	if err != nil {
-		return fmt.Errorf("Error during LogicalSwitch read: %v", err)
+		return diag.Errorf("Error during LogicalSwitch read: %v", err)
 	}
 
 	d.Set("revision", logicalSwitch.Revision)
@@ -144,14 +145,14 @@ func resourceNsxtVlanLogicalSwitchRead(d *schema.ResourceData, m interface{}) er
 	setTagsInSchema(d, logicalSwitch.Tags)
 	err = setAddressBindingsInSchema(d, logicalSwitch.AddressBindings)
 	if err != nil {
-		return fmt.Errorf("Error during logical switch address bindings set in schema: %v", err)
+		return diag.Errorf("Error during logical switch address bindings set in schema: %v", err)
 	}
 	d.Set("admin_state", logicalSwitch.AdminState)
 	d.Set("ip_pool_id", logicalSwitch.IpPoolId)
 	d.Set("mac_pool_id", logicalSwitch.MacPoolId)
 	err = setSwitchingProfileIdsInSchema(d, nsxClient, logicalSwitch.SwitchingProfileIds)
 	if err != nil {
-		return fmt.Errorf("Error during logical switch profiles set in schema: %v", err)
+		return diag.Errorf("Error during logical switch profiles set in schema: %v", err)
 	}
 	d.Set("transport_zone_id", logicalSwitch.TransportZoneId)
 	d.Set("vlan", logicalSwitch.Vlan)
@@ -159,15 +160,15 @@ func resourceNsxtVlanLogicalSwitchRead(d *schema.ResourceData, m interface{}) er
 	return nil
 }
 
-func resourceNsxtVlanLogicalSwitchUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtVlanLogicalSwitchUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return getErrorDiagnostics(resourceNotSupportedError())
 	}
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining logical switch id")
+		return diag.Errorf("Error obtaining logical switch id")
 	}
 
 	description := d.Get("description").(string)
@@ -197,28 +198,28 @@ func resourceNsxtVlanLogicalSwitchUpdate(d *schema.ResourceData, m interface{})
 
 	_, resp, err := nsxClient.LogicalSwitchingApi.UpdateLogicalSwitch(nsxClient.Context, id, logicalSwitch)
 	if err != nil || resp.StatusCode == http.StatusNotFound {
-		return fmt.Errorf("Error during LogicalSwitch update: %v", err)
+		return diag.Errorf("Error during LogicalSwitch update: %v", err)
 	}
 
-	return resourceNsxtVlanLogicalSwitchRead(d, m)
+	return resourceNsxtVlanLogicalSwitchRead(ctx, d, m)
 }
 
-func resourceNsxtVlanLogicalSwitchDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtVlanLogicalSwitchDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return getErrorDiagnostics(resourceNotSupportedError())
 	}
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining logical switch id")
+		return diag.Errorf("Error obtaining logical switch id")
 	}
 
 	localVarOptionals := make(map[string]interface{})
 	localVarOptionals["cascade"] = true
 	resp, err := nsxClient.LogicalSwitchingApi.DeleteLogicalSwitch(nsxClient.Context, id, localVarOptionals)
 	if err != nil {
-		return fmt.Errorf("Error during LogicalSwitch delete: %v", err)
+		return diag.Errorf("Error during LogicalSwitch delete: %v", err)
 	}
 
 	if resp.StatusCode == http.StatusNotFound {
@@ -1,11 +1,13 @@
 package nsxt
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"net/http"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	api "github.com/vmware/go-vmware-nsxt"
 	"github.com/vmware/go-vmware-nsxt/common"
@@ -17,12 +19,12 @@ import (
 
 func resourceNsxtVMTags() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtVMTagsCreate,
-		Read:   resourceNsxtVMTagsRead,
-		Update: resourceNsxtVMTagsUpdate,
-		Delete: resourceNsxtVMTagsDelete,
+		CreateContext: resourceNsxtVMTagsCreate,
+		ReadContext:   resourceNsxtVMTagsRead,
+		UpdateContext: resourceNsxtVMTagsUpdate,
+		DeleteContext: resourceNsxtVMTagsDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		DeprecationMessage: mpObjectResourceDeprecationMessage,
 		Schema: map[string]*schema.Schema{
@@ -207,24 +209,24 @@ func updatePortTags(nsxClient *api.APIClient, id string, tags []common.Tag) erro
 	return nil
 }
 
-func resourceNsxtVMTagsCreate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtVMTagsCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	instanceID := d.Get("instance_id").(string)
 
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return getErrorDiagnostics(resourceNotSupportedError())
 	}
 
 	vm, err := findVMIDByLocalID(nsxClient, instanceID)
 	if err != nil {
-		return fmt.Errorf("Error during VM retrieval: %v", err)
+		return diag.Errorf("Error during VM retrieval: %v", err)
 	}
 
 	tags := getTagsFromSchema(d)
 	if len(tags) > 0 || d.HasChange("tag") {
 		err = updateTags(nsxClient, vm.ExternalId, tags)
 		if err != nil {
-			return err
+			return getOperationDiagnostics(m, err)
 		}
 	}
 
@@ -232,34 +234,34 @@ func resourceNsxtVMTagsCreate(d *schema.ResourceData, m interface{}) error {
 	if len(portTags) > 0 || d.HasChange("logical_port_tag") {
 		err = updatePortTags(nsxClient, vm.ExternalId, portTags)
 		if err != nil {
-			return err
+			return getOperationDiagnostics(m, err)
 		}
 	}
 
 	d.SetId(vm.ExternalId)
 
-	return resourceNsxtVMTagsRead(d, m)
+	return resourceNsxtVMTagsRead(ctx, d, m)
 }
 
-func resourceNsxtVMTagsRead(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtVMTagsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return getErrorDiagnostics(resourceNotSupportedError())
 	}
 
 	id := d.Id()
 	if id == "" {
-		return fmt.Errorf("Error obtaining logical object id")
+		return diag.Errorf("Error obtaining logical object id")
 	}
 
 	vm, err := findVMByExternalID(nsxClient, id)
 	if err != nil {
-		return fmt.Errorf("Error during VM retrieval: %v", err)
+		return diag.Errorf("Error during VM retrieval: %v", err)
 	}
 
 	ports, err := findPortsByExternalID(nsxClient, id)
 	if err != nil {
-		return fmt.Errorf("Error during logical port retrieval: %v", err)
+		return diag.Errorf("Error during logical port retrieval: %v", err)
 	}
 
 	setTagsInSchema(d, vm.Tags)
@@ -276,21 +278,21 @@ func resourceNsxtVMTagsRead(d *schema.ResourceData, m interface{}) error {
 	return nil
 }
 
-func resourceNsxtVMTagsUpdate(d *schema.ResourceData, m interface{}) error {
-	return resourceNsxtVMTagsCreate(d, m)
+func resourceNsxtVMTagsUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	return resourceNsxtVMTagsCreate(ctx, d, m)
 }
 
-func resourceNsxtVMTagsDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtVMTagsDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	instanceID := d.Get("instance_id").(string)
 
 	nsxClient := m.(nsxtClients).NsxtClient
 	if nsxClient == nil {
-		return resourceNotSupportedError()
+		return getErrorDiagnostics(resourceNotSupportedError())
 	}
 
 	vm, err := findVMIDByLocalID(nsxClient, instanceID)
 	if err != nil {
-		return fmt.Errorf("Error during VM retrieval: %v", err)
+		return diag.Errorf("Error during VM retrieval: %v", err)
 	}
 
 	noTags := make([]common.Tag, 0)
@@ -299,7 +301,7 @@ func resourceNsxtVMTagsDelete(d *schema.ResourceData, m interface{}) error {
 		// Update tags only if they were configured by the provider
 		err = updateTags(nsxClient, vm.ExternalId, noTags)
 		if err != nil {
-			return err
+			return getOperationDiagnostics(m, err)
 		}
 	}
 
@@ -308,7 +310,7 @@ func resourceNsxtVMTagsDelete(d *schema.ResourceData, m interface{}) error {
 		// Update port tags only if they were configured by the provider
 		err := updatePortTags(nsxClient, vm.ExternalId, noTags)
 		if err != nil {
-			return err
+			return getOperationDiagnostics(m, err)
 		}
 	}
 
@@ -20,6 +20,9 @@ import (
 	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/segments"
 	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/tier_1s"
 	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+	project_infra "github.com/vmware/vsphere-automation-sdk-go/services/nsxt/orgs/projects/infra"
+	project_segments "github.com/vmware/vsphere-automation-sdk-go/services/nsxt/orgs/projects/infra/segments"
+	project_tier_1s "github.com/vmware/vsphere-automation-sdk-go/services/nsxt/orgs/projects/infra/tier_1s"
 )
 
 var connectivityValues = []string{
@@ -265,6 +268,7 @@ func getPolicyCommonSegmentSchema(vlanRequired bool, isFixed bool) map[string]*s
 		"description":  getDescriptionSchema(),
 		"revision":     getRevisionSchema(),
 		"tag":          getTagsSchema(),
+		"context":      getPolicyContextSchema(),
 		"advanced_config": {
 			Type:        schema.TypeList,
 			Description: "Advanced segment configuration",
@@ -661,7 +665,7 @@ func nsxtPolicySegmentAddGatewayToInfraStruct(d *schema.ResourceData, dataValue
 	gwPath := d.Get("connectivity_path").(string)
 	isT0, gwID := parseGatewayPolicyPath(gwPath)
 	if gwID == "" {
-		return nil, fmt.Errorf("connectivity_path is not a valid gateway path")
+		return nil, newAttributeError("connectivity_path", fmt.Errorf("connectivity_path is not a valid gateway path"))
 	}
 	if isT0 {
 		return nil, fmt.Errorf("Tier0 fixed segments are not supported")
@@ -695,7 +699,7 @@ func policySegmentResourceToInfraStruct(id string, d *schema.ResourceData, isVla
 	resourceType := "Segment"
 
 	if (tzPath == "") && !isGlobalManager && !isFixed {
-		return model.Infra{}, fmt.Errorf("transport_zone_path needs to be specified for infra segment on local manager")
+		return model.Infra{}, newAttributeError("transport_zone_path", fmt.Errorf("transport_zone_path needs to be specified for infra segment on local manager"))
 	}
 
 	obj := model.Segment{
@@ -870,13 +874,17 @@ func policySegmentResourceToInfraStruct(id string, d *schema.ResourceData, isVla
 }
 
 func resourceNsxtPolicySegmentExists(gwPath string, isFixed bool) func(id string, connector client.Connector, isGlobalManager bool) (bool, error) {
+	return resourceNsxtPolicySegmentExistsInContext("", gwPath, isFixed)
+}
+
+func resourceNsxtPolicySegmentExistsInContext(projectID string, gwPath string, isFixed bool) func(id string, connector client.Connector, isGlobalManager bool) (bool, error) {
 	return func(id string, connector client.Connector, isGlobalManager bool) (bool, error) {
 		var err error
 
 		if isGlobalManager {
 			_, err = nsxtPolicyGlobalManagerGetSegment(connector, id, gwPath, isFixed)
 		} else {
-			_, err = nsxtPolicyLocalManagerGetSegment(connector, id, gwPath, isFixed)
+			_, err = nsxtPolicyLocalManagerGetSegment(connector, projectID, id, gwPath, isFixed)
 		}
 		if err == nil {
 			return true, nil
@@ -1163,6 +1171,13 @@ func nsxtPolicySegmentDiscoveryProfileRead(d *schema.ResourceData, m interface{}
 			return err
 		}
 		results = lmResults.(model.SegmentDiscoveryProfileBindingMapListResult)
+	} else if projectID := getPolicyProjectID(d); projectID != "" {
+		client := project_segments.NewSegmentDiscoveryProfileBindingMapsClient(connector)
+		var err error
+		results, err = client.List(defaultOrgID, projectID, segmentID, nil, nil, nil, nil, nil, nil)
+		if err != nil {
+			return fmt.Errorf(errorMessage, segmentID, err)
+		}
 	} else {
 		client := segments.NewSegmentDiscoveryProfileBindingMapsClient(connector)
 		var err error
@@ -1204,6 +1219,13 @@ func nsxtPolicySegmentQosProfileRead(d *schema.ResourceData, m interface{}) erro
 			return err
 		}
 		results = lmResults.(model.SegmentQosProfileBindingMapListResult)
+	} else if projectID := getPolicyProjectID(d); projectID != "" {
+		client := project_segments.NewSegmentQosProfileBindingMapsClient(connector)
+		var err error
+		results, err = client.List(defaultOrgID, projectID, segmentID, nil, nil, nil, nil, nil)
+		if err != nil {
+			return fmt.Errorf(errorMessage, segmentID, err)
+		}
 	} else {
 		client := segments.NewSegmentQosProfileBindingMapsClient(connector)
 		var err error
@@ -1244,6 +1266,13 @@ func nsxtPolicySegmentSecurityProfileRead(d *schema.ResourceData, m interface{})
 			return err
 		}
 		results = lmResults.(model.SegmentSecurityProfileBindingMapListResult)
+	} else if projectID := getPolicyProjectID(d); projectID != "" {
+		client := project_segments.NewSegmentSecurityProfileBindingMapsClient(connector)
+		var err error
+		results, err = client.List(defaultOrgID, projectID, segmentID, nil, nil, nil, nil, nil)
+		if err != nil {
+			return fmt.Errorf(errorMessage, segmentID, err)
+		}
 	} else {
 		client := segments.NewSegmentSecurityProfileBindingMapsClient(connector)
 		var err error
@@ -1305,19 +1334,25 @@ func setSegmentBridgeConfigInSchema(d *schema.ResourceData, obj *model.Segment)
 	d.Set("bridge_config", configs)
 }
 
-func nsxtPolicyLocalManagerGetSegment(connector client.Connector, id string, gwPath string, isFixed bool) (model.Segment, error) {
+func nsxtPolicyLocalManagerGetSegment(connector client.Connector, projectID string, id string, gwPath string, isFixed bool) (model.Segment, error) {
 	if !isFixed {
+		if projectID != "" {
+			return project_infra.NewSegmentsClient(connector).Get(defaultOrgID, projectID, id)
+		}
 		return infra.NewSegmentsClient(connector).Get(id)
 	}
 
 	isT0, gwID := parseGatewayPolicyPath(gwPath)
 	if gwID == "" {
-		return model.Segment{}, fmt.Errorf("connectivity_path is not a valid gateway path")
+		return model.Segment{}, newAttributeError("connectivity_path", fmt.Errorf("connectivity_path is not a valid gateway path"))
 	}
 	if isT0 {
 		return model.Segment{}, fmt.Errorf("Tier-0 fixed segments are not supported")
 	}
 
+	if projectID != "" {
+		return project_tier_1s.NewSegmentsClient(connector).Get(defaultOrgID, projectID, gwID, id)
+	}
 	return tier_1s.NewSegmentsClient(connector).Get(gwID, id)
 }
 
@@ -1330,7 +1365,7 @@ func nsxtPolicyGlobalManagerGetSegment(connector client.Connector, id string, gw
 	} else {
 		isT0, gwID := parseGatewayPolicyPath(gwPath)
 		if gwID == "" {
-			return model.Segment{}, fmt.Errorf("connectivity_path is not a valid gateway path")
+			return model.Segment{}, newAttributeError("connectivity_path", fmt.Errorf("connectivity_path is not a valid gateway path"))
 		}
 		if isT0 {
 			return model.Segment{}, fmt.Errorf("Tier-0 fixed segments are not supported")
@@ -1367,7 +1402,7 @@ func nsxtPolicySegmentRead(d *schema.ResourceData, m interface{}, isVlan bool, i
 	if isPolicyGlobalManager(m) {
 		obj, err = nsxtPolicyGlobalManagerGetSegment(connector, id, gwPath, isFixed)
 	} else {
-		obj, err = nsxtPolicyLocalManagerGetSegment(connector, id, gwPath, isFixed)
+		obj, err = nsxtPolicyLocalManagerGetSegment(connector, getPolicyProjectID(d), id, gwPath, isFixed)
 	}
 
 	if err != nil {
@@ -1379,6 +1414,9 @@ func nsxtPolicySegmentRead(d *schema.ResourceData, m interface{}, isVlan bool, i
 	setPolicyTagsInSchema(d, obj.Tags)
 	d.Set("nsx_id", id)
 	d.Set("path", obj.Path)
+	if obj.Path != nil {
+		setPolicyProjectIDInSchema(d, getPolicyProjectIDFromPath(*obj.Path))
+	}
 	d.Set("revision", obj.Revision)
 	if !isVlan {
 		d.Set("connectivity_path", obj.ConnectivityPath)
@@ -1387,7 +1425,7 @@ func nsxtPolicySegmentRead(d *schema.ResourceData, m interface{}, isVlan bool, i
 	d.Set("domain_name", obj.DomainName)
 	d.Set("transport_zone_path", obj.TransportZonePath)
 
-	d.Set("vlan_ids", obj.VlanIds)
+	d.Set("vlan_ids", getStringListOrderedByState(d.Get("vlan_ids").([]interface{}), obj.VlanIds))
 	if !isVlan {
 		if obj.OverlayId != nil {
 			d.Set("overlay_id", int(*obj.OverlayId))
@@ -1431,7 +1469,11 @@ func nsxtPolicySegmentRead(d *schema.ResourceData, m interface{}, isVlan bool, i
 
 	if obj.L2Extension != nil {
 		l2Ext := make(map[string]interface{})
-		l2Ext["l2vpn_paths"] = obj.L2Extension.L2vpnPaths
+		var currentL2vpnPaths []interface{}
+		if currentL2Ext := d.Get("l2_extension").([]interface{}); len(currentL2Ext) > 0 && currentL2Ext[0] != nil {
+			currentL2vpnPaths = currentL2Ext[0].(map[string]interface{})["l2vpn_paths"].([]interface{})
+		}
+		l2Ext["l2vpn_paths"] = getStringListOrderedByState(currentL2vpnPaths, obj.L2Extension.L2vpnPaths)
 		l2Ext["tunnel_id"] = obj.L2Extension.TunnelId
 		// This is a list with 1 element
 		var l2ExtList []map[string]interface{}
@@ -1439,10 +1481,32 @@ func nsxtPolicySegmentRead(d *schema.ResourceData, m interface{}, isVlan bool, i
 		d.Set("l2_extension", l2ExtList)
 	}
 
+	// Subnets are identified by gateway address
+	currentSubnets := d.Get("subnet").([]interface{})
+	getSubnetKey := func(subnet interface{}) string {
+		if subnet == nil {
+			return ""
+		}
+		switch cidr := subnet.(map[string]interface{})["cidr"].(type) {
+		case string:
+			return cidr
+		case *string:
+			if cidr != nil {
+				return *cidr
+			}
+		}
+		return ""
+	}
 	var subnetSegments []interface{}
 	for _, subnetSeg := range obj.Subnets {
 		seg := make(map[string]interface{})
-		seg["dhcp_ranges"] = subnetSeg.DhcpRanges
+		var currentDhcpRanges []interface{}
+		for _, currentSubnet := range currentSubnets {
+			if subnetSeg.GatewayAddress != nil && getSubnetKey(currentSubnet) == *subnetSeg.GatewayAddress {
+				currentDhcpRanges = currentSubnet.(map[string]interface{})["dhcp_ranges"].([]interface{})
+			}
+		}
+		seg["dhcp_ranges"] = getStringListOrderedByState(currentDhcpRanges, subnetSeg.DhcpRanges)
 		seg["cidr"] = subnetSeg.GatewayAddress
 		seg["network"] = subnetSeg.Network
 		err := setSegmentSubnetDhcpConfigInSchema(seg, subnetSeg)
@@ -1452,7 +1516,7 @@ func nsxtPolicySegmentRead(d *schema.ResourceData, m interface{}, isVlan bool, i
 		subnetSegments = append(subnetSegments, seg)
 	}
 
-	d.Set("subnet", subnetSegments)
+	d.Set("subnet", getListOrderedByState(currentSubnets, subnetSegments, getSubnetKey))
 
 	if !isFixed {
 		err = nsxtPolicySegmentProfilesRead(d, m)
@@ -1476,7 +1540,17 @@ func nsxtPolicySegmentCreate(d *schema.ResourceData, m interface{}, isVlan bool,
 		gwPath = d.Get("connectivity_path").(string)
 	}
 
-	id, err := getOrGenerateID(d, m, resourceNsxtPolicySegmentExists(gwPath, isFixed))
+	projectID := getPolicyProjectID(d)
+	err := validatePolicyProjectContext(projectID, m)
+	if err != nil {
+		return err
+	}
+	err = validatePolicyPathInContext("connectivity_path", gwPath, projectID)
+	if err != nil {
+		return err
+	}
+
+	id, err := getOrGenerateID(d, m, resourceNsxtPolicySegmentExistsInContext(projectID, gwPath, isFixed))
 	if err != nil {
 		return err
 	}
@@ -1486,7 +1560,7 @@ func nsxtPolicySegmentCreate(d *schema.ResourceData, m interface{}, isVlan bool,
 		return err
 	}
 
-	err = policyInfraPatch(obj, isPolicyGlobalManager(m), getPolicyConnector(m), false)
+	err = policyInfraPatchWithContext(projectID, obj, isPolicyGlobalManager(m), getPolicyConnector(m), false)
 	if err != nil {
 		return handleCreateError("Segment", id, err)
 	}
@@ -1504,12 +1578,19 @@ func nsxtPolicySegmentUpdate(d *schema.ResourceData, m interface{}, isVlan bool,
 		return fmt.Errorf("Error obtaining Segment ID")
 	}
 
+	if !isVlan {
+		err := validatePolicyPathInContext("connectivity_path", d.Get("connectivity_path").(string), getPolicyProjectID(d))
+		if err != nil {
+			return err
+		}
+	}
+
 	obj, err := policySegmentResourceToInfraStruct(id, d, isVlan, isFixed, isPolicyGlobalManager(m))
 	if err != nil {
 		return err
 	}
 
-	err = policyInfraPatch(obj, isPolicyGlobalManager(m), getPolicyConnector(m), true)
+	err = policyInfraPatchWithContext(getPolicyProjectID(d), obj, isPolicyGlobalManager(m), getPolicyConnector(m), true)
 	if err != nil {
 		return handleCreateError("Segment", id, err)
 	}
@@ -1545,6 +1626,14 @@ func nsxtPolicySegmentDelete(d *schema.ResourceData, m interface{}, isFixed bool
 				}
 				numOfPorts = len(gmPorts.Results)
 				ports = gmPorts
+			} else if projectID := getPolicyProjectID(d); projectID != "" {
+				portsClient := project_segments.NewPortsClient(connector)
+				projectPorts, err := portsClient.List(defaultOrgID, projectID, id, nil, nil, nil, nil, nil, nil)
+				if err != nil {
+					return projectPorts, "error", logAPIError("Error listing segment ports", err)
+				}
+				numOfPorts = len(projectPorts.Results)
+				ports = projectPorts
 			} else {
 				portsClient := segments.NewPortsClient(connector)
 				lmPorts, err := portsClient.List(id, nil, nil, nil, nil, nil, nil)
@@ -1568,7 +1657,7 @@ func nsxtPolicySegmentDelete(d *schema.ResourceData, m interface{}, isFixed bool
 		Delay:      1 * time.Second,
 	}
 	if !isFixed {
-		_, err := stateConf.WaitForState()
+		_, err := stateConf.WaitForStateContext(getProviderContext(m))
 		if err != nil {
 			return fmt.Errorf("Failed to get port information for segment %s: %v", id, err)
 		}
@@ -1613,7 +1702,7 @@ func nsxtPolicySegmentDelete(d *schema.ResourceData, m interface{}, isFixed bool
 	}
 
 	log.Printf("[DEBUG] Using H-API to delete segment with ID %s", id)
-	err := policyInfraPatch(infraObj, isPolicyGlobalManager(m), getPolicyConnector(m), false)
+	err := policyInfraPatchWithContext(getPolicyProjectID(d), infraObj, isPolicyGlobalManager(m), getPolicyConnector(m), false)
 	if err != nil {
 		return handleDeleteError("Segment", id, err)
 	}
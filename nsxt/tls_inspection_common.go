@@ -0,0 +1,428 @@
+/* Copyright © 2023 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/bindings"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/data"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+var tlsInspectionProfileTLSVersionValues = []string{
+	model.TlsInspectionExternalProfile_CLIENT_MIN_TLS_VERSION_0,
+	model.TlsInspectionExternalProfile_CLIENT_MIN_TLS_VERSION_1,
+	model.TlsInspectionExternalProfile_CLIENT_MIN_TLS_VERSION_2,
+}
+
+var tlsInspectionProfileCipherSuiteValues = []string{
+	model.TlsInspectionExternalProfile_CLIENT_CIPHER_SUITE_ECDHE_RSA_WITH_AES_128_GCM_SHA256,
+	model.TlsInspectionExternalProfile_CLIENT_CIPHER_SUITE_ECDHE_RSA_WITH_AES_256_GCM_SHA384,
+	model.TlsInspectionExternalProfile_CLIENT_CIPHER_SUITE_ECDHE_RSA_WITH_AES_128_CBC_SHA,
+	model.TlsInspectionExternalProfile_CLIENT_CIPHER_SUITE_ECDHE_RSA_WITH_AES_256_CBC_SHA,
+	model.TlsInspectionExternalProfile_CLIENT_CIPHER_SUITE_ECDHE_RSA_WITH_AES_128_CBC_SHA256,
+	model.TlsInspectionExternalProfile_CLIENT_CIPHER_SUITE_ECDHE_RSA_WITH_AES_256_CBC_SHA384,
+	model.TlsInspectionExternalProfile_CLIENT_CIPHER_SUITE_RSA_WITH_AES_128_GCM_SHA256,
+	model.TlsInspectionExternalProfile_CLIENT_CIPHER_SUITE_RSA_WITH_AES_128_CBC_SHA256,
+	model.TlsInspectionExternalProfile_CLIENT_CIPHER_SUITE_RSA_WITH_AES_256_GCM_SHA384,
+	model.TlsInspectionExternalProfile_CLIENT_CIPHER_SUITE_RSA_WITH_AES_256_CBC_SHA256,
+	model.TlsInspectionExternalProfile_CLIENT_CIPHER_SUITE_RSA_WITH_AES_256_CBC_SHA,
+	model.TlsInspectionExternalProfile_CLIENT_CIPHER_SUITE_RSA_WITH_AES_128_CBC_SHA,
+}
+
+var tlsInspectionProfileConfigSettingValues = []string{
+	model.TlsInspectionExternalProfile_TLS_CONFIG_SETTING_BALANCED,
+	model.TlsInspectionExternalProfile_TLS_CONFIG_SETTING_HIGH_FIDELITY,
+	model.TlsInspectionExternalProfile_TLS_CONFIG_SETTING_HIGH_SECURITY,
+	model.TlsInspectionExternalProfile_TLS_CONFIG_SETTING_CUSTOM,
+}
+
+var tlsInspectionProfileCryptoEnforcementValues = []string{
+	model.TlsInspectionExternalProfile_CRYPTO_ENFORCEMENT_ENFORCE,
+	model.TlsInspectionExternalProfile_CRYPTO_ENFORCEMENT_TRANSPARENT,
+}
+
+var tlsInspectionProfileDecryptionFailActionValues = []string{
+	model.TlsInspectionExternalProfile_DECRYPTION_FAIL_ACTION_BLOCK,
+	model.TlsInspectionExternalProfile_DECRYPTION_FAIL_ACTION_BYPASS,
+}
+
+var tlsInspectionProfileInvalidCertActionValues = []string{
+	model.TlsInspectionExternalProfile_INVALID_CERT_ACTION_BLOCK,
+	model.TlsInspectionExternalProfile_INVALID_CERT_ACTION_ALLOW,
+}
+
+func getTLSInspectionProfileVersionSchema(description string) *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeString,
+		Description:  description,
+		Optional:     true,
+		Computed:     true,
+		ValidateFunc: validation.StringInSlice(tlsInspectionProfileTLSVersionValues, false),
+	}
+}
+
+func getTLSInspectionProfileCipherSuiteSchema(description string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeSet,
+		Description: description,
+		Optional:    true,
+		Computed:    true,
+		Elem: &schema.Schema{
+			Type:         schema.TypeString,
+			ValidateFunc: validation.StringInSlice(tlsInspectionProfileCipherSuiteValues, false),
+		},
+	}
+}
+
+func getTLSInspectionProfilePathSetSchema(required bool, description string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeSet,
+		Description: description,
+		Optional:    !required,
+		Required:    required,
+		Elem:        getElemPolicyPathSchema(),
+	}
+}
+
+func getPolicyTLSInspectionProfileSchema(isExternal bool) map[string]*schema.Schema {
+	result := map[string]*schema.Schema{
+		"nsx_id":       getNsxIDSchema(),
+		"path":         getPathSchema(),
+		"display_name": getDisplayNameSchema(),
+		"description":  getDescriptionSchema(),
+		"revision":     getRevisionSchema(),
+		"tag":          getTagsSchema(),
+		"tls_config_setting": {
+			Type:         schema.TypeString,
+			Description:  "TLS security level, CUSTOM allows to configure TLS versions and cipher suites",
+			Optional:     true,
+			Default:      model.TlsInspectionExternalProfile_TLS_CONFIG_SETTING_BALANCED,
+			ValidateFunc: validation.StringInSlice(tlsInspectionProfileConfigSettingValues, false),
+		},
+		"client_min_tls_version": getTLSInspectionProfileVersionSchema("Minimal TLS version for client connection"),
+		"client_max_tls_version": getTLSInspectionProfileVersionSchema("Maximal TLS version for client connection"),
+		"server_min_tls_version": getTLSInspectionProfileVersionSchema("Minimal TLS version for server connection"),
+		"server_max_tls_version": getTLSInspectionProfileVersionSchema("Maximal TLS version for server connection"),
+		"client_cipher_suite":    getTLSInspectionProfileCipherSuiteSchema("Cipher suites for client connection"),
+		"server_cipher_suite":    getTLSInspectionProfileCipherSuiteSchema("Cipher suites for server connection"),
+		"crypto_enforcement": {
+			Type:         schema.TypeString,
+			Description:  "Whether to enforce configured TLS versions and cipher suites",
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.StringInSlice(tlsInspectionProfileCryptoEnforcementValues, false),
+		},
+		"decryption_fail_action": {
+			Type:         schema.TypeString,
+			Description:  "Action to take when decryption fails",
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.StringInSlice(tlsInspectionProfileDecryptionFailActionValues, false),
+		},
+		"ocsp_must_staple": {
+			Type:        schema.TypeBool,
+			Description: "Whether OCSP stapling is mandatory",
+			Optional:    true,
+			Default:     false,
+		},
+		"idle_connection_timeout": {
+			Type:         schema.TypeInt,
+			Description:  "Timeout in seconds for idle TLS connections",
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.IntAtLeast(1),
+		},
+		"trusted_ca_bundles": getTLSInspectionProfilePathSetSchema(false, "Policy paths of trusted CA bundles"),
+		"crls":               getTLSInspectionProfilePathSetSchema(false, "Policy paths of certificate revocation lists"),
+	}
+
+	if isExternal {
+		result["invalid_cert_action"] = &schema.Schema{
+			Type:         schema.TypeString,
+			Description:  "Action to take when server certificate is invalid",
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.StringInSlice(tlsInspectionProfileInvalidCertActionValues, false),
+		}
+		result["proxy_trusted_ca_cert"] = getPolicyPathSchema(true, false, "Policy path of CA certificate used to sign proxy certificates for trusted servers")
+		result["proxy_untrusted_ca_cert"] = getPolicyPathSchema(false, false, "Policy path of CA certificate used to sign proxy certificates for untrusted servers")
+	} else {
+		result["server_certs_key"] = getTLSInspectionProfilePathSetSchema(true, "Policy paths of server certificates and keys")
+		result["default_cert_key"] = getPolicyPathSchema(false, false, "Policy path of default server certificate and key")
+		result["certificate_validation"] = &schema.Schema{
+			Type:        schema.TypeBool,
+			Description: "Whether server certificate validation is enabled",
+			Optional:    true,
+			Default:     false,
+		}
+	}
+
+	return result
+}
+
+func resourceNsxtPolicyTLSInspectionProfileExists(id string, connector client.Connector, isGlobalManager bool) (bool, error) {
+	client := infra.NewTlsInspectionActionProfilesClient(connector)
+	_, err := client.Get(id)
+	if err == nil {
+		return true, nil
+	}
+
+	if isNotFoundError(err) {
+		return false, nil
+	}
+
+	return false, logAPIError("Error retrieving TLS Inspection Profile", err)
+}
+
+func getOptionalStringFromSchema(d *schema.ResourceData, attrName string) *string {
+	value := d.Get(attrName).(string)
+	if value == "" {
+		return nil
+	}
+
+	return &value
+}
+
+func policyTLSInspectionProfileSchemaToStructValue(d *schema.ResourceData, id string, isExternal bool) (*data.StructValue, error) {
+	converter := bindings.NewTypeConverter()
+
+	displayName := d.Get("display_name").(string)
+	description := d.Get("description").(string)
+	tags := getPolicyTagsFromSchema(d)
+	tlsConfigSetting := d.Get("tls_config_setting").(string)
+	ocspMustStaple := d.Get("ocsp_must_staple").(bool)
+	clientCipherSuite := getStringListFromSchemaSet(d, "client_cipher_suite")
+	serverCipherSuite := getStringListFromSchemaSet(d, "server_cipher_suite")
+	trustedCaBundles := getStringListFromSchemaSet(d, "trusted_ca_bundles")
+	crls := getStringListFromSchemaSet(d, "crls")
+	var idleConnectionTimeout *int64
+	if timeout := int64(d.Get("idle_connection_timeout").(int)); timeout > 0 {
+		idleConnectionTimeout = &timeout
+	}
+
+	var obj interface{}
+	var bindingType bindings.BindingType
+	if isExternal {
+		obj = model.TlsInspectionExternalProfile{
+			Id:                    &id,
+			DisplayName:           &displayName,
+			Description:           &description,
+			Tags:                  tags,
+			ResourceType:          model.TlsInspectionExternalProfile__TYPE_IDENTIFIER,
+			TlsConfigSetting:      &tlsConfigSetting,
+			ClientMinTlsVersion:   getOptionalStringFromSchema(d, "client_min_tls_version"),
+			ClientMaxTlsVersion:   getOptionalStringFromSchema(d, "client_max_tls_version"),
+			ServerMinTlsVersion:   getOptionalStringFromSchema(d, "server_min_tls_version"),
+			ServerMaxTlsVersion:   getOptionalStringFromSchema(d, "server_max_tls_version"),
+			ClientCipherSuite:     clientCipherSuite,
+			ServerCipherSuite:     serverCipherSuite,
+			CryptoEnforcement:     getOptionalStringFromSchema(d, "crypto_enforcement"),
+			DecryptionFailAction:  getOptionalStringFromSchema(d, "decryption_fail_action"),
+			OcspMustStaple:        &ocspMustStaple,
+			IdleConnectionTimeout: idleConnectionTimeout,
+			TrustedCaBundles:      trustedCaBundles,
+			Crls:                  crls,
+			InvalidCertAction:     getOptionalStringFromSchema(d, "invalid_cert_action"),
+			ProxyTrustedCaCert:    getOptionalStringFromSchema(d, "proxy_trusted_ca_cert"),
+			ProxyUntrustedCaCert:  getOptionalStringFromSchema(d, "proxy_untrusted_ca_cert"),
+		}
+		bindingType = model.TlsInspectionExternalProfileBindingType()
+	} else {
+		certValidation := d.Get("certificate_validation").(bool)
+		obj = model.TlsInspectionInternalProfile{
+			Id:                    &id,
+			DisplayName:           &displayName,
+			Description:           &description,
+			Tags:                  tags,
+			ResourceType:          model.TlsInspectionInternalProfile__TYPE_IDENTIFIER,
+			TlsConfigSetting:      &tlsConfigSetting,
+			ClientMinTlsVersion:   getOptionalStringFromSchema(d, "client_min_tls_version"),
+			ClientMaxTlsVersion:   getOptionalStringFromSchema(d, "client_max_tls_version"),
+			ServerMinTlsVersion:   getOptionalStringFromSchema(d, "server_min_tls_version"),
+			ServerMaxTlsVersion:   getOptionalStringFromSchema(d, "server_max_tls_version"),
+			ClientCipherSuite:     clientCipherSuite,
+			ServerCipherSuite:     serverCipherSuite,
+			CryptoEnforcement:     getOptionalStringFromSchema(d, "crypto_enforcement"),
+			DecryptionFailAction:  getOptionalStringFromSchema(d, "decryption_fail_action"),
+			OcspMustStaple:        &ocspMustStaple,
+			IdleConnectionTimeout: idleConnectionTimeout,
+			TrustedCaBundles:      trustedCaBundles,
+			Crls:                  crls,
+			ServerCertsKey:        getStringListFromSchemaSet(d, "server_certs_key"),
+			DefaultCertKey:        getOptionalStringFromSchema(d, "default_cert_key"),
+			CertificateValidation: &certValidation,
+		}
+		bindingType = model.TlsInspectionInternalProfileBindingType()
+	}
+
+	dataValue, errs := converter.ConvertToVapi(obj, bindingType)
+	if errs != nil {
+		return nil, fmt.Errorf("Error converting TLS Inspection Profile: %v", errs[0])
+	}
+
+	return dataValue.(*data.StructValue), nil
+}
+
+func policyTLSInspectionProfileCreate(d *schema.ResourceData, m interface{}, isExternal bool) error {
+	if isPolicyGlobalManager(m) {
+		return policyResourceNotSupportedError()
+	}
+
+	connector := getPolicyConnector(m)
+	id, err := getOrGenerateID(d, m, resourceNsxtPolicyTLSInspectionProfileExists)
+	if err != nil {
+		return err
+	}
+
+	dataValue, err := policyTLSInspectionProfileSchemaToStructValue(d, id, isExternal)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Creating TLS Inspection Profile with ID %s", id)
+	client := infra.NewTlsInspectionActionProfilesClient(connector)
+	_, err = client.Patch(id, dataValue)
+	if err != nil {
+		return handleCreateError("TLS Inspection Profile", id, err)
+	}
+
+	d.SetId(id)
+	d.Set("nsx_id", id)
+
+	return policyTLSInspectionProfileRead(d, m, isExternal)
+}
+
+func policyTLSInspectionProfileRead(d *schema.ResourceData, m interface{}, isExternal bool) error {
+	connector := getPolicyConnector(m)
+	converter := bindings.NewTypeConverter()
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining TLS Inspection Profile ID")
+	}
+
+	client := infra.NewTlsInspectionActionProfilesClient(connector)
+	profileData, err := client.Get(id)
+	if err != nil {
+		return handleReadError(d, "TLS Inspection Profile", id, err)
+	}
+
+	if isExternal {
+		profile, errs := converter.ConvertToGolang(profileData, model.TlsInspectionExternalProfileBindingType())
+		if len(errs) > 0 {
+			return fmt.Errorf("Error converting TLS Inspection Profile %s", errs[0])
+		}
+		obj := profile.(model.TlsInspectionExternalProfile)
+		if obj.ResourceType != model.TlsInspectionExternalProfile__TYPE_IDENTIFIER {
+			return fmt.Errorf("TLS Inspection Profile %s is of type %s, expected %s", id, obj.ResourceType, model.TlsInspectionExternalProfile__TYPE_IDENTIFIER)
+		}
+
+		d.Set("display_name", obj.DisplayName)
+		d.Set("description", obj.Description)
+		setPolicyTagsInSchema(d, obj.Tags)
+		d.Set("nsx_id", id)
+		d.Set("path", obj.Path)
+		d.Set("revision", obj.Revision)
+		d.Set("tls_config_setting", obj.TlsConfigSetting)
+		d.Set("client_min_tls_version", obj.ClientMinTlsVersion)
+		d.Set("client_max_tls_version", obj.ClientMaxTlsVersion)
+		d.Set("server_min_tls_version", obj.ServerMinTlsVersion)
+		d.Set("server_max_tls_version", obj.ServerMaxTlsVersion)
+		d.Set("client_cipher_suite", obj.ClientCipherSuite)
+		d.Set("server_cipher_suite", obj.ServerCipherSuite)
+		d.Set("crypto_enforcement", obj.CryptoEnforcement)
+		d.Set("decryption_fail_action", obj.DecryptionFailAction)
+		d.Set("ocsp_must_staple", obj.OcspMustStaple)
+		d.Set("idle_connection_timeout", obj.IdleConnectionTimeout)
+		d.Set("trusted_ca_bundles", obj.TrustedCaBundles)
+		d.Set("crls", obj.Crls)
+		d.Set("invalid_cert_action", obj.InvalidCertAction)
+		d.Set("proxy_trusted_ca_cert", obj.ProxyTrustedCaCert)
+		d.Set("proxy_untrusted_ca_cert", obj.ProxyUntrustedCaCert)
+
+		return nil
+	}
+
+	profile, errs := converter.ConvertToGolang(profileData, model.TlsInspectionInternalProfileBindingType())
+	if len(errs) > 0 {
+		return fmt.Errorf("Error converting TLS Inspection Profile %s", errs[0])
+	}
+	obj := profile.(model.TlsInspectionInternalProfile)
+	if obj.ResourceType != model.TlsInspectionInternalProfile__TYPE_IDENTIFIER {
+		return fmt.Errorf("TLS Inspection Profile %s is of type %s, expected %s", id, obj.ResourceType, model.TlsInspectionInternalProfile__TYPE_IDENTIFIER)
+	}
+
+	d.Set("display_name", obj.DisplayName)
+	d.Set("description", obj.Description)
+	setPolicyTagsInSchema(d, obj.Tags)
+	d.Set("nsx_id", id)
+	d.Set("path", obj.Path)
+	d.Set("revision", obj.Revision)
+	d.Set("tls_config_setting", obj.TlsConfigSetting)
+	d.Set("client_min_tls_version", obj.ClientMinTlsVersion)
+	d.Set("client_max_tls_version", obj.ClientMaxTlsVersion)
+	d.Set("server_min_tls_version", obj.ServerMinTlsVersion)
+	d.Set("server_max_tls_version", obj.ServerMaxTlsVersion)
+	d.Set("client_cipher_suite", obj.ClientCipherSuite)
+	d.Set("server_cipher_suite", obj.ServerCipherSuite)
+	d.Set("crypto_enforcement", obj.CryptoEnforcement)
+	d.Set("decryption_fail_action", obj.DecryptionFailAction)
+	d.Set("ocsp_must_staple", obj.OcspMustStaple)
+	d.Set("idle_connection_timeout", obj.IdleConnectionTimeout)
+	d.Set("trusted_ca_bundles", obj.TrustedCaBundles)
+	d.Set("crls", obj.Crls)
+	d.Set("server_certs_key", obj.ServerCertsKey)
+	d.Set("default_cert_key", obj.DefaultCertKey)
+	d.Set("certificate_validation", obj.CertificateValidation)
+
+	return nil
+}
+
+func policyTLSInspectionProfileUpdate(d *schema.ResourceData, m interface{}, isExternal bool) error {
+	connector := getPolicyConnector(m)
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining TLS Inspection Profile ID")
+	}
+
+	dataValue, err := policyTLSInspectionProfileSchemaToStructValue(d, id, isExternal)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Updating TLS Inspection Profile with ID %s", id)
+	client := infra.NewTlsInspectionActionProfilesClient(connector)
+	_, err = client.Patch(id, dataValue)
+	if err != nil {
+		return handleUpdateError("TLS Inspection Profile", id, err)
+	}
+
+	return policyTLSInspectionProfileRead(d, m, isExternal)
+}
+
+func policyTLSInspectionProfileDelete(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining TLS Inspection Profile ID")
+	}
+
+	log.Printf("[INFO] Deleting TLS Inspection Profile with ID %s", id)
+	client := infra.NewTlsInspectionActionProfilesClient(connector)
+	err := client.Delete(id)
+	if err != nil {
+		return handleDeleteError("TLS Inspection Profile", id, err)
+	}
+
+	return nil
+}
@@ -156,6 +156,34 @@ func getTestLBServiceName() string {
 	return os.Getenv("NSXT_TEST_LB_SERVICE_NAME")
 }
 
+func getTestLdapHost() string {
+	return os.Getenv("NSXT_TEST_LDAP_HOST")
+}
+
+func getTestLdapUser() string {
+	return os.Getenv("NSXT_TEST_LDAP_USER")
+}
+
+func getTestLdapPassword() string {
+	return os.Getenv("NSXT_TEST_LDAP_PASSWORD")
+}
+
+func getTestLdapDomain() string {
+	return os.Getenv("NSXT_TEST_LDAP_DOMAIN")
+}
+
+func getTestLdapBaseDN() string {
+	return os.Getenv("NSXT_TEST_LDAP_BASE_DN")
+}
+
+func testAccNSXLdapPrecheck(t *testing.T) {
+	testAccEnvDefined(t, "NSXT_TEST_LDAP_HOST")
+	testAccEnvDefined(t, "NSXT_TEST_LDAP_USER")
+	testAccEnvDefined(t, "NSXT_TEST_LDAP_PASSWORD")
+	testAccEnvDefined(t, "NSXT_TEST_LDAP_DOMAIN")
+	testAccEnvDefined(t, "NSXT_TEST_LDAP_BASE_DN")
+}
+
 func testAccEnvDefined(t *testing.T, envVar string) {
 	if len(os.Getenv(envVar)) == 0 {
 		t.Skipf("This test requires %s environment variable to be set", envVar)
@@ -0,0 +1,61 @@
+/* Copyright © 2023 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+// Exports existing NSX policy configuration into terraform configuration with
+// import blocks. Connection to NSX is configured via provider environment
+// variables, such as NSXT_MANAGER_HOST, NSXT_USERNAME and NSXT_PASSWORD.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/vmware/terraform-provider-nsxt/nsxt"
+)
+
+func main() {
+	if err := run(); err != nil {
+		fmt.Fprintf(os.Stderr, "%v\n", err)
+		os.Exit(1)
+	}
+}
+
+func run() (err error) {
+	resources := flag.String("resources", "", "Comma-separated list of resources to export, such as nsxt_policy_group (default all supported)")
+	filter := flag.String("filter", "", "Regular expression to filter exported objects on NSX")
+	output := flag.String("out", "", "Output file (default stdout)")
+	flag.Parse()
+
+	var resourceNames []string
+	if *resources != "" {
+		resourceNames = strings.Split(*resources, ",")
+	}
+
+	ctx := context.Background()
+	provider := nsxt.Provider()
+	diags := provider.Configure(ctx, terraform.NewResourceConfigRaw(map[string]interface{}{}))
+	if diags.HasError() {
+		return fmt.Errorf("Failed to configure provider: %s", diags[0].Summary)
+	}
+
+	var w io.Writer = os.Stdout
+	if *output != "" {
+		file, createErr := os.Create(*output)
+		if createErr != nil {
+			return fmt.Errorf("Failed to create %s: %v", *output, createErr)
+		}
+		defer func() {
+			if closeErr := file.Close(); closeErr != nil && err == nil {
+				err = fmt.Errorf("Failed to close %s: %v", *output, closeErr)
+			}
+		}()
+		w = file
+	}
+
+	return nsxt.ExportPolicyConfig(ctx, provider, resourceNames, *filter, w)
+}